@@ -0,0 +1,182 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	shell "github.com/ipfs/go-ipfs-api"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+)
+
+// addArchive adds the contents of a tar, tar.gz or zip archive to IPFS without
+// extracting it to disk and returns the CID of the directory holding them,
+// pinned if pin is set. The archive is read from stdin if pth is "-". Entries are added one
+// at a time and assembled into a directory tree in MFS, so paths within the
+// archive become paths below the root CID.
+func addArchive(sh *shell.Shell, pth string, pin bool) (string, error) {
+	var in io.Reader = os.Stdin
+	if pth != "-" {
+		f, err := os.Open(pth)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	ctx := context.Background()
+	mfsRoot := fmt.Sprintf("/amzn-archive-%d", time.Now().UnixNano())
+	if err := sh.FilesMkdir(ctx, mfsRoot, shell.FilesMkdir.Parents(true)); err != nil {
+		return "", err
+	}
+	defer sh.FilesRm(ctx, mfsRoot, true)
+	a := &archiveAdder{sh: sh, root: mfsRoot}
+
+	r := bufio.NewReader(in)
+	magic, err := r.Peek(4)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return "", err
+		}
+		err = a.addTar(gz)
+		gz.Close()
+		if err != nil {
+			return "", err
+		}
+	case bytes.Equal(magic, []byte("PK\x03\x04")), bytes.Equal(magic, []byte("PK\x05\x06")):
+		// A local file header, or the end of central directory record of
+		// an empty archive.
+		if err := a.addZip(r, pth); err != nil {
+			return "", err
+		}
+	default:
+		if err := a.addTar(r); err != nil {
+			return "", err
+		}
+	}
+
+	stat, err := sh.FilesStat(ctx, mfsRoot)
+	if err != nil {
+		return "", err
+	}
+	if !pin {
+		return stat.Hash, nil
+	}
+	if err := sh.Pin(stat.Hash); err != nil {
+		return "", err
+	}
+	return stat.Hash, nil
+}
+
+// archiveAdder adds the entries of an archive below a directory in MFS.
+type archiveAdder struct {
+	sh   *shell.Shell
+	root string
+}
+
+func (a *archiveAdder) addTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = a.mkdir(hdr.Name)
+		case tar.TypeReg, tar.TypeRegA:
+			err = a.addFile(hdr.Name, tr)
+		default:
+			log.Warningf("Skipping archive entry %s: unsupported type %c", hdr.Name, hdr.Typeflag)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// addZip adds the entries of a zip archive. Zip archives are read from their
+// central directory at the end, so one read from stdin is buffered to a
+// temporary file first.
+func (a *archiveAdder) addZip(r io.Reader, pth string) error {
+	if pth == "-" {
+		tmp, err := ioutil.TempFile("", "amzn-archive-*.zip")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		if _, err := io.Copy(tmp, r); err != nil {
+			return err
+		}
+		pth = tmp.Name()
+	}
+
+	zr, err := zip.OpenReader(pth)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			err = a.mkdir(f.Name)
+		case mode.IsRegular():
+			var rc io.ReadCloser
+			if rc, err = f.Open(); err == nil {
+				err = a.addFile(f.Name, rc)
+				rc.Close()
+			}
+		default:
+			log.Warningf("Skipping archive entry %s: unsupported mode %s", f.Name, mode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mfsPath maps the name of an archive entry to its path in MFS. Names are
+// cleaned so that entries can't escape the root with "..".
+func (a *archiveAdder) mfsPath(name string) string {
+	return path.Join(a.root, path.Clean("/"+name))
+}
+
+func (a *archiveAdder) mkdir(name string) error {
+	return a.sh.FilesMkdir(context.Background(), a.mfsPath(name), shell.FilesMkdir.Parents(true))
+}
+
+// addFile adds the contents of a file entry to IPFS and links it into the tree.
+// The file isn't pinned on its own since the tree holds it once it's linked.
+func (a *archiveAdder) addFile(name string, r io.Reader) error {
+	dst := a.mfsPath(name)
+	if dst == a.root {
+		return nil
+	}
+	hash, err := a.sh.Add(r, shell.Pin(false))
+	if err != nil {
+		return err
+	}
+	if err := a.sh.FilesMkdir(context.Background(), path.Dir(dst), shell.FilesMkdir.Parents(true)); err != nil {
+		return err
+	}
+	return a.sh.FilesCp(context.Background(), "/ipfs/"+hash, dst)
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeMFS is an IPFS node that keeps the files copied into MFS, named after
+// the content they were added with.
+type fakeMFS struct {
+	mtx     sync.Mutex
+	root    string
+	dirs    []string
+	files   map[string]string
+	pins    []string
+	removed []string
+}
+
+func newFakeMFS(t *testing.T) (*fakeMFS, map[string]http.HandlerFunc) {
+	m := &fakeMFS{files: make(map[string]string)}
+	return m, map[string]http.HandlerFunc{
+		"files/mkdir": func(w http.ResponseWriter, r *http.Request) {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+			dir := r.URL.Query().Get("arg")
+			if m.root == "" {
+				m.root = dir
+			}
+			m.dirs = append(m.dirs, dir)
+		},
+		"add": func(w http.ResponseWriter, r *http.Request) {
+			mr, err := r.MultipartReader()
+			if err != nil {
+				ipfsError(w, err.Error())
+				return
+			}
+			part, err := mr.NextPart()
+			if err != nil {
+				ipfsError(w, err.Error())
+				return
+			}
+			content, err := ioutil.ReadAll(part)
+			if err != nil {
+				ipfsError(w, err.Error())
+				return
+			}
+			if r.URL.Query().Get("pin") != "false" {
+				t.Errorf("added %q with pinning", content)
+			}
+			writeJSON(w, map[string]string{"Hash": "H" + string(content)})
+		},
+		"files/cp": func(w http.ResponseWriter, r *http.Request) {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+			args := r.URL.Query()["arg"]
+			m.files[args[1]] = strings.TrimPrefix(args[0], "/ipfs/H")
+		},
+		"files/stat": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, map[string]string{"Hash": "ROOT", "Type": "directory"})
+		},
+		"pin/add": func(w http.ResponseWriter, r *http.Request) {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+			m.pins = append(m.pins, r.URL.Query().Get("arg"))
+			writeJSON(w, map[string][]string{"Pins": {r.URL.Query().Get("arg")}})
+		},
+		"files/rm": func(w http.ResponseWriter, r *http.Request) {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+			m.removed = append(m.removed, r.URL.Query().Get("arg"))
+		},
+	}
+}
+
+// entries returns the files and directories made below the root, relative
+// to it.
+func (m *fakeMFS) entries() (map[string]string, []string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	files := make(map[string]string)
+	for pth, content := range m.files {
+		files[strings.TrimPrefix(pth, m.root)] = content
+	}
+	var dirs []string
+	for _, dir := range m.dirs {
+		if dir != m.root {
+			dirs = appendMissing(dirs, strings.TrimPrefix(dir, m.root))
+		}
+	}
+	sort.Strings(dirs)
+	return files, dirs
+}
+
+type archiveEntry struct {
+	name    string
+	content string
+	dir     bool
+	link    bool
+}
+
+var archiveEntries = []archiveEntry{
+	{name: "a/", dir: true},
+	{name: "a/b.txt", content: "b"},
+	{name: "./c.txt", content: "c"},
+	{name: "../escape.txt", content: "escape"},
+	{name: "/abs/d.txt", content: "d"},
+	{name: "a/../../e.txt", content: "e"},
+	{name: "link", link: true},
+}
+
+var wantArchiveFiles = map[string]string{
+	"/a/b.txt":    "b",
+	"/c.txt":      "c",
+	"/escape.txt": "escape",
+	"/abs/d.txt":  "d",
+	"/e.txt":      "e",
+}
+
+func writeTar(t *testing.T, w *bytes.Buffer) {
+	tw := tar.NewWriter(w)
+	for _, e := range archiveEntries {
+		hdr := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.content)), Typeflag: tar.TypeReg}
+		switch {
+		case e.dir:
+			hdr.Typeflag = tar.TypeDir
+		case e.link:
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = "a/b.txt"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeZip(t *testing.T, w *bytes.Buffer) {
+	zw := zip.NewWriter(w)
+	for _, e := range archiveEntries {
+		hdr := &zip.FileHeader{Name: e.name}
+		switch {
+		case e.dir:
+			hdr.SetMode(os.ModeDir | 0755)
+		case e.link:
+			hdr.SetMode(os.ModeSymlink | 0777)
+		default:
+			hdr.SetMode(0644)
+		}
+		fw, err := zw.CreateHeader(hdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(e.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddArchive(t *testing.T) {
+	formats := map[string]func(*testing.T, *bytes.Buffer){
+		"tar": writeTar,
+		"tar.gz": func(t *testing.T, w *bytes.Buffer) {
+			var tarball bytes.Buffer
+			writeTar(t, &tarball)
+			gz := gzip.NewWriter(w)
+			gz.Write(tarball.Bytes())
+			if err := gz.Close(); err != nil {
+				t.Fatal(err)
+			}
+		},
+		"zip": writeZip,
+	}
+	dir, err := ioutil.TempDir("", "amzn-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for format, write := range formats {
+		var buf bytes.Buffer
+		write(t, &buf)
+		pth := path.Join(dir, "archive."+format)
+		if err := ioutil.WriteFile(pth, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		m, commands := newFakeMFS(t)
+
+		root, err := addArchive(newTestShell(t, commands), pth, true)
+		if err != nil {
+			t.Errorf("%s: %s", format, err)
+			continue
+		}
+		if root != "ROOT" || len(m.pins) != 1 || m.pins[0] != "ROOT" {
+			t.Errorf("%s: got root %s and pins %v", format, root, m.pins)
+		}
+		if !strings.HasPrefix(m.root, "/amzn-archive-") || len(m.removed) != 1 || m.removed[0] != m.root {
+			t.Errorf("%s: MFS root %s not removed: %v", format, m.root, m.removed)
+		}
+
+		// Entries stay below the root whatever their names, and links are
+		// skipped.
+		files, dirs := m.entries()
+		if len(files) != len(wantArchiveFiles) {
+			t.Errorf("%s: got files %v, want %v", format, files, wantArchiveFiles)
+		}
+		for name, content := range wantArchiveFiles {
+			if files[name] != content {
+				t.Errorf("%s: file %s: got %q, want %q", format, name, files[name], content)
+			}
+		}
+		if want := []string{"/a", "/abs"}; !equalStrings(dirs, want) {
+			t.Errorf("%s: got dirs %v, want %v", format, dirs, want)
+		}
+	}
+}
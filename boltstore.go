@@ -0,0 +1,326 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/textileio/powergate/ffs"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var (
+	objectsBucket  = []byte("objects")
+	cidsBucket     = []byte("cids")
+	dirsBucket     = []byte("dirs")
+	sessionsBucket = []byte("sessions")
+	cacheBucket    = []byte("cache")
+	namesBucket    = []byte("names")
+)
+
+// boltStore is a MetadataStore kept in a single BoltDB file. It needs no
+// database server, which makes it suitable for small deployments and tests.
+// Records are stored as JSON, objects keyed by path and dirs by root CID.
+// Objects are indexed by CID with empty keys made of the CID and the path.
+type boltStore struct {
+	db *bolt.DB
+}
+
+func newBoltStore(dbPath string) (*boltStore, error) {
+	db, err := bolt.Open(dbPath, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{objectsBucket, cidsBucket, dirsBucket, sessionsBucket, cacheBucket, namesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+func (s *boltStore) PutObject(obj Object) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		objects, cids := tx.Bucket(objectsBucket), tx.Bucket(cidsBucket)
+		var old Object
+		switch err := getJSON(objects, obj.Path, &old); err {
+		case nil:
+			if err := cids.Delete(cidKey(old)); err != nil {
+				return err
+			}
+		case ErrNotFound:
+		default:
+			return err
+		}
+		if err := cids.Put(cidKey(obj), []byte{}); err != nil {
+			return err
+		}
+		return putJSON(objects, obj.Path, obj)
+	})
+}
+
+func (s *boltStore) GetObject(pth string) (Object, error) {
+	var obj Object
+	err := s.get(objectsBucket, pth, &obj)
+	return obj, err
+}
+
+func (s *boltStore) FindObject(cid string) (Object, error) {
+	var obj Object
+	err := s.db.View(func(tx *bolt.Tx) error {
+		objects := tx.Bucket(objectsBucket)
+		c := tx.Bucket(cidsBucket).Cursor()
+		prefix := []byte(cid + "\x00")
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			if err := getJSON(objects, string(k[len(prefix):]), &obj); err != nil {
+				return err
+			}
+			if obj.BucketID != "" {
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+	return obj, err
+}
+
+func (s *boltStore) ListObjects(pth string) ([]Object, error) {
+	var objs []Object
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return forEachObject(tx, pth, func(k []byte, obj Object) error {
+			objs = append(objs, obj)
+			return nil
+		})
+	})
+	return objs, err
+}
+
+func (s *boltStore) ListChildren(pth string) ([]Object, error) {
+	var objs []Object
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(objectsBucket).Cursor()
+		prefix := []byte(pth + "/")
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); {
+			if i := bytes.IndexByte(k[len(prefix):], '/'); i >= 0 {
+				// Skip the objects below this child, since '0' sorts
+				// right after '/'.
+				next := append(k[:len(prefix)+i:len(prefix)+i], '0')
+				k, v = c.Seek(next)
+				continue
+			}
+			var obj Object
+			if err := json.Unmarshal(v, &obj); err != nil {
+				return err
+			}
+			objs = append(objs, obj)
+			k, v = c.Next()
+		}
+		return nil
+	})
+	return objs, err
+}
+
+func (s *boltStore) PutDir(dir Dir) error {
+	return s.put(dirsBucket, dir.RootCID, dir)
+}
+
+func (s *boltStore) GetDir(rootCID string) (Dir, error) {
+	var dir Dir
+	err := s.get(dirsBucket, rootCID, &dir)
+	return dir, err
+}
+
+func (s *boltStore) ListDirs() ([]Dir, error) {
+	var dirs []Dir
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(dirsBucket).ForEach(func(k, v []byte) error {
+			var dir Dir
+			if err := json.Unmarshal(v, &dir); err != nil {
+				return err
+			}
+			dirs = append(dirs, dir)
+			return nil
+		})
+	})
+	return dirs, err
+}
+
+func (s *boltStore) UpdateJob(rootCID, bucket string, job BucketJob) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(dirsBucket)
+		var dir Dir
+		if err := getJSON(b, rootCID, &dir); err != nil {
+			return err
+		}
+		if dir.Jobs == nil {
+			dir.Jobs = make(map[string]BucketJob)
+		}
+		dir.Jobs[bucket] = job
+		return putJSON(b, rootCID, dir)
+	})
+}
+
+func (s *boltStore) Migrate() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := indexObjects(tx); err != nil {
+			return err
+		}
+
+		b := tx.Bucket(dirsBucket)
+		upgraded := make(map[string]Dir)
+		err := b.ForEach(func(k, v []byte) error {
+			var version struct{ SchemaVersion int }
+			if err := json.Unmarshal(v, &version); err != nil {
+				return err
+			}
+			if version.SchemaVersion >= dirSchemaVersion {
+				return nil
+			}
+
+			var legacy struct {
+				RootCID string
+				Buckets []string
+				Jobs    map[string]ffs.Job
+			}
+			if err := json.Unmarshal(v, &legacy); err != nil {
+				return err
+			}
+			upgraded[string(k)] = upgradeDir(legacy.RootCID, legacy.Buckets, legacy.Jobs)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for k, dir := range upgraded {
+			if err := putJSON(b, k, dir); err != nil {
+				return err
+			}
+			log.Infof("Migrated %s to schema version %d", dir.RootCID, dir.SchemaVersion)
+		}
+		return nil
+	})
+}
+
+func (s *boltStore) PutSession(session StageSession) error {
+	return s.put(sessionsBucket, session.RootCID, session)
+}
+
+func (s *boltStore) GetSession(rootCID string) (StageSession, error) {
+	var session StageSession
+	err := s.get(sessionsBucket, rootCID, &session)
+	return session, err
+}
+
+func (s *boltStore) DeleteSession(rootCID string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Delete([]byte(rootCID))
+	})
+}
+
+func (s *boltStore) PutCachedBucket(c CachedBucket) error {
+	return s.put(cacheBucket, c.Bucket, c)
+}
+
+func (s *boltStore) ListCachedBuckets() ([]CachedBucket, error) {
+	var cached []CachedBucket
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(cacheBucket).ForEach(func(k, v []byte) error {
+			var c CachedBucket
+			if err := json.Unmarshal(v, &c); err != nil {
+				return err
+			}
+			cached = append(cached, c)
+			return nil
+		})
+	})
+	return cached, err
+}
+
+func (s *boltStore) DeleteCachedBucket(bucket string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cacheBucket).Delete([]byte(bucket))
+	})
+}
+
+func (s *boltStore) PutName(rec NameRecord) error {
+	return s.put(namesBucket, rec.Name, rec)
+}
+
+func (s *boltStore) GetName(name string) (NameRecord, error) {
+	var rec NameRecord
+	err := s.get(namesBucket, name, &rec)
+	return rec, err
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *boltStore) put(bucket []byte, key string, v interface{}) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(bucket), key, v)
+	})
+}
+
+func (s *boltStore) get(bucket []byte, key string, v interface{}) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		return getJSON(tx.Bucket(bucket), key, v)
+	})
+}
+
+// indexObjects fills the CID index for objects written before it existed.
+func indexObjects(tx *bolt.Tx) error {
+	cids := tx.Bucket(cidsBucket)
+	if k, _ := cids.Cursor().First(); k != nil {
+		return nil
+	}
+	return forEachObject(tx, "", func(k []byte, obj Object) error {
+		return cids.Put(cidKey(obj), []byte{})
+	})
+}
+
+// forEachObject calls fn for the object at pth and every object below it. An
+// empty pth visits every object.
+func forEachObject(tx *bolt.Tx, pth string, fn func(k []byte, obj Object) error) error {
+	c := tx.Bucket(objectsBucket).Cursor()
+	prefix := []byte(pth)
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		if len(prefix) > 0 && len(k) > len(prefix) && k[len(prefix)] != '/' {
+			continue
+		}
+		var obj Object
+		if err := json.Unmarshal(v, &obj); err != nil {
+			return err
+		}
+		if err := fn(k, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cidKey(obj Object) []byte {
+	return []byte(obj.Cid + "\x00" + obj.Path)
+}
+
+func putJSON(b *bolt.Bucket, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), data)
+}
+
+func getJSON(b *bolt.Bucket, key string, v interface{}) error {
+	data := b.Get([]byte(key))
+	if data == nil {
+		return ErrNotFound
+	}
+	return json.Unmarshal(data, v)
+}
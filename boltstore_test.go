@@ -0,0 +1,256 @@
+package main
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/ffs"
+	bolt "go.etcd.io/bbolt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func newTestBoltStore(t *testing.T) *boltStore {
+	dir, err := ioutil.TempDir("", "amzn-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := newBoltStore(filepath.Join(dir, "amzn.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		s.Close()
+		os.RemoveAll(dir)
+	})
+	return s
+}
+
+func TestBoltStoreObjects(t *testing.T) {
+	s := newTestBoltStore(t)
+	if _, err := s.GetObject("/ipfs/R/a"); err != ErrNotFound {
+		t.Errorf("GetObject of missing path: got err %v, want ErrNotFound", err)
+	}
+	want := Object{Path: "/ipfs/R/a", Cid: "A", Size: 3, BucketID: "B"}
+	if err := s.PutObject(want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetObject("/ipfs/R/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Path != want.Path || got.Cid != want.Cid || got.Size != want.Size || got.BucketID != want.BucketID {
+		t.Errorf("GetObject: got %+v, want %+v", got, want)
+	}
+}
+
+func TestBoltStorePutObjectReindexes(t *testing.T) {
+	s := newTestBoltStore(t)
+	if err := s.PutObject(Object{Path: "/ipfs/R/a", Cid: "A", BucketID: "B1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutObject(Object{Path: "/ipfs/R/a", Cid: "C", BucketID: "B2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.FindObject("A"); err != ErrNotFound {
+		t.Errorf("FindObject of replaced CID: got err %v, want ErrNotFound", err)
+	}
+	obj, err := s.FindObject("C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Path != "/ipfs/R/a" || obj.BucketID != "B2" {
+		t.Errorf("FindObject: got %+v", obj)
+	}
+}
+
+func TestBoltStoreFindObjectSkipsUnbucketed(t *testing.T) {
+	s := newTestBoltStore(t)
+	for _, obj := range []Object{
+		{Path: "/ipfs/R/x", Cid: "X"},
+		{Path: "/ipfs/S/x", Cid: "X", BucketID: "B"},
+	} {
+		if err := s.PutObject(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	obj, err := s.FindObject("X")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj.Path != "/ipfs/S/x" {
+		t.Errorf("FindObject: got %s, want /ipfs/S/x", obj.Path)
+	}
+	if _, err := s.FindObject("Y"); err != ErrNotFound {
+		t.Errorf("FindObject of unknown CID: got err %v, want ErrNotFound", err)
+	}
+}
+
+func TestBoltStoreMigrateIndexesObjects(t *testing.T) {
+	s := newTestBoltStore(t)
+	// Objects written before the CID index existed aren't in it.
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(objectsBucket), "/ipfs/R/a", Object{Path: "/ipfs/R/a", Cid: "A", BucketID: "B"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.FindObject("A"); err != ErrNotFound {
+		t.Errorf("FindObject before migration: got err %v, want ErrNotFound", err)
+	}
+
+	if err := s.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	if obj, err := s.FindObject("A"); err != nil || obj.Path != "/ipfs/R/a" {
+		t.Errorf("FindObject after migration: got %+v, %v", obj, err)
+	}
+}
+
+func TestBoltStoreListObjectsPrefix(t *testing.T) {
+	s := newTestBoltStore(t)
+	for _, pth := range []string{"/ipfs/R", "/ipfs/R/a", "/ipfs/R/a/b", "/ipfs/Ra", "/ipfs/Ra/b"} {
+		if err := s.PutObject(Object{Path: pth, Cid: pth}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objs, err := s.ListObjects("/ipfs/R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, obj := range objs {
+		paths = append(paths, obj.Path)
+	}
+	sort.Strings(paths)
+	want := []string{"/ipfs/R", "/ipfs/R/a", "/ipfs/R/a/b"}
+	if !equalStrings(paths, want) {
+		t.Errorf("ListObjects: got %v, want %v", paths, want)
+	}
+}
+
+func TestBoltStoreListChildren(t *testing.T) {
+	s := newTestBoltStore(t)
+	for _, pth := range []string{
+		"/ipfs/R", "/ipfs/R/a", "/ipfs/R/a/b", "/ipfs/R/a/b/c", "/ipfs/R/a-b", "/ipfs/R/a.b/c",
+		"/ipfs/R/a0", "/ipfs/R/z", "/ipfs/Ra", "/ipfs/Ra/b",
+	} {
+		if err := s.PutObject(Object{Path: pth, Cid: pth}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objs, err := s.ListChildren("/ipfs/R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, obj := range objs {
+		paths = append(paths, obj.Path)
+	}
+	sort.Strings(paths)
+	want := []string{"/ipfs/R/a", "/ipfs/R/a-b", "/ipfs/R/a0", "/ipfs/R/z"}
+	if !equalStrings(paths, want) {
+		t.Errorf("ListChildren: got %v, want %v", paths, want)
+	}
+}
+
+func TestBoltStoreDirs(t *testing.T) {
+	s := newTestBoltStore(t)
+	for _, root := range []string{"R", "S"} {
+		if err := s.PutDir(Dir{RootCID: root, Buckets: []string{"B" + root}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dir, err := s.GetDir("R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dir.RootCID != "R" || !equalStrings(dir.Buckets, []string{"BR"}) {
+		t.Errorf("GetDir: got %+v", dir)
+	}
+	dirs, err := s.ListDirs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 2 {
+		t.Errorf("ListDirs: got %d dirs, want 2", len(dirs))
+	}
+	if _, err := s.GetDir("T"); err != ErrNotFound {
+		t.Errorf("GetDir of missing dir: got err %v, want ErrNotFound", err)
+	}
+}
+
+func TestBoltStoreMigrate(t *testing.T) {
+	s := newTestBoltStore(t)
+	bucket, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy := struct {
+		RootCID string
+		Buckets []string
+		Jobs    map[string]ffs.Job
+	}{
+		RootCID: "R",
+		Buckets: []string{bucket.String()},
+		Jobs: map[string]ffs.Job{
+			"job1": {ID: "job1", Status: ffs.Failed, ErrCause: "no deals"},
+		},
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(dirsBucket), "R", legacy)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := s.GetDir("R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dir.SchemaVersion != dirSchemaVersion {
+		t.Errorf("SchemaVersion: got %d, want %d", dir.SchemaVersion, dirSchemaVersion)
+	}
+	job, ok := dir.Jobs[bucket.String()]
+	if !ok {
+		t.Fatalf("no job for bucket %s: %+v", bucket, dir.Jobs)
+	}
+	if job.JobID != "job1" || !job.Failed() || job.Error != "no deals" || job.Attempts != 1 {
+		t.Errorf("migrated job: got %+v", job)
+	}
+
+	// Migrating again leaves current records alone.
+	if err := s.UpdateJob("R", bucket.String(), BucketJob{JobID: "job2", Status: "Success"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	if dir, err = s.GetDir("R"); err != nil {
+		t.Fatal(err)
+	}
+	if job := dir.Jobs[bucket.String()]; job.JobID != "job2" {
+		t.Errorf("job after second migration: got %+v", job)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
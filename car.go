@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+	"github.com/ipld/go-car/util"
+	"io"
+)
+
+// Buckets are staged as CARv1 archives holding the original blocks of the
+// objects in them, so that retrieving a bucket brings back content with the
+// CIDs it was staged with. See https://ipld.io/specs/transport/car/carv1/.
+
+// errInvalidCar is returned when reading something that isn't a CARv1 archive.
+var errInvalidCar = errors.New("invalid CAR archive")
+
+// maxCarHeaderSize bounds the header of archives being read.
+const maxCarHeaderSize = 32 << 20
+
+// writeCarHeader starts an archive with the given roots.
+func writeCarHeader(w io.Writer, roots []cid.Cid) error {
+	return car.WriteHeader(&car.CarHeader{Roots: roots, Version: 1}, w)
+}
+
+// writeCarBlock appends a block to an archive.
+func writeCarBlock(w io.Writer, id cid.Cid, data []byte) error {
+	return util.LdWrite(w, id.Bytes(), data)
+}
+
+// newCarReader reads the header of the archive in r. It returns errInvalidCar
+// if r doesn't hold a CARv1 archive.
+func newCarReader(r io.Reader) (*car.CarReader, error) {
+	// go-car allocates the header before reading it, so its length is
+	// checked first in case r holds something else entirely.
+	br := bufio.NewReader(r)
+	b, _ := br.Peek(binary.MaxVarintLen64)
+	if n, _ := binary.Uvarint(b); n == 0 || n > maxCarHeaderSize {
+		return nil, errInvalidCar
+	}
+	cr, err := car.NewCarReader(br)
+	if err != nil {
+		return nil, errInvalidCar
+	}
+	return cr, nil
+}
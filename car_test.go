@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"github.com/ipfs/go-cid"
+	"io"
+	"testing"
+)
+
+func TestCarRoundTrip(t *testing.T) {
+	// CIDv1 raw blocks hashed with sha2-256.
+	prefix := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: 0x12, MhLength: -1}
+	var (
+		ids  []cid.Cid
+		data = [][]byte{[]byte("hello"), []byte("world"), {}}
+	)
+	for _, d := range data {
+		id, err := prefix.Sum(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+
+	var buf bytes.Buffer
+	if err := writeCarHeader(&buf, ids[:2]); err != nil {
+		t.Fatal(err)
+	}
+	for i, id := range ids {
+		if err := writeCarBlock(&buf, id, data[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cr, err := newCarReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cr.Header.Roots) != 2 || !cr.Header.Roots[0].Equals(ids[0]) || !cr.Header.Roots[1].Equals(ids[1]) {
+		t.Errorf("roots: got %v, want %v", cr.Header.Roots, ids[:2])
+	}
+	for i, id := range ids {
+		blk, err := cr.Next()
+		if err != nil {
+			t.Fatalf("block %d: %s", i, err)
+		}
+		if !blk.Cid().Equals(id) || !bytes.Equal(blk.RawData(), data[i]) {
+			t.Errorf("block %d: got %s %q, want %s %q", i, blk.Cid(), blk.RawData(), id, data[i])
+		}
+	}
+	if _, err := cr.Next(); err != io.EOF {
+		t.Errorf("after last block: got err %v, want io.EOF", err)
+	}
+}
+
+func TestCarReaderRejectsOtherContent(t *testing.T) {
+	for _, content := range []string{
+		"",
+		"PK\x03\x04 not a car",
+		"\xff\xff\xff\xff\xff\xff\xff\x7f",
+		"\x05hello",
+	} {
+		if _, err := newCarReader(bytes.NewReader([]byte(content))); err != errInvalidCar {
+			t.Errorf("newCarReader(%q): got err %v, want errInvalidCar", content, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// retrievalEvent is published whenever a bucket retrieval changes state. Paths
+// holds every requested path that is waiting on the bucket.
+type retrievalEvent struct {
+	Type   string   `json:"type"`
+	Bucket string   `json:"bucket"`
+	Paths  []string `json:"paths"`
+	Error  string   `json:"error,omitempty"`
+
+	// NextAttempt is when a failed retrieval will be retried.
+	NextAttempt *time.Time `json:"nextAttempt,omitempty"`
+
+	Time time.Time `json:"time"`
+}
+
+// eventBroker fans retrieval events out to every subscriber. Subscribers that
+// fall behind miss events rather than holding up retrievals.
+type eventBroker struct {
+	subs map[chan retrievalEvent]struct{}
+	mtx  sync.Mutex
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{subs: make(map[chan retrievalEvent]struct{})}
+}
+
+func (b *eventBroker) Subscribe() chan retrievalEvent {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	ch := make(chan retrievalEvent, 16)
+	b.subs[ch] = struct{}{}
+	return ch
+}
+
+func (b *eventBroker) Unsubscribe(ch chan retrievalEvent) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	delete(b.subs, ch)
+}
+
+func (b *eventBroker) Publish(e retrievalEvent) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+			log.Warningf("Dropping %s event for bucket %s: subscriber is too slow", e.Type, e.Bucket)
+		}
+	}
+}
+
+// handleEvents streams retrieval events to the client as Server-Sent Events.
+// The stream can be narrowed to a single bucket or requested path with the
+// bucket and path query parameters.
+func (x *Serve) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	bucket, pth := r.URL.Query().Get("bucket"), r.URL.Query().Get("path")
+	if pth != "" {
+		resolved, err := x.ipfsPath(r, pth)
+		if err != nil {
+			http.Error(w, "Path not found", http.StatusNotFound)
+			return
+		}
+		pth = resolved
+	}
+
+	events := x.events.Subscribe()
+	defer x.events.Unsubscribe(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Comments keep idle connections from being closed by proxies.
+	keepalive := time.NewTicker(30 * time.Second)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case e := <-events:
+			if (bucket != "" && e.Bucket != bucket) || (pth != "" && !contains(e.Paths, pth)) {
+				continue
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				log.Errorf("Error encoding event: %s", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+		case <-keepalive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
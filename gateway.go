@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+)
+
+// errNotAvailable is returned when the IPFS gateway can't serve the requested
+// content, which usually means it has been archived to Filecoin.
+var errNotAvailable = errors.New("content not available from the IPFS gateway")
+
+// newGatewayProxy returns a reverse proxy to the IPFS gateway. Responses are
+// passed through with their status and headers intact, and request headers
+// such as Range and If-None-Match are forwarded, so the gateway's caching and
+// partial content support keep working. Only when the gateway reports the
+// content as unavailable or times out looking for it is the request handed to
+// fallback, while other errors are reported as a bad gateway.
+func newGatewayProxy(gateway string, timeout time.Duration, fallback http.HandlerFunc) *httputil.ReverseProxy {
+	target := &url.URL{Scheme: "http", Host: gateway}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		// Keep the gateway from treating our Host header as a DNSLink name.
+		r.Host = target.Host
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = timeout
+	proxy.Transport = transport
+
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		if gatewayUnavailable(resp.StatusCode) {
+			resp.Body.Close()
+			return errNotAvailable
+		}
+		return nil
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		switch {
+		case errors.Is(err, context.Canceled):
+			// The client went away.
+		case err == errNotAvailable || headerTimeout(err):
+			fallback(w, r)
+		default:
+			log.Errorf("Error proxying %s to the IPFS gateway: %s", r.URL.Path, err)
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}
+	return proxy
+}
+
+// headerTimeout reports whether err means the gateway was reached but didn't
+// start responding within the timeout, which it does while it looks for
+// content nobody provides. Timeouts connecting to the gateway don't count.
+func headerTimeout(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// gatewayUnavailable reports whether a gateway status code means the content
+// could not be found, as opposed to an error that should be passed on.
+func gatewayUnavailable(status int) bool {
+	return status == http.StatusNotFound || status == http.StatusGatewayTimeout
+}
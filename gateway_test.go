@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// proxyTo sends a request for /ipfs/R through a gateway proxy to srv and
+// reports whether the fallback was called.
+func proxyTo(t *testing.T, srv *httptest.Server, timeout time.Duration, r *http.Request) (*httptest.ResponseRecorder, bool) {
+	var fellBack bool
+	fallback := func(w http.ResponseWriter, r *http.Request) {
+		fellBack = true
+		w.WriteHeader(http.StatusTeapot)
+	}
+	proxy := newGatewayProxy(strings.TrimPrefix(srv.URL, "http://"), timeout, fallback)
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, r)
+	return w, fellBack
+}
+
+func TestGatewayProxyPassThrough(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "bytes=0-1" {
+			t.Errorf("range header not forwarded: %v", r.Header)
+		}
+		w.Header().Set("Etag", `"R"`)
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte("ab"))
+	}))
+	defer srv.Close()
+
+	r := httptest.NewRequest("GET", "/ipfs/R", nil)
+	r.Header.Set("Range", "bytes=0-1")
+	w, fellBack := proxyTo(t, srv, time.Second, r)
+	if fellBack {
+		t.Error("fell back for content the gateway has")
+	}
+	if w.Code != http.StatusPartialContent || w.Header().Get("Etag") != `"R"` || w.Body.String() != "ab" {
+		t.Errorf("got %d %v %q", w.Code, w.Header(), w.Body.String())
+	}
+}
+
+func TestGatewayProxyNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	w, fellBack := proxyTo(t, srv, time.Second, httptest.NewRequest("GET", "/ipfs/R", nil))
+	if !fellBack || w.Code != http.StatusTeapot {
+		t.Errorf("got %d, fell back: %v", w.Code, fellBack)
+	}
+}
+
+func TestGatewayProxyHeaderTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	w, fellBack := proxyTo(t, srv, 50*time.Millisecond, httptest.NewRequest("GET", "/ipfs/R", nil))
+	if !fellBack || w.Code != http.StatusTeapot {
+		t.Errorf("got %d, fell back: %v", w.Code, fellBack)
+	}
+}
+
+func TestGatewayProxyDialError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	w, fellBack := proxyTo(t, srv, time.Second, httptest.NewRequest("GET", "/ipfs/R", nil))
+	if fellBack || w.Code != http.StatusBadGateway {
+		t.Errorf("got %d, fell back: %v", w.Code, fellBack)
+	}
+}
+
+func TestGatewayProxyCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	r := httptest.NewRequest("GET", "/ipfs/R", nil).WithContext(ctx)
+	w, fellBack := proxyTo(t, srv, time.Second, r)
+	if fellBack || w.Code != http.StatusOK {
+		t.Errorf("got %d, fell back: %v", w.Code, fellBack)
+	}
+}
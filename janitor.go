@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	shell "github.com/ipfs/go-ipfs-api"
+	"sort"
+	"strings"
+	"time"
+)
+
+// accessPersistInterval limits how often the access time of a cached bucket is
+// written to the metadata store. Access times only need to be precise enough
+// to tell recently used buckets from stale ones.
+const accessPersistInterval = time.Minute
+
+// loadCache reads the buckets retrieved into IPFS by earlier runs.
+func (x *Serve) loadCache() error {
+	cached, err := x.db.ListCachedBuckets()
+	if err != nil {
+		return err
+	}
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	for i := range cached {
+		x.cache[cached[i].Bucket] = &cached[i]
+	}
+	return nil
+}
+
+// cacheBucket records that the bucket was retrieved into IPFS and pinned.
+func (x *Serve) cacheBucket(bucket string, size int64, pins []string) {
+	now := time.Now()
+	c := CachedBucket{
+		Bucket:    bucket,
+		Size:      size,
+		Pins:      pins,
+		Retrieved: now,
+		Accessed:  now,
+	}
+	x.mtx.Lock()
+	x.cache[bucket] = &c
+	x.mtx.Unlock()
+
+	if err := x.db.PutCachedBucket(c); err != nil {
+		log.Errorf("Error saving cached bucket %s: %s", bucket, err)
+	}
+}
+
+// uncached returns the buckets that aren't cached in IPFS.
+func (x *Serve) uncached(buckets []string) []string {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	var missing []string
+	for _, bucket := range buckets {
+		if _, ok := x.cache[bucket]; !ok {
+			missing = append(missing, bucket)
+		}
+	}
+	return missing
+}
+
+// touch updates the access time of the cached buckets holding the given path.
+func (x *Serve) touch(pth string) {
+	x.mtx.Lock()
+	empty := len(x.cache) == 0
+	x.mtx.Unlock()
+	if empty {
+		return
+	}
+
+	_, buckets, err := x.resolve(pth)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	var persist []CachedBucket
+	x.mtx.Lock()
+	for _, bucket := range buckets {
+		c, ok := x.cache[bucket]
+		if !ok {
+			continue
+		}
+		if now.Sub(c.Accessed) > accessPersistInterval {
+			persist = append(persist, *c)
+			persist[len(persist)-1].Accessed = now
+		}
+		c.Accessed = now
+	}
+	x.mtx.Unlock()
+
+	for _, c := range persist {
+		if err := x.db.PutCachedBucket(c); err != nil {
+			log.Errorf("Error saving cached bucket %s: %s", c.Bucket, err)
+		}
+	}
+}
+
+// runJanitor evicts cached buckets every interval, forever.
+func (x *Serve) runJanitor() {
+	ticker := time.NewTicker(x.JanitorInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		x.evictBuckets()
+	}
+}
+
+// evictBuckets unpins the cached buckets that haven't been accessed within the
+// cache TTL and then, while the IPFS repo is larger than the cache budget, the
+// least recently accessed of the rest. The unpinned blocks are garbage
+// collected, leaving Filecoin to serve any future requests for them. Buckets
+// being retrieved, and buckets holding directories above the content of
+// buckets that stay cached, are left alone.
+func (x *Serve) evictBuckets() {
+	now := time.Now()
+	var candidates []CachedBucket
+	x.mtx.Lock()
+	for _, c := range x.cache {
+		if r, ok := x.retrievals[c.Bucket]; ok && !r.finished() {
+			continue
+		}
+		candidates = append(candidates, *c)
+	}
+	x.mtx.Unlock()
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Accessed.Before(candidates[j].Accessed)
+	})
+
+	var evict, keep []CachedBucket
+	for _, c := range candidates {
+		if x.CacheTTL > 0 && now.Sub(c.Accessed) > x.CacheTTL {
+			evict = append(evict, c)
+		} else {
+			keep = append(keep, c)
+		}
+	}
+	if x.CacheBudget > 0 {
+		size, err := repoSize(x.sh)
+		if err != nil {
+			log.Errorf("Error loading IPFS repo size: %s", err)
+		} else {
+			for _, c := range evict {
+				size -= c.Size
+			}
+			for i := 0; size > int64(x.CacheBudget) && i < len(keep); i++ {
+				evict = append(evict, keep[i])
+				size -= keep[i].Size
+			}
+		}
+	}
+	if len(evict) == 0 {
+		return
+	}
+	evict = x.keepAncestors(evict)
+	if len(evict) == 0 {
+		return
+	}
+
+	for _, c := range evict {
+		if err := x.evictBucket(c); err != nil {
+			log.Errorf("Error evicting bucket %s: %s", c.Bucket, err)
+		}
+	}
+	if err := x.sh.Request("repo/gc").Exec(context.Background(), nil); err != nil {
+		log.Errorf("Error collecting IPFS garbage: %s", err)
+	}
+}
+
+// keepAncestors drops from evict the buckets holding directories above the
+// content of the buckets that stay cached, since the gateway can't reach that
+// content without them.
+func (x *Serve) keepAncestors(evict []CachedBucket) []CachedBucket {
+	x.mtx.Lock()
+	cached := make([]CachedBucket, 0, len(x.cache))
+	for _, c := range x.cache {
+		cached = append(cached, *c)
+	}
+	x.mtx.Unlock()
+
+	ancestors := make(map[string][]string)
+	for _, c := range cached {
+		for _, pin := range c.Pins {
+			obj, err := x.db.FindObject(pin)
+			if err != nil {
+				continue
+			}
+			ancestors[c.Bucket] = appendMissing(ancestors[c.Bucket], x.ancestorBuckets(obj.Path)...)
+		}
+	}
+
+	// Keeping a bucket may in turn keep the buckets above its own content, so
+	// repeat until nothing more is kept.
+	for {
+		evicting := make(map[string]bool)
+		for _, c := range evict {
+			evicting[c.Bucket] = true
+		}
+		needed := make(map[string]bool)
+		for _, c := range cached {
+			if evicting[c.Bucket] {
+				continue
+			}
+			for _, bucket := range ancestors[c.Bucket] {
+				needed[bucket] = true
+			}
+		}
+		var rest []CachedBucket
+		for _, c := range evict {
+			if !needed[c.Bucket] {
+				rest = append(rest, c)
+			}
+		}
+		if len(rest) == len(evict) {
+			return evict
+		}
+		evict = rest
+	}
+}
+
+// evictBucket unpins everything pinned when the bucket was imported and
+// forgets the bucket, so that the next request for it goes to Filecoin.
+func (x *Serve) evictBucket(c CachedBucket) error {
+	for _, pin := range c.Pins {
+		if err := x.sh.Unpin(pin); err != nil && !strings.Contains(err.Error(), "not pinned") {
+			return err
+		}
+	}
+	if err := x.db.DeleteCachedBucket(c.Bucket); err != nil {
+		return err
+	}
+
+	x.mtx.Lock()
+	delete(x.cache, c.Bucket)
+	if r, ok := x.retrievals[c.Bucket]; ok && r.State == retrievalReady {
+		delete(x.retrievals, c.Bucket)
+	}
+	x.mtx.Unlock()
+
+	log.Infof("Evicted bucket %s from IPFS, last accessed %s", c.Bucket, c.Accessed.Format(time.RFC3339))
+	return nil
+}
+
+// repoSize returns the size of the local IPFS repo in bytes.
+func repoSize(sh *shell.Shell) (int64, error) {
+	var stat struct {
+		RepoSize int64
+	}
+	err := sh.Request("repo/stat").
+		Option("size-only", true).
+		Exec(context.Background(), &stat)
+	return stat.RepoSize, err
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestJanitor returns a Serve with the buckets cached, each last accessed
+// the given time ago, and a fake IPFS node recording the CIDs unpinned.
+func newTestJanitor(t *testing.T, db MetadataStore, accessed map[string]time.Duration, pins map[string][]string) (*Serve, func() []string) {
+	var mtx sync.Mutex
+	var unpinned []string
+	sh := newTestShell(t, map[string]http.HandlerFunc{
+		"pin/rm": func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			unpinned = append(unpinned, r.URL.Query().Get("arg"))
+			mtx.Unlock()
+			writeJSON(w, map[string]interface{}{})
+		},
+		"repo/gc": func(w http.ResponseWriter, r *http.Request) {},
+	})
+	x := &Serve{db: db, sh: sh, CacheTTL: time.Hour, cache: make(map[string]*CachedBucket)}
+	now := time.Now()
+	for bucket, ago := range accessed {
+		c := CachedBucket{Bucket: bucket, Pins: pins[bucket], Accessed: now.Add(-ago)}
+		if err := db.PutCachedBucket(c); err != nil {
+			t.Fatal(err)
+		}
+		x.cache[bucket] = &c
+	}
+	return x, func() []string {
+		mtx.Lock()
+		defer mtx.Unlock()
+		sort.Strings(unpinned)
+		return unpinned
+	}
+}
+
+func cachedBuckets(x *Serve) []string {
+	var buckets []string
+	for bucket := range x.cache {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+	return buckets
+}
+
+func janitorObjects(t *testing.T) MetadataStore {
+	return putTestObjects(t,
+		Object{Path: "/ipfs/R", Cid: "R", IsDir: true, BucketID: "B0"},
+		Object{Path: "/ipfs/R/a", Cid: "A", IsDir: true, BucketID: "B1"},
+		Object{Path: "/ipfs/R/a/f", Cid: "F", BucketID: "B2"},
+		Object{Path: "/ipfs/R/g", Cid: "G", BucketID: "B3"},
+	)
+}
+
+var janitorPins = map[string][]string{"B0": {"R"}, "B1": {"A"}, "B2": {"F"}, "B3": {"G"}}
+
+func TestEvictBucketsKeepsAncestors(t *testing.T) {
+	x, unpinned := newTestJanitor(t, janitorObjects(t), map[string]time.Duration{
+		"B0": 2 * time.Hour,
+		"B1": 2 * time.Hour,
+		"B2": time.Minute,
+		"B3": 2 * time.Hour,
+	}, janitorPins)
+
+	// B2 stays cached, so the directories above its file must stay too.
+	x.evictBuckets()
+	if got := cachedBuckets(x); !equalStrings(got, []string{"B0", "B1", "B2"}) {
+		t.Errorf("cached: got %v", got)
+	}
+	if got := unpinned(); !equalStrings(got, []string{"G"}) {
+		t.Errorf("unpinned: got %v", got)
+	}
+}
+
+func TestEvictBucketsWithAncestors(t *testing.T) {
+	x, unpinned := newTestJanitor(t, janitorObjects(t), map[string]time.Duration{
+		"B0": 2 * time.Hour,
+		"B1": 2 * time.Hour,
+		"B2": 2 * time.Hour,
+	}, janitorPins)
+
+	x.evictBuckets()
+	if got := cachedBuckets(x); len(got) != 0 {
+		t.Errorf("cached: got %v", got)
+	}
+	if got := unpinned(); !equalStrings(got, []string{"A", "F", "R"}) {
+		t.Errorf("unpinned: got %v", got)
+	}
+	if cached, err := x.db.ListCachedBuckets(); err != nil || len(cached) != 0 {
+		t.Errorf("saved cached buckets: got %v, %v", cached, err)
+	}
+}
+
+func TestTouchAncestors(t *testing.T) {
+	x, _ := newTestJanitor(t, janitorObjects(t), map[string]time.Duration{
+		"B0": 2 * time.Hour,
+		"B1": 2 * time.Hour,
+		"B2": 2 * time.Hour,
+		"B3": 2 * time.Hour,
+	}, janitorPins)
+
+	x.touch("/ipfs/R/a/f")
+	for bucket, c := range x.cache {
+		touched := time.Since(c.Accessed) < time.Minute
+		if touched != (bucket != "B3") {
+			t.Errorf("bucket %s touched: %v", bucket, touched)
+		}
+	}
+	saved, err := x.db.ListCachedBuckets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range saved {
+		touched := time.Since(c.Accessed) < time.Minute
+		if touched != (c.Bucket != "B3") {
+			t.Errorf("saved bucket %s touched: %v", c.Bucket, touched)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// resolve looks up the object staged at the requested path and the buckets
+// needed to serve it. Like on an IPFS gateway, a directory resolves to its
+// index.html if it has one, in which case the buckets of both are needed. The
+// gateway walks to the object through every directory above it, so the buckets
+// holding those are needed too.
+func (x *Serve) resolve(pth string) (Object, []string, error) {
+	obj, err := x.db.GetObject(path.Clean(pth))
+	if err != nil {
+		return obj, obj.Buckets(), err
+	}
+	buckets := appendMissing(obj.Buckets(), x.ancestorBuckets(obj.Path)...)
+	if !obj.IsDir {
+		return obj, buckets, nil
+	}
+	index, err := x.db.GetObject(path.Join(obj.Path, "index.html"))
+	if err != nil {
+		return obj, buckets, nil
+	}
+	return index, appendMissing(buckets, index.Buckets()...), nil
+}
+
+// ancestorBuckets returns the buckets holding the directories above the staged
+// path, up to the root of its staged directory.
+func (x *Serve) ancestorBuckets(pth string) []string {
+	var buckets []string
+	for dir := path.Dir(pth); strings.Count(dir, "/") > 1; dir = path.Dir(dir) {
+		obj, err := x.db.GetObject(dir)
+		if err != nil || obj.BucketID == "" {
+			continue
+		}
+		buckets = appendMissing(buckets, obj.Buckets()...)
+	}
+	return buckets
+}
+
+// listingEntry is an entry of a directory listing. Entries are hot when their
+// content is in IPFS and archived when it has to be retrieved from Filecoin.
+type listingEntry struct {
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	Cid   string `json:"cid"`
+	Size  int64  `json:"size"`
+	IsDir bool   `json:"isDir"`
+	State string `json:"state"`
+}
+
+type listing struct {
+	Path    string         `json:"path"`
+	Parent  string         `json:"parent,omitempty"`
+	Entries []listingEntry `json:"entries"`
+}
+
+// serveListing renders the listing of a staged directory from the metadata
+// store, as JSON if the client asks for it with a format=json query parameter
+// or an Accept header and as HTML otherwise.
+func (x *Serve) serveListing(w http.ResponseWriter, r *http.Request, dir Object) {
+	objs, err := x.db.ListChildren(dir.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sortByPath(objs)
+
+	l := listing{Path: dir.Path, Entries: []listingEntry{}}
+	if parent := path.Dir(dir.Path); parent != "/ipfs" {
+		l.Parent = parent
+	}
+	local := localObjects(x.sh, objs)
+	for i, obj := range objs {
+		state := "archived"
+		if local[i] {
+			state = "hot"
+		}
+		l.Entries = append(l.Entries, listingEntry{
+			Name:  path.Base(obj.Path),
+			Path:  obj.Path,
+			Cid:   obj.Cid,
+			Size:  obj.Size,
+			IsDir: obj.IsDir,
+			State: state,
+		})
+	}
+
+	if r.URL.Query().Get("format") == "json" || strings.Contains(r.Header.Get("Accept"), "application/json") {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(l)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := listingTemplate.Execute(w, l); err != nil {
+		log.Errorf("Error rendering listing of %s: %s", dir.Path, err)
+	}
+}
+
+var listingTemplate = template.Must(template.New("listing").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Path}}</title>
+    <style>
+        body { font-family: sans-serif; margin: 2em; }
+        table { border-collapse: collapse; }
+        td { padding: 0.25em 1em 0.25em 0; }
+        .archived { color: #888; }
+    </style>
+</head>
+<body>
+<h1>Index of {{.Path}}</h1>
+<table>
+    {{if .Parent}}<tr><td><a href="{{.Parent}}">..</a></td><td></td><td></td></tr>{{end}}
+    {{range .Entries}}
+    <tr class="{{.State}}">
+        <td><a href="{{.Path}}">{{.Name}}{{if .IsDir}}/{{end}}</a></td>
+        <td>{{.Size}} B</td>
+        <td>{{if eq .State "hot"}}in IPFS{{else}}archived in Filecoin{{end}}</td>
+    </tr>
+    {{end}}
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	db := newTestBoltStore(t)
+	for _, obj := range []Object{
+		{Path: "/ipfs/R", IsDir: true, BucketID: "B0"},
+		{Path: "/ipfs/R/a", IsDir: true, BucketID: "B1"},
+		{Path: "/ipfs/R/a/b.txt", BucketID: "B2"},
+		{Path: "/ipfs/R/a/c", IsDir: true, BucketID: "B0"},
+		{Path: "/ipfs/R/a/c/index.html", BucketID: "B3"},
+		{Path: "/ipfs/R/big", Parts: []ObjectPart{{BucketID: "B4"}, {BucketID: "B5"}}, BucketID: "B4"},
+	} {
+		if err := db.PutObject(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	x := &Serve{db: db}
+
+	tests := []struct {
+		pth     string
+		obj     string
+		buckets []string
+	}{
+		{"/ipfs/R/a/b.txt", "/ipfs/R/a/b.txt", []string{"B2", "B1", "B0"}},
+		{"/ipfs/R/a/c/", "/ipfs/R/a/c/index.html", []string{"B0", "B1", "B3"}},
+		{"/ipfs/R/big", "/ipfs/R/big", []string{"B4", "B5", "B0"}},
+		{"/ipfs/R", "/ipfs/R", []string{"B0"}},
+	}
+	for _, tt := range tests {
+		obj, buckets, err := x.resolve(tt.pth)
+		if err != nil {
+			t.Errorf("resolve(%s): %s", tt.pth, err)
+			continue
+		}
+		if obj.Path != tt.obj || !equalStrings(buckets, tt.buckets) {
+			t.Errorf("resolve(%s): got %s %v, want %s %v", tt.pth, obj.Path, buckets, tt.obj, tt.buckets)
+		}
+	}
+	if _, _, err := x.resolve("/ipfs/R/missing"); err != ErrNotFound {
+		t.Errorf("resolve of missing path: got err %v, want ErrNotFound", err)
+	}
+}
+
+// localBlocks serves block/stat for the given local blocks. Every lookup waits
+// until n of them are in flight, so that checks made one at a time time out.
+func localBlocks(n int, local ...string) map[string]http.HandlerFunc {
+	var mtx sync.Mutex
+	inFlight := 0
+	all := make(chan struct{})
+	return map[string]http.HandlerFunc{
+		"block/stat": func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			if inFlight++; inFlight == n {
+				close(all)
+			}
+			mtx.Unlock()
+			select {
+			case <-all:
+			case <-r.Context().Done():
+				return
+			}
+			id := r.URL.Query().Get("arg")
+			if !contains(local, id) {
+				ipfsError(w, "block not found")
+				return
+			}
+			writeJSON(w, map[string]interface{}{"Key": id, "Size": 1})
+		},
+	}
+}
+
+func TestServeListing(t *testing.T) {
+	db := putTestObjects(t,
+		Object{Path: "/ipfs/R", Cid: "R", IsDir: true, BucketID: "B0"},
+		Object{Path: "/ipfs/R/a", Cid: "A", IsDir: true, BucketID: "B0"},
+		Object{Path: "/ipfs/R/a/x", Cid: "X", BucketID: "B1"},
+		Object{Path: "/ipfs/R/b", Cid: "B", Size: 3, BucketID: "B2"},
+		Object{Path: "/ipfs/R/c", Cid: "C", Size: 4, BucketID: "B3"},
+	)
+	x := &Serve{db: db, sh: newTestShell(t, localBlocks(3, "A", "C"))}
+	dir, err := db.GetObject("/ipfs/R")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	x.serveListing(w, httptest.NewRequest("GET", "/ipfs/R?format=json", nil), dir)
+	var l listing
+	if err := json.NewDecoder(w.Body).Decode(&l); err != nil {
+		t.Fatal(err)
+	}
+	want := []listingEntry{
+		{Name: "a", Path: "/ipfs/R/a", Cid: "A", IsDir: true, State: "hot"},
+		{Name: "b", Path: "/ipfs/R/b", Cid: "B", Size: 3, State: "archived"},
+		{Name: "c", Path: "/ipfs/R/c", Cid: "C", Size: 4, State: "hot"},
+	}
+	if l.Path != "/ipfs/R" || l.Parent != "" || len(l.Entries) != len(want) {
+		t.Fatalf("got %+v", l)
+	}
+	for i, entry := range l.Entries {
+		if entry != want[i] {
+			t.Errorf("entry %d: got %+v, want %+v", i, entry, want[i])
+		}
+	}
+}
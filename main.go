@@ -12,7 +12,9 @@ func main() {
 		"stage a directory for storage",
 		"The stage command will add the full directory into API, split it into smaller"+
 			"directories suitable for storage in Filecoin, and save the mapping of the filepath to the directory"+
-			"in Filecoin so that it can easily be retrieved later.",
+			"in Filecoin so that it can easily be retrieved later. Content that is already in IPFS can be staged "+
+			"by its root CID, and tar, tar.gz or zip archives can be staged without extracting them, instead of a "+
+			"local directory.",
 		&Stage{})
 	if err != nil {
 		log.Fatal(err)
@@ -21,7 +23,8 @@ func main() {
 	_, err = parser.AddCommand("store",
 		"store a staged directory in Filecoin",
 		"The store command will store the provided directory in filecoin. You must have previously staged" +
-		"the directory using the stage command. You will need to pass in the root CID for the directory into this command.",
+		"the directory using the stage command. You will need to pass in the root CID for the directory into this command. " +
+		"It then keeps running, tracking the storage jobs of every staged directory and retrying any that fail.",
 		&Store{})
 	if err != nil {
 		log.Fatal(err)
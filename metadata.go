@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned by a MetadataStore when the requested record does not exist.
+var ErrNotFound = errors.New("not found")
+
+// MetadataStore persists the mapping from staged files to the Filecoin buckets
+// that hold them, along with the storage jobs for each staged directory.
+type MetadataStore interface {
+	// PutObject saves the record for a staged file or directory, replacing
+	// any record already saved for the same path.
+	PutObject(obj Object) error
+
+	// GetObject returns the object staged at the given /ipfs/ path.
+	GetObject(pth string) (Object, error)
+
+	// FindObject returns an object with the given CID that is already held in
+	// a bucket, from any staged directory. The bucket may only be staged and
+	// not yet stored in Filecoin.
+	FindObject(cid string) (Object, error)
+
+	// ListObjects returns the object staged at the given /ipfs/ path along
+	// with every object below it.
+	ListObjects(pth string) ([]Object, error)
+
+	// ListChildren returns the objects staged directly in the directory at the
+	// given /ipfs/ path.
+	ListChildren(pth string) ([]Object, error)
+
+	// PutDir saves the record for a staged root directory, replacing any
+	// record already saved for the same root CID.
+	PutDir(dir Dir) error
+
+	// GetDir returns the staged directory with the given root CID.
+	GetDir(rootCID string) (Dir, error)
+
+	// ListDirs returns every staged directory.
+	ListDirs() ([]Dir, error)
+
+	// UpdateJob records the latest storage job for one bucket of the given
+	// root CID without touching the jobs of its other buckets.
+	UpdateJob(rootCID, bucket string, job BucketJob) error
+
+	// Migrate upgrades any Dir records written with an older schema version.
+	Migrate() error
+
+	// PutSession saves the progress of a staging.
+	PutSession(session StageSession) error
+
+	// GetSession returns the unfinished staging of the given root CID.
+	GetSession(rootCID string) (StageSession, error)
+
+	// DeleteSession removes the staging of the given root CID once it is done.
+	DeleteSession(rootCID string) error
+
+	// PutCachedBucket saves the record for a bucket retrieved into IPFS,
+	// replacing any record already saved for the same bucket.
+	PutCachedBucket(c CachedBucket) error
+
+	// ListCachedBuckets returns every bucket retrieved into IPFS.
+	ListCachedBuckets() ([]CachedBucket, error)
+
+	// DeleteCachedBucket removes the record for a bucket evicted from IPFS.
+	DeleteCachedBucket(bucket string) error
+
+	// PutName saves the latest resolution of a name, replacing any record
+	// already saved for the same name.
+	PutName(rec NameRecord) error
+
+	// GetName returns the latest resolution of the given name.
+	GetName(name string) (NameRecord, error)
+
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+// openMetadataStore opens the metadata store for the named backend and, if
+// migrate is set, migrates its records to the current schema. The mongo backend
+// connects to the server at dbAPI while the bolt backend keeps everything in the
+// file at dbPath.
+func openMetadataStore(backend, dbAPI, dbPath string, migrate bool) (MetadataStore, error) {
+	var (
+		db  MetadataStore
+		err error
+	)
+	switch backend {
+	case "mongo":
+		db, err = newMongoStore(dbAPI)
+	case "bolt":
+		db, err = newBoltStore(dbPath)
+	default:
+		return nil, fmt.Errorf("unknown metadata store %q", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !migrate {
+		return db, nil
+	}
+	if err := db.Migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/textileio/powergate/ffs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+)
+
+// mongoStore is a MetadataStore backed by the filemapdb.files collection in
+// MongoDB. Unfinished stagings are kept in filemapdb.sessions, buckets
+// retrieved into IPFS in filemapdb.cache and resolved names in filemapdb.names.
+type mongoStore struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+	sessions   *mongo.Collection
+	cache      *mongo.Collection
+	names      *mongo.Collection
+}
+
+func newMongoStore(dbAPI string) (*mongoStore, error) {
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(fmt.Sprintf("mongodb://%s", dbAPI)))
+	if err != nil {
+		return nil, err
+	}
+	s := &mongoStore{
+		client:     client,
+		collection: client.Database("filemapdb").Collection("files"),
+		sessions:   client.Database("filemapdb").Collection("sessions"),
+		cache:      client.Database("filemapdb").Collection("cache"),
+		names:      client.Database("filemapdb").Collection("names"),
+	}
+	// Objects are looked up by path and, when deduplicating, by CID once
+	// for every object being staged, so neither may need a collection scan.
+	_, err = s.collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "path", Value: 1}}},
+		{Keys: bson.D{{Key: "cid", Value: 1}, {Key: "bucketid", Value: 1}}},
+		{Keys: bson.D{{Key: "rootcid", Value: 1}}},
+	})
+	if err != nil {
+		client.Disconnect(context.Background())
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *mongoStore) PutObject(obj Object) error {
+	return s.upsert(s.collection, bson.M{"path": obj.Path}, obj)
+}
+
+func (s *mongoStore) GetObject(pth string) (Object, error) {
+	var obj Object
+	err := s.findOne(bson.M{"path": pth}, &obj)
+	return obj, err
+}
+
+func (s *mongoStore) FindObject(cid string) (Object, error) {
+	var obj Object
+	err := s.findOne(bson.M{"cid": cid, "bucketid": bson.M{"$exists": true, "$ne": ""}}, &obj)
+	return obj, err
+}
+
+func (s *mongoStore) ListObjects(pth string) ([]Object, error) {
+	cursor, err := s.collection.Find(context.Background(), pathFilter(pth))
+	if err != nil {
+		return nil, err
+	}
+	var objs []Object
+	if err := cursor.All(context.Background(), &objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (s *mongoStore) ListChildren(pth string) ([]Object, error) {
+	filter := bson.M{"path": bson.M{"$regex": "^" + regexp.QuoteMeta(pth+"/") + "[^/]+$"}}
+	cursor, err := s.collection.Find(context.Background(), filter)
+	if err != nil {
+		return nil, err
+	}
+	var objs []Object
+	if err := cursor.All(context.Background(), &objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (s *mongoStore) PutDir(dir Dir) error {
+	return s.upsert(s.collection, bson.M{"rootcid": dir.RootCID}, dir)
+}
+
+func (s *mongoStore) GetDir(rootCID string) (Dir, error) {
+	var dir Dir
+	err := s.findOne(bson.M{"rootcid": rootCID}, &dir)
+	return dir, err
+}
+
+func (s *mongoStore) ListDirs() ([]Dir, error) {
+	cursor, err := s.collection.Find(context.Background(), bson.M{"rootcid": bson.M{"$exists": true}})
+	if err != nil {
+		return nil, err
+	}
+	var dirs []Dir
+	if err := cursor.All(context.Background(), &dirs); err != nil {
+		return nil, err
+	}
+	return dirs, nil
+}
+
+func (s *mongoStore) UpdateJob(rootCID, bucket string, job BucketJob) error {
+	update := bson.M{
+		"$set": bson.M{
+			"jobs." + bucket: job,
+		},
+	}
+	_, err := s.collection.UpdateOne(context.Background(), bson.M{"rootcid": rootCID}, update)
+	return err
+}
+
+func (s *mongoStore) Migrate() error {
+	filter := bson.M{
+		"rootcid":       bson.M{"$exists": true},
+		"schemaversion": bson.M{"$exists": false},
+	}
+	cursor, err := s.collection.Find(context.Background(), filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(context.Background())
+
+	for cursor.Next(context.Background()) {
+		// The first job update used the "Jobs" key while later ones used "jobs".
+		var legacy struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			RootCID  string             `bson:"rootcid"`
+			Buckets  []string           `bson:"buckets"`
+			Jobs     map[string]ffs.Job `bson:"jobs"`
+			OrigJobs map[string]ffs.Job `bson:"Jobs"`
+		}
+		if err := cursor.Decode(&legacy); err != nil {
+			return err
+		}
+		for id, job := range legacy.OrigJobs {
+			if _, ok := legacy.Jobs[id]; !ok {
+				if legacy.Jobs == nil {
+					legacy.Jobs = make(map[string]ffs.Job)
+				}
+				legacy.Jobs[id] = job
+			}
+		}
+
+		dir := upgradeDir(legacy.RootCID, legacy.Buckets, legacy.Jobs)
+		if _, err := s.collection.ReplaceOne(context.Background(), bson.M{"_id": legacy.ID}, dir); err != nil {
+			return err
+		}
+		log.Infof("Migrated %s to schema version %d", dir.RootCID, dir.SchemaVersion)
+	}
+	return cursor.Err()
+}
+
+func (s *mongoStore) PutSession(session StageSession) error {
+	return s.upsert(s.sessions, bson.M{"rootcid": session.RootCID}, session)
+}
+
+func (s *mongoStore) GetSession(rootCID string) (StageSession, error) {
+	var session StageSession
+	err := s.sessions.FindOne(context.Background(), bson.M{"rootcid": rootCID}).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return session, ErrNotFound
+	}
+	return session, err
+}
+
+func (s *mongoStore) DeleteSession(rootCID string) error {
+	_, err := s.sessions.DeleteOne(context.Background(), bson.M{"rootcid": rootCID})
+	return err
+}
+
+func (s *mongoStore) PutCachedBucket(c CachedBucket) error {
+	return s.upsert(s.cache, bson.M{"bucket": c.Bucket}, c)
+}
+
+func (s *mongoStore) ListCachedBuckets() ([]CachedBucket, error) {
+	cursor, err := s.cache.Find(context.Background(), bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var cached []CachedBucket
+	if err := cursor.All(context.Background(), &cached); err != nil {
+		return nil, err
+	}
+	return cached, nil
+}
+
+func (s *mongoStore) DeleteCachedBucket(bucket string) error {
+	_, err := s.cache.DeleteOne(context.Background(), bson.M{"bucket": bucket})
+	return err
+}
+
+func (s *mongoStore) PutName(rec NameRecord) error {
+	return s.upsert(s.names, bson.M{"name": rec.Name}, rec)
+}
+
+func (s *mongoStore) GetName(name string) (NameRecord, error) {
+	var rec NameRecord
+	err := s.names.FindOne(context.Background(), bson.M{"name": name}).Decode(&rec)
+	if err == mongo.ErrNoDocuments {
+		return rec, ErrNotFound
+	}
+	return rec, err
+}
+
+func (s *mongoStore) Close() error {
+	return s.client.Disconnect(context.Background())
+}
+
+// pathFilter matches the object at pth and every object below it.
+func pathFilter(pth string) bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{"path": pth},
+			bson.M{"path": bson.M{"$regex": "^" + regexp.QuoteMeta(pth+"/")}},
+		},
+	}
+}
+
+func (s *mongoStore) upsert(collection *mongo.Collection, filter bson.M, doc interface{}) error {
+	_, err := collection.ReplaceOne(context.Background(), filter, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (s *mongoStore) findOne(filter bson.M, out interface{}) error {
+	err := s.collection.FindOne(context.Background(), filter).Decode(out)
+	if err == mongo.ErrNoDocuments {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// resolvedName is a name resolution cached in memory until it expires.
+type resolvedName struct {
+	path    string
+	expires time.Time
+}
+
+// handleName serves requests for /ipns/ paths and, with DNSLink enabled, for
+// any other path on a host name with a DNSLink record. The name is resolved
+// and the request served as one for the resulting /ipfs/ path, so that staged
+// content published under a name is retrieved from Filecoin like any other.
+func (x *Serve) handleName(w http.ResponseWriter, r *http.Request) {
+	pth, err := x.ipfsPath(r, r.URL.Path)
+	if err != nil {
+		log.Errorf("Error resolving %s%s: %s", r.Host, r.URL.Path, err)
+		serveNotFound(w)
+		return
+	}
+	req := r.Clone(r.Context())
+	req.URL.Path = pth
+	req.URL.RawPath = ""
+	x.handle(w, req)
+}
+
+// ipfsPath translates a path requested from the server into an /ipfs/ path,
+// resolving /ipns/ names and, with DNSLink enabled, the host of the request.
+func (x *Serve) ipfsPath(r *http.Request, pth string) (string, error) {
+	var name, rest string
+	switch {
+	case strings.HasPrefix(pth, "/ipfs/"):
+		return pth, nil
+	case strings.HasPrefix(pth, "/ipns/"):
+		parts := strings.SplitN(strings.TrimPrefix(pth, "/ipns/"), "/", 2)
+		name = parts[0]
+		if len(parts) > 1 {
+			rest = parts[1]
+		}
+	case x.DNSLink:
+		name = r.Host
+		if host, _, err := net.SplitHostPort(r.Host); err == nil {
+			name = host
+		}
+		rest = pth
+	default:
+		return "", ErrNotFound
+	}
+
+	root, err := x.resolveName(name)
+	if err != nil {
+		return "", err
+	}
+	resolved := path.Join(root, rest)
+	if strings.HasSuffix(pth, "/") && !strings.HasSuffix(resolved, "/") {
+		resolved += "/"
+	}
+	return resolved, nil
+}
+
+// resolveName resolves an IPNS or DNSLink name to an /ipfs/ path. Resolutions
+// are cached for the name cache TTL. Those pointing at staged directories are
+// also saved in the metadata store, and if a name can't be resolved the last
+// known resolution is used so that archived content stays reachable under it.
+func (x *Serve) resolveName(name string) (string, error) {
+	x.mtx.Lock()
+	cached, ok := x.names[name]
+	x.mtx.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.path, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.GatewayTimeout)
+	defer cancel()
+	var out struct {
+		Path string
+	}
+	err := x.sh.Request("name/resolve", name).
+		Option("recursive", true).
+		Exec(ctx, &out)
+	if err != nil {
+		last := cached.path
+		if !ok {
+			rec, dbErr := x.db.GetName(name)
+			if dbErr != nil {
+				return "", err
+			}
+			last = rec.Path
+		}
+		// Cache the last known path again so that the name isn't resolved
+		// on every request while resolving it fails.
+		log.Warningf("Error resolving %s, using last known path %s: %s", name, last, err)
+		x.cacheName(name, last)
+		return last, nil
+	}
+	x.cacheName(name, out.Path)
+
+	root := strings.SplitN(strings.TrimPrefix(out.Path, "/ipfs/"), "/", 2)[0]
+	if _, err := x.db.GetDir(root); err == nil {
+		if err := x.db.PutName(NameRecord{Name: name, Path: out.Path, Resolved: time.Now()}); err != nil {
+			log.Errorf("Error saving resolution of %s: %s", name, err)
+		}
+	}
+	return out.Path, nil
+}
+
+// cacheName caches the resolution of the name for the name cache TTL.
+func (x *Serve) cacheName(name, pth string) {
+	x.mtx.Lock()
+	x.names[name] = resolvedName{path: pth, expires: time.Now().Add(x.NameCacheTTL)}
+	x.mtx.Unlock()
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestResolver returns a Serve whose IPFS node resolves the given names and
+// fails to resolve any other, along with the number of resolutions made.
+func newTestResolver(t *testing.T, db MetadataStore, names map[string]string) (*Serve, func() int) {
+	var mtx sync.Mutex
+	var resolves int
+	sh := newTestShell(t, map[string]http.HandlerFunc{
+		"name/resolve": func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			resolves++
+			mtx.Unlock()
+			pth, ok := names[r.URL.Query().Get("arg")]
+			if !ok {
+				ipfsError(w, "could not resolve name")
+				return
+			}
+			writeJSON(w, map[string]string{"Path": pth})
+		},
+	})
+	x := &Serve{
+		db:             db,
+		sh:             sh,
+		names:          make(map[string]resolvedName),
+		GatewayTimeout: time.Second,
+		NameCacheTTL:   time.Hour,
+	}
+	return x, func() int {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return resolves
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	db := newTestBoltStore(t)
+	if err := db.PutDir(Dir{RootCID: "R"}); err != nil {
+		t.Fatal(err)
+	}
+	x, resolves := newTestResolver(t, db, map[string]string{"good": "/ipfs/R/a"})
+
+	for i := 0; i < 2; i++ {
+		if pth, err := x.resolveName("good"); err != nil || pth != "/ipfs/R/a" {
+			t.Fatalf("resolveName(good): got %q, %v", pth, err)
+		}
+	}
+	if n := resolves(); n != 1 {
+		t.Errorf("got %d resolutions, want 1", n)
+	}
+	if rec, err := db.GetName("good"); err != nil || rec.Path != "/ipfs/R/a" {
+		t.Errorf("saved resolution: got %+v, %v", rec, err)
+	}
+	if _, err := x.resolveName("missing"); err == nil {
+		t.Error("resolveName(missing): expected an error")
+	}
+}
+
+func TestResolveNameFallback(t *testing.T) {
+	db := newTestBoltStore(t)
+	if err := db.PutName(NameRecord{Name: "saved", Path: "/ipfs/S", Resolved: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	x, resolves := newTestResolver(t, db, nil)
+	x.names["expired"] = resolvedName{path: "/ipfs/E", expires: time.Now().Add(-time.Minute)}
+
+	// The last known path is cached again, so failing names aren't resolved
+	// on every request.
+	for _, tt := range []struct{ name, want string }{
+		{"expired", "/ipfs/E"},
+		{"saved", "/ipfs/S"},
+	} {
+		for i := 0; i < 2; i++ {
+			if pth, err := x.resolveName(tt.name); err != nil || pth != tt.want {
+				t.Errorf("resolveName(%s): got %q, %v", tt.name, pth, err)
+			}
+		}
+		if cached := x.names[tt.name]; cached.path != tt.want || !cached.expires.After(time.Now()) {
+			t.Errorf("cached resolution of %s: got %+v", tt.name, cached)
+		}
+	}
+	if n := resolves(); n != 2 {
+		t.Errorf("got %d resolutions, want 2", n)
+	}
+}
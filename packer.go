@@ -0,0 +1,173 @@
+package main
+
+import (
+	"path"
+	"sort"
+)
+
+// packers maps the names accepted by the --packing option of Stage to the
+// functions that assign objects to buckets of at most capacity bytes.
+var packers = map[string]func(objs []Object, capacity int64) [][]Object{
+	"ffd":      packFirstFitDecreasing,
+	"locality": packLocality,
+}
+
+// packFirstFitDecreasing assigns objects to buckets of at most capacity bytes using
+// first-fit-decreasing: the largest objects are placed first, each into the
+// first bucket with room for it. Ties are broken by path, so packing the same
+// objects always yields the same buckets, and with them the same bucket CIDs.
+// An object larger than capacity gets a bucket of its own.
+func packFirstFitDecreasing(objs []Object, capacity int64) [][]Object {
+	sorted := make([]Object, len(objs))
+	copy(sorted, objs)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Size != sorted[j].Size {
+			return sorted[i].Size > sorted[j].Size
+		}
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].part < sorted[j].part
+	})
+
+	var (
+		buckets [][]Object
+		sizes   []int64
+	)
+	for _, obj := range sorted {
+		idx := -1
+		for i, size := range sizes {
+			if size+obj.Size <= capacity {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			buckets = append(buckets, nil)
+			sizes = append(sizes, 0)
+			idx = len(buckets) - 1
+		}
+		buckets[idx] = append(buckets[idx], obj)
+		sizes[idx] += obj.Size
+	}
+
+	for _, bucket := range buckets {
+		sortByPath(bucket)
+	}
+	return buckets
+}
+
+// packLocality assigns objects to buckets of at most capacity bytes while
+// keeping directory subtrees together, so that retrieving one bucket brings
+// back a whole logical unit such as a web page and its assets. The tree is
+// walked in path order and each subtree is added to the current bucket if it
+// fits, otherwise to a fresh bucket if it fits there. Only subtrees larger than
+// a bucket are split, at the boundaries of their children.
+func packLocality(objs []Object, capacity int64) [][]Object {
+	p := &localityPacker{capacity: capacity}
+	for _, root := range buildTree(objs) {
+		p.place(root)
+	}
+	p.flush()
+	return p.buckets
+}
+
+type localityPacker struct {
+	capacity int64
+	buckets  [][]Object
+	cur      []Object
+	curSize  int64
+}
+
+func (p *localityPacker) place(n *treeNode) {
+	switch {
+	case n.size <= p.capacity-p.curSize:
+	case n.size <= p.capacity:
+		p.flush()
+	case len(n.children) == 0:
+		// A single object that is larger than a bucket gets one of its own.
+		p.buckets = append(p.buckets, []Object{n.obj})
+		return
+	default:
+		if n.obj.Size > p.capacity-p.curSize {
+			p.flush()
+		}
+		p.add(n.obj)
+		for _, child := range n.children {
+			p.place(child)
+		}
+		return
+	}
+	n.walk(p.add)
+}
+
+func (p *localityPacker) add(obj Object) {
+	p.cur = append(p.cur, obj)
+	p.curSize += obj.Size
+}
+
+func (p *localityPacker) flush() {
+	if len(p.cur) > 0 {
+		sortByPath(p.cur)
+		p.buckets = append(p.buckets, p.cur)
+	}
+	p.cur, p.curSize = nil, 0
+}
+
+// treeNode is an object along with the objects below it in the directory tree.
+type treeNode struct {
+	obj      Object
+	children []*treeNode
+	size     int64
+}
+
+func (n *treeNode) walk(fn func(Object)) {
+	fn(n.obj)
+	for _, child := range n.children {
+		child.walk(fn)
+	}
+}
+
+// buildTree arranges objects into trees by path and returns the roots. The
+// children of each node are in path order and sizes include the whole subtree.
+func buildTree(objs []Object) []*treeNode {
+	sorted := make([]Object, len(objs))
+	copy(sorted, objs)
+	sortByPath(sorted)
+
+	var (
+		roots []*treeNode
+		nodes = make(map[string]*treeNode)
+	)
+	for _, obj := range sorted {
+		n := &treeNode{obj: obj}
+		nodes[obj.Path] = n
+		if parent, ok := nodes[path.Dir(obj.Path)]; ok && obj.Path != "/" {
+			parent.children = append(parent.children, n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+
+	var sum func(n *treeNode) int64
+	sum = func(n *treeNode) int64 {
+		n.size = n.obj.Size
+		for _, child := range n.children {
+			n.size += sum(child)
+		}
+		return n.size
+	}
+	for _, root := range roots {
+		sum(root)
+	}
+	return roots
+}
+
+func sortByPath(objs []Object) {
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].Path != objs[j].Path {
+			return objs[i].Path < objs[j].Path
+		}
+		return objs[i].part < objs[j].part
+	})
+}
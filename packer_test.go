@@ -0,0 +1,84 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func bucketPaths(buckets [][]Object) [][]string {
+	paths := make([][]string, len(buckets))
+	for i, bucket := range buckets {
+		for _, obj := range bucket {
+			paths[i] = append(paths[i], obj.Path)
+		}
+	}
+	return paths
+}
+
+func equalBuckets(a, b [][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalStrings(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// testPacker checks that packing objs yields want however the objects are
+// ordered, since bucket CIDs depend on it.
+func testPacker(t *testing.T, pack func([]Object, int64) [][]Object, objs []Object, capacity int64, want [][]string) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		shuffled := make([]Object, len(objs))
+		copy(shuffled, objs)
+		rng.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		if got := bucketPaths(pack(shuffled, capacity)); !equalBuckets(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPackFirstFitDecreasing(t *testing.T) {
+	objs := []Object{
+		{Path: "/r/a", Size: 6},
+		{Path: "/r/b", Size: 5},
+		{Path: "/r/c", Size: 4},
+		{Path: "/r/d", Size: 3},
+		{Path: "/r/e", Size: 2},
+		{Path: "/r/f", Size: 4},
+		{Path: "/r/g", Size: 12},
+	}
+	want := [][]string{
+		{"/r/g"},
+		{"/r/a", "/r/c"},
+		{"/r/b", "/r/f"},
+		{"/r/d", "/r/e"},
+	}
+	testPacker(t, packFirstFitDecreasing, objs, 10, want)
+}
+
+func TestPackLocality(t *testing.T) {
+	objs := []Object{
+		{Path: "/r", Size: 1, IsDir: true},
+		{Path: "/r/a", Size: 1, IsDir: true},
+		{Path: "/r/a/x", Size: 4},
+		{Path: "/r/a/y", Size: 3},
+		{Path: "/r/b", Size: 1, IsDir: true},
+		{Path: "/r/b/z", Size: 5},
+		{Path: "/r/c", Size: 12},
+	}
+	// /r is too large for one bucket so it is split at its children, and
+	// /r/c, which doesn't fit anywhere, gets a bucket of its own straight away.
+	want := [][]string{
+		{"/r", "/r/a", "/r/a/x", "/r/a/y"},
+		{"/r/c"},
+		{"/r/b", "/r/b/z"},
+	}
+	testPacker(t, packLocality, objs, 10, want)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// planLargestFiles is how many of the largest files of each bucket are listed
+// in a staging plan.
+const planLargestFiles = 5
+
+// stagePlan describes what a staging would do, as printed by a dry run.
+type stagePlan struct {
+	RootCID    string       `json:"rootCid"`
+	Packing    string       `json:"packing"`
+	BucketSize int64        `json:"bucketSize"`
+	Buckets    []bucketPlan `json:"buckets"`
+
+	// ReusedObjects counts the objects that are already held in buckets of
+	// other staged directories and so aren't staged again.
+	ReusedObjects int `json:"reusedObjects"`
+
+	// SplitFiles counts the files too large for a single bucket.
+	SplitFiles int `json:"splitFiles"`
+
+	TotalSize      int64 `json:"totalSize"`
+	WastedCapacity int64 `json:"wastedCapacity"`
+}
+
+type bucketPlan struct {
+	Size           int64         `json:"size"`
+	Files          int           `json:"files"`
+	Dirs           int           `json:"dirs"`
+	WastedCapacity int64         `json:"wastedCapacity"`
+	LargestFiles   []plannedFile `json:"largestFiles"`
+}
+
+// plannedFile is a file, or part of a split file, in a planned bucket. Parts
+// are numbered from 1.
+type plannedFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	Part int    `json:"part,omitempty"`
+}
+
+func newStagePlan(rootCid, packing string, capacity int64, buckets [][]Object, reused []Object, split map[string]*Object) stagePlan {
+	plan := stagePlan{
+		RootCID:       rootCid,
+		Packing:       packing,
+		BucketSize:    capacity,
+		Buckets:       []bucketPlan{},
+		ReusedObjects: len(reused),
+		SplitFiles:    len(split),
+	}
+	for _, bucket := range buckets {
+		var bp bucketPlan
+		files := []plannedFile{}
+		for _, obj := range bucket {
+			bp.Size += obj.Size
+			if obj.IsDir {
+				bp.Dirs++
+				continue
+			}
+			bp.Files++
+			f := plannedFile{Path: obj.Path, Size: obj.Size}
+			if obj.blocks != nil {
+				f.Part = obj.part + 1
+			}
+			files = append(files, f)
+		}
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].Size > files[j].Size
+		})
+		if len(files) > planLargestFiles {
+			files = files[:planLargestFiles]
+		}
+		bp.LargestFiles = files
+		if bp.Size < capacity {
+			bp.WastedCapacity = capacity - bp.Size
+		}
+
+		plan.Buckets = append(plan.Buckets, bp)
+		plan.TotalSize += bp.Size
+		plan.WastedCapacity += bp.WastedCapacity
+	}
+	return plan
+}
+
+func (p stagePlan) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
+
+func (p stagePlan) writeText(w io.Writer) error {
+	fmt.Fprintf(w, "Plan for %s: %d buckets of %d bytes packed with %s\n", p.RootCID, len(p.Buckets), p.BucketSize, p.Packing)
+	if p.ReusedObjects > 0 {
+		fmt.Fprintf(w, "%d objects are already held in existing buckets\n", p.ReusedObjects)
+	}
+	if p.SplitFiles > 0 {
+		fmt.Fprintf(w, "%d files are split over several buckets\n", p.SplitFiles)
+	}
+	for i, b := range p.Buckets {
+		fmt.Fprintf(w, "\nBucket %d: %d bytes in %d files and %d directories, %d bytes unused\n", i+1, b.Size, b.Files, b.Dirs, b.WastedCapacity)
+		for _, f := range b.LargestFiles {
+			if f.Part > 0 {
+				fmt.Fprintf(w, "  %d\t%s (part %d)\n", f.Size, f.Path, f.Part)
+			} else {
+				fmt.Fprintf(w, "  %d\t%s\n", f.Size, f.Path)
+			}
+		}
+	}
+
+	var wasted float64
+	if len(p.Buckets) > 0 {
+		wasted = 100 * float64(p.WastedCapacity) / float64(int64(len(p.Buckets))*p.BucketSize)
+	}
+	_, err := fmt.Fprintf(w, "\nTotal: %d bytes in %d buckets, %d bytes of capacity unused (%.1f%%)\n", p.TotalSize, len(p.Buckets), p.WastedCapacity, wasted)
+	return err
+}
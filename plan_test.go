@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNewStagePlan(t *testing.T) {
+	buckets := [][]Object{
+		{
+			{Path: "/ipfs/R", Size: 1, IsDir: true},
+			{Path: "/ipfs/R/a", Size: 1},
+			{Path: "/ipfs/R/b", Size: 3},
+			{Path: "/ipfs/R/c", Size: 2},
+			{Path: "/ipfs/R/d", Size: 3},
+			{Path: "/ipfs/R/e", Size: 5},
+			{Path: "/ipfs/R/f", Size: 4},
+		},
+		{
+			{Path: "/ipfs/R/big", Size: 20, blocks: []string{"L1"}, part: 0},
+		},
+		{
+			{Path: "/ipfs/R/big", Size: 12, blocks: []string{"L2"}, part: 1},
+			{Path: "/ipfs/R/g", IsDir: true, Size: 1},
+		},
+	}
+	reused := []Object{{Path: "/ipfs/R/h"}, {Path: "/ipfs/R/i"}}
+	split := map[string]*Object{"/ipfs/R/big": {Path: "/ipfs/R/big"}}
+
+	plan := newStagePlan("R", "ffd", 20, buckets, reused, split)
+	if plan.RootCID != "R" || plan.Packing != "ffd" || plan.BucketSize != 20 || plan.ReusedObjects != 2 || plan.SplitFiles != 1 {
+		t.Errorf("got %+v", plan)
+	}
+	if plan.TotalSize != 52 || plan.WastedCapacity != 8 || len(plan.Buckets) != 3 {
+		t.Fatalf("got total %d, wasted %d in %d buckets", plan.TotalSize, plan.WastedCapacity, len(plan.Buckets))
+	}
+
+	// Only the largest files are listed, largest first and in bucket order
+	// among files of the same size.
+	b := plan.Buckets[0]
+	if b.Size != 19 || b.Files != 6 || b.Dirs != 1 || b.WastedCapacity != 1 {
+		t.Errorf("bucket 1: got %+v", b)
+	}
+	want := []plannedFile{
+		{Path: "/ipfs/R/e", Size: 5},
+		{Path: "/ipfs/R/f", Size: 4},
+		{Path: "/ipfs/R/b", Size: 3},
+		{Path: "/ipfs/R/d", Size: 3},
+		{Path: "/ipfs/R/c", Size: 2},
+	}
+	if len(b.LargestFiles) != len(want) {
+		t.Fatalf("bucket 1 largest files: got %+v", b.LargestFiles)
+	}
+	for i, f := range b.LargestFiles {
+		if f != want[i] {
+			t.Errorf("bucket 1 largest file %d: got %+v, want %+v", i, f, want[i])
+		}
+	}
+
+	// Parts of split files are numbered from 1 and a full bucket wastes
+	// nothing.
+	if b := plan.Buckets[1]; b.WastedCapacity != 0 || len(b.LargestFiles) != 1 || b.LargestFiles[0].Part != 1 {
+		t.Errorf("bucket 2: got %+v", b)
+	}
+	if b := plan.Buckets[2]; b.Files != 1 || b.Dirs != 1 || b.WastedCapacity != 7 || b.LargestFiles[0].Part != 2 {
+		t.Errorf("bucket 3: got %+v", b)
+	}
+
+	var buf bytes.Buffer
+	if err := plan.writeText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"Plan for R: 3 buckets of 20 bytes packed with ffd",
+		"2 objects are already held in existing buckets",
+		"  12\t/ipfs/R/big (part 2)",
+		"Total: 52 bytes in 3 buckets, 8 bytes of capacity unused (13.3%)",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("text plan is missing %q:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestNewStagePlanEmpty(t *testing.T) {
+	plan := newStagePlan("R", "locality", 20, nil, nil, nil)
+	var buf bytes.Buffer
+	if err := plan.writeJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"buckets": []`) {
+		t.Errorf("empty plan: got %s", buf.String())
+	}
+	buf.Reset()
+	if err := plan.writeText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "(0.0%)") {
+		t.Errorf("empty text plan: got %s", buf.String())
+	}
+}
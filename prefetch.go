@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	shell "github.com/ipfs/go-ipfs-api"
+	"path"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Buckets retrieved within coAccessWindow of each other are counted as
+// co-accessed, and are prefetched together once that happened minCoAccesses
+// times. The history is kept in memory for the life of the server.
+const (
+	coAccessWindow = time.Minute
+	minCoAccesses  = 2
+)
+
+// bucketRequest is a request for a bucket that wasn't in IPFS.
+type bucketRequest struct {
+	bucket string
+	at     time.Time
+}
+
+// prefetch queues retrievals of buckets related to the requested object, up to
+// the prefetch limit: first those holding the other entries of its directory,
+// then those that were often requested along with its buckets. Buckets whose
+// content is already in IPFS are skipped.
+func (x *Serve) prefetch(obj Object) {
+	requested := obj.Buckets()
+	if x.PrefetchCoAccessed {
+		x.recordCoAccess(requested)
+	}
+
+	var buckets []string
+	if x.PrefetchSiblings {
+		buckets = x.siblingBuckets(obj, requested)
+	}
+	if x.PrefetchCoAccessed && len(buckets) < x.PrefetchLimit {
+		for _, bucket := range x.coAccessedBuckets(requested) {
+			if !contains(buckets, bucket) {
+				buckets = append(buckets, bucket)
+			}
+		}
+	}
+	if len(buckets) > x.PrefetchLimit {
+		buckets = buckets[:x.PrefetchLimit]
+	}
+
+	for _, bucket := range buckets {
+		x.mtx.Lock()
+		_, cached := x.cache[bucket]
+		x.mtx.Unlock()
+		if cached {
+			continue
+		}
+		log.Infof("Prefetching bucket %s for %s", bucket, obj.Path)
+		x.enqueue(bucket, "", true)
+	}
+}
+
+// siblingBuckets returns the buckets holding the other entries of the
+// directory of obj that aren't in IPFS, leaving out the requested buckets.
+func (x *Serve) siblingBuckets(obj Object, requested []string) []string {
+	parent := path.Dir(obj.Path)
+	if parent == "/ipfs" || parent == "/" {
+		return nil
+	}
+	objs, err := x.db.ListChildren(parent)
+	if err != nil {
+		log.Errorf("Error listing %s: %s", parent, err)
+		return nil
+	}
+	sortByPath(objs)
+
+	var siblings []Object
+	for _, o := range objs {
+		if o.Path == obj.Path {
+			continue
+		}
+		for _, bucket := range o.Buckets() {
+			if bucket != "" && !contains(requested, bucket) {
+				siblings = append(siblings, o)
+				break
+			}
+		}
+	}
+
+	// Siblings are checked a batch at a time so that large directories stop
+	// being checked once the limit is reached.
+	var buckets []string
+	for start := 0; start < len(siblings) && len(buckets) < x.PrefetchLimit; start += maxLocalChecks {
+		batch := siblings[start:]
+		if len(batch) > maxLocalChecks {
+			batch = batch[:maxLocalChecks]
+		}
+		local := localObjects(x.sh, batch)
+		for i, o := range batch {
+			if local[i] {
+				continue
+			}
+			for _, bucket := range o.Buckets() {
+				if bucket != "" && !contains(requested, bucket) && !contains(buckets, bucket) {
+					buckets = append(buckets, bucket)
+				}
+			}
+			if len(buckets) >= x.PrefetchLimit {
+				break
+			}
+		}
+	}
+	return buckets
+}
+
+// recordCoAccess counts the requested buckets as co-accessed with every bucket
+// requested within the window before them.
+func (x *Serve) recordCoAccess(requested []string) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	now := time.Now()
+	recent := x.recentRequests[:0]
+	for _, r := range x.recentRequests {
+		if now.Sub(r.at) <= coAccessWindow {
+			recent = append(recent, r)
+		}
+	}
+	for _, bucket := range requested {
+		for _, r := range recent {
+			if r.bucket == bucket || contains(requested, r.bucket) {
+				continue
+			}
+			x.countCoAccess(bucket, r.bucket)
+			x.countCoAccess(r.bucket, bucket)
+		}
+	}
+	for _, bucket := range requested {
+		recent = append(recent, bucketRequest{bucket: bucket, at: now})
+	}
+	x.recentRequests = recent
+}
+
+// countCoAccess must be called with the lock held.
+func (x *Serve) countCoAccess(a, b string) {
+	if x.coAccesses[a] == nil {
+		x.coAccesses[a] = make(map[string]int)
+	}
+	x.coAccesses[a][b]++
+}
+
+// coAccessedBuckets returns the buckets most often co-accessed with the
+// requested ones, most frequent first.
+func (x *Serve) coAccessedBuckets(requested []string) []string {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	counts := make(map[string]int)
+	for _, bucket := range requested {
+		for other, n := range x.coAccesses[bucket] {
+			if n >= minCoAccesses && !contains(requested, other) {
+				counts[other] += n
+			}
+		}
+	}
+	buckets := make([]string, 0, len(counts))
+	for bucket := range counts {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if counts[buckets[i]] != counts[buckets[j]] {
+			return counts[buckets[i]] > counts[buckets[j]]
+		}
+		return buckets[i] < buckets[j]
+	})
+	return buckets
+}
+
+// maxLocalChecks limits how many blocks are looked up in the local IPFS repo at
+// once.
+const maxLocalChecks = 16
+
+// localObjects reports for each of the objects whether its root block is in
+// the local IPFS repo, checking up to maxLocalChecks of them at once.
+func localObjects(sh *shell.Shell, objs []Object) []bool {
+	local := make([]bool, len(objs))
+	sem := make(chan struct{}, maxLocalChecks)
+	var wg sync.WaitGroup
+	for i := range objs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			local[i] = isLocal(sh, objs[i].Cid)
+			<-sem
+		}(i)
+	}
+	wg.Wait()
+	return local
+}
+
+// isLocal reports whether the block with the given CID is in the local IPFS
+// repo, without looking for it on the network.
+func isLocal(sh *shell.Shell, id string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return sh.Request("block/stat", id).Option("offline", true).Exec(ctx, nil) == nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSiblingBuckets(t *testing.T) {
+	db := putTestObjects(t,
+		Object{Path: "/ipfs/R", Cid: "R", IsDir: true, BucketID: "B0"},
+		Object{Path: "/ipfs/R/a", Cid: "A", BucketID: "B1"},
+		Object{Path: "/ipfs/R/b", Cid: "B", BucketID: "B2"},
+		Object{Path: "/ipfs/R/b2", Cid: "B2", BucketID: "B2"},
+		Object{Path: "/ipfs/R/c", Cid: "C", BucketID: "B3"},
+		Object{Path: "/ipfs/R/d", Cid: "D", IsDir: true, BucketID: "B4"},
+		Object{Path: "/ipfs/R/d/x", Cid: "X", BucketID: "B5"},
+		Object{Path: "/ipfs/R/e", Cid: "E", BucketID: "B1"},
+	)
+	// Every sibling with a bucket other than the requested one is checked at
+	// once, and C is already in IPFS.
+	x := &Serve{db: db, sh: newTestShell(t, localBlocks(4, "C")), PrefetchLimit: 4}
+	obj, err := db.GetObject("/ipfs/R/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := x.siblingBuckets(obj, obj.Buckets())
+	if want := []string{"B2", "B4"}; !equalStrings(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
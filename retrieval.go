@@ -0,0 +1,347 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The states a bucket retrieval goes through.
+const (
+	retrievalQueued      = "queued"
+	retrievalDownloading = "downloading"
+	retrievalImporting   = "importing"
+	retrievalReady       = "ready"
+	retrievalFailed      = "failed"
+)
+
+// retrieval is the progress of retrieving one bucket from Filecoin, as reported
+// by the retrieval status API.
+type retrieval struct {
+	Bucket        string     `json:"bucket"`
+	Paths         []string   `json:"paths"`
+	State         string     `json:"state"`
+	Requested     time.Time  `json:"requested"`
+	Prefetch      bool       `json:"prefetch"`
+	Started       *time.Time `json:"started,omitempty"`
+	Finished      *time.Time `json:"finished,omitempty"`
+	BytesReceived int64      `json:"bytesReceived"`
+
+	// Attempts counts the retrievals of the bucket that have been started.
+	// After a failure the bucket isn't retried until NextAttempt, and Error
+	// holds the cause until a retrieval succeeds.
+	Attempts    int        `json:"attempts"`
+	NextAttempt *time.Time `json:"nextAttempt,omitempty"`
+	Error       string     `json:"error,omitempty"`
+
+	// size is the disk space the retrieval is expected to need.
+	size int64
+	done chan struct{}
+}
+
+func (r *retrieval) finished() bool {
+	return r.State == retrievalReady || r.State == retrievalFailed
+}
+
+// backingOff reports whether the retrieval failed and must not be retried yet.
+func (r *retrieval) backingOff(now time.Time) bool {
+	return r.State == retrievalFailed && now.Before(*r.NextAttempt)
+}
+
+// diskSpaceRetry is how long workers wait for disk space to be freed before
+// checking again.
+const diskSpaceRetry = 30 * time.Second
+
+// fetch queues the bucket for retrieval from Filecoin for the requested path
+// unless it is already queued or in progress. The returned channel is closed
+// once the retrieval is done. A bucket whose last retrieval failed isn't
+// queued again until its backoff has passed, so the closed channel of the
+// failed retrieval is returned instead.
+func (x *Serve) fetch(bucket, pth string) <-chan struct{} {
+	return x.enqueue(bucket, pth, false)
+}
+
+// enqueue implements fetch. Prefetched buckets have no requested path and are
+// only retrieved once no bucket a client asked for is waiting.
+func (x *Serve) enqueue(bucket, pth string, prefetch bool) <-chan struct{} {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	now := time.Now()
+	prev, ok := x.retrievals[bucket]
+	if ok && (!prev.finished() || prev.backingOff(now)) {
+		if !prefetch {
+			prev.Requested = now
+			prev.Prefetch = false
+			if !contains(prev.Paths, pth) {
+				prev.Paths = append(prev.Paths, pth)
+			}
+		}
+		return prev.done
+	}
+	r := &retrieval{
+		Bucket:    bucket,
+		State:     retrievalQueued,
+		Requested: now,
+		Prefetch:  prefetch,
+		size:      int64(x.BucketSize),
+		done:      make(chan struct{}),
+	}
+	if !prefetch {
+		r.Paths = []string{pth}
+	}
+	if ok && prev.State == retrievalFailed {
+		r.Attempts = prev.Attempts
+		r.Error = prev.Error
+	}
+	x.retrievals[bucket] = r
+	x.queue = append(x.queue, r)
+	x.queued.Signal()
+	x.publish(r)
+	return r.done
+}
+
+// retrievalWorker runs queued retrievals one at a time, forever.
+func (x *Serve) retrievalWorker() {
+	for {
+		x.fetchBucketFromFilecoin(x.nextRetrieval())
+	}
+}
+
+// nextRetrieval blocks until a retrieval is queued, then takes the most
+// recently requested bucket off the queue once there is enough free disk space
+// to run it. Recent requests go first since their clients are the most likely
+// to still be waiting, and prefetches go last.
+func (x *Serve) nextRetrieval() string {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	for {
+		for len(x.queue) == 0 {
+			x.queued.Wait()
+		}
+
+		next := 0
+		for i, r := range x.queue {
+			if n := x.queue[next]; (r.Prefetch == n.Prefetch && r.Requested.After(n.Requested)) || (n.Prefetch && !r.Prefetch) {
+				next = i
+			}
+		}
+		r := x.queue[next]
+		if !x.hasDiskSpace(r) {
+			x.mtx.Unlock()
+			time.Sleep(diskSpaceRetry)
+			x.mtx.Lock()
+			continue
+		}
+		x.queue = append(x.queue[:next], x.queue[next+1:]...)
+		now := time.Now()
+		r.Started = &now
+		r.Attempts++
+		return r.Bucket
+	}
+}
+
+// hasDiskSpace reports whether the temp directory retrievals are downloaded to
+// would keep the configured minimum of free space after the retrieval, and
+// those already running, have received what they are expected to. It must be
+// called with the lock held.
+func (x *Serve) hasDiskSpace(next *retrieval) bool {
+	free, err := freeSpace(os.TempDir())
+	if err != nil {
+		log.Errorf("Error checking free disk space: %s", err)
+		return true
+	}
+	need := uint64(next.size)
+	for _, r := range x.retrievals {
+		if r.Started != nil && !r.finished() && r.size > r.BytesReceived {
+			need += uint64(r.size - r.BytesReceived)
+		}
+	}
+	if free < x.MinFreeSpace+need {
+		log.Warningf("Only %d bytes free in %s with %d bytes still to be retrieved, holding %d queued retrievals", free, os.TempDir(), need, len(x.queue))
+		return false
+	}
+	return true
+}
+
+// setRetrievalState moves the retrieval of the bucket to the given state.
+func (x *Serve) setRetrievalState(bucket, state string) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	r := x.retrievals[bucket]
+	r.State = state
+	x.publish(r)
+}
+
+// finishRetrieval records the outcome of the retrieval of the bucket and
+// releases anyone waiting on it.
+func (x *Serve) finishRetrieval(bucket string, err error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	r := x.retrievals[bucket]
+	now := time.Now()
+	r.Finished = &now
+	if err != nil {
+		next := now.Add(x.retrievalDelay(r.Attempts))
+		r.State = retrievalFailed
+		r.Error = err.Error()
+		r.NextAttempt = &next
+		log.Infof("Retrying bucket %s after %s", bucket, next.Format(time.RFC3339))
+	} else {
+		r.State = retrievalReady
+		r.Error = ""
+	}
+	close(r.done)
+	x.publish(r)
+}
+
+// retrievalDelay returns how long to wait before retrying a bucket whose
+// retrieval failed after the given number of attempts. The delay doubles with
+// every attempt up to the maximum backoff.
+func (x *Serve) retrievalDelay(attempts int) time.Duration {
+	delay := x.RetrievalBackoff
+	for i := 1; i < attempts && delay < x.MaxRetrievalBackoff; i++ {
+		delay *= 2
+	}
+	if delay > x.MaxRetrievalBackoff {
+		delay = x.MaxRetrievalBackoff
+	}
+	return delay
+}
+
+// nextAttempt returns the latest time at which any of the given buckets whose
+// retrieval failed will be retried, or the zero time if none are backing off.
+func (x *Serve) nextAttempt(buckets []string) time.Time {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	var next time.Time
+	for _, bucket := range buckets {
+		if r, ok := x.retrievals[bucket]; ok && r.backingOff(time.Now()) && r.NextAttempt.After(next) {
+			next = *r.NextAttempt
+		}
+	}
+	return next
+}
+
+// publish sends an event for the current state of the retrieval. It must be
+// called with the lock held.
+func (x *Serve) publish(r *retrieval) {
+	x.events.Publish(retrievalEvent{
+		Type:        r.State,
+		Bucket:      r.Bucket,
+		Paths:       append([]string(nil), r.Paths...),
+		Error:       r.Error,
+		NextAttempt: r.NextAttempt,
+		Time:        time.Now(),
+	})
+}
+
+// trackBytesReceived updates the bytes received for the retrieval of the
+// bucket from the size of the directory it is downloaded to, until stop is
+// closed.
+func (x *Serve) trackBytesReceived(bucket, dir string, stop <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			size := dirSize(dir)
+			x.mtx.Lock()
+			x.retrievals[bucket].BytesReceived = size
+			x.mtx.Unlock()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// retrievalStatus returns copies of the retrievals of the given buckets, or of
+// every bucket if none are given. Finished retrievals are forgotten once they
+// are older than the retrieval history, but failures are remembered until the
+// history has passed since their next attempt was due.
+func (x *Serve) retrievalStatus(buckets ...string) []retrieval {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	for bucket, r := range x.retrievals {
+		if !r.finished() {
+			continue
+		}
+		since := *r.Finished
+		if r.State == retrievalFailed {
+			since = *r.NextAttempt
+		}
+		if time.Since(since) > x.RetrievalHistory {
+			delete(x.retrievals, bucket)
+		}
+	}
+
+	if len(buckets) == 0 {
+		for bucket := range x.retrievals {
+			buckets = append(buckets, bucket)
+		}
+		sort.Strings(buckets)
+	}
+	status := make([]retrieval, 0, len(buckets))
+	for _, bucket := range buckets {
+		if r, ok := x.retrievals[bucket]; ok {
+			s := *r
+			s.Paths = append([]string(nil), r.Paths...)
+			status = append(status, s)
+		}
+	}
+	return status
+}
+
+// handleRetrievals serves the retrieval status API. /api/retrievals/{bucket}
+// reports on a single bucket, /api/retrievals/?path={path} on the buckets
+// holding a staged path and /api/retrievals/ on every known retrieval.
+func (x *Serve) handleRetrievals(w http.ResponseWriter, r *http.Request) {
+	var resp interface{}
+	switch bucket, pth := strings.TrimPrefix(r.URL.Path, "/api/retrievals/"), r.URL.Query().Get("path"); {
+	case bucket != "":
+		status := x.retrievalStatus(bucket)
+		if len(status) == 0 {
+			http.Error(w, "No retrieval found for bucket", http.StatusNotFound)
+			return
+		}
+		resp = status[0]
+	case pth != "":
+		resolved, err := x.ipfsPath(r, pth)
+		if err != nil {
+			http.Error(w, "Path not found", http.StatusNotFound)
+			return
+		}
+		_, buckets, err := x.resolve(resolved)
+		if err != nil {
+			http.Error(w, "Path not found", http.StatusNotFound)
+			return
+		}
+		resp = x.retrievalStatus(buckets...)
+	default:
+		resp = x.retrievalStatus()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func dirSize(dir string) int64 {
+	var size int64
+	filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
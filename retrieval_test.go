@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetrievalDelay(t *testing.T) {
+	x := &Serve{RetrievalBackoff: time.Minute, MaxRetrievalBackoff: 10 * time.Minute}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{100, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := x.retrievalDelay(tt.attempts); got != tt.want {
+			t.Errorf("retrievalDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"github.com/textileio/powergate/ffs"
+	"time"
+)
+
+// dirSchemaVersion is the version of the Dir layout written by this build.
+// Records written with an older layout are upgraded when the store is opened.
+const dirSchemaVersion = 1
+
+// Object maps a staged file or directory to the bucket that holds it.
+type Object struct {
+	Path     string
+	Cid      string
+	Size     int64
+	IsDir    bool
+	BucketID string
+
+	// Parts is set for files that were too large for a single bucket. They
+	// are staged as raw blocks spread over several buckets, and BucketID is
+	// the bucket holding the root block.
+	Parts []ObjectPart
+
+	// While staging, a part of a split file is packed as an object of its own
+	// holding the CIDs of its blocks.
+	blocks []string
+	part   int
+
+	// dirBlocks holds the blocks of a directory node, which are more than
+	// its root block when the directory is HAMT-sharded.
+	dirBlocks []string
+}
+
+// ObjectPart records which bucket holds a range of the blocks of a split file.
+// First and Last index the file's blocks, inclusive, in the order they are
+// listed by a recursive refs walk starting with the root block.
+type ObjectPart struct {
+	BucketID string
+	First    int
+	Last     int
+	Size     int64
+}
+
+// Buckets returns every bucket holding data for the object.
+func (o Object) Buckets() []string {
+	if len(o.Parts) == 0 {
+		return []string{o.BucketID}
+	}
+	buckets := make([]string, len(o.Parts))
+	for i, part := range o.Parts {
+		buckets[i] = part.BucketID
+	}
+	return buckets
+}
+
+// Dir is the record for a staged root directory and its buckets.
+type Dir struct {
+	SchemaVersion int
+	RootCID       string
+	Buckets       []string
+
+	// Previous is the root CID of the earlier staging this one was made
+	// incrementally from, if any.
+	Previous string
+
+	// Jobs holds the latest storage job for each bucket, keyed by bucket CID.
+	Jobs map[string]BucketJob
+}
+
+// StageSession records the progress of a staging so that an interrupted run
+// can pick up where it left off. Buckets maps the digest of the contents of
+// each bucket staged so far to the CID it was staged as.
+type StageSession struct {
+	RootCID string
+	Buckets map[string]string
+	Started time.Time
+}
+
+// CachedBucket records a bucket that Serve retrieved from Filecoin and pinned
+// in IPFS, so that it can be evicted again once it is no longer accessed.
+type CachedBucket struct {
+	Bucket string
+	Size   int64
+
+	// Pins holds the CIDs pinned when the bucket was imported.
+	Pins []string
+
+	Retrieved time.Time
+	Accessed  time.Time
+}
+
+// NameRecord is the last resolution of an IPNS or DNSLink name to the path of
+// a staged directory. It lets the content be served after the name can no
+// longer be resolved, such as once its IPNS record has expired.
+type NameRecord struct {
+	Name     string
+	Path     string
+	Resolved time.Time
+}
+
+// BucketJob is the state of the latest Filecoin storage job for a bucket.
+type BucketJob struct {
+	JobID  string
+	Status string
+	Error  string
+
+	// Deals holds the proposal CIDs of the deals made for the bucket.
+	Deals []string
+
+	// Attempts counts how many times the storage config has been pushed.
+	Attempts int
+
+	Created time.Time
+	Updated time.Time
+}
+
+// Succeeded reports whether the bucket was stored successfully.
+func (j BucketJob) Succeeded() bool {
+	return j.Status == ffs.JobStatusStr[ffs.Success]
+}
+
+// Failed reports whether the job ended without storing the bucket.
+func (j BucketJob) Failed() bool {
+	return j.Status == ffs.JobStatusStr[ffs.Failed] || j.Status == ffs.JobStatusStr[ffs.Canceled]
+}
+
+// upgradeDir converts a Dir record written before schema versioning, whose
+// jobs were keyed by job ID, into the current layout. The bucket of a legacy
+// job is taken from its CID when that was persisted, which the mongo store
+// never managed to do, or otherwise assumed when the dir has a single bucket.
+// Jobs that can't be matched to a bucket are dropped; the job tracker recovers
+// them from Powergate when it resumes.
+func upgradeDir(rootCID string, buckets []string, legacy map[string]ffs.Job) Dir {
+	dir := Dir{
+		SchemaVersion: dirSchemaVersion,
+		RootCID:       rootCID,
+		Buckets:       buckets,
+		Jobs:          make(map[string]BucketJob),
+	}
+	now := time.Now()
+	for _, job := range legacy {
+		var bucket string
+		switch {
+		case job.Cid.Defined():
+			bucket = job.Cid.String()
+		case len(buckets) == 1:
+			bucket = buckets[0]
+		default:
+			log.Warningf("Dropping job %s of %s during migration: unknown bucket", job.ID, rootCID)
+			continue
+		}
+
+		next := BucketJob{
+			JobID:    job.ID.String(),
+			Status:   ffs.JobStatusStr[job.Status],
+			Error:    job.ErrCause,
+			Attempts: 1,
+			Created:  now,
+			Updated:  now,
+		}
+		if prev, ok := dir.Jobs[bucket]; ok {
+			next.Attempts += prev.Attempts
+			if r, s := upgradeRank(prev), upgradeRank(next); r > s || (r == s && prev.JobID > next.JobID) {
+				prev.Attempts = next.Attempts
+				next = prev
+			}
+		}
+		dir.Jobs[bucket] = next
+	}
+	return dir
+}
+
+// upgradeRank orders the legacy jobs of a bucket so that the job kept doesn't
+// depend on the order they are read in: a successful job wins over one in
+// progress, which wins over a failed one.
+func upgradeRank(job BucketJob) int {
+	switch {
+	case job.Succeeded():
+		return 2
+	case job.Failed():
+		return 0
+	}
+	return 1
+}
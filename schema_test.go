@@ -0,0 +1,72 @@
+package main
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/ffs"
+	"testing"
+)
+
+func TestUpgradeDir(t *testing.T) {
+	b1, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := cid.Decode("QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A job without a CID can only be matched to the only bucket.
+	dir := upgradeDir("R", []string{b1.String()}, map[string]ffs.Job{
+		"job1": {ID: "job1", Status: ffs.Executing},
+	})
+	if dir.SchemaVersion != dirSchemaVersion || dir.RootCID != "R" {
+		t.Errorf("upgraded dir: got %+v", dir)
+	}
+	if job := dir.Jobs[b1.String()]; job.JobID != "job1" || job.Status != "Executing" || job.Attempts != 1 {
+		t.Errorf("single bucket: got %+v", dir.Jobs)
+	}
+
+	// With several buckets it is dropped.
+	dir = upgradeDir("R", []string{b1.String(), b2.String()}, map[string]ffs.Job{
+		"job1": {ID: "job1", Status: ffs.Executing},
+		"job2": {ID: "job2", Cid: b2, Status: ffs.Success},
+	})
+	if len(dir.Jobs) != 1 || dir.Jobs[b2.String()].JobID != "job2" {
+		t.Errorf("several buckets: got %+v", dir.Jobs)
+	}
+
+	// Of several jobs for the same bucket a successful one wins, then one in
+	// progress, whatever order they are read in, and every attempt counts.
+	// Map iteration order varies, so each case is upgraded a few times.
+	tests := []struct {
+		jobs map[string]ffs.Job
+		want string
+	}{
+		{map[string]ffs.Job{
+			"job1": {ID: "job1", Cid: b1, Status: ffs.Failed},
+			"job2": {ID: "job2", Cid: b1, Status: ffs.Success},
+		}, "job2"},
+		{map[string]ffs.Job{
+			"job1": {ID: "job1", Cid: b1, Status: ffs.Executing},
+			"job2": {ID: "job2", Cid: b1, Status: ffs.Success},
+		}, "job2"},
+		{map[string]ffs.Job{
+			"job1": {ID: "job1", Cid: b1, Status: ffs.Success},
+			"job2": {ID: "job2", Cid: b1, Status: ffs.Executing},
+			"job3": {ID: "job3", Cid: b1, Status: ffs.Canceled},
+		}, "job1"},
+		{map[string]ffs.Job{
+			"job1": {ID: "job1", Cid: b1, Status: ffs.Queued},
+			"job2": {ID: "job2", Cid: b1, Status: ffs.Failed},
+		}, "job1"},
+	}
+	for i, tt := range tests {
+		for n := 0; n < 20; n++ {
+			job := upgradeDir("R", []string{b1.String()}, tt.jobs).Jobs[b1.String()]
+			if job.JobID != tt.want || job.Attempts != len(tt.jobs) {
+				t.Fatalf("case %d: got %+v, want %s after %d attempts", i, job, tt.want, len(tt.jobs))
+			}
+		}
+	}
+}
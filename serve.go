@@ -2,20 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ipfs/go-cid"
+	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/ob1company/amzn/static"
 	"github.com/op/go-logging"
 	powergate "github.com/textileio/powergate/api/client"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -23,40 +24,92 @@ import (
 var log = logging.MustGetLogger("amzn")
 
 type Serve struct {
-	IpfGateway       string `short:"g" long:"gateway" description:"The hostname:port of the IPFS Gateway." default:"127.0.0.1:8080"`
-	IPFSReverseProxy string `long:"ipfsreverseproxy" description:"An IPFS reverse proxy address if needed." default:"127.0.0.1:6002"`
-	DbAPI            string `long:"db" default:"localhost:27017"`
-	Port             int    `short:"p" long:"port" default:"8000"`
-	PowergateAPI     string `short:"a" long:"powergateapi" description:"The hostname:port of the Powergate API." default:"127.0.0.1:5002"`
-	PowergateToken   string `long:"powergatetoken" description:"An authentication token for powergate if needed." default:""`
+	IpfGateway          string        `short:"g" long:"gateway" description:"The hostname:port of the IPFS Gateway." default:"127.0.0.1:8080"`
+	IpfsAPI             string        `long:"ipfsapi" description:"The hostname:port of the IPFS API." default:"127.0.0.1:5001"`
+	IPFSReverseProxy    string        `long:"ipfsreverseproxy" description:"An IPFS reverse proxy address if needed." default:"127.0.0.1:6002"`
+	DbBackend           string        `long:"dbbackend" description:"The metadata store to use." choice:"mongo" choice:"bolt" default:"mongo"`
+	DbAPI               string        `long:"db" default:"localhost:27017"`
+	DbPath              string        `long:"dbpath" description:"The path to the database file for the bolt metadata store." default:"amzn.db"`
+	Port                int           `short:"p" long:"port" default:"8000"`
+	PowergateAPI        string        `short:"a" long:"powergateapi" description:"The hostname:port of the Powergate API." default:"127.0.0.1:5002"`
+	PowergateToken      string        `long:"powergatetoken" description:"An authentication token for powergate if needed." default:""`
+	GatewayTimeout      time.Duration `long:"gatewaytimeout" description:"How long to wait for the IPFS gateway to respond before looking in Filecoin." default:"30s"`
+	MaxWait             time.Duration `long:"maxwait" description:"The longest a client that asks to wait for a retrieval from Filecoin is held before timing out." default:"10m"`
+	RetrievalHistory    time.Duration `long:"retrievalhistory" description:"How long finished retrievals are reported by the retrieval status API." default:"1h"`
+	RetrievalWorkers    int           `long:"retrievalworkers" description:"The number of buckets retrieved from Filecoin at once." default:"2"`
+	RetrievalBackoff    time.Duration `long:"retrievalbackoff" description:"How long to wait before retrying a bucket whose retrieval failed. Doubles with every failed attempt." default:"1m"`
+	MaxRetrievalBackoff time.Duration `long:"maxretrievalbackoff" description:"The longest to wait before retrying a failed retrieval." default:"1h"`
+	PrefetchSiblings    bool          `long:"prefetchsiblings" description:"Also retrieve the buckets holding the other entries of the directory of a path retrieved from Filecoin."`
+	PrefetchCoAccessed  bool          `long:"prefetchcoaccessed" description:"Also retrieve the buckets that were often retrieved together with those of a path retrieved from Filecoin."`
+	PrefetchLimit       int           `long:"prefetchlimit" description:"The most buckets prefetched for one request." default:"4"`
+	CacheTTL            time.Duration `long:"cachettl" description:"How long content retrieved from Filecoin stays pinned in IPFS after it was last accessed. 0 keeps it regardless of access." default:"168h"`
+	CacheBudget         uint64        `long:"cachebudget" description:"The size in bytes the IPFS repo may grow to before the least recently accessed content retrieved from Filecoin is unpinned. 0 disables the budget." default:"0"`
+	JanitorInterval     time.Duration `long:"janitorinterval" description:"How often to look for retrieved content to unpin from IPFS." default:"10m"`
+	DNSLink             bool          `long:"dnslink" description:"Resolve the host of requests for paths outside /ipfs/ and /ipns/ as a DNSLink name."`
+	NameCacheTTL        time.Duration `long:"namecachettl" description:"How long IPNS and DNSLink name resolutions are cached." default:"1m"`
+	MinFreeSpace        uint64        `long:"minfreespace" description:"The free disk space in bytes to keep in the temp directory. Retrievals wait in the queue until they fit without going below it." default:"10000000000"`
+	BucketSize          uint64        `long:"bucketsize" description:"The bucket size content was staged with, used as the disk space a retrieval needs." default:"1000000000"`
 
-	inflightFilecoinRequests map[string]bool
-	mtx                      sync.RWMutex
-	db                       *mongo.Collection
-	powergateClient          *powergate.Client
+	retrievals     map[string]*retrieval
+	queue          []*retrieval
+	queued         *sync.Cond
+	cache          map[string]*CachedBucket
+	recentRequests []bucketRequest
+	coAccesses     map[string]map[string]int
+	names          map[string]resolvedName
+	events         *eventBroker
+	mtx            sync.Mutex
+	db             MetadataStore
+	ffs            bucketClient
+	sh             *shell.Shell
+	gateway        http.Handler
 }
 
 func (x *Serve) Execute(args []string) error {
-	dbClient, err := mongo.Connect(context.Background(), options.Client().ApplyURI(fmt.Sprintf("mongodb://%s", x.DbAPI)))
+	if x.RetrievalWorkers < 1 {
+		return errors.New("retrievalworkers must be at least 1")
+	}
+
+	db, err := openMetadataStore(x.DbBackend, x.DbAPI, x.DbPath, true)
 	if err != nil {
 		return err
 	}
-	defer dbClient.Disconnect(context.Background())
-
-	collection := dbClient.Database("filemapdb").Collection("files")
-	x.db = collection
+	defer db.Close()
+	x.db = db
 
-	x.inflightFilecoinRequests = make(map[string]bool)
-	x.mtx = sync.RWMutex{}
+	x.retrievals = make(map[string]*retrieval)
+	x.events = newEventBroker()
+	x.queued = sync.NewCond(&x.mtx)
+	x.cache = make(map[string]*CachedBucket)
+	x.coAccesses = make(map[string]map[string]int)
+	x.names = make(map[string]resolvedName)
 
 	powergateClient, err := powergate.NewClient(x.PowergateAPI)
 	if err != nil {
 		return err
 	}
 	defer powergateClient.Close()
-	x.powergateClient = powergateClient
+	x.ffs = powergateClient.FFS
+	x.sh = shell.NewShell(x.IpfsAPI)
+	x.gateway = newGatewayProxy(x.IpfGateway, x.GatewayTimeout, x.serveFromFilecoin)
+
+	if err := x.loadCache(); err != nil {
+		return err
+	}
+	for i := 0; i < x.RetrievalWorkers; i++ {
+		go x.retrievalWorker()
+	}
+	if x.CacheTTL > 0 || x.CacheBudget > 0 {
+		go x.runJanitor()
+	}
 
 	http.HandleFunc("/ipfs/", x.handle)
+	http.HandleFunc("/ipns/", x.handleName)
+	if x.DNSLink {
+		http.HandleFunc("/", x.handleName)
+	}
+	http.HandleFunc("/api/retrievals/", x.handleRetrievals)
+	http.HandleFunc("/api/events", x.handleEvents)
 
 	log.Infof("Http server running on :%d", x.Port)
 
@@ -68,75 +121,362 @@ func (x *Serve) Execute(args []string) error {
 }
 
 func (x *Serve) handle(w http.ResponseWriter, r *http.Request) {
-	client := http.Client{
-		Timeout: time.Second * 30,
+	go x.touch(r.URL.Path)
+	x.gateway.ServeHTTP(w, r)
+}
+
+// serveFromFilecoin handles requests for content the IPFS gateway doesn't have.
+// If the path was staged, retrieval of its buckets from Filecoin is started.
+// Clients that ask to wait are held until the retrieval completes and then
+// served the content, while everyone else gets the fetching page. Directories
+// without an index.html are listed from the metadata store instead.
+func (x *Serve) serveFromFilecoin(w http.ResponseWriter, r *http.Request) {
+	obj, buckets, err := x.resolve(r.URL.Path)
+	if err != nil {
+		serveNotFound(w)
+		return
 	}
-	resp, err := client.Get(fmt.Sprintf("http://%s%s", x.IpfGateway, r.URL.Path))
-	if err == nil {
-		io.Copy(w, resp.Body)
+	if obj.IsDir {
+		x.serveListing(w, r, obj)
+		return
+	}
+	if r.Context().Value(waitedKey{}) != nil {
+		if next := x.nextAttempt(buckets); !next.IsZero() {
+			w.Header().Set("Retry-After", strconv.Itoa(int(time.Until(next).Seconds())+1))
+		}
+		http.Error(w, "Content could not be retrieved from Filecoin", http.StatusBadGateway)
 		return
 	}
 
-	var (
-		obj    Object
-		filter = bson.D{{"path", &r.URL.Path}}
-	)
-
-	err = x.db.FindOne(context.Background(), filter).Decode(&obj)
-	if err != nil {
-		w.WriteHeader(http.StatusNotFound)
+	// Files too large for one bucket are spread over several, all of which
+	// are needed to put the file back together. The directories above the
+	// file are usually in buckets that are still cached, so only missing
+	// buckets are retrieved unless the cache claims to hold all of them.
+	if missing := x.uncached(buckets); len(missing) > 0 {
+		buckets = missing
+	}
+	var done []<-chan struct{}
+	for _, bucket := range buckets {
+		done = append(done, x.fetch(bucket, r.URL.Path))
+	}
+	if x.PrefetchSiblings || x.PrefetchCoAccessed {
+		go x.prefetch(obj)
+	}
 
-		notFoundPage, err := static.Asset("notfound.html")
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		w.Write(notFoundPage)
+	if wait := waitDuration(r, x.MaxWait); wait > 0 {
+		x.waitAndServe(w, r, done, wait)
 		return
 	}
+
 	fetchingPage, err := static.Asset("fetching.html")
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	w.Write(fetchingPage)
+}
+
+func serveNotFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+
+	notFoundPage, err := static.Asset("notfound.html")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(notFoundPage)
+}
 
-	x.mtx.RLock()
-	inFlight := x.inflightFilecoinRequests[obj.BucketID]
-	x.mtx.RUnlock()
+// waitedKey marks a request that has already waited for a retrieval.
+type waitedKey struct{}
 
-	if !inFlight {
-		x.mtx.Lock()
-		x.inflightFilecoinRequests[obj.BucketID] = true
-		x.mtx.Unlock()
+// waitAndServe holds the request until every retrieval is done, or the wait
+// runs out, and then serves it from the IPFS gateway.
+func (x *Serve) waitAndServe(w http.ResponseWriter, r *http.Request, done []<-chan struct{}, wait time.Duration) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
 
-		go x.fetchBucketFromFilecoin(obj.BucketID)
+	for _, ch := range done {
+		select {
+		case <-ch:
+		case <-timer.C:
+			http.Error(w, "Timed out waiting for retrieval from Filecoin", http.StatusGatewayTimeout)
+			return
+		case <-r.Context().Done():
+			return
+		}
 	}
+	x.gateway.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), waitedKey{}, true)))
+}
+
+// waitDuration returns how long the client asked to wait for content to be
+// retrieved from Filecoin, capped at max, or zero if it didn't ask to wait.
+// Clients ask with a wait query parameter holding either a boolean or a
+// duration, or with an RFC 7240 "Prefer: wait=<seconds>" header.
+func waitDuration(r *http.Request, max time.Duration) time.Duration {
+	var wait time.Duration
+	if q := r.URL.Query(); q.Get("wait") != "" {
+		if ok, err := strconv.ParseBool(q.Get("wait")); err == nil {
+			if ok {
+				wait = max
+			}
+		} else if d, err := time.ParseDuration(q.Get("wait")); err == nil {
+			wait = d
+		}
+	}
+	for _, pref := range strings.Split(r.Header.Get("Prefer"), ",") {
+		pref = strings.TrimSpace(pref)
+		if strings.HasPrefix(pref, "wait=") {
+			if secs, err := strconv.Atoi(strings.TrimPrefix(pref, "wait=")); err == nil {
+				wait = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait
 }
 
 func (x *Serve) fetchBucketFromFilecoin(bucket string) {
-	defer func() {
-		x.mtx.Lock()
-		delete(x.inflightFilecoinRequests, bucket)
-		x.mtx.Unlock()
-	}()
+	err := x.retrieveBucket(bucket)
+	if err != nil {
+		log.Errorf("Error retrieving bucket %s: %s", bucket, err)
+	}
+	x.finishRetrieval(bucket, err)
+}
 
+// retrieveBucket downloads the bucket from Filecoin and imports it into IPFS.
+func (x *Serve) retrieveBucket(bucket string) error {
+	x.setRetrievalState(bucket, retrievalDownloading)
 	id, err := cid.Decode(bucket)
 	if err != nil {
-		log.Errorf("Error decoding bucket CID: %s", err)
-		return
+		return fmt.Errorf("decoding bucket CID: %s", err)
 	}
 	r := rand.Int63()
 	tmpDir := path.Join(os.TempDir(), fmt.Sprintf("amzn-%d", r))
 	if err := os.Mkdir(tmpDir, os.ModePerm); err != nil {
-		log.Errorf("Error creating temp directory: %s", err)
-		return
+		return fmt.Errorf("creating temp directory: %s", err)
 	}
 	defer os.RemoveAll(tmpDir)
 
-	if err := x.powergateClient.FFS.GetFolder(context.Background(), x.IPFSReverseProxy, id, tmpDir); err != nil {
-		log.Errorf("Error downloading bucket from powergate: %s", err)
-		return
+	stop := make(chan struct{})
+	go x.trackBytesReceived(bucket, tmpDir, stop)
+	ctx := context.WithValue(context.Background(), powergate.AuthKey, x.PowergateToken)
+	carPath := path.Join(tmpDir, bucket+".car")
+	isCar, err := downloadBucket(ctx, x.ffs, x.IPFSReverseProxy, id, carPath)
+	close(stop)
+	if err != nil {
+		return fmt.Errorf("downloading bucket from powergate: %s", err)
+	}
+
+	x.setRetrievalState(bucket, retrievalImporting)
+	size := dirSize(tmpDir)
+	var pins []string
+	if isCar {
+		pins, err = importCar(x.sh, carPath)
+	} else {
+		pins, err = importBucket(x.sh, tmpDir)
+	}
+	if err != nil {
+		return fmt.Errorf("importing bucket into IPFS: %s", err)
+	}
+	x.cacheBucket(bucket, size, pins)
+	log.Infof("Bucket %s imported into IPFS", bucket)
+	return nil
+}
+
+// bucketClient downloads buckets from Powergate. It is implemented by the FFS
+// API of the Powergate client.
+type bucketClient interface {
+	Get(ctx context.Context, c cid.Cid) (io.Reader, error)
+	GetFolder(ctx context.Context, ipfsRevProxyAddr string, c cid.Cid, outputDir string) error
+}
+
+// downloadBucket saves the bucket with the given CID to carPath and reports
+// whether it is a CAR archive. Buckets staged before they were CAR archives are
+// folders, which Powergate refuses to read as a file, so those are saved to the
+// directory of carPath through the IPFS reverse proxy instead.
+func downloadBucket(ctx context.Context, client bucketClient, proxy string, id cid.Cid, carPath string) (bool, error) {
+	err := downloadCar(ctx, client, id, carPath)
+	if err == nil {
+		return true, nil
 	}
+	if err != errInvalidCar && !strings.Contains(err.Error(), "node is a directory") {
+		return false, err
+	}
+	log.Debugf("Bucket %s is not a CAR archive, retrieving it as a folder", id)
+	os.Remove(carPath)
+	return false, client.GetFolder(ctx, proxy, id, path.Dir(carPath))
+}
+
+// downloadCar saves the bucket with the given CID to pth. It returns
+// errInvalidCar if the bucket was downloaded but isn't a CAR archive.
+func downloadCar(ctx context.Context, client bucketClient, id cid.Cid, pth string) error {
+	r, err := client.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(pth)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := newCarReader(f); err != nil {
+		return errInvalidCar
+	}
+	return nil
+}
+
+// importCar puts every block of the CAR archive at pth into the local IPFS node
+// and pins the roots of the archive, which are returned. Roots are pinned
+// recursively when their whole DAG is in the archive and directly otherwise,
+// such as directories whose entries live in other buckets.
+func importCar(sh *shell.Shell, pth string) ([]string, error) {
+	f, err := os.Open(pth)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cr, err := newCarReader(f)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		blk, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := putBlock(sh, blk.Cid(), blk.RawData()); err != nil {
+			return nil, err
+		}
+	}
+
+	var pins []string
+	for _, root := range cr.Header.Roots {
+		// Pinning offline fails straight away if any block is missing
+		// instead of looking for it on the network.
+		err := sh.Request("pin/add", root.String()).
+			Option("recursive", true).
+			Option("offline", true).
+			Exec(context.Background(), nil)
+		if err != nil {
+			err = pinDirect(sh, root)
+		}
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, root.String())
+	}
+	return pins, nil
+}
+
+// importBucket adds the contents of a bucket staged as a folder, as they were
+// before buckets were CAR archives, back into the local IPFS node and pins
+// them. Stage named every entry in such a bucket after the CID it had when
+// staged: directory nodes and the blocks of files too large for one bucket were
+// written as raw blocks while other files were written as their full contents,
+// so each entry is restored the same way it was written. The CIDs pinned are
+// returned.
+func importBucket(sh *shell.Shell, dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var pins []string
+	for _, entry := range entries {
+		id, err := cid.Decode(entry.Name())
+		if err != nil {
+			log.Warningf("Skipping unexpected bucket entry %s", entry.Name())
+			continue
+		}
+
+		pth := path.Join(dir, entry.Name())
+		isBlock, err := isRawBlock(id, pth, entry.Size())
+		if err != nil {
+			return nil, err
+		}
+		if isBlock {
+			if err := importBlock(sh, id, pth); err != nil {
+				return nil, err
+			}
+			pins = append(pins, id.String())
+			continue
+		}
+
+		f, err := os.Open(pth)
+		if err != nil {
+			return nil, err
+		}
+		added, err := sh.Add(f, shell.CidVersion(int(id.Version())))
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if added != id.String() {
+			log.Warningf("File %s was re-added to IPFS as %s", id, added)
+		}
+		pins = append(pins, added)
+	}
+	return pins, nil
+}
+
+// maxBlockSize is the largest block IPFS will accept. Anything bigger than this
+// in a bucket must be file content rather than a raw block.
+const maxBlockSize = 1 << 21
+
+// isRawBlock reports whether the file at pth holds the raw block for id rather
+// than the contents of a UnixFS file.
+func isRawBlock(id cid.Cid, pth string, size int64) (bool, error) {
+	if size > maxBlockSize {
+		return false, nil
+	}
+	data, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return false, err
+	}
+	sum, err := id.Prefix().Sum(data)
+	if err != nil {
+		return false, err
+	}
+	return sum.Equals(id), nil
+}
+
+// importBlock puts the raw block stored at pth into IPFS and pins it directly.
+// The pin is not recursive since the block's children may live in other buckets.
+func importBlock(sh *shell.Shell, id cid.Cid, pth string) error {
+	data, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return err
+	}
+	if err := putBlock(sh, id, data); err != nil {
+		return err
+	}
+	return pinDirect(sh, id)
+}
+
+// putBlock puts a block into IPFS with the format of its CID.
+func putBlock(sh *shell.Shell, id cid.Cid, data []byte) error {
+	format := "v0"
+	if id.Version() != 0 {
+		format = cid.CodecToStr[id.Type()]
+	}
+	_, err := sh.BlockPut(data, format, "sha2-256", -1)
+	return err
+}
 
+func pinDirect(sh *shell.Shell, id cid.Cid) error {
+	return sh.Request("pin/add", id.String()).
+		Option("recursive", false).
+		Exec(context.Background(), nil)
 }
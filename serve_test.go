@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ipfs/go-cid"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestWaitDuration(t *testing.T) {
+	const max = 10 * time.Minute
+	tests := []struct {
+		url    string
+		prefer string
+		want   time.Duration
+	}{
+		{"/ipfs/R/a", "", 0},
+		{"/ipfs/R/a?wait=true", "", max},
+		{"/ipfs/R/a?wait=1", "", max},
+		{"/ipfs/R/a?wait=false", "", 0},
+		{"/ipfs/R/a?wait=30s", "", 30 * time.Second},
+		{"/ipfs/R/a?wait=2h", "", max},
+		{"/ipfs/R/a?wait=soon", "", 0},
+		{"/ipfs/R/a", "wait=20", 20 * time.Second},
+		{"/ipfs/R/a", "respond-async, wait=5", 5 * time.Second},
+		{"/ipfs/R/a", "wait=3600", max},
+		{"/ipfs/R/a", "wait=later", 0},
+		{"/ipfs/R/a?wait=true", "wait=5", 5 * time.Second},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", tt.url, nil)
+		if tt.prefer != "" {
+			r.Header.Set("Prefer", tt.prefer)
+		}
+		if got := waitDuration(r, max); got != tt.want {
+			t.Errorf("waitDuration(%s, Prefer: %q) = %s, want %s", tt.url, tt.prefer, got, tt.want)
+		}
+	}
+}
+
+// fakeBuckets is a bucketClient serving a bucket as the given content, or
+// failing with err once the content has been read.
+type fakeBuckets struct {
+	content []byte
+	err     error
+	folders []string
+}
+
+func (f *fakeBuckets) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
+	r, w := io.Pipe()
+	go func() {
+		w.Write(f.content)
+		w.CloseWithError(f.err)
+	}()
+	return r, nil
+}
+
+func (f *fakeBuckets) GetFolder(ctx context.Context, ipfsRevProxyAddr string, c cid.Cid, outputDir string) error {
+	f.folders = append(f.folders, c.String())
+	return ioutil.WriteFile(path.Join(outputDir, c.String()), []byte("file"), 0644)
+}
+
+func TestDownloadBucket(t *testing.T) {
+	id, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var car bytes.Buffer
+	if err := writeCarHeader(&car, []cid.Cid{id}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		client *fakeBuckets
+		isCar  bool
+		folder bool
+		err    bool
+	}{
+		{"car", &fakeBuckets{content: car.Bytes()}, true, false, false},
+		{"folder", &fakeBuckets{err: errors.New("rpc error: code = Unknown desc = this dag node is a directory")}, false, true, false},
+		{"other content", &fakeBuckets{content: []byte("not a car")}, false, true, false},
+		{"other error", &fakeBuckets{err: errors.New("rpc error: code = Unavailable")}, false, false, true},
+	}
+	for _, tt := range tests {
+		dir, err := ioutil.TempDir("", "amzn-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		carPath := path.Join(dir, id.String()+".car")
+
+		isCar, err := downloadBucket(context.Background(), tt.client, "", id, carPath)
+		if (err != nil) != tt.err || isCar != tt.isCar {
+			t.Errorf("%s: got %v, %v", tt.name, isCar, err)
+		}
+		if folder := len(tt.client.folders) == 1; folder != tt.folder {
+			t.Errorf("%s: retrieved as folder: %v", tt.name, folder)
+		}
+		if _, err := os.Stat(carPath); (err == nil && tt.folder) || (err != nil && tt.isCar) {
+			t.Errorf("%s: CAR archive saved: %v", tt.name, err == nil)
+		}
+	}
+}
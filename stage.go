@@ -2,193 +2,606 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/ipfs/go-cid"
 	shell "github.com/ipfs/go-ipfs-api"
+	files "github.com/ipfs/go-ipfs-files"
 	powergate "github.com/textileio/powergate/api/client"
-	"github.com/textileio/powergate/ffs"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"io"
-	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 type Stage struct {
 	IpfsAPI          string `short:"a" long:"ipfsapi" description:"The hostname:port of the IPFS API." default:"127.0.0.1:5001"`
-	IPFSReverseProxy string `long:"ipfsreverseproxy" description:"An IPFS reverse proxy address if needed." default:"127.0.0.1:6002"`
 	PowergateAPI     string `short:"p" long:"powergateapi" description:"The hostname:port of the Powergate API." default:"127.0.0.1:5002"`
 	PowergateToken   string `long:"powergatetoken" description:"An authentication token for powergate if needed." default:""`
+	IPFSReverseProxy string `long:"ipfsreverseproxy" description:"Deprecated and ignored, since buckets are staged as CAR archives." default:"127.0.0.1:6002"`
+	DbBackend        string `long:"dbbackend" description:"The metadata store to use." choice:"mongo" choice:"bolt" default:"mongo"`
 	DbAPI            string `long:"db" default:"localhost:27017"`
+	DbPath           string `long:"dbpath" description:"The path to the database file for the bolt metadata store." default:"amzn.db"`
 	DirPath          string `short:"d" long:"directory path" description:"The path to the directory to stage."`
+	Cid              string `short:"c" long:"cid" description:"The root CID of content already in IPFS to stage instead of a local directory."`
+	Archive          string `long:"archive" description:"A tar, tar.gz or zip archive to stage instead of a local directory, or - to read it from stdin."`
 	BucketSize       uint64 `short:"b" long:"bucketsize" description:"The size of each bucket stored in filecoin." default:"1000000000"`
-}
-
-type Object struct {
-	Path     string
-	Cid      string
-	Size     int64
-	IsDir    bool
-	BucketID string
-}
+	NoDedup          bool   `long:"nodedup" description:"Stage every file even if identical content is already held in a bucket of another staged directory."`
+	Previous         string `long:"previous" description:"The root CID of an earlier staging of this directory. Only new or changed files are staged while unchanged content keeps its existing buckets."`
+	Packing          string `long:"packing" description:"How files are grouped into buckets. ffd fills buckets as fully as possible while locality keeps directory subtrees together." choice:"ffd" choice:"locality" default:"ffd"`
+	DryRun           bool   `long:"dry-run" description:"Print the planned buckets without pinning anything in IPFS, staging anything in Powergate or saving any records."`
+	Format           string `long:"format" description:"The format of the dry run plan." choice:"text" choice:"json" default:"text"`
 
-type Dir struct {
-	RootCID string
-	Buckets []string
-	Jobs    map[string]ffs.Job
+	// out receives progress messages. They go to stderr when the plan is
+	// printed as JSON so that stdout holds nothing but the plan.
+	out io.Writer
 }
 
 func (x *Stage) Execute(args []string) error {
+	var inputs int
+	for _, in := range []string{x.DirPath, x.Cid, x.Archive} {
+		if in != "" {
+			inputs++
+		}
+	}
+	if inputs != 1 {
+		return errors.New("exactly one of --directory path, --cid and --archive is required")
+	}
+	x.out = os.Stdout
+	if x.DryRun && x.Format == "json" {
+		x.out = os.Stderr
+	}
 	sh := shell.NewShell(x.IpfsAPI)
 
-	client, err := powergate.NewClient(x.PowergateAPI)
+	db, err := x.openStore()
 	if err != nil {
 		return err
 	}
-	defer client.Close()
+	if db != nil {
+		defer db.Close()
+	}
 
-	dbClient, err := mongo.Connect(context.Background(), options.Client().ApplyURI(fmt.Sprintf("mongodb://%s", x.DbAPI)))
+	rootCid, err := x.addRoot(sh)
 	if err != nil {
 		return err
 	}
-	defer dbClient.Disconnect(context.Background())
+	fmt.Fprintf(x.out, "IPFS Root Cid: %s\n\n", rootCid)
 
-	collection := dbClient.Database("filemapdb").Collection("files")
+	var files []Object
+	if err := enumerateFiles("/ipfs/"+rootCid, rootCid, sh, &files); err != nil {
+		return err
+	}
 
-	x.DirPath = strings.TrimSuffix(x.DirPath, "/")
+	var reused []Object
+	if db != nil {
+		if x.Previous != "" {
+			if _, err := db.GetDir(x.Previous); err != nil {
+				return err
+			}
+		}
+		files, reused, err = reuseBuckets(db, x.Previous, !x.NoDedup, files)
+		if err != nil {
+			return err
+		}
+	} else if x.Previous != "" {
+		return fmt.Errorf("previous staging %s: %s", x.Previous, ErrNotFound)
+	}
+	if len(reused) > 0 {
+		fmt.Fprintf(x.out, "Reusing existing buckets for %d already staged objects\n", len(reused))
+	}
 
-	fmt.Print("Adding to IPFS...")
-	rootCid, err := sh.AddDir(x.DirPath)
+	files, split, err := splitLargeFiles(x.out, sh, files, int64(x.BucketSize))
 	if err != nil {
 		return err
 	}
-	fmt.Print("done\n")
-	fmt.Printf("IPFS Root Cid: %s\n\n", rootCid)
 
-	files := make(map[Object]struct{})
-	if err := enumerateFiles(x.DirPath, "/ipfs/"+rootCid, "", rootCid, sh, files); err != nil {
+	buckets := packers[x.Packing](files, int64(x.BucketSize))
+	if x.DryRun {
+		plan := newStagePlan(rootCid, x.Packing, int64(x.BucketSize), buckets, reused, split)
+		if x.Format == "json" {
+			return plan.writeJSON(os.Stdout)
+		}
+		return plan.writeText(os.Stdout)
+	}
+
+	client, err := powergate.NewClient(x.PowergateAPI)
+	if err != nil {
 		return err
 	}
+	defer client.Close()
 
-	buckets := make([][]Object, 1)
-	idx, bucketSize := 0, int64(0)
-	for f := range files {
-		if f.IsDir {
-			buckets[0] = append(buckets[0], f)
-			bucketSize += f.Size
+	session, err := db.GetSession(rootCid)
+	switch err {
+	case nil:
+		fmt.Fprintf(x.out, "Resuming staging with %d buckets already staged\n", len(session.Buckets))
+	case ErrNotFound:
+		session = StageSession{
+			RootCID: rootCid,
+			Buckets: make(map[string]string),
+			Started: time.Now(),
 		}
+	default:
+		return err
 	}
 
-	for f := range files {
-		if f.IsDir {
-			continue
+	var bucketCids []string
+	fmt.Fprint(x.out, "Staging in powergate...")
+	for _, bucket := range buckets {
+		digest := bucketDigest(bucket)
+		bucketID, ok := session.Buckets[digest]
+		if !ok {
+			bucketID, err = x.stageBucket(sh, client, bucket)
+			if err != nil {
+				return err
+			}
+			session.Buckets[digest] = bucketID
+			if err := db.PutSession(session); err != nil {
+				return err
+			}
+		}
+		for j := range bucket {
+			bucket[j].BucketID = bucketID
 		}
+		bucketCids = append(bucketCids, bucketID)
+	}
 
-		if bucketSize+f.Size > int64(x.BucketSize) {
-			buckets = append(buckets, []Object{})
-			idx++
-			bucketSize = 0
+	// Nothing is written to the metadata store until every bucket is staged,
+	// and the writes replace existing records, so an interrupted run that is
+	// resumed never leaves partial or duplicate records behind.
+	for _, bucket := range buckets {
+		for _, obj := range bucket {
+			if obj.blocks != nil {
+				split[obj.Path].Parts[obj.part].BucketID = obj.BucketID
+				continue
+			}
+			if err := db.PutObject(obj); err != nil {
+				return err
+			}
+		}
+	}
+	for _, obj := range split {
+		obj.BucketID = obj.Parts[0].BucketID
+		if err := db.PutObject(*obj); err != nil {
+			return err
 		}
-		bucketSize += f.Size
-		buckets[idx] = append(buckets[idx], f)
+	}
+	for _, obj := range reused {
+		if err := db.PutObject(obj); err != nil {
+			return err
+		}
+		bucketCids = appendMissing(bucketCids, obj.Buckets()...)
+	}
+	fmt.Fprint(x.out, "done\n")
+	fmt.Fprintln(x.out, "Filecoin Bucket Cids:")
+	for _, id := range bucketCids {
+		fmt.Fprintln(x.out, id)
 	}
 
-	var bucketCids []string
-	fmt.Print("Staging in powergate...")
-	for i, bucket := range buckets {
-		tmp := path.Join(os.TempDir(), fmt.Sprintf("amzn-bucket%d", i))
-		if err := os.Mkdir(tmp, os.ModePerm); err != nil {
+	dir := Dir{
+		SchemaVersion: dirSchemaVersion,
+		Buckets:       bucketCids,
+		RootCID:       rootCid,
+		Previous:      x.Previous,
+		Jobs:          make(map[string]BucketJob),
+	}
+	if err := db.PutDir(dir); err != nil {
+		return err
+	}
+	return db.DeleteSession(rootCid)
+}
+
+// openStore opens the metadata store, migrating it unless this is a dry run.
+// A dry run with the bolt backend plans against an empty store, returned as
+// nil, when the database file doesn't exist yet rather than creating it.
+func (x *Stage) openStore() (MetadataStore, error) {
+	if x.DryRun && x.DbBackend == "bolt" {
+		if _, err := os.Stat(x.DbPath); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+	return openMetadataStore(x.DbBackend, x.DbAPI, x.DbPath, !x.DryRun)
+}
+
+// addRoot makes sure the content to stage is pinned in IPFS and returns its
+// root CID. A local directory or archive is added, while content that is
+// already in IPFS, such as that published by someone else, is pinned so that
+// the whole DAG is fetched and kept while its buckets are staged. A dry run
+// adds the content without pinning it.
+func (x *Stage) addRoot(sh *shell.Shell) (string, error) {
+	if x.Archive != "" {
+		fmt.Fprint(x.out, "Adding archive to IPFS...")
+		rootCid, err := addArchive(sh, x.Archive, !x.DryRun)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprint(x.out, "done\n")
+		return rootCid, nil
+	}
+	if x.Cid != "" {
+		id, err := cid.Decode(strings.TrimPrefix(x.Cid, "/ipfs/"))
+		if err != nil {
+			return "", err
+		}
+		if x.DryRun {
+			return id.String(), nil
+		}
+		fmt.Fprint(x.out, "Pinning in IPFS...")
+		if err := sh.Pin(id.String()); err != nil {
+			return "", err
+		}
+		fmt.Fprint(x.out, "done\n")
+		return id.String(), nil
+	}
+
+	fmt.Fprint(x.out, "Adding to IPFS...")
+	rootCid, err := addDir(sh, strings.TrimSuffix(x.DirPath, "/"), !x.DryRun)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprint(x.out, "done\n")
+	return rootCid, nil
+}
+
+// addDir adds a local directory to IPFS like shell.AddDir, but lets the caller
+// choose whether it's pinned.
+func addDir(sh *shell.Shell, dir string, pin bool) (string, error) {
+	stat, err := os.Lstat(dir)
+	if err != nil {
+		return "", err
+	}
+	sf, err := files.NewSerialFile(dir, false, stat)
+	if err != nil {
+		return "", err
+	}
+	slf := files.NewSliceDirectory([]files.DirEntry{files.FileEntry(filepath.Base(dir), sf)})
+
+	resp, err := sh.Request("add").
+		Option("recursive", true).
+		Option("pin", pin).
+		Body(files.NewMultiFileReader(slf, true)).
+		Send(context.Background())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close()
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+
+	// The directory itself is added last.
+	var root string
+	dec := json.NewDecoder(resp.Output)
+	for {
+		var out struct {
+			Hash string
+		}
+		if err := dec.Decode(&out); err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		root = out.Hash
+	}
+	if root == "" {
+		return "", errors.New("no results received from IPFS add")
+	}
+	return root, nil
+}
+
+// stageBucket streams a CAR archive of the blocks of the objects in a bucket
+// straight from IPFS into Powergate, returning the bucket CID.
+func (x *Stage) stageBucket(sh *shell.Shell, client *powergate.Client, bucket []Object) (string, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeBucketCar(sh, pw, bucket))
+	}()
+	// Closing the reader stops the writer if staging fails part way.
+	defer pr.Close()
+
+	ctx := context.WithValue(context.Background(), powergate.AuthKey, x.PowergateToken)
+	outCid, err := client.FFS.Stage(ctx, pr)
+	if err != nil {
+		return "", err
+	}
+	return outCid.String(), nil
+}
+
+// writeBucketCar writes the original blocks of the objects in a bucket to w as
+// a CAR archive. Files are written whole while directories are written as just
+// their own blocks and parts of split files as the blocks in their range. The
+// roots of the archive are the CIDs of the files and directories, along with
+// every block of a split file or sharded directory, which is what gets pinned
+// when it's imported.
+func writeBucketCar(sh *shell.Shell, w io.Writer, bucket []Object) error {
+	var roots []string
+	for _, obj := range bucket {
+		switch {
+		case obj.blocks != nil:
+			roots = appendMissing(roots, obj.blocks...)
+		case obj.dirBlocks != nil:
+			roots = appendMissing(roots, obj.dirBlocks...)
+		default:
+			roots = appendMissing(roots, obj.Cid)
+		}
+	}
+	rootCids := make([]cid.Cid, len(roots))
+	for i, root := range roots {
+		id, err := cid.Decode(root)
+		if err != nil {
 			return err
 		}
+		rootCids[i] = id
+	}
+	if err := writeCarHeader(w, rootCids); err != nil {
+		return err
+	}
 
-		for _, f := range bucket {
-			if f.IsDir {
-				blk, err := sh.BlockGet(f.Path)
-				if err != nil {
-					return err
-				}
-				if err := ioutil.WriteFile(path.Join(tmp, f.Cid), blk, os.ModePerm); err != nil {
-					return err
-				}
+	seen := make(map[string]bool)
+	for _, obj := range bucket {
+		var blocks []string
+		switch {
+		case obj.blocks != nil:
+			blocks = obj.blocks
+		case obj.IsDir:
+			blocks = obj.dirBlocks
+			if blocks == nil {
+				blocks = []string{obj.Cid}
+			}
+		default:
+			var err error
+			if blocks, err = fileBlocks(sh, obj.Cid); err != nil {
+				return err
+			}
+		}
+		for _, b := range blocks {
+			if seen[b] {
 				continue
 			}
-
-			pth := x.DirPath + strings.TrimPrefix(f.Path, "/ipfs/"+rootCid)
-
-			in, err := os.Open(pth)
+			seen[b] = true
+			id, err := cid.Decode(b)
 			if err != nil {
 				return err
 			}
-			out, err := os.Create(path.Join(tmp, f.Cid))
+			data, err := sh.BlockGet(b)
 			if err != nil {
 				return err
 			}
-			if _, err := io.Copy(out, in); err != nil {
+			if err := writeCarBlock(w, id, data); err != nil {
 				return err
 			}
-			in.Close()
-			out.Close()
 		}
+	}
+	return nil
+}
 
-		ctx := context.WithValue(context.Background(), powergate.AuthKey, x.PowergateToken)
-		outCid, err := client.FFS.StageFolder(ctx, x.IPFSReverseProxy, tmp)
+// bucketDigest identifies a bucket by the content it holds. Packing is
+// deterministic, so a resumed staging recreates the same buckets and can
+// recognize the ones that were already staged by their digest.
+func bucketDigest(bucket []Object) string {
+	h := sha256.New()
+	for _, obj := range bucket {
+		if obj.blocks != nil {
+			for _, id := range obj.blocks {
+				fmt.Fprintln(h, id)
+			}
+			continue
+		}
+		fmt.Fprintln(h, obj.Cid)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// reuseBuckets finds the objects of a new staging whose content is already
+// held in a bucket, looking first at the staging with the previous root CID,
+// if any, and then, when dedup is set, at every staged directory. Those objects
+// keep the buckets they are in and are returned as reused, with their new
+// paths. The rest are returned as still needing to be staged.
+//
+// A bucket is reused as soon as it is staged in Powergate, whether or not its
+// storage job has succeeded. Its data is already in Powergate's hot storage,
+// the new directory lists it among its buckets, and storing the directory
+// pushes it unless it is stored or being stored already, so the content ends
+// up in Filecoin either way.
+func reuseBuckets(db MetadataStore, previous string, dedup bool, objs []Object) ([]Object, []Object, error) {
+	staged := make(map[string]Object)
+	if previous != "" {
+		prevObjs, err := db.ListObjects("/ipfs/" + previous)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		if err := os.RemoveAll(tmp); err != nil {
-			return err
+		for _, obj := range prevObjs {
+			staged[obj.Cid] = obj
 		}
+	}
 
-		for x := range buckets[i] {
-			buckets[i][x].BucketID = outCid.String()
-			_, err = collection.InsertOne(context.Background(), buckets[i][x])
-			if err != nil {
-				return err
+	var pending, reused []Object
+	for _, obj := range objs {
+		prev, ok := staged[obj.Cid]
+		if !ok && dedup {
+			var err error
+			prev, err = db.FindObject(obj.Cid)
+			switch err {
+			case nil:
+				ok = true
+			case ErrNotFound:
+			default:
+				return nil, nil, err
 			}
 		}
-		bucketCids = append(bucketCids, outCid.String())
+		if !ok {
+			pending = append(pending, obj)
+			continue
+		}
+		obj.BucketID = prev.BucketID
+		obj.Parts = prev.Parts
+		reused = append(reused, obj)
 	}
-	fmt.Print("done\n")
-	fmt.Println("Filecoin Bucket Cids:")
-	for _, id := range bucketCids {
-		fmt.Println(id)
+	return pending, reused, nil
+}
+
+// appendMissing appends the values that are not already in the slice.
+func appendMissing(s []string, vals ...string) []string {
+	for _, v := range vals {
+		if !contains(s, v) {
+			s = append(s, v)
+		}
 	}
+	return s
+}
 
-	_, err = collection.InsertOne(context.Background(), Dir{
-		Buckets: bucketCids,
-		RootCID: rootCid,
-		Jobs:    make(map[string]ffs.Job),
-	})
-	if err != nil {
-		return err
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
 	}
+	return false
+}
 
-	return nil
+// splitLargeFiles replaces every file larger than capacity with parts that do
+// fit in a bucket, each holding a contiguous range of the file's blocks. The
+// objects for the split files are returned keyed by path, with their Parts
+// waiting for bucket IDs to be filled in once the parts are staged. Each split
+// is reported to w.
+func splitLargeFiles(w io.Writer, sh *shell.Shell, objs []Object, capacity int64) ([]Object, map[string]*Object, error) {
+	var (
+		out   []Object
+		split = make(map[string]*Object)
+	)
+	for _, obj := range objs {
+		if obj.IsDir || obj.Size <= capacity {
+			out = append(out, obj)
+			continue
+		}
+
+		blocks, err := fileBlocks(sh, obj.Cid)
+		if err != nil {
+			return nil, nil, err
+		}
+		file := obj
+		var part *Object
+		for i, id := range blocks {
+			_, size, err := sh.BlockStat(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if part != nil && part.Size+int64(size) > capacity {
+				out = append(out, *part)
+				part = nil
+			}
+			if part == nil {
+				part = &Object{
+					Path: obj.Path,
+					Cid:  obj.Cid,
+					part: len(file.Parts),
+				}
+				file.Parts = append(file.Parts, ObjectPart{First: i})
+			}
+			part.blocks = append(part.blocks, id)
+			part.Size += int64(size)
+			file.Parts[part.part].Last = i
+			file.Parts[part.part].Size = part.Size
+		}
+		out = append(out, *part)
+		split[obj.Path] = &file
+		fmt.Fprintf(w, "Splitting %s (%d bytes) into %d parts\n", obj.Path, obj.Size, len(file.Parts))
+	}
+	return out, split, nil
 }
 
-func enumerateFiles(osPathPrefix, ipfsPathPrefix, pth, id string, sh *shell.Shell, objs map[Object]struct{}) error {
-	stat, err := os.Stat(path.Join(osPathPrefix, pth))
+// fileBlocks lists the CIDs of every block in the file's DAG, root first.
+func fileBlocks(sh *shell.Shell, id string) ([]string, error) {
+	refs, err := sh.Refs(id, true)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var (
+		blocks = []string{id}
+		seen   = map[string]bool{id: true}
+	)
+	for ref := range refs {
+		if !seen[ref] {
+			seen[ref] = true
+			blocks = append(blocks, ref)
+		}
 	}
-	links, err := sh.List(id)
+	return blocks, nil
+}
+
+// enumerateFiles lists the object with the given CID at the /ipfs/ path pth and
+// every object below it. Everything is read from the IPFS DAG, and objects are
+// sized by the blocks they take up in a bucket: the whole DAG of a file but
+// only the blocks of a directory node, since its entries are objects of their
+// own.
+func enumerateFiles(pth, id string, sh *shell.Shell, objs *[]Object) error {
+	stat, err := sh.FilesStat(context.Background(), "/ipfs/"+id)
 	if err != nil {
 		return err
 	}
-	for _, link := range links {
-		if link.Name != "" {
-			if err := enumerateFiles(osPathPrefix, ipfsPathPrefix, path.Join(pth, link.Name), link.Hash, sh, objs); err != nil {
+	isDir := stat.Type == "directory"
+	size := int64(stat.CumulativeSize)
+	var blocks []string
+	if isDir {
+		links, err := sh.List(id)
+		if err != nil {
+			return err
+		}
+		for _, link := range links {
+			if link.Name != "" {
+				if err := enumerateFiles(path.Join(pth, link.Name), link.Hash, sh, objs); err != nil {
+					return err
+				}
+			}
+		}
+		if blocks, err = dirBlocks(sh, id, links); err != nil {
+			return err
+		}
+		size = 0
+		for _, b := range blocks {
+			_, blockSize, err := sh.BlockStat(b)
+			if err != nil {
 				return err
 			}
+			size += int64(blockSize)
 		}
 	}
 
-	objs[Object{
-		Cid:   id,
-		Path:  path.Join(ipfsPathPrefix, pth),
-		Size:  stat.Size(),
-		IsDir: stat.IsDir(),
-	}] = struct{}{}
+	*objs = append(*objs, Object{
+		Cid:       id,
+		Path:      pth,
+		Size:      size,
+		IsDir:     isDir,
+		dirBlocks: blocks,
+	})
 	return nil
 }
+
+// dirBlocks returns the blocks making up the directory node with the given CID
+// and entries. That is just its root block unless the directory is HAMT-sharded,
+// in which case the entries hang off a tree of shard blocks, which are found by
+// walking the links that don't point at entries.
+func dirBlocks(sh *shell.Shell, id string, entries []*shell.LsLink) ([]string, error) {
+	seen := map[string]bool{id: true}
+	for _, e := range entries {
+		seen[e.Hash] = true
+	}
+	blocks := []string{id}
+	for i := 0; i < len(blocks); i++ {
+		refs, err := sh.Refs(blocks[i], false)
+		if err != nil {
+			return nil, err
+		}
+		for ref := range refs {
+			if !seen[ref] {
+				seen[ref] = true
+				blocks = append(blocks, ref)
+			}
+		}
+	}
+	return blocks, nil
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	shell "github.com/ipfs/go-ipfs-api"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+// newTestShell returns a shell for a fake IPFS API that serves the given
+// commands, keyed by name such as "block/stat".
+func newTestShell(t *testing.T, commands map[string]http.HandlerFunc) *shell.Shell {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h, ok := commands[strings.TrimPrefix(r.URL.Path, "/api/v0/")]; ok {
+			h(w, r)
+			return
+		}
+		ipfsError(w, "unknown command "+r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+	return shell.NewShell(srv.URL)
+}
+
+func ipfsError(w http.ResponseWriter, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(map[string]interface{}{"Message": msg, "Code": 0, "Type": "error"})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// dagCommands serves refs and block/stat for a DAG given as the links and
+// size of each block.
+func dagCommands(links map[string][]string, sizes map[string]int) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"refs": func(w http.ResponseWriter, r *http.Request) {
+			var walk func(id string)
+			walk = func(id string) {
+				for _, link := range links[id] {
+					writeJSON(w, map[string]string{"Ref": link})
+					if r.URL.Query().Get("recursive") == "true" {
+						walk(link)
+					}
+				}
+			}
+			walk(r.URL.Query().Get("arg"))
+		},
+		"block/stat": func(w http.ResponseWriter, r *http.Request) {
+			id := r.URL.Query().Get("arg")
+			size, ok := sizes[id]
+			if !ok {
+				ipfsError(w, "block not found")
+				return
+			}
+			writeJSON(w, map[string]interface{}{"Key": id, "Size": size})
+		},
+	}
+}
+
+func TestSplitLargeFiles(t *testing.T) {
+	sh := newTestShell(t, dagCommands(
+		map[string][]string{"F": {"L1", "L2", "L3"}},
+		map[string]int{"F": 1, "L1": 3, "L2": 3, "L3": 3},
+	))
+	objs := []Object{
+		{Path: "/ipfs/R", Cid: "R", Size: 1, IsDir: true},
+		{Path: "/ipfs/R/big", Cid: "F", Size: 10},
+		{Path: "/ipfs/R/small", Cid: "S", Size: 5},
+	}
+
+	out, split, err := splitLargeFiles(ioutil.Discard, sh, objs, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var parts [][]string
+	for _, obj := range out {
+		if obj.blocks == nil {
+			if obj.Path == "/ipfs/R/big" {
+				t.Errorf("large file wasn't split: %+v", obj)
+			}
+			continue
+		}
+		if obj.Path != "/ipfs/R/big" || obj.part != len(parts) {
+			t.Errorf("unexpected part %+v", obj)
+		}
+		parts = append(parts, obj.blocks)
+	}
+	if len(out) != 5 {
+		t.Errorf("got %d objects, want 5", len(out))
+	}
+	wantParts := [][]string{{"F", "L1"}, {"L2"}, {"L3"}}
+	if !equalBuckets(parts, wantParts) {
+		t.Errorf("parts: got %v, want %v", parts, wantParts)
+	}
+
+	file, ok := split["/ipfs/R/big"]
+	if !ok || len(split) != 1 {
+		t.Fatalf("split files: got %v", split)
+	}
+	wantRanges := []ObjectPart{{First: 0, Last: 1, Size: 4}, {First: 2, Last: 2, Size: 3}, {First: 3, Last: 3, Size: 3}}
+	if len(file.Parts) != len(wantRanges) {
+		t.Fatalf("split file parts: got %+v, want %+v", file.Parts, wantRanges)
+	}
+	for i, part := range file.Parts {
+		if part != wantRanges[i] {
+			t.Errorf("part %d: got %+v, want %+v", i, part, wantRanges[i])
+		}
+	}
+}
+
+// putTestObjects saves the objects to a new test store.
+func putTestObjects(t *testing.T, objs ...Object) MetadataStore {
+	db := newTestBoltStore(t)
+	for _, obj := range objs {
+		if err := db.PutObject(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+func objectBuckets(objs []Object) map[string]string {
+	buckets := make(map[string]string)
+	for _, obj := range objs {
+		buckets[obj.Path] = obj.BucketID
+	}
+	return buckets
+}
+
+func TestReuseBucketsPrevious(t *testing.T) {
+	db := putTestObjects(t,
+		Object{Path: "/ipfs/P", Cid: "P", IsDir: true, BucketID: "B1"},
+		Object{Path: "/ipfs/P/a", Cid: "A", BucketID: "B1"},
+		Object{Path: "/ipfs/P/big", Cid: "F", BucketID: "B2", Parts: []ObjectPart{{BucketID: "B2"}, {BucketID: "B3"}}},
+		Object{Path: "/ipfs/Q/c", Cid: "C", BucketID: "B4"},
+	)
+	objs := []Object{
+		{Path: "/ipfs/N", Cid: "N", IsDir: true},
+		{Path: "/ipfs/N/a", Cid: "A"},
+		{Path: "/ipfs/N/moved", Cid: "F"},
+		{Path: "/ipfs/N/c", Cid: "C"},
+	}
+
+	pending, reused, err := reuseBuckets(db, "P", false, objs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"/ipfs/N/a": "B1", "/ipfs/N/moved": "B2"}
+	if got := objectBuckets(reused); len(got) != len(want) || got["/ipfs/N/a"] != "B1" || got["/ipfs/N/moved"] != "B2" {
+		t.Errorf("reused: got %v, want %v", got, want)
+	}
+	for _, obj := range reused {
+		if obj.Path == "/ipfs/N/moved" && len(obj.Parts) != 2 {
+			t.Errorf("reused split file lost its parts: %+v", obj)
+		}
+	}
+	// Content only held by other staged directories is staged again.
+	got := objectBuckets(pending)
+	if _, ok := got["/ipfs/N/c"]; !ok || len(got) != 2 {
+		t.Errorf("pending: got %v", got)
+	}
+}
+
+func TestReuseBucketsDedup(t *testing.T) {
+	db := putTestObjects(t,
+		Object{Path: "/ipfs/P/a", Cid: "A", BucketID: "B1"},
+		Object{Path: "/ipfs/Q/a", Cid: "A", BucketID: "B2"},
+		Object{Path: "/ipfs/Q/c", Cid: "C", BucketID: "B3"},
+		Object{Path: "/ipfs/S/d", Cid: "D"},
+	)
+	objs := []Object{
+		{Path: "/ipfs/N/a", Cid: "A"},
+		{Path: "/ipfs/N/c", Cid: "C"},
+		{Path: "/ipfs/N/d", Cid: "D"},
+	}
+
+	// The previous staging wins over other directories holding the same
+	// content, and content that was never put in a bucket is staged again.
+	pending, reused, err := reuseBuckets(db, "P", true, objs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := objectBuckets(reused)
+	if len(got) != 2 || got["/ipfs/N/a"] != "B1" || got["/ipfs/N/c"] != "B3" {
+		t.Errorf("reused: got %v", got)
+	}
+	if len(pending) != 1 || pending[0].Path != "/ipfs/N/d" {
+		t.Errorf("pending: got %v", objectBuckets(pending))
+	}
+
+	// Without dedup only the previous staging is used.
+	pending, reused, err = reuseBuckets(db, "", false, objs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reused) != 0 || len(pending) != 3 {
+		t.Errorf("without dedup: got %d reused and %d pending", len(reused), len(pending))
+	}
+}
+
+func TestAddRootDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "amzn-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(path.Join(dir, "a.txt"), []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var tarball bytes.Buffer
+	writeTar(t, &tarball)
+	archive := path.Join(dir, "archive.tar")
+	if err := ioutil.WriteFile(archive, tarball.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The fake nodes have no pin/add, so any pinning fails.
+	x := &Stage{DryRun: true, Cid: "/ipfs/QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn", out: ioutil.Discard}
+	if root, err := x.addRoot(newTestShell(t, nil)); err != nil || root != "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn" {
+		t.Errorf("cid: got %s, %v", root, err)
+	}
+
+	x = &Stage{DryRun: true, DirPath: dir + "/", out: ioutil.Discard}
+	root, err := x.addRoot(newTestShell(t, map[string]http.HandlerFunc{
+		"add": func(w http.ResponseWriter, r *http.Request) {
+			if q := r.URL.Query(); q.Get("pin") != "false" || q.Get("recursive") != "true" {
+				t.Errorf("directory added with %v", q)
+			}
+			ioutil.ReadAll(r.Body)
+			writeJSON(w, map[string]string{"Name": "d/a.txt", "Hash": "A"})
+			writeJSON(w, map[string]string{"Name": "d", "Hash": "D"})
+		},
+	}))
+	if err != nil || root != "D" {
+		t.Errorf("directory: got %s, %v", root, err)
+	}
+
+	m, commands := newFakeMFS(t)
+	delete(commands, "pin/add")
+	x = &Stage{DryRun: true, Archive: archive, out: ioutil.Discard}
+	if root, err := x.addRoot(newTestShell(t, commands)); err != nil || root != "ROOT" || len(m.pins) != 0 {
+		t.Errorf("archive: got %s, %v and pins %v", root, err, m.pins)
+	}
+}
+
+func TestOpenStoreDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "amzn-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	x := &Stage{DryRun: true, DbBackend: "bolt", DbPath: path.Join(dir, "amzn.db")}
+
+	db, err := x.openStore()
+	if err != nil || db != nil {
+		t.Fatalf("got %v, %v", db, err)
+	}
+	if _, err := os.Stat(x.DbPath); !os.IsNotExist(err) {
+		t.Errorf("dry run created the database file: %v", err)
+	}
+
+	// An existing database is used to plan reusing buckets.
+	x.DryRun = false
+	if db, err = x.openStore(); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	x.DryRun = true
+	if db, err = x.openStore(); err != nil || db == nil {
+		t.Fatalf("existing database: got %v, %v", db, err)
+	}
+	db.Close()
+}
@@ -89,7 +89,7 @@ func notfoundHtml() (*asset, error) {
 	return a, nil
 }
 
-var _fetchingHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xbc\xc7\x0e\xc4\x5a\x92\xa6\xb7\xef\xa7\x28\xed\x24\x50\x23\x32\xe9\xd9\xd3\x33\x00\xbd\xf7\x9e\x1b\x81\xde\x7b\x4f\x41\xef\x2e\xd4\xed\x9e\xae\x02\xb4\xec\xbb\xb8\xb9\xe1\x21\x12\xb9\x8b\xf8\xe2\xff\x23\x22\xcf\xbf\xfc\xdb\xff\xc6\x99\xac\x17\x5b\xfc\xdf\x9a\x63\x1c\xfe\xe7\xbf\xfc\xcb\xbf\xfd\xfb\xf3\xdf\x9a\x32\x2d\xfe\xe7\xbf\xfc\xed\x6f\x7f\xfb\xdb\xbf\xed\xc7\x3b\x94\xff\x7e\xfe\xfb\xe7\xff\x6a\x97\x6a\xff\x6f\xff\x77\x36\xa4\x53\xff\xb7\xff\x27\x4b\xf3\xbe\xde\xe6\x73\x2a\xfe\x5b\x3b\xa6\x75\xf9\xaf\xe7\x36\xfc\xef\x45\x7a\xa4\xff\xfa\xc7\x2b\xb8\x4c\xf5\x7f\xcf\xd2\xbd\xc4\xd1\xff\xb3\x0d\x18\xd3\xb9\x21\x55\xac\x67\x9a\xa6\x69\xc3\xf5\x1b\xde\xaf\x69\x9a\x66\xfe\xfe\x4a\xdb\x2c\x1d\xff\xfd\x59\x91\x20\xd5\xfc\xfd\x20\x46\x8e\x10\x4a\x8e\x97\xc1\x09\x54\xc0\xc2\x9b\xd8\x0c\x93\x88\x54\x9b\xb8\x8c\x92\x85\xc2\x94\x04\xca\x10\x87\x0e\x96\xe7\xc3\x60\xfd\xfd\x07\x21\xa3\x38\xbc\xe0\x97\xc6\xca\xfb\xdd\x4f\x7b\x78\xda\x9e\x8a\x9f\x1e\xd0\xe8\xf0\xca\x7c\xf8\xe0\xbe\xac\xf2\xa2\xcf\x3f\xe2\x9e\xb1\xc8\xa1\x55\xb7\x02\x36\x1e\x58\x13\xc1\xc0\x4e\x67\xb6\x3e\x11\x73\xd7\xa0\x8d\x36\xb6\x05\x6a\x8d\xaa\x61\x53\x72\x32\xfb\x75\x8a\x55\x88\xe9\xe2\x7b\x7e\x3a\xd1\x7d\xc5\x15\x9c\x0e\xc6\xd5\xf1\x94\x00\x15\x45\xa5\x2d\x11\x1c\xd8\x41\xa9\xd4\x39\x9d\xa8\xa4\xdd\xa5\x8c\xac\x61\xfc\xc8\xb8\xc5\xcd\x7b\xf1\x42\x55\x93\x4f\xc7\x63\xa2\x28\x9b\x9b\xcc\x67\xd1\xc4\x58\xf1\xef\x29\xdd\x15\x31\x1d\x76\x8f\x76\xf9\xf4\xde\xe6\x23\x0f\xd5\x63\xb2\x46\x9c\x6a\x41\x9d\xe1\x79\x94\xf5\x5e\xd1\x28\xd1\x0a\xbb\xf2\x60\xe8\x2c\xf4\xc8\x6b\x23\xe5\x1f\x97\xb5\xa4\x19\x7c\x8b\xe6\xfa\x29\x14\x96\x99\x53\x8d\x6d\xad\x49\x6b\xf7\xe3\x73\x2b\x99\xee\x8a\xa8\x33\x4e\xde\xe2\xc0\x79\xf9\x76\x3a\xb7\x0e\xef\x3c\x46\x7a\x65\x62\x59\x83\x4d\xb0\x57\x3e\x3b\x70\x8f\x1f\x13\xea\xfa\x19\x0e\xad\x18\xec\xef\xf1\xe2\x47\xb6\x1b\x50\x1f\x1d\x7f\x18\x05\x9b\x47\xd6\xd0\x0c\x7f\xd3\xca\xc0\x5e\xd8\xff\x40\xe4\xa8\x95\x00\xba\xd3\xe0\xea\x05\x99\x2f\x93\x4c\x5a\x51\x7e\x78\xd5\x8f\x1e\xf9\xc8\x7c\xf0\x7a\xe2\x03\xe5\x0c\x99\x6f\xec\x1d\x85\x71\xac\x58\x4c\x18\x6e\x0f\x52\x73\x58\xdc\x3a\x50\x78\xf8\x0d\x52\xba\x04\xa1\xfe\x01\x91\xf4\x63\x1c\x13\x0a\xf6\x4c\x17\x12\x1e\x61\x39\x37\x51\xef\x32\xa9\x62\x39\xa8\x01\x2e\xc1\x2f\xa2\xa1\x44\x2e\x30\xef\xc4\xba\x41\x6b\x7e\xd4\x13\xac\xe8\xfa\xa6\xb5\x8c\xa3\xa3\x8a\xe0\x0a\xbb\xff\x23\x50\x5c\x3f\x30\x1d\x15\x63\x63\x59\xfe\x1f\xff\xc7\x7f\xff\xa7\x00\xdc\xca\xa5\x4c\x8f\x7f\x9d\xe6\xff\x38\xfd\xf3\x77\x7b\xfb\x95\xff\x9a\xcf\xd3\x91\xb6\xd3\xff\xfb\x1f\x21\xbc\xa4\x75\xf9\xd7\x8a\xe0\xb1\xf9\x8f\x08\x5e\x76\xff\x3b\x2e\xe8\x47\xc7\x56\x55\x34\xe6\x0b\x46\x5e\x42\x42\x41\xf0\x0b\x66\x76\x1d\x68\xf8\xb3\x57\xbf\xbe\x75\x9e\xdf\x42\x86\xd6\x2e\x54\x54\xb8\x8a\xe7\x46\x93\x97\x76\xc1\x5b\x03\xd6\xe3\x1f\xba\x6d\x5d\x19\xb6\x79\x55\x51\x79\x37\x10\x04\x26\x9c\x21\xd4\xe3\x5d\xe7\xab\x5e\x38\x27\xb4\x18\xec\xb5\xa3\x82\x0b\x12\xc0\x30\xec\xbb\x74\xca\x48\xe5\xdf\xbc\xa5\xd8\x7e\x65\x2f\x65\x4e\x99\xcf\x33\x4f\xcd\x0d\xb2\xda\x86\x5d\x64\x97\xda\xfb\xa3\xc0\xe8\x47\xec\xfc\x91\x5b\x31\xbc\x1d\x92\x4b\xe1\xa0\x74\x42\xce\x15\x45\xda\x07\xe4\xfe\xa7\x93\xc4\xe7\x36\xbb\x0c\x53\xdc\x22\xb1\xf1\x81\xf2\x38\x33\x3f\xa8\x9c\x7c\xde\x94\x71\x0f\x11\x10\xa8\xd8\x35\xa6\x5b\xdd\x35\x0f\x49\x30\x8e\x90\x2a\xd5\x40\x24\x8d\xb4\x92\x18\x64\x9d\x77\x13\x35\x1e\x8f\xf4\x7b\x83\x4c\x5d\xe6\x92\x21\x8a\x4a\x77\x55\x76\x23\x57\x63\x25\x0b\x1c\x1d\x29\x4b\x77\x9e\x75\x4b\x14\x9d\x2c\x04\x32\x6a\x41\xcb\x90\x1f\xb5\x62\x43\xcf\x24\x94\x58\x69\x9a\x10\x6c\xde\xfc\x02\x07\x7d\xe3\xb2\x13\x4b\x86\x26\x5b\xac\xf5\x3d\x7d\xc2\x83\x82\xf2\xbb\x2c\xb7\x13\x18\x40\x14\x47\x7b\x99\xaf\x09\xfd\x5b\x18\x94\x2c\x7b\x7a\xba\x29\x10\x15\x93\x07\xd1\x20\xd4\x00\xc5\xd9\xbb\xf9\x2e\x42\x73\x43\xc2\xae\xc1\xaa\xba\x83\x96\xe3\x85\xcc\xc3\xcd\x3e\x8f\x67\x63\x31\x7d\x2e\x90\x60\x8f\x02\x4f\x17\xdc\xf5\x48\x79\xa0\x1e\xa8\xa2\x49\xfd\x87\x87\x8f\x28\x69\x05\x62\x8a\xdb\x36\x3d\x14\xe4\xc2\x76\xd2\x01\x7f\xaf\xc9\x1a\x9a\x79\xde\x5d\xcf\x6b\xad\x97\x8d\x2c\x55\x28\xd2\x22\xa7\x22\xed\xee\x5c\x3f\x0b\xb6\xa8\xd8\xb7\x14\xea\xb9\x14\x2a\xb1\x39\xfa\xdd\xcf\xec\x2e\x7b\x3e\xce\xf7\x38\xb0\x49\xd2\x35\x5e\xec\xd9\xf7\xc1\x6d\x1f\x21\xd1\xee\xee\x7d\xba\x80\xf7\x47\xc9\x5c\xd0\x76\x9b\xe4\xa8\x41\x72\x93\xbe\xcf\x36\x3f\x37\x15\x9a\xc7\xde\x68\x3c\xae\x6d\xdd\x96\xe6\x8e\xf5\x87\x7a\x62\x69\xfa\x52\x05\x66\x8e\xa2\x54\xaa\xe8\xcd\x65\x13\x8e\x87\xea\x37\x54\x6a\x41\x56\xc7\xcb\x17\x54\x13\xd5\x7d\x43\xee\xe1\x14\x05\x1a\x93\x3b\x38\x3f\x38\x15\x50\x21\x10\x98\x56\x5d\x3c\x39\xe4\xb1\xf0\x5e\xda\xb6\x19\x24\x4b\x0c\x72\xa0\x0e\x02\x93\x85\x06\x52\x74\xbf\x08\xd4\x7d\x6e\x76\xdf\x5a\x9d\x7a\xea\x53\xe8\x88\xeb\xba\x8e\x17\x56\xea\x55\xd1\xdb\x25\x19\x33\x4c\x7d\xee\x18\xb4\xf5\x64\x96\x2d\x97\x2b\xc2\x10\x5a\xe6\x2b\xfb\x48\x7f\xad\xcf\x8e\xb3\xd7\x32\x63\xc1\x6f\x2d\xec\xd4\x45\xa1\x0a\xcd\xa9\xcc\x6b\x06\xa6\x15\xf8\xde\x60\x81\x7c\x9e\xa1\xe3\x7e\x9f\x9a\x55\xbb\xe3\x70\xea\xd7\x45\x41\x67\xd3\x07\x6c\xd1\x69\xc5\x40\x30\x7d\xdd\x41\x81\xec\x47\xd3\xd9\xa8\x75\xec\x56\x31\xad\x53\xff\xa9\x84\x48\xd3\xfc\xaf\xc5\x87\xe9\x3f\xf9\x00\xf9\xfd\xcf\xe2\x21\x3e\xae\x8e\xed\x0c\x86\x8b\xf6\xfb\x26\xe0\xad\x99\xc9\x77\x7e\xd6\x1c\x85\x89\xa1\x9f\x6e\x49\x1d\xbb\x23\xf9\x35\xd7\xb9\x07\xa2\xab\xa2\x18\x98\x71\x49\xcd\x3c\x37\xb9\x39\x59\x37\x1a\xf3\xa2\xdc\xb3\x0d\x30\x6b\x22\xa3\x8e\x48\x42\x4c\x09\x52\x8c\x25\x7c\x75\x66\xe3\xe5\x5d\xab\x28\x57\xf2\x59\x97\xa9\x93\xa0\x24\xc2\x2b\x02\x63\xdc\x86\xf4\xda\x42\xc4\xbf\x36\x5b\xe4\xa7\xdb\x51\xb5\xb1\x99\xa4\xba\x02\x7c\x27\x54\x2c\xc8\xa0\xac\x2b\xe7\x0a\x4b\x86\x5c\x7c\x77\xeb\x97\x62\xbf\xb1\xad\x4c\xee\xcd\xae\x8f\x27\xc7\x8f\xcd\x36\x7a\xf6\x38\xd9\x6b\xf4\x59\x8c\x92\x36\x41\x63\xb7\x3d\xb7\x0a\x48\x7e\x51\x79\xb2\xab\x42\x98\x1d\xb0\xab\x48\x67\xe7\x06\x3d\xd6\xe4\xd3\x8b\x36\xf1\x1b\xe3\xb2\xcb\x69\x06\xa5\xae\x65\x2c\xcf\x4f\xc9\xf3\x01\x02\x0a\xbf\xc3\x55\x99\xbe\x2a\x89\xb5\x6a\xc4\x00\x4e\xfa\x42\xaf\x9a\x62\xcc\x27\x67\xc7\x8a\xff\x4e\x0b\xac\xcb\x69\x19\xcb\xb5\xe1\xf5\xfa\x2c\xca\x1f\xa4\xdc\x23\xc0\x9d\xbe\xfc\xfa\x85\x37\xac\x27\x9c\xbd\xb3\x5e\x45\x60\xe1\x94\xdb\xc8\x94\xfc\x23\xed\x5e\x1b\x1a\x2d\x02\x0c\xfa\xf2\x39\x37\xd7\xb7\xe4\xf7\xb0\x32\xed\x8d\x5f\x56\x5e\xd5\xc8\x0e\x67\xdf\x5a\x98\x21\x34\x4a\x7d\xf7\x5d\xe9\x78\xa5\xe6\x36\x85\x39\x07\xca\x3a\xd1\xf5\xdd\xd7\x2c\x9d\xe0\x80\x5f\x68\x2c\x75\xdc\x9d\x9d\x90\x4b\xf1\xb5\xb3\xd0\x27\x8c\x14\x23\x2b\xce\x20\x03\x5a\x28\x3c\xcf\x5b\x26\x5b\x72\x68\x1e\x07\x12\x11\xed\xd5\x0f\x13\x00\x41\x1e\x59\x82\xc0\x64\x83\x56\x18\xc5\xed\x83\x39\x9c\x21\x3b\xa6\xe2\xb5\x6e\x1d\x34\x49\x3e\x27\x15\x99\xbe\xb8\x55\x24\x13\x1e\x51\x73\x12\xbf\x62\x10\x67\x84\x1f\xf4\xa1\x58\xf1\xd8\xfd\x47\xa2\xec\xb8\x57\x65\x96\x13\x4e\xed\x51\xe9\xf9\x13\x93\xbb\xf0\x56\xc6\xd9\x61\x21\xd3\x86\x28\x6b\xad\x82\x67\x75\x56\x4a\x8b\x0e\xa4\x53\x2e\x4a\x0c\x61\x2d\x0b\x56\xbe\x26\x27\x77\x51\x7f\x71\x66\x02\x68\x58\xec\xf3\xc7\x9b\x34\x97\x92\xba\x7d\xa1\x9d\xae\x65\x4c\x08\x43\x3d\x60\xc7\x1b\x19\xb4\x51\x93\x19\x9f\xc8\xd5\x42\xcd\xf5\x27\x31\xbd\x3a\x04\x31\x6d\x33\x9b\x3f\x7c\x2d\x03\x45\xb6\xdb\x56\x68\x3c\xda\x4d\xbf\xd7\x86\xac\xf4\x54\x9b\x6a\x8d\x95\x06\x54\x06\xfa\x23\x70\x2a\x2e\x5b\x7c\x2f\x97\x89\x89\xed\xb8\xfd\xd5\xcd\xc0\x84\x2a\xc6\x73\x66\xa2\xef\xee\x6c\x07\x24\xec\x62\x72\x71\xd7\x8b\x3a\x6d\x70\x70\x4f\x94\x8f\xbf\x57\x4d\xcf\x16\x00\x4c\xbd\xf5\xc9\x90\x64\x38\x10\x42\x08\xce\xf8\xe1\x11\x60\x9d\x47\x2b\x5d\x8b\x17\xd1\x34\xcf\x4f\x0b\x99\x76\x2b\xa5\x60\x22\xa8\xd8\xc2\xd9\xc6\x46\xa6\x2e\x03\x4b\x61\xa8\xc2\x55\x5c\x3d\x44\x42\x92\x41\x94\x1b\x51\x86\x5b\xd8\x37\x41\x1f\xbc\xfb\x9b\xa6\xc7\x39\x1d\xf2\x67\x30\xe8\xdf\x1f\xd9\xc9\x0f\x82\xd7\xbb\xa7\x3d\xb2\xec\x7f\x89\x19\xed\x5f\x0b\x19\x3d\xf6\x0f\x49\x01\xfd\x2e\x7e\xe0\x7d\xcb\xb3\xbe\x64\x65\x68\x3b\x75\x05\xbb\x55\x62\x7f\x1c\xd4\xb8\x99\x3d\x01\xfd\x69\x88\x97\x9d\xa0\x0d\x02\xf4\xb5\x47\x08\xb3\x80\xb5\xb5\x9f\xa1\xb6\xad\xef\x6a\x13\x9e\xff\xe8\x07\x7d\x09\xbf\x23\x16\xe7\xe8\x36\x6e\xe2\xbe\x88\xef\x8e\xeb\x43\x8d\x10\xed\x46\x08\x0a\x23\xb0\x05\xfb\x08\xc2\x84\x7e\x73\x13\x02\x20\x17\x34\xe4\xd7\x11\x69\xac\x36\xd5\x20\x16\x22\x95\xec\xe2\xad\x19\x6f\x51\x55\x75\x50\x6d\x3f\x2a\x96\xae\x9d\x4f\x81\xe4\x04\xa7\x67\x2a\xe6\x0c\x73\x0f\x5b\x05\x8a\xf7\xbb\x92\xef\xbd\xc1\x5a\x1d\x46\xbd\x23\xe4\xb7\x16\x74\xe0\xb9\x8c\x3c\x9d\x16\x14\x3c\xb0\x82\x9e\xc7\x48\x62\x5e\xd8\xf7\x19\xd3\x84\x75\x7a\x00\x19\x0a\xcc\xad\x0c\xb7\x7c\xed\x9c\xa5\x07\x0a\x75\xa8\x44\xc4\x6b\xf1\xcd\x87\x43\xee\x84\x56\xc2\xf3\x48\xd6\xd8\x8f\xc7\x75\x71\x6b\xcc\xd4\x6b\x09\x89\x6d\x33\xd3\x30\x2a\x84\xc0\x73\x18\x96\xed\x75\x10\xa9\xac\x7b\x95\x5f\xdb\x90\x71\xc5\x00\x75\x90\xcb\x26\x76\xb9\x71\x73\x2f\x11\xbc\x45\x7a\x10\x1a\xfe\x61\x0c\xb6\x0f\x67\x10\x83\x61\xa8\xd7\x04\x40\x7b\x0c\x38\xe4\xda\xae\x2e\xde\x3c\x49\x1f\x07\x98\xba\xc0\x9f\xde\x47\xb6\x7d\x7f\x50\xea\x7d\x50\xb1\x92\x30\x08\x99\x2f\x4c\x31\xdb\x74\xa2\x14\xda\xc5\x8a\xcc\x5b\xcd\x16\x83\x47\x45\x4f\x41\x4b\xfc\xe2\x51\x8d\xe4\x20\x97\x86\x37\xbd\xd5\x10\xc5\xa5\x22\x36\xb0\xbb\x2f\x18\xd0\x92\xf7\x09\x3d\x77\x05\x4b\x8e\x68\x7a\x32\x6b\x4a\x6b\xa7\x5b\x97\x1f\x11\x30\x3f\xe7\xe0\x5c\x3f\x34\xed\xcb\x4f\x19\x4d\xf0\x3f\x85\xd1\x15\x98\xbb\x47\xab\x2e\x7e\xca\x3d\x42\xe1\xec\xd6\x4a\xd5\x71\xd7\xe6\xa9\xcf\x71\xd3\xf2\xcb\xf7\xb4\x69\x63\x8d\x0f\x82\x49\x51\x63\xe7\x7c\x73\x83\x6f\xe8\xd3\x9d\x68\x21\xb4\xbb\xa7\x7f\xd2\x6c\xdc\xdc\xb6\x73\x0b\x97\xf2\x9c\x0b\xdb\xf1\xb1\xef\xe7\xb9\xd9\xcf\xa9\x3a\x16\xe6\x90\x4c\xf1\xcf\xeb\x6b\x3a\x8f\xe8\xe6\x69\xcc\x5b\xdb\xb9\x5d\x9a\x0d\xe4\x44\x4d\x4c\xf1\xb8\x2a\x60\x25\x5c\xa6\xa6\xaa\x93\x0b\xa4\x96\x6b\xd7\xa7\x3d\x41\xe9\xc1\x26\x28\x2f\xf1\x30\xf5\xdb\x85\xa2\xdb\x46\xe8\x8f\x01\x85\x6d\x50\x6b\xd2\x86\x09\x19\xee\xd2\x2b\x98\x93\x4d\xa8\x6f\x0e\x5d\x83\x76\xa1\xf9\x57\x1f\xa6\x54\xa0\xb7\x2f\xed\x40\x0e\xe2\x5a\x3d\x1a\x14\x5a\xb0\x5f\x15\xde\xc8\x6a\xd2\x75\x27\x59\xd0\xc1\xfe\xe9\xa9\xab\x61\x87\x0f\xfe\xfa\x95\xee\x75\xb6\x81\x8f\x58\x06\x08\xa0\x6f\x08\x28\xef\xac\x6c\x43\x33\x3e\xc1\x7e\x5d\xb2\x91\x9e\x32\x31\x94\xa5\x44\xcb\x87\x04\x28\xcd\xd0\x96\x69\x07\xca\x04\xdb\x38\x24\xfe\xb9\xa9\x5f\x55\x7f\xad\xe4\x9f\xff\x53\x2f\xf4\x3e\x75\xac\xfc\x8f\xf7\xa3\x64\x4c\x16\x1e\x49\x3d\x46\xcb\x14\xc1\xf2\x0e\xc1\x77\x0e\x97\x3d\x9a\x8d\xf0\xd9\x57\x55\x05\x05\xa4\xe8\x09\x99\x6b\x9c\xae\xc1\x39\xaa\x66\xbf\x23\xe6\x9a\x4a\x77\x96\x89\x55\x0f\xf7\x65\x4b\x4a\xc3\x63\x59\x09\xc3\x87\xd3\x44\xc5\x3e\xec\x4e\xa8\xe7\xd1\x17\xfd\x4e\xb8\xb3\xbc\x3f\x6a\xd9\x36\x04\x4e\x5a\x13\x24\x58\x23\xe5\x1e\xc4\xd4\x0e\xbc\x32\x3b\x66\x15\x6b\x30\x55\x1d\x56\x96\xa7\x85\x04\xd4\xaf\x52\x25\xa2\x3a\x8b\x29\x10\x30\x72\xe2\x7f\x78\x39\x00\xe0\xeb\xc3\x23\x23\xb8\x61\xa2\x2e\xa1\xa2\x06\x39\x64\xd6\x3e\x33\x43\xb0\x8a\x1a\xdc\xe8\xe3\x33\x81\xe9\x0f\x10\x16\x27\x25\xfb\x91\x50\xb6\x26\x0d\x2e\xd3\xef\x91\xca\x38\x3d\xeb\x80\x2b\xbe\x25\x5b\xa8\xdf\x2a\x0e\xb4\x2a\x7a\x0f\x59\xb0\x3f\x0a\x0c\x97\xa7\xe9\xa6\xfd\xe9\x40\xc7\xb9\x7b\x12\x6b\x44\x85\xa5\x8a\x9f\x89\x57\x53\x19\xd2\xe7\xfc\x1d\x5c\xec\xba\x5a\x49\x99\x1e\xee\x9e\x11\x03\xe7\xf9\x43\x20\x09\x75\x9e\xfd\xcd\xdd\xd5\xb0\xff\x6a\xe8\x49\xe4\x18\xa4\x0b\x50\x9c\xde\x03\x8c\x82\x2a\x7b\xe3\xcd\xe5\x1c\x9e\xb4\xf8\x47\x62\x70\x90\x64\x70\xfb\x4c\x1f\xf9\x76\x18\x41\x3f\xa0\x56\xa6\x5b\xfd\x01\x34\xf6\xdd\xc9\xae\x12\x15\xde\x6f\x7b\x38\x5c\x12\x93\x83\x5d\xbb\xa2\x66\xee\x08\x56\xfb\x9e\x17\x96\x6e\x63\x1c\x17\x5c\x70\x1e\x91\x8c\xc0\xd8\xc0\x85\xf5\xc1\x27\x68\x05\x0e\x5b\x85\x61\x93\x2e\x81\x0b\xe5\x74\x7a\x4e\x49\x84\x5b\xa0\x1d\xc2\x67\x99\x2e\x45\x2a\xa3\x7a\x62\x38\xe6\x67\xf6\x70\xd8\x8e\x2a\x47\xc4\x5b\x8a\x1d\x17\x16\x65\x2f\x45\x96\x79\x5c\x48\xac\x63\x91\xf9\xd6\x1b\x87\x17\x12\xad\xa7\x78\xa2\x65\x98\x75\x33\x37\xb3\x1a\x7e\x11\xe8\x94\x87\x3f\x93\x4b\x7d\x7a\x08\xc3\x9b\x9b\x5d\x8c\x6b\x4a\x71\xcd\xc2\x25\xe0\x41\x88\x1d\x57\x36\xa6\xd6\x9e\x7f\x65\x83\xc9\xcb\x0c\x3e\x69\xce\x31\x6c\x32\x73\x43\x3a\x08\x3e\x59\x50\xe7\x91\xb5\xdb\x11\x80\x86\xd3\x45\x5f\x35\x1e\x76\x69\x70\xde\x64\x26\xf8\xdd\xe5\x59\x18\x51\xb1\x8b\xde\xa3\x06\x94\x28\xf8\x4b\xfa\xd3\xb9\x9e\x92\x71\xb1\x89\x7f\xe0\x77\x81\xd5\xb5\x79\xac\x39\xc0\xbc\xd8\x05\x49\xf6\x28\xe8\xe5\x1d\x74\x6a\x4f\x6c\x55\x75\xed\xef\x2d\x1d\x6f\x2f\x9d\x00\xb6\x67\xfa\x93\xe3\x98\xec\xc6\x12\xe3\x0c\xaa\x92\xa1\xc2\x9e\x7d\xb9\xa3\x0d\xf1\x2d\x2c\x2a\xd0\x09\x2b\xb9\xa9\x05\xa4\x5b\x66\x31\xe2\xad\xc8\x6e\xf0\x80\x5e\x4f\x59\x9d\xa3\xf0\x29\x7f\xab\x14\xd6\xd7\x17\x57\x91\xeb\x2c\xfc\xf6\xb8\xc4\x7e\x4c\x1e\xb4\xd2\xa7\x49\xe5\xca\x3a\x6b\x93\xfc\xe2\xe8\x77\x08\xb7\x51\x25\xcc\xe3\x52\x26\x62\x84\x3d\x9d\x4b\xab\x83\x43\x78\x0f\x4e\x20\x92\xcf\x2a\x3d\x07\x60\x4c\x5c\x36\x68\xc6\x6c\x52\x5e\xc3\x36\x5e\x48\x0e\x89\xbb\xd0\x40\xf8\x89\x80\x15\xaa\xea\x34\x73\xb9\x61\xc5\x49\x63\xa6\xc2\x7f\x58\x0f\x46\x71\x7c\x8c\xdf\x7a\xa5\xae\xeb\xff\xf1\x5f\xf3\x1e\xd7\x5f\x4c\x48\x8c\xbf\x7f\x08\x09\xf2\x38\x21\x88\xf6\xad\x28\xc9\x0d\x68\xfa\xbc\x65\x85\x55\x1b\x59\xe8\xbb\x97\xe9\x29\x94\x56\x76\x2e\xcb\x87\xc9\x00\xda\x06\x68\x26\x21\x69\x78\x07\x68\xb9\x2f\x46\x18\xc7\x6d\x5f\x91\x85\x50\x65\x7a\xa9\xfe\xa9\x8b\x23\xb5\xd8\x3c\x2a\x62\x9f\x29\x48\x26\x4a\x1d\x80\xea\x3f\xde\xde\xbc\xcb\x7d\x45\xcb\x3a\xcb\xb2\xcc\x01\xe0\xc7\xd7\x36\xf3\x48\x0b\x00\x52\x63\xf6\x9e\x5b\xeb\x24\xc5\xd3\x29\x69\xab\x32\x1c\x12\x02\xe7\x0f\x58\xa8\x94\xc4\xf0\x31\x7d\x80\x9a\x33\xc9\x32\xf3\x80\xd0\x83\x21\xfe\x6b\x29\x23\x69\xc1\x7c\x5b\xd3\xe2\x9a\x60\xdd\x66\x68\xb1\x5a\x58\x73\x59\x8d\xed\x84\x29\x84\xb8\x1e\x4b\x5e\x62\xdc\x9c\xba\x3c\x7f\x10\xef\xce\x7b\x4a\x67\x5a\xd7\x87\xa6\xbc\x92\xda\x2d\x12\xc6\x24\x42\xf4\x82\xba\x57\xd6\xbf\x5b\x3e\x30\xc0\x2f\xb5\x2b\xe9\x80\xae\x2a\x63\x4a\xec\xb4\xdf\xd3\x0a\xea\x65\xc0\x92\xe9\xf7\x7b\x33\x0d\x05\x5b\x70\xe8\x65\xd6\xbc\xb1\xa9\xb5\x1d\xd1\x76\x9b\xb9\x5d\x65\x9c\x99\x51\x24\x84\xcf\x8c\x9c\x90\xc6\x39\xb4\xc4\x23\x6a\xef\x9d\x7a\xbc\xee\xd9\x88\xaa\x69\xf3\x59\xe8\xe9\x69\xa4\x84\xbb\xd9\x1f\x98\xca\x3f\x3e\x68\x95\x79\x38\x41\xbb\x51\x1d\x5d\x4a\x7e\x7e\x04\x89\xdb\xcf\x77\x65\x7e\xc2\x04\x2b\x94\x5e\x00\xbc\xbd\xb9\xef\x37\xdb\x5c\xe1\x8b\x49\xa5\x84\x31\xd0\x66\x8c\x29\xe5\xae\x36\x94\x9e\xc0\xa3\x6c\x79\x06\xc5\x92\x84\xbb\xb9\x56\x6f\x66\xfe\xf1\x20\x03\xd8\x63\x8e\x3d\xfd\xbe\x5f\x06\x4d\x1e\x22\x0b\x05\x23\x3e\x2a\x66\xc0\xf1\x9b\xa2\x61\x5c\xb7\xf2\xef\x23\xf0\x7c\x91\xd4\x65\x49\xa5\xec\x60\x95\x09\x0e\x3c\x63\xfc\xda\xe0\xe5\x1e\x59\x6b\xeb\x3f\xc0\xeb\xa9\xfa\xa6\x9d\xd0\xa6\xc2\x67\x59\xb2\x28\x69\x8c\x69\xb9\x81\x37\x45\x69\x4c\x4a\x5a\x5c\x43\x07\xd9\x21\xc3\x97\xcd\x35\x7c\xf7\x92\x96\x0e\xbb\xb0\xa6\xc7\xe5\xe9\x27\x0c\xe8\x57\x2f\x1d\x55\x3c\xec\xd9\x7b\xe4\x9d\x51\xe3\xb4\xab\x63\x20\x5d\xa3\xda\x3e\x8c\x2c\x4d\x38\xe7\x2f\x36\xd3\x05\xd1\x48\x29\x36\x70\x4e\x2a\x32\x17\x2c\x30\xb6\xf4\x02\x66\x2a\x98\x8f\x24\x04\x96\xd1\xe2\xde\xf0\x5e\xb9\x63\xec\x72\x9c\x64\x7e\x77\x63\x6c\x88\x37\xf5\x07\xf8\x73\x98\x7b\xb3\xa6\x77\xbc\x44\xbf\xb2\x0d\x33\x2c\xe3\x3e\xa0\x11\x34\x40\xe2\x37\xdb\x3a\xf2\x2b\x53\x66\x2e\x12\xbe\x4d\xa1\xd5\xd0\x77\x80\x67\x4d\xa7\x72\x3f\xec\xae\xa8\x49\xac\x96\xe2\x1f\xa7\x8b\x4d\x02\x4b\xdd\x2b\xee\xd3\x22\x58\xab\x21\x4d\x31\x5c\x8d\xd2\x85\x38\x98\xab\xbf\x63\x9c\x39\xe6\xfe\xb9\x36\xec\x98\x74\x4c\xad\x52\x3b\x57\xd7\xf0\xb8\x39\xf6\xec\xaa\xba\xae\x53\x8c\x14\xad\xc3\x53\x0d\x1a\x61\x05\xb8\x7c\x35\x31\x4c\x3a\xda\x5e\xfb\x76\xd3\x5c\x49\x85\x35\xc8\xda\x4a\x2d\xd0\x7f\x2e\x27\xb2\x71\xf9\x8b\x71\x22\xf9\x47\x8f\x02\xf8\x6d\xfe\xc0\xc7\xe0\x0f\xb9\xa8\x38\x80\xae\x15\xbe\x8f\x43\xc6\xd8\x62\xd1\xa8\x94\x17\x99\xc4\xe7\x2e\xa8\xa8\x70\x9c\x94\xf1\x09\x98\x37\xc8\xb7\x41\xe7\x3c\x39\x88\xaf\x03\x8e\x4e\xb6\x5d\x15\x50\xda\xf9\xa5\x38\x6b\xaa\x21\xb6\x46\x57\x54\xe6\x30\x61\x9d\x41\x59\xa4\x48\x56\xe7\x02\x5e\x92\xe0\x5b\x62\x60\x41\x4c\xad\x9e\x34\x35\xff\x4c\x2a\x55\x65\xde\x36\x62\x4b\xc6\x88\x7a\xc2\xf5\x35\xfb\xae\x40\xf0\x36\x9e\x9f\x7a\x38\x3f\xc3\x89\xa4\x94\x96\x15\x46\x4a\xe4\x34\xe5\x87\x30\xbf\xf7\x19\x75\xd0\x2e\xf2\x23\xf2\x5d\xb6\x3e\x02\xd4\x7a\x51\xf6\x8a\x91\xbc\x7f\xd1\xf2\xf7\x60\xdc\xa0\xb1\xa9\x98\x65\x5f\x17\x71\xf9\x7c\xee\x22\x66\xda\x25\x26\x59\x8e\xcb\xda\x96\x8a\x5a\xcb\xce\xeb\x60\x24\xa1\xe1\xd5\xb8\xe8\x34\x97\x27\xa2\xdc\x92\x28\x3a\xad\xaf\x35\xbb\x3e\xab\x89\x8b\xca\x90\x78\xf0\x32\x43\x8d\xb5\xaf\x29\x22\x0d\x01\x14\x67\x31\x0b\xe3\x11\x7b\x55\x5d\xd5\x2f\x04\xb5\x1a\x37\x6b\xe5\xac\x6e\x92\x85\x58\x47\xe4\xa3\x30\xfd\xfc\xbb\x6d\x5f\xc1\xee\x68\x32\xdb\xbb\x40\xed\x52\xe5\x9b\x2f\x9d\x03\x6d\xac\x7e\x5e\xa5\x11\xe1\xfc\x7a\xcb\xa8\x84\x73\xfa\xa4\x39\xdb\x10\x99\x91\xb9\xb4\x54\x3c\x3d\xc1\x49\xb9\xaa\x41\x7e\x03\xe3\x85\x2d\xb5\x9c\x63\x97\xa9\x10\x83\x2a\xf6\x39\x20\x97\x69\x2f\x3a\xa9\x14\x7b\x6f\x6a\x5b\xc1\x5e\x5c\xce\x75\xf4\xde\x1d\x35\xe6\xc9\xfd\xdc\xaa\x5a\x3a\xf7\xba\xe1\xc0\xf9\x94\x64\xab\x41\xeb\x6f\xbe\x8a\x44\x94\x49\xaa\x03\x57\xfa\x77\x9b\x60\xb1\x3f\xee\x7d\x42\x4c\xcb\x28\xbb\xb1\x5f\xb0\x76\xd3\x0a\xad\xb0\x32\x76\x3e\x70\x1d\xc5\xd9\x6a\x94\x47\xfe\x61\xd6\x6c\x3a\xf2\x2f\xe7\x5c\x68\x22\xe9\x47\x0c\x09\x1a\xf8\x2e\x3e\x66\xd4\x04\x4e\x86\x7c\xe7\x0f\x45\xcb\xca\x42\xef\x4a\x8b\x49\x59\xcf\xd6\xeb\xe6\x41\xb1\x73\x78\xc3\xfe\x7b\xf4\xc0\xb7\x61\x47\xb7\x1b\xad\x12\xcc\x1f\x1e\xd3\x2b\xbb\xb5\x8f\x35\x8d\x78\xbb\xaa\x9b\x93\xc1\x21\x8c\xf0\x95\x80\xe6\xbe\xe1\x6e\x52\x4d\x41\xf9\x46\x34\xdc\xcb\x20\x88\xee\x4d\xee\xe8\xab\xb4\x16\xec\xe8\x29\x0f\x8e\xd9\x3d\x35\x40\xef\x56\x23\x06\x03\x52\x9d\xf2\x00\x1f\xb2\xc9\x40\x71\x4a\xfd\xea\xde\x6c\xa9\xf1\x69\xe8\x74\x80\xca\x7e\xde\xfa\x5f\x9b\x83\xbd\xa8\x31\xa6\xa3\x3f\x2f\xac\xfe\x56\x97\x0a\x7e\x89\x9d\x19\x5e\x23\x77\x30\x2f\x36\x00\x7c\xec\xaf\x39\xb9\x69\x4b\x6e\x3f\xff\xd1\x0d\x99\x61\x00\xcd\xf1\x3c\x5f\xdb\x50\xbf\xd8\x21\xf5\x77\xf8\xe0\x69\x6a\x8f\xbc\x8d\x99\xf1\x91\x51\xe4\x6e\x13\xed\xba\xcb\x99\x89\x54\x95\xa9\xce\x1b\x34\xbf\x09\xfc\xdc\x1b\xa6\x0e\x6a\x66\x7f\x19\x4a\xe8\xd5\xed\xc7\x34\xed\xef\x5a\x1b\x6e\xa8\xed\x2b\x7f\xa6\x21\xf9\x8b\x75\x2f\xf3\xe7\x1f\xf3\xb9\xfe\xb7\xfd\xd1\xbd\x1c\x93\xa9\xaf\x67\x85\x4d\x45\x83\x2d\x9b\x53\xf1\xd2\xa1\x51\x12\xd7\x4f\x18\x1c\x4f\x21\xe9\x8e\x76\x0b\xb4\x27\x40\xd0\x01\x0a\x68\x8e\x17\xd2\x8c\x98\xdd\x60\x99\xf0\x03\x4d\x0e\x21\x21\x87\x9a\xc5\x5e\x5c\x97\x30\x09\x7d\x33\x29\x7d\x5f\xce\x2b\x08\xc8\x58\x9f\x7a\x70\x9a\xe0\x12\x7b\xa0\xd0\x71\x04\xfd\xb2\xc0\x1b\x0f\xdd\x0f\xeb\xb2\x16\xa4\x8c\xa7\xb2\x70\x0a\x09\x17\x84\xdb\x14\x76\xd2\x43\xac\x80\x49\x8b\x48\x01\x99\xff\x61\xc3\x59\x39\xe4\xfc\x4b\x78\xf7\xb8\xad\xa6\x0f\xa5\x3d\xcc\xb6\x04\x28\x0d\xde\x7a\xf6\xf3\xcc\x78\x01\x1f\x21\x8f\xb9\x60\x1b\x1d\x5a\x6b\xae\xa2\xf3\xbb\xca\x73\x38\x0e\x77\x0d\x61\xff\x96\x00\xb1\x5b\x59\xa8\x99\xb7\xc5\xd5\xe0\xa9\xf3\x49\x0a\x10\x5e\xfd\xee\x06\x6f\x78\x4c\x45\xf7\x52\x2a\xb1\xa5\x8f\xb3\xe5\xae\xbe\x8b\x79\xa4\x3e\xed\xab\x22\x2b\x27\xb0\x3e\x9b\x4c\x7a\xbf\x19\xc5\x1b\x58\x38\xda\x56\xdb\xd1\xb5\xa7\x69\x51\x14\xe6\x6a\x06\xf5\x05\xa6\xa9\xad\x65\x44\x18\x6c\xa9\x19\xc9\xf0\xfd\xc7\x56\x69\x69\x27\x17\xa9\x42\x3c\xfe\x14\xf4\xf2\xd0\x29\x59\xdd\xc0\x16\x1e\x43\xfd\x66\x21\x77\x3a\x5d\xc1\x13\xe1\x58\x7d\x97\xe5\x56\xe3\xa0\x10\x4d\xc2\x13\x9a\x8f\x59\x45\xb9\x9c\x82\x52\x63\xfd\xa3\xf2\x9d\x9a\xdf\xce\xfc\xc1\x78\x56\x75\x78\x95\xf1\xd1\x02\x61\x88\x1f\x69\x49\x1f\x9e\xcb\xac\xe8\x81\x3f\x7b\x27\xd7\xc5\x3e\x44\xb3\x78\x9b\x43\xe4\x69\x27\xe4\x6c\x61\xb5\x15\xb7\xe4\x34\x8a\x3c\xd1\x20\xd3\x52\xf5\xa6\x64\x87\x56\xac\xbb\x3b\x66\x45\x1c\x02\x7a\xe6\x6c\x12\x82\x80\xca\x11\x3c\x2f\x8e\xf2\x6e\xc8\x23\x03\xae\x01\x60\x47\xf0\x05\xd3\xbe\x6f\xb8\xca\x5f\xcb\x3b\x66\x4a\x32\xa2\xf4\xe3\xb3\xcd\xa5\xcb\x75\xeb\x1e\x7e\x6d\x73\xc9\x3e\x48\x30\x76\x39\x49\x5f\x52\x63\x27\x6e\x85\x5e\xa8\xeb\xaa\x32\x98\x24\xda\x22\x9b\xe9\xa0\x77\xe8\x14\xab\x98\x38\xb2\xa8\xed\x31\x51\xbd\xae\xa0\xe7\xb2\xac\x42\xaa\x69\x9e\x26\xcd\x59\x2c\x50\xa8\xfd\x75\xd0\x9f\x3a\x0d\xc8\x97\xbf\x58\xa5\xad\x94\x7f\xe4\xd3\x4e\x59\x3c\xc4\xfb\xe0\x98\xc0\x61\xbd\x2e\x51\x04\xd8\x32\x27\x14\xe9\x10\xec\x43\x43\x1a\x03\x6d\xda\xa9\xa9\x0a\xf4\x5d\x5d\xd2\x75\x7b\xa0\xa0\x93\x25\xe8\x83\xba\x39\x31\xe3\x9d\x77\x64\xc8\x41\x34\xcb\x8b\x75\x23\xf7\x99\x0f\x65\x69\xca\x1f\x4d\x89\x98\x9e\x02\x25\x61\x00\xe3\x2b\x42\x28\xc9\x81\x93\x65\x09\x66\x14\x81\x20\xaf\x48\xdf\xb5\x9e\x69\x3f\x18\xac\x3e\x20\xfd\xb2\x89\xb6\x62\xd8\xd8\x0e\x22\xa9\xd6\x8f\x52\xf4\x2d\x4e\x00\x05\x05\x4b\x78\x31\x85\xae\xcd\x5b\xe7\x8a\x86\x7a\xc1\xc4\x4f\x9a\xa7\xa8\x87\x67\xdc\x25\x03\xbc\x07\xbd\x8e\xd9\x74\xf0\x11\x69\x54\xd7\xde\xd2\x74\xad\x84\xec\xfa\x87\xe0\x80\x73\x65\x7d\x84\x1e\xdf\xdf\x2b\xc7\xed\xc7\x20\x38\x3d\xb0\x6e\x7f\x57\x5d\xc8\x39\xe8\x79\xa2\xd0\xab\x0f\xe0\x0e\x44\x77\x57\x86\x5e\x63\xdb\x68\x82\xdb\x86\x3b\xb9\x7d\xfc\xf6\x39\x17\xa5\xbb\x85\x0e\x63\x75\x99\x89\xe7\x0a\xd4\x4a\x7a\x1c\xc3\x0b\x56\x66\x60\x99\xb9\xb2\xf5\xc0\xb2\xec\xa3\x42\xde\x75\x25\xaf\x1c\xa0\xac\xfc\x64\xf5\xc2\x02\x68\x3d\x82\x96\x3f\x0a\xac\xcc\x93\xad\x62\xbd\x3a\x0f\x96\xa4\x36\x4f\x54\x87\x4a\xd7\x2b\xc5\x5a\xa7\xba\xb7\xc3\xba\xbc\x5f\x57\x14\xf0\xc3\x46\x21\x7b\x48\xdb\xc5\xd9\x44\xd1\x3f\x85\x72\x07\x86\xbd\x23\xa4\x52\x38\x4d\x44\xad\x88\x78\xfb\xfc\xee\x47\x7b\x54\x8e\x85\x6f\xc2\x94\xa8\x7f\xc5\x65\x0c\xee\xdd\xdd\xe5\x50\x66\x1f\x71\xd0\x91\x36\x0f\x0d\xb4\x80\xc2\x53\x52\x15\xbf\xa9\x70\xc8\x09\x22\x5f\xab\xdd\x53\x19\x5d\x59\x47\xd0\xc0\x79\x39\xd8\x94\xc6\x29\x50\x14\x28\x89\xfc\x3e\xe4\x6a\x74\xbf\x73\xfa\x85\x06\x57\x0c\xec\x86\x03\xdc\xac\xd4\x1b\xf3\x15\x32\xe4\x51\x5b\x6c\xe2\x6f\x0d\x7f\xfb\xa7\x0f\xa9\xaa\x23\xdc\x85\x99\xcc\x97\x9d\x6f\x72\x7e\xe3\x8f\xf9\xe1\x3e\x6a\x7c\x1f\x5c\x21\xb9\x60\x3e\x90\xb8\xfe\x60\x81\xca\xbf\x63\xe1\x9f\x29\xdf\xd7\x78\x4e\xa5\xc5\x95\xd5\xe3\x81\x3e\xa5\x08\x64\x61\x91\x6c\x7c\x79\x61\x6a\x3c\x8d\xfe\x77\x7a\x06\x09\x53\x33\x88\xbb\x12\x3f\x35\x24\x24\x09\x29\x8f\x1b\xdd\xa8\x4d\xe1\xe3\x2b\xef\x4f\x7b\xbd\xe1\x60\x90\x9e\x64\x98\x9a\x29\xf9\xd2\xf6\xda\xd0\xd9\xfb\x3f\x59\xed\xe6\xfb\xfe\xd7\xca\xc1\xfe\x9f\x5c\x71\x7f\x5c\xfe\xc0\xdb\xe0\x89\x71\x33\xab\x0d\xa0\x43\xab\xaa\xea\x5f\xe1\x28\x3f\x5d\x3c\x1d\xf6\x91\x9f\x88\x76\xfd\xb1\x74\x12\x69\xc7\x0f\xcf\x67\x81\x85\xad\x7d\xee\xf8\x54\x57\x93\x83\x5d\x85\xd4\x2b\xc4\xb1\xcb\x5c\x88\x15\x11\xe6\x05\xae\xb2\x45\x4c\x93\x71\xc5\xc8\xf5\x89\xf3\x33\xde\x84\x20\x3f\xe4\x73\x04\xfc\x72\xf0\xf8\x8a\xbe\xcf\xda\x74\x9f\x7d\x2b\x9c\xcc\x18\xea\x33\x81\xa0\x5a\x1c\x73\xc2\x9a\xde\x2c\x2a\xcb\x8a\x40\x78\x90\xc0\xa1\x6a\xb5\xb6\xf8\x8e\x08\x1b\x0d\xb8\xd3\x44\x11\xda\x54\x8d\x37\x64\x9e\x1c\xda\x70\x29\xbe\xdb\x22\xa4\x6b\x55\xd6\xb3\x1b\xaf\xb3\x10\x7c\x34\x13\x62\x32\xec\x7f\x42\xb2\x9b\xcc\xa1\x2e\x4b\x41\xc6\x2c\x67\x9c\x04\xc1\x26\xe5\x55\x20\x75\x35\x54\x9e\x2f\xe4\x7e\x63\x41\xa5\xa5\xb5\x59\x2b\x3b\xd4\xd6\xb9\xcc\x52\xdf\x74\x55\xa2\x45\x90\x07\x78\xd1\x1c\xe5\x82\x5a\x12\x36\xee\xef\x20\xc6\xda\xf6\x95\x6f\xc6\x6f\xb9\x47\x62\x42\xdc\x4a\x20\xfb\x5a\x74\x45\xa0\xb5\x99\xb1\xf0\xa5\x37\xdf\xde\x3a\x8d\xd5\x2c\x2c\x5e\xda\xbe\x0e\xf0\xf4\x6f\x51\x3e\x59\xe3\xa7\xce\x71\x4f\x65\xef\x4e\x42\x57\xd6\xdd\x67\x5d\xa7\x6c\x71\x6e\x41\xde\x68\xcd\x0b\x71\x90\x12\x70\xd3\xac\xf4\xb3\x43\x91\x9d\x48\x00\x8f\x43\x74\xa1\x7b\x3e\x84\xdc\xee\xf3\xbf\x74\x0a\x07\x36\x05\xb5\x3a\x77\xa0\xc1\xa8\xe6\xcb\xe3\x5b\x7c\xc8\xe5\xfe\x2b\xf7\x49\x07\x1d\xd2\x19\x0c\x48\x36\xc4\x05\x69\x50\x4e\x9a\xb9\x9d\xc7\x62\xe7\xb2\xdf\x56\x6d\x1d\x57\xff\xda\xde\xa1\x4d\x17\x0e\xe7\x75\x4e\xc2\x13\xfd\xa9\x84\x73\x7f\x83\x69\xd2\x01\xa7\xeb\xba\xd4\xc8\xe2\x25\x0c\xce\xa3\x08\x9b\x1a\x87\x50\xb9\x40\x72\x5c\xb3\x88\xcb\x35\x68\xf6\x1b\x3c\xa5\x33\x2a\xde\xd8\xb7\xf4\x33\x69\x9d\xbe\x71\x12\x1a\xad\x33\x04\xc8\x41\x98\xe6\x9f\x85\x46\x5c\x5b\x3b\x8d\xd9\xde\x36\xfd\x2a\x2a\xe1\x65\xbb\xe1\x82\x56\x4a\x13\x75\x4b\xcb\x74\x13\x7f\xf0\xeb\xd4\x8c\x5c\xed\x01\x4d\xde\x72\x79\x58\x22\x2e\xd0\x6b\x53\x18\x1b\x8b\x9d\x50\x05\x78\xfe\x2b\xd5\xaf\xee\x71\x9c\x37\xf3\x07\x58\x1d\xf6\xce\x5f\x21\xca\x5b\x22\x66\x26\xf9\x76\xdd\xd0\x38\xb4\x63\xba\x79\x51\x6f\x55\x4b\x64\xe5\xc6\x60\xcf\xe2\xd1\xd1\x6c\xbd\x6c\xaf\x1d\xed\x96\x71\x48\xe0\x74\x39\xd1\xca\x28\xcb\xac\x4a\xfa\x27\x1a\xbd\xc1\x40\x66\x31\xec\x84\x66\xbc\x74\xac\x2e\xfe\xfa\x2b\x8e\x5f\x7b\x5e\x0c\x51\x39\xdc\x42\xf5\x1b\xc5\xfd\x1e\x53\xb8\xfb\x65\xee\xf7\xe8\xdc\xc7\x28\x4d\x42\x61\x82\xf2\x39\x20\xa9\x0d\xcc\x70\x90\xae\x6f\xba\xf7\x79\x23\xb5\x53\xe7\xd9\xef\x3f\xb5\xfe\x16\xe9\xf1\xd7\xca\xfd\x2a\xf8\x5f\xf5\x57\xf7\xa1\x9f\xc5\xff\x78\xdf\x2a\x41\x23\x85\xce\xe5\x18\x07\xda\xad\x11\x7d\x6e\x93\x9a\xa5\x91\xc6\x26\x2d\x89\xee\xb2\xf5\xde\x5d\x8a\x45\x00\xd6\x22\xc3\x5f\x08\x02\x8c\xeb\x94\x6f\xec\x5b\xb7\x8c\xda\x74\xd0\xd3\xb5\xcf\xd7\x72\x16\xc8\xf5\x4f\x48\x32\x78\x19\xb0\x0c\xf9\x2e\x38\x97\x98\x6e\x4f\x3d\xcf\x4c\x81\x92\x22\x29\xb3\xaa\x72\xb1\x54\x71\xad\xf1\xca\x4b\xb8\x00\x53\x99\x13\xc9\x92\xcc\x6a\xfb\xc0\x7d\x20\x71\x75\x09\xab\xe6\x99\xb4\x92\x18\x22\xed\x07\xce\x06\xa8\xbb\xc9\x3a\x4f\x66\xe4\x83\x3d\x44\xd3\xf8\x50\x19\x0d\x2f\xc8\xdb\x40\xef\x16\x6c\xe9\xaf\x75\x6c\x3f\x7a\xcf\xb5\xe5\xad\xb1\x25\x3b\x64\x89\xdf\x9a\xb5\xfc\x50\x20\xbd\xc7\x1c\xc5\x26\x99\x14\xf8\xeb\x36\xd2\xd6\x3e\x19\x69\xf5\x72\xf7\xd1\x3b\xa5\x19\x23\x08\x34\xf7\xe5\xf7\x6c\x24\x41\x0a\x4f\xc8\x7f\xb7\xde\x4e\x0f\xfd\xf1\x34\xfa\x08\xf2\x32\x99\x5d\x59\x48\x9e\xba\xc4\xd9\x13\xa2\x3e\xcc\x5a\x68\x47\x9e\xe1\x33\xfa\xea\x5e\x37\x3a\x5b\x0b\x32\x3c\x0a\x25\x65\xd7\x52\xd2\x41\x4c\x8a\xbe\x8d\x20\x00\xea\x25\x2a\xde\x2c\x3b\xda\x69\xb1\x60\x59\x4d\x1d\x87\x03\x30\x7e\x33\x3f\x6d\x05\x9f\x3a\x73\x59\x26\x52\xcb\x50\x11\x41\xd3\x2b\xfa\x97\xcc\x43\xaf\xf0\x10\x96\x72\xc6\xd0\x15\x7b\x38\x62\xb5\x21\x9c\xd1\xc7\x33\xb2\xca\xc0\xd6\x86\xb0\x96\x48\x27\x60\xb1\x4b\x4a\xe9\x80\xea\x65\xa5\xec\x6c\x94\xd0\xbb\x11\x33\xc0\x58\x7c\x70\x2b\x39\x9b\x68\xf2\x30\x08\xa2\xe7\x8a\x4c\xe9\xf9\x58\x7b\x5e\x6c\x5e\x8d\x54\x3e\xd3\xf0\xf9\x97\xc8\xf9\x0b\x68\x2c\x56\xe3\x30\x17\xc3\xdf\x2e\x95\x2c\x54\xd1\xe8\x37\x15\xf2\x26\x99\xdd\x55\xc3\x51\x46\xdb\x8e\x5b\xed\xe7\x4b\x45\x0f\x0b\xaf\x5f\x0b\x4a\x18\x83\x83\x38\x19\x42\xf4\x2e\xf6\x21\xa4\xbe\x8a\xc5\x68\x19\x67\x42\x55\x8d\x28\x11\xc8\xfa\x3a\xf9\x6b\xa1\x8c\xc3\x43\xb5\xc4\x3b\x6a\x35\x2d\x05\xe1\xf5\x5c\x80\x0e\xd7\xd8\x05\x1a\xaf\xf1\x74\x58\x43\x20\x38\x12\xc6\xd7\x08\x32\x38\x7a\xc0\x5c\xa7\x92\xf0\xa9\x6d\xc7\x56\xd3\x66\x3b\xb7\x29\x15\x81\x29\x05\x59\x30\xab\xe0\xec\x5c\x3f\x3e\x91\x4d\xce\xd1\x73\x7d\xc2\x8b\xc0\x73\x9a\xbe\xd9\x3b\xbb\x9c\x0c\x71\xeb\xf8\xb1\xff\xdc\x1a\x5c\x8c\xf5\x5f\x2b\x0f\x27\xea\x9f\x47\xdc\xdb\xbf\xe7\x21\x35\xf4\x3d\x4a\xbc\xa1\xe7\x1e\xec\x78\x18\x8d\xe0\x86\xcf\xbc\xc3\x7c\x14\x34\xee\xee\xe4\xe9\x7e\x3e\x71\x00\x61\x01\x69\x57\x28\x57\x73\x20\x7d\xb1\x2a\xc6\x07\xbf\x59\x90\x89\xd6\x67\xd6\x91\x35\x0a\xee\x5a\x96\x15\x1e\x43\xd7\xfd\xf8\xef\x45\x2e\xcb\x5c\x04\x61\x05\x4e\xce\xa8\x1c\x10\x99\xa6\xeb\x2c\xa3\x32\xf6\xeb\x8d\x09\xa5\x1f\x48\x81\x06\x4f\xf4\x18\xa3\x3a\xd3\xe9\xe4\x95\x00\xaf\xc5\x34\x2a\x2b\xc5\x95\x02\x75\xd8\x00\xdc\xee\xa7\xbe\x3f\x91\x18\x4a\x0d\x98\xfe\x46\x3e\xd8\x2d\xc5\x95\xb1\xca\xf5\x11\xd3\xe2\x3b\x98\xfa\xc9\xf2\x60\xa0\x94\x13\x7d\x90\xba\x7e\xbc\xdd\xc9\x65\xe2\x82\x1a\x16\x83\xc8\x6d\x89\x0d\xf7\xc8\x3a\xd8\x82\x34\x51\x25\x61\x1d\x88\xd0\x81\xf1\xa9\x08\x25\x7e\x0a\x29\xa2\x4e\xd6\x97\xb5\x25\xa3\x76\x60\xa4\x1d\xdf\x17\xf5\x1b\x2b\x7a\x65\x65\x1e\xda\xe9\xb5\x3e\x47\x46\x90\x3d\xd1\xfe\x16\x3f\x6e\x4e\x01\x52\x2b\x27\x25\xee\x94\x7b\xa3\x28\x9a\x87\x25\xd8\xf6\x06\x84\x8e\xda\xd5\xb8\x0d\x93\x81\xdb\x4f\xe6\x9f\x2f\xb1\x6e\x4f\x57\x6d\x6e\x7d\x31\xde\x9d\x82\x3b\x66\x8e\xb5\xb1\x60\xfa\x75\x2b\x50\xf5\xb9\x09\x41\x35\xbd\xce\x3e\xc1\x40\xbc\xa7\xf6\x55\x98\x9d\xac\xd7\x79\x71\xb9\x62\x33\xef\xd2\xe9\x59\x3e\xb9\xe7\xe6\x11\x90\xd0\x6c\x28\x8c\x50\x6c\xbb\x5a\xa2\x36\x52\xb1\xe1\x04\x00\xde\x21\x54\x42\x45\xdd\xc5\x33\xb2\xb3\x7c\x14\xbd\x59\x7c\x30\x5c\xe0\x4a\xda\x02\x97\x3b\x22\x2f\xbb\xac\xb9\x30\x9a\xa3\xf0\xd0\x33\x35\xce\x7d\x4e\x57\x3b\x1f\xcb\x25\xe0\xb3\x41\xa2\xe1\x28\xf7\x1d\xb7\x61\xfd\x1d\x40\x13\xeb\xe9\x3d\x94\x27\x14\x20\x77\xd6\x7b\x40\xef\xb7\x9b\x99\x13\xb2\xa8\xd0\x1f\x4e\x3a\xa0\x9d\xf6\x3f\x86\x1e\x1e\xa4\x59\x69\xd7\x12\x19\xc5\x89\x96\xc8\xbc\x30\xb2\xe8\x3f\xa4\xa4\x6b\x7b\x36\x47\xb4\x79\x3b\x8c\x30\xea\x64\x84\x74\xe9\x5a\x98\x51\xc5\xed\xc2\x2f\x6a\x90\xa3\x6b\x2a\x77\xfa\xa6\xcd\x19\x97\x4b\x55\xa5\x28\xe7\x5f\x56\x3c\xa3\x03\x73\x47\xc8\x71\xfe\x33\x60\x3f\x29\x01\x6c\xc9\x7b\x77\x0e\x89\x0a\xdb\xa4\x30\xb1\xba\x20\x3c\x2d\xc5\x9c\x2e\x98\x2d\x46\xd8\xa6\x25\x87\xdb\x44\x08\x9c\xb4\xc3\x88\x50\xb0\xf5\x17\x85\x6f\xe0\x3e\x75\xda\xb5\x66\xa4\xbd\x8c\x08\x37\xdf\xb9\x39\x6a\x40\x0d\xe3\x41\xc8\xae\xd1\xf7\xdd\x8c\x4b\x8d\xf1\x76\xb3\x0a\xc0\x75\xe4\xec\x2a\x8f\x11\x89\xcc\xc3\xf3\xf1\x83\x92\x5f\xf5\x41\x7e\x89\xd2\x4c\x70\x23\x86\xde\x4d\xe1\x0f\xdd\xe4\xa9\x21\x3b\x2f\x7e\xef\xd9\xab\x11\xde\x14\x16\x53\x47\xc7\xc8\xa8\x24\xca\xb2\xfd\x4a\x1c\x36\xfa\x66\xcc\x1d\x91\xc9\xa1\xcb\x8e\x53\x0f\x1b\x27\xfc\x64\xd9\xda\xfa\x09\x10\xf3\x38\xaf\xe5\x90\x9f\xef\x2c\x01\xa0\x95\x41\x10\x14\x44\x65\x9d\xe6\x78\xb7\x5b\xb3\x49\xca\x24\xe8\x4f\x9e\x6c\x15\xf3\x5f\xac\x2f\xb5\x2c\xff\xe0\x07\x41\x59\x02\xff\x87\x8f\x46\xa8\x2b\x39\x09\xa2\x26\xb8\xb9\xcf\x2d\x08\x65\x18\xd7\x1f\x5b\x5e\x65\x5d\xf6\xd9\x09\xbe\x85\x20\x85\x8e\x4d\xd8\x18\x3c\xf5\xd5\xb7\x08\x60\x95\x26\x15\xef\x79\x71\x6d\xa8\xd0\x0f\x81\xdf\x33\xce\x61\xea\x36\xe1\x6d\x59\x40\x65\x29\xaf\x01\xf8\x80\x13\xb2\x4c\x30\x9b\x1b\x26\xfd\x37\x26\x14\x40\x52\x04\x72\x55\x25\x69\xf0\xbe\x42\x6f\x95\x6b\x34\x9f\x25\x42\x81\x5c\x95\x72\xcb\x39\x2e\xd7\xdf\xbf\x3c\x0c\x0e\xc4\x20\xb7\x46\xc4\xdd\xc2\x35\x61\xf1\x9a\xc8\xa2\x3a\xcf\x72\x4d\xee\xd3\x48\xd6\x1e\x51\x9e\x9a\x68\x8d\x5c\xae\x98\xac\x8e\x38\x3c\x61\xc9\x13\x5b\xab\xea\x8b\xc8\x72\x8e\xcb\x97\x7b\x58\x82\x38\xdf\x5a\x6d\xf2\xf9\x9c\xaf\x3a\xf0\xed\xee\x27\xa1\x31\x03\xcf\x97\x71\x72\x14\x70\x78\x9e\x0f\x7d\x7a\xc0\x8c\x22\x08\xb0\x24\x2d\xc7\x0d\x29\x20\xf8\x8f\xd3\xc0\x97\xc7\xa4\x89\xe6\xd4\x78\x08\x8a\x1a\xd7\x39\x7a\x0c\x89\xfc\xfc\xb0\x50\x3c\x6f\xc0\xda\x43\x2e\x16\xe9\x2b\xd3\x48\x9e\x15\xfc\x03\x55\x09\x90\x96\x0a\x50\xa6\x72\x82\xd1\xd2\xa7\x9d\x38\x58\x2b\xb0\x64\x26\x6a\x82\x74\xb4\xd3\x69\x06\xc6\x85\xf3\x25\xcc\xc4\xca\x3d\xaa\xa6\xba\x59\x7e\x51\xa1\xc9\xef\xd8\x2c\x3c\xc5\x5d\xcb\x5b\xd7\xe4\x35\xab\xe9\x57\x30\xae\x8b\x18\x19\x70\x69\xd7\x2a\x27\xd7\xe2\x0a\x58\x24\x23\x93\x20\xa5\xad\x79\xab\xd7\xe8\x57\xdf\x5b\x5e\x21\x8c\x6d\xb5\x3f\xfd\xd7\x12\xf7\xdb\xcc\x7a\xb2\x9a\xcd\x65\xef\x78\x02\xcd\x57\x54\x17\xb4\x42\xb3\xce\x7c\x0e\x7c\x8d\x49\xe6\xbd\x99\xdb\xac\xf6\xd0\x67\xc6\x30\xc3\x1d\xc1\xe2\x09\x10\xeb\xe3\xbd\xd6\xdb\x4a\x94\x02\x8c\xc1\xcf\x22\x34\xe8\xee\xce\x30\xaa\x1d\x85\x86\xdd\x1f\xe3\x2c\xe6\x69\x5c\xba\xa9\xcd\xca\x41\x68\x5e\x7e\x6c\xd3\x6d\x98\x08\xf6\xd1\x63\xa3\xab\xcf\xee\xc7\xcb\xda\xc1\x6c\x5e\xc5\xe7\x57\xcb\x62\xd9\x1d\xdc\xf6\x38\x37\x14\x7e\xd3\x50\x9b\x63\x05\x83\x11\x53\x5d\xce\xa9\x27\x5b\x6c\x91\x21\xe8\xc7\xc9\x5e\x6c\x68\xa6\x29\x97\x81\xbb\x87\x40\x61\xb3\x00\x01\x18\x6e\xf8\xe0\x9d\x82\x1e\x31\xb8\x85\x46\x40\x58\x55\x2e\x5e\xd0\xc1\xcc\x96\xa7\x13\x3a\x5a\xaa\x4a\x50\x3f\xb0\xe0\xf1\xd4\x89\xda\x96\x8b\x3f\x40\x2f\x7d\xd5\x0d\x8e\x26\xdd\xa5\x5b\x08\x37\x8a\x88\x34\x2f\xf9\xc9\x79\x79\x97\x67\x0c\x58\xce\xe1\xf0\x7a\xf1\x65\xdd\x97\x17\x58\xc8\xac\x16\x83\x3f\xa5\x23\xfa\xba\xe8\x7c\x52\x17\x5e\xfa\xf8\xd8\xc4\xd1\x9a\xc2\x4f\x61\x0c\x5c\x68\xbd\x02\x77\x5f\xca\x39\x85\x4c\x5a\x22\x42\xd7\xf5\xd5\xe4\x20\x92\x94\x5b\x60\x5e\x30\xd3\xa7\x4d\xa2\x7b\x4b\x47\xac\x98\x7b\x13\x48\xb6\x26\xc7\xe8\xe4\x34\x74\x2a\xf5\xe8\xab\x85\xbb\xb9\x93\x0f\x68\x2a\x22\xa5\x0a\xec\x3d\x91\x04\xe7\x3c\xab\x0b\x6c\x39\xa6\x7a\xa2\xdc\x4c\x22\xc6\x11\xed\xca\x49\x7e\xe3\xcb\x77\xc5\x95\x54\xc7\xcb\xaa\x4b\x5b\xf4\xfa\x3b\x63\x18\x19\xe2\x78\x6b\xb5\xd4\xbe\xfd\xd3\x19\x73\x3c\x7f\x2d\xc8\x4c\xc5\xff\x5f\xa4\x78\xd6\xa7\xf4\xd0\x0b\x84\x8d\xee\x1e\x21\xbc\x16\x41\xf8\xf8\x01\x6f\xfb\x02\x24\x03\x28\xaf\xd9\x2d\x4b\x0c\x1f\x6a\x50\x1f\xe4\x01\x8d\x48\xdd\x5b\x91\xa8\x7e\xad\xad\xe7\xd0\x6c\x99\x9b\xd6\x3f\x56\x5d\x3c\xa8\x26\x9c\x25\xee\xd6\x64\xac\xaa\xa1\xc4\x0c\x5e\x2d\x74\x69\xf8\xaa\xeb\x8a\x2e\xcb\xaa\xaa\xdc\x44\x53\x9f\x9f\x66\xaa\x42\x82\x03\x2d\xbc\x05\x60\x22\xb3\x04\x2a\x06\x3a\x41\x23\x0b\x96\x8b\x68\x48\xca\xf0\x7f\xdd\xcf\x63\x75\x52\x22\x80\x27\xa6\x6e\xe5\xb6\xa4\x44\xcf\xcc\xce\x49\x0b\x8d\xbe\x78\x10\xae\xbd\x59\xf1\x5a\xcf\x7a\x48\xaa\x07\xcc\x21\x28\x40\xcd\x03\x20\xcf\x92\xad\xc5\x04\xdd\xef\xb5\x7b\x14\x7a\x58\xfd\x61\xdc\x84\x4a\x10\x76\xa6\x1f\x8c\x33\xbb\x52\xf2\x08\x37\xe3\xa1\xc8\x5d\xbe\x3b\x25\xcd\x90\xa1\x3a\xd9\xe0\xb5\x79\xb0\x6b\x92\x76\x20\xbe\xf6\xd5\xe9\x67\xc9\x83\x1c\x2e\xbd\x7f\xa0\xb9\x65\x3c\xbd\x67\xfa\x3f\xf8\xd8\x76\x72\xa1\x30\xa7\xa5\xa3\x65\x4d\xbf\x63\x9d\x4f\x72\xc5\x5d\xd5\x56\x94\x31\x71\x57\xf1\xde\xe7\x42\xd8\x65\xa5\xff\xf5\xf9\xab\xc5\x17\x21\x97\x69\x95\x6b\x0f\x2a\xf9\x46\x2f\x08\x68\x27\x78\xfc\xa3\x27\x10\x43\x71\x5b\xc5\xa1\x92\xcf\x9f\x7b\xdc\x84\x3b\x2a\x5a\xbc\x52\x63\xf7\xf0\x82\x07\x13\x3b\xb2\x59\x72\xcd\xc2\xdf\x83\x8b\xa0\x4f\xfe\xa6\x5d\x61\x6f\x88\x7f\x9b\x1e\xac\x0f\x8a\xc9\x33\xce\x29\x1a\x52\xb8\x50\xb4\xed\x04\x11\x57\xd9\xad\x10\x80\x3e\x26\x32\x6e\x6b\x63\x0b\x3e\xd5\x0c\x9f\x62\x1f\x52\xb5\x72\xc3\xe2\x35\x2e\xeb\x1c\x73\x9b\xc5\x2c\x59\x7d\x3c\x49\x56\xf0\x36\x06\x74\x08\x04\x8f\xda\xee\xb2\x51\x28\xcb\x9b\x2a\xaf\x55\xd5\x0a\xbc\x14\xb3\x02\x1c\xab\x10\x5b\xc8\xcc\xb6\xcd\x04\x99\xe5\xc0\x26\x2e\xa2\xe6\xb0\x6b\xb4\xab\xe8\x07\x9b\x54\xf6\x3a\x42\x86\x85\xc9\x41\x38\xa5\x63\x41\xf7\xfe\x93\xbd\xa7\x1c\x1f\x1c\x71\x87\x65\x9d\x41\x31\xfd\xd1\x81\xde\xf7\x32\x6e\xdc\x96\x4e\x3d\xfc\x2d\x0d\xf3\xcb\x89\xf4\xd8\x13\xb4\xea\x52\x1b\xed\x42\x82\x6a\x16\x67\x9d\xc5\x25\x2d\x59\x27\x61\x5a\xbc\x0e\xe8\x31\xb1\x22\x8d\xac\xae\x9e\x60\x4b\x23\x8b\x44\xb9\x69\xe4\x60\xf5\x14\x95\xce\x6d\x3d\x58\xde\x60\x59\x7c\x6f\x73\x5d\xde\xc8\xd0\x45\x31\xe7\x81\xb3\x4a\x88\xb0\xa5\x4d\x7a\x62\xf8\xba\x24\x5c\x33\x13\x80\x35\x79\x75\x54\x55\x2d\x58\x67\x52\x3c\x6a\xfc\x65\xb9\xb2\xcd\x2c\x98\xe2\xe3\xf0\x1e\x7f\x3f\x44\xc4\x21\xea\x53\x99\xe8\x9a\x4d\xbd\x88\x27\xf8\xab\x47\x22\xaa\x61\x3d\xd0\x35\x10\xe5\x4f\xe4\xb4\xea\xa7\xe9\xe1\x3a\x1a\x8d\x81\xbc\xc0\x16\x5e\xf2\xc8\xb3\xc8\x43\x2d\x3c\x3e\xcf\x42\x2d\x59\x39\x3b\xba\x4f\x70\xc0\x97\xea\xce\xf8\xc9\x15\x77\x8f\x12\x42\x9a\x46\xc7\x42\xba\x60\x90\x8a\x15\xf4\xcf\x6d\x30\xdc\x7f\x31\x63\x33\x0b\xff\xc4\x8c\xc3\xe2\x61\xde\xb7\x2a\xb3\x86\x20\xbc\xe1\xd0\xcd\x6e\xc8\xb0\xce\x58\xf7\xee\xd5\x3a\x13\xe0\xf9\x3e\xbb\x94\x6d\xed\x3d\x7a\x96\x08\xe0\x70\x7d\xcc\x8e\x88\xdf\x25\x56\x1b\x65\x3a\xe3\x71\x43\x9b\xc9\x78\x10\xe5\xc7\xe1\xe5\xbd\x91\xd7\x60\x00\x46\x1b\xef\x48\xf0\x13\x02\x36\x43\xfd\xe3\x4c\xce\x94\xa0\x30\x8c\x20\xb6\x01\x11\x58\x9e\xc7\x98\x81\xa0\x2e\xab\xc0\x34\x29\x6e\x4d\x8b\xc8\xa5\x1f\xed\x87\x2d\x89\xbd\xc7\x78\x0d\xe9\xfe\xcb\x14\x8a\xc7\xb6\x97\x99\x0e\x09\x61\x96\xd3\x02\x23\xaa\x25\xe6\xab\x1f\xce\xb3\xe5\x49\x83\x72\xb9\x72\x49\xb5\x85\xcd\x1d\x96\x5d\x53\x30\xe0\xd8\x54\x3f\xe5\xce\x0d\x2d\x18\xf3\x97\x01\x9f\x00\x9b\x9b\xd9\x0f\x8f\x1b\x42\x55\xbf\x06\xd9\x65\x58\x3b\xfd\x76\xb4\x0e\xc7\x79\xd3\x75\x2e\x3a\x48\xe7\x86\x39\x6e\x65\xcf\xf5\x5a\x60\x94\x60\x2a\x3c\x21\xda\xaf\x28\x76\x4f\x2c\x65\x0e\xb1\xfc\x53\x06\x94\xd4\x46\x36\xc7\x7a\x59\x9d\x31\x7a\xc0\x09\x86\xaa\x7d\x31\x7e\xb6\x73\x02\x30\x63\xd2\x07\x21\x01\x44\xb5\xb5\x69\xae\x83\x53\x82\x5e\xcf\xbd\xd4\xe4\xab\xd3\x2f\x4c\xd5\x53\x97\x39\xd1\x3d\x0c\xda\x0d\x40\x5a\x32\x90\x03\x35\x76\xb3\x17\xf2\xbd\x42\xf0\xc9\x64\x3a\x49\x3a\x66\x24\xbb\x0e\x4c\x85\x37\xb0\xd3\x8c\x5a\xd4\xdf\x8b\xf4\xbe\x7d\x48\x70\x2b\xdc\x4b\xdd\xf9\xbd\x71\xce\xb9\xce\xde\xea\x6b\x31\x5c\x38\xf5\x45\x05\x32\x45\x3a\x79\x64\xb7\x55\xe0\x84\x09\xdf\x1a\xd5\x72\xd0\x40\x9c\x9a\xcb\xf4\xe8\x18\x09\xb5\xbc\x6b\xab\xdc\xa6\xf7\xd5\xa9\x1a\x3f\x07\xc5\xf5\xa7\xab\x46\xc6\x34\xd4\x24\xd3\x3a\xd7\xf1\xcb\x32\xe7\xcf\x4e\x49\xac\xde\x1f\x95\xe8\xb1\xb7\x05\xce\x26\xd3\xfb\xaa\xd1\x83\xab\x80\x2c\x5c\xcc\xe2\xea\xd1\x93\x4e\x15\x7e\x6f\x9a\xce\xea\xb6\x16\x5c\xca\x70\xf7\x9b\x92\xe1\x6f\x84\x40\xd6\x62\x1d\xa4\x17\x5e\x82\xcd\xa7\x31\xb4\x1d\x5f\x59\x73\x47\x11\x7c\xf5\xf0\x26\x8b\x2d\x1d\xe2\xa7\xdd\x73\x19\x0b\x14\xcc\xc6\x6f\x30\xb1\x42\xb2\xb0\xde\xe6\x1c\xec\x85\x1d\x33\xec\x1e\xa4\x6b\x6e\xea\xd3\x5a\x2b\xcf\xd3\x68\xd5\xc7\x1e\x30\xca\xd0\x56\xcc\xa5\xdf\x4a\xbf\x28\x09\x4e\xdb\x5a\x76\x59\x5d\x6f\xd8\x1e\xab\xb6\xf2\xf6\xc9\xa4\x99\x36\x7c\xdd\x41\xa2\x4e\x1f\x92\x1a\x58\x5f\xbb\x3d\x09\x30\xed\x58\x2c\x77\x16\x4b\x83\xda\xd4\xa9\x61\x22\xa2\x6c\x66\xd3\xd5\x64\xd9\xaa\x26\xd1\x34\x22\x51\xd7\x43\x83\x78\xfc\x2a\x18\x8a\x98\xcb\x3a\x1d\x19\xb7\x06\x93\x58\xae\x76\x7f\x04\xd7\x48\x35\x67\xe7\xb2\x0f\x44\x19\xf3\x4c\x9c\x37\xfd\x9e\x9f\x7f\xda\xb9\x42\xb5\x17\x31\x60\x36\x56\xfe\x3e\x4d\xdc\x5a\x0a\xe6\x39\x45\x98\x66\xba\x96\x8e\xbc\xda\xf0\x3b\xcb\x82\xc8\x5f\xd7\x1f\x85\x00\xd0\x47\x11\x13\xe2\x2b\xc7\x0f\x28\xb0\xdf\x44\xa4\xd2\xcd\x8b\x34\x2d\x45\xca\x4c\x9f\x89\x31\x7c\x7f\xe6\xb8\xbd\x78\xfe\x6a\xeb\xbf\xff\xbc\xfb\x7f\xfc\x5d\x73\xc4\x56\x65\xe8\xe3\x51\xcc\x12\xdc\x73\xc6\xa8\x64\x69\x20\xe4\x29\x13\x08\x0b\xbd\x3b\xbc\xbd\x08\x77\x0c\x65\x27\x22\x75\xf6\xe4\x75\xbf\x31\x28\x00\xda\xc8\x4f\x16\x59\xed\xf6\x19\xb7\x17\xe7\xa1\x3e\x14\x02\xd9\x06\x32\xf5\x85\x8f\x4c\x50\x46\xa4\x1c\xdf\xc8\x3b\x3b\x31\x4f\x01\x94\xeb\xef\xbd\x2c\x2a\x6d\x9a\x10\xcb\x2c\x08\xe2\x74\x71\x62\xbb\x7f\x17\x4b\x50\x65\x4f\x54\x09\xff\x2d\x27\x42\xc7\xfe\xea\x52\x87\x6a\x8b\x89\xa5\x2d\x29\x12\x44\x16\x74\x0a\xec\xa8\x80\xe4\x41\x99\x1a\x8b\xac\xe0\xa9\x64\xf9\x8e\x65\xbd\xfc\x66\x57\x21\xcd\xfa\xee\x66\x9a\xca\x1b\xce\x78\xf9\x70\x98\x1b\x93\xd7\x39\x02\x47\xe8\xda\x2f\x14\x5d\x4b\x91\x64\x7c\xa7\x51\xd5\x09\x75\x3a\x0c\x38\xeb\xd1\xe2\xf2\x41\x5a\x76\xac\x96\x53\x6e\x7e\xf6\x2e\xb8\xaf\xfe\xd8\x26\x2a\x5e\x91\x24\xdd\xa6\x82\x47\xa1\xfb\xca\x79\x4f\x5e\xb8\x64\xef\x53\x47\x3f\x42\x4e\x5c\x5e\x07\x07\x37\x53\xa3\xa9\x48\x90\x41\xc1\x48\xb6\xc0\xf6\x40\x91\x05\xf9\xa3\x17\x77\x79\x51\xb5\xe7\xf2\x2c\x8a\x61\xbb\xcc\x98\x27\xac\x6a\xfb\xac\x08\xdf\x9a\xee\x16\x75\x75\x6c\xc9\x31\x43\xbc\x7e\x13\x1a\x44\xd1\x9d\x6e\xa2\x9a\x18\x04\xbb\x15\x98\x5d\x69\x9c\x07\x12\xea\xc2\xe8\x24\x9a\x53\x95\xe0\x0b\x9d\xb0\xc0\x77\xd1\xe3\xfb\x58\x0d\xce\xae\xaa\xab\x9e\x12\x7c\x5d\x29\x0a\x42\xe7\xb0\xa9\x0e\xed\x01\x2f\xfc\xc8\x78\x76\x35\xd5\xc5\x32\x9d\xcb\xec\x13\x8c\x7f\x38\x62\xdb\x7a\x25\x51\x45\xad\xb3\x8e\x2a\xa0\x9e\xdc\x84\x7b\xcb\x15\xcf\xf5\xc8\x38\x4a\xbe\x99\xb7\xac\x68\x72\xf8\x8c\x3a\x50\x98\xfb\xab\xa9\xdb\x6b\xd3\xa9\xb2\x74\xca\x0f\xa4\x9f\x4d\x34\x11\x77\x2d\x92\x83\xe8\xd5\x44\xd9\x3e\x25\x64\x6a\x6c\x69\x25\xde\x38\x63\xf2\x0a\xf0\x12\xf2\xcd\xb7\xfc\x79\x6f\x75\x5c\x2c\x36\x00\x75\xc2\x90\xae\xa4\x78\xf7\xe2\x3e\xe8\x8a\x2a\xa5\xe7\xab\xe9\xd3\x44\xb3\x83\x11\x0d\xe1\x4c\x00\xd2\x0a\xf3\xa0\x20\xcf\x65\x35\x69\x86\x4b\xb5\x22\x82\x8b\x42\x74\xbb\x61\xa1\xbb\x1f\x01\x5d\xd2\xb2\x35\xbf\x87\x05\xc8\x75\x12\x26\x6f\x6e\xbc\x30\x3e\xbd\x41\xaf\xb4\x7c\xee\x1c\xa9\xa6\x05\x63\x29\x6e\x32\xcd\x54\x34\xd0\x97\x47\xbf\x55\x8e\x75\xd8\x91\xb3\x13\x6f\x21\xb5\x95\x38\x8c\x01\x45\x4f\x97\x44\x08\xe9\x3d\x71\xe1\x35\x42\x22\xa1\x5b\x12\xcc\xf0\x6e\x56\x2e\x01\xdc\xa5\x67\xcc\x83\xe5\x62\x85\x6b\x12\x1e\xad\x9d\x5c\x35\xb2\xf8\x9d\xe8\x35\x63\xf5\x2c\x5a\xee\x25\xb0\x84\x8c\x9f\x6a\x28\x55\x15\x31\x8b\x3f\xf5\xb7\xa0\xb7\x5c\xd6\xda\x90\xf3\xd6\x58\x1c\x00\xd7\x45\xd9\x97\xef\x8f\x30\xb0\xef\xe4\xdc\x77\x40\xdf\x21\xde\x74\x6b\x59\x43\xc9\x81\xc1\x3f\x37\x66\x43\x56\xb9\xb2\x12\xf2\x27\x98\xf2\xb9\x87\x90\xea\x6d\x47\x64\xbe\x3d\xa4\xa8\xbf\x91\xce\xbc\xe0\x6a\xa0\x60\x8e\x64\x98\x9a\x56\xca\x77\x65\x1a\x0a\xe2\x8d\x3f\xb9\xd9\x5a\x2e\x7f\xb1\x81\xe7\x38\xfc\x63\xbd\xef\x7f\xfd\x9f\xa8\xd2\x05\x96\x0f\xe8\xad\x98\xdd\x95\xa7\x21\x35\x80\x51\xdc\x57\x0a\x5f\xd1\x61\x6d\x86\x15\x15\xa4\x84\x2e\x3a\x91\xf3\x50\x56\xcf\xf8\x50\x8e\xba\xe7\x6d\xdb\x6d\xfe\xc9\x84\x85\x16\x3c\xc8\x8b\x17\x88\x4d\xc8\x45\xa6\xdb\x57\xaf\xf4\x8b\x99\xae\x11\x16\xe6\x94\x3a\x23\x44\xbb\x89\x9c\x22\x29\x82\x7a\x08\xa2\x31\x74\xe8\x76\x2a\xe2\xac\xac\x7d\x25\x12\xd8\xb5\xe3\x1b\xb6\x15\xba\xe7\x1e\x3b\x1d\xfc\x24\x45\x42\x99\x6c\x5e\xa4\x1e\x93\xed\x87\x65\x27\xf0\x91\xbd\xac\x31\x3d\x5b\x3a\xac\xd2\xe2\xd9\x59\xa9\x7a\xd7\x42\x46\x73\xb7\xac\xed\xe0\x6d\x7b\x14\xe9\xe1\x5d\x36\xa6\xbd\xa6\xa6\xf1\x6d\x98\xc7\x67\x3f\x22\x23\x9a\x34\x63\xb6\xfe\x98\xe8\x87\x08\xef\xfc\x9c\x12\xf1\xd0\x43\x76\x2d\xab\xea\xa8\xbf\xca\x83\xac\x30\x73\x6e\x7e\xe4\xf8\xb7\x7d\x7f\x76\x50\x83\xc1\x4f\x34\x21\xf8\x30\x7f\x87\xaa\xf1\x31\x6c\xc9\x2f\xa2\xf2\xf4\x30\x2a\x0e\xa7\x45\x19\xe4\x95\x89\x06\x4c\x54\x8d\xea\xd2\xa0\x29\x98\x24\x33\x67\x3c\xb9\x5f\xb4\x02\xdc\x90\xef\xb6\xd6\x83\x1c\x25\xcd\xcd\x2f\xdc\x64\xbf\x1b\xac\x7c\xd9\x63\xd1\xfe\x5c\x44\x0b\xe7\x94\x69\x2e\xb5\x8c\x42\xdd\x66\x39\xfa\xc7\x51\xcb\x41\x5a\x69\xc4\x3b\x7e\x77\x9b\x1d\x9f\xd8\x42\x93\xd8\x0b\xa5\xc1\x2f\xef\xb4\x6b\xec\xb0\xbd\x75\x80\x1f\x46\xce\xb5\x27\xae\x92\xad\xf1\x3f\x46\xab\xfd\x91\xc5\x23\x91\xc3\xe8\xa1\x5d\x18\x59\x50\xfb\xac\x49\x27\x55\x12\x63\x8d\xe1\x56\x1b\x4d\x78\x0e\x32\xe9\xac\xd2\x86\x99\x34\xe5\xe7\x16\xaf\x26\xa8\x5f\x4f\x1a\x68\xe4\xe9\xe7\xb1\x93\xc0\xba\xac\x99\x9a\xf3\x1d\xfd\xc2\xab\x94\xcb\x98\x64\x31\x00\xf0\x90\x7a\x9f\x5e\xfa\xc2\xa3\x41\xeb\x4e\x68\xeb\x99\x8e\xbb\xf0\xdf\x2e\xa6\x41\x20\xd1\x57\x45\xea\xb4\x5a\xa7\xda\x0b\xc4\x3f\xe7\x80\xc5\x5a\x4f\x65\x1e\xfe\xa5\x81\xdc\x4d\xee\x08\x71\xa2\x55\x8d\xcd\xf7\x16\x25\xe4\xd3\x3c\xcc\x75\xc0\x42\x9c\x9b\xca\x5a\x57\x9f\xf4\x27\x55\x2a\xb2\x66\xba\xba\x3f\xf5\x57\xd0\xc8\x33\x21\xc6\x32\x83\x3c\xae\x1a\x17\xcd\x4c\xff\xca\x2d\x12\x03\x35\x5a\x2e\xe1\x49\xee\x82\x99\xa7\x89\xc3\x49\xcb\x26\x62\xfe\xd6\x51\x13\x7d\x6e\xdd\x7a\x72\xd2\x89\x79\x92\xa8\x51\x41\xce\x3f\xa0\xbf\xaa\x71\xa2\xa1\xf8\x93\x15\xf9\x25\x08\x70\x84\x4c\x76\xdd\xbd\xbe\x67\xbd\x77\x57\xad\x4e\x11\x91\xab\xd5\xca\x16\x47\xc4\x11\xe9\x59\x13\xf7\xc3\x35\xd7\xcb\xae\x7b\xca\x5c\xb7\xc6\x67\x9f\x05\xf5\x88\xe7\x05\xf7\x8d\x76\x8b\x35\x2a\x3c\x72\xcc\x94\xd6\xef\x9b\xe4\x48\x5a\x7f\x53\x4a\x98\x95\xae\x38\x66\xae\x92\x29\x6e\x12\x7a\x17\x12\x53\xf5\x25\xc5\x56\x10\xdd\xe7\xa1\x34\x43\xb3\x0d\x62\x88\x7b\x83\x88\x79\xf1\x67\x6a\x8e\xf2\xf9\x8b\x5d\x61\x30\xff\xe7\x42\x04\xd4\x93\xc7\x1f\x43\xd1\x67\x03\x92\xef\x72\x14\x13\x87\x07\x9f\xb6\x53\x55\xb6\x21\x21\x50\x84\x8e\x61\x8a\x59\xee\x37\x8e\x93\xce\x48\x69\x51\x63\x92\x8e\x08\x90\xd8\x2e\x44\x21\xd2\x06\xed\x51\xf2\x9c\x94\x75\x9c\xb9\x91\x95\xb6\xaa\xdb\x96\x91\x59\xcf\x3e\x61\x6a\x94\xaa\x29\x27\x74\x29\x07\xf4\x81\x67\xd5\xe2\x49\x3e\x8c\xc2\xbe\x0a\xc8\x09\x84\x98\x38\x3c\x9b\xcd\xf8\x7b\x14\x8d\xc5\x8d\xe6\x2e\x3b\xa2\x04\x61\x54\xea\x4d\x82\xa0\x76\x42\xc4\x47\xbd\xcb\xf9\xbb\x52\x13\xf8\x07\x80\x05\x50\x8e\x80\x93\xa5\x75\x2a\x94\x17\x4c\xae\xfc\x92\xdc\x9f\x5a\x23\x49\xde\xaf\xac\x25\xfd\xa6\xca\x25\x1b\x37\x97\x8b\xb7\x61\x4a\x5c\x59\x3d\x11\xb1\xaa\xd7\xf3\x4a\x6d\xbb\x85\xe6\xca\xd4\xe2\xa5\xc0\xa7\xc5\xec\x7a\x88\x34\xe8\x15\x95\x5d\xe5\xf2\x3c\x89\x09\x8d\x93\xe9\x3b\x94\x16\xb7\x2a\xb7\x90\x69\x00\xc7\x20\xa7\x16\xf5\xdf\x21\xe0\x1d\x52\x8f\x71\x4b\xea\xa0\xd3\x5d\x7c\x15\x6a\x9e\xaf\xf2\xc6\x70\xf7\x8f\x69\xe6\x6f\x13\xd9\xca\x0d\x47\x3b\xfe\x7d\xda\x11\x07\xa5\x17\x27\xb8\x28\x95\xf8\xe7\x52\x22\xa4\xbb\x09\x36\x22\xa3\xae\x32\x1d\xbf\xe0\x06\x73\x6e\x1a\x1f\xad\xe8\xa0\x93\x35\xd6\xca\x46\x2a\xf8\xbd\xf2\xaa\xc2\x42\x1d\x1f\x40\x32\xee\x49\x7e\xa1\x83\x44\x1a\x19\x76\x2e\x11\x47\x00\x4d\xa0\x77\xb2\x63\xa7\xe4\x5a\x72\x35\xe9\x7b\xb1\xe5\x63\xb9\x97\x3f\x6e\x8f\x4e\x2e\x01\x51\x91\xc7\x61\x48\xd2\x2c\x1c\x20\xc7\x3a\x6d\x75\x80\xc7\x7c\x14\x52\x25\xed\x87\xcd\x52\x80\xd2\xd6\xaf\x12\x8b\xe1\x95\x12\xb9\x97\x93\x7e\x93\x09\xf1\x6d\x26\x95\x62\x93\xe8\x05\xe8\xb6\xc6\x97\x1f\x20\xd9\x74\x0c\x41\x08\x9d\x0b\x24\x1d\x1c\xa2\x58\x69\xa1\x48\x45\x9e\x89\xea\x5a\x82\xe5\x1a\x14\xd2\xed\x33\x7b\xe8\x17\x8c\x96\x44\x73\x6e\xab\xcf\x48\xf3\x49\x34\xf8\xd4\x25\x17\x0a\x5d\x66\xd4\xa4\x84\xd0\x44\xaf\x4e\x11\xd4\xcf\x7c\x6e\x15\xde\x09\xbe\x43\xed\x88\x49\x6d\x76\xc6\x85\x90\xdf\xec\x46\x2c\xc9\x24\x0a\xe7\xa8\xe6\x70\x8b\x7c\x00\x05\x4a\x20\xea\xd0\x8b\x76\x33\x6d\x99\xbf\x7d\x89\xb2\x07\xdf\x0d\xa9\xe4\x7e\xa7\x23\xc7\xa2\xcf\x3e\x81\xeb\x0c\xdc\xf1\xab\x58\x72\xab\xae\x9c\x9b\xf6\x51\x01\x0a\x33\xd5\xea\x99\xde\x71\x3a\xd0\x12\x4b\x51\x88\xb0\x68\xf8\xde\x7f\x3d\x52\x54\x16\xa6\x1a\x5c\x26\x0f\x54\xce\xbb\x16\x8e\xb9\x61\xf1\xb3\x57\xdb\xfb\x99\x75\x9a\xac\xbe\xd9\x1c\x82\xed\x7b\xbe\x3a\x05\x5e\x10\x1a\x4b\xfd\x62\xbf\x58\x0b\xd4\xa5\x21\xf6\x55\x8d\x7b\x1a\xa5\x7f\x6e\xce\x57\x1e\x8e\x5f\x23\xd6\xb3\x08\x30\x57\x0d\x1e\x7d\x18\x25\xd8\x58\xb6\xf4\x17\x8f\xda\x21\xa2\x63\xf4\x32\xbf\xf3\xd6\x0c\x14\xcd\xc8\xea\x24\xd4\x46\xe1\x99\x0d\x6c\xf4\x8a\x88\x87\x6e\x45\x95\x0e\xa3\x1c\xa3\x70\x6b\x9c\x32\x6e\xad\xff\x95\xa9\xd9\xc7\xd7\x95\x47\x7f\xf7\x30\x72\x60\x48\x78\xfe\xf7\x9a\xd0\xff\x99\x3c\xa9\x86\xeb\xaf\xc5\x93\xf1\xf8\x87\x87\x19\xfe\xb8\xf2\xc0\xb7\xb2\x7d\xd7\x62\x9f\x8d\x8a\x25\x65\xa9\x3a\x9c\x5d\xaf\xa6\x5f\xa1\xad\x5b\x47\x8c\x01\xb5\xbb\xce\xd3\xdb\x40\x94\x46\x51\xdd\x02\xeb\x07\xac\xf5\xb8\xba\xe5\x3d\x9d\x20\xa7\x93\x3b\xb1\x55\xe4\xa4\x76\x65\x51\x90\xe7\xc8\xe5\xa0\x03\x49\x90\x62\x2a\x08\xe8\x5b\x64\x2f\xfd\xce\x64\x54\x3e\xeb\x8f\xe1\xee\x98\xea\xbc\x6d\xb3\x0f\xd2\x3d\x38\xe5\x06\x48\x8a\xa8\xa1\xfe\x94\xc7\xe2\xd6\xea\x63\x21\x23\x8c\xc2\xdc\x8c\x22\xae\xfd\xc2\x3d\x18\x3b\x6f\x71\x15\x3b\x4e\x4c\x64\x8a\x23\x3d\x20\x38\xee\x44\x0c\x60\x56\xd4\xd8\x51\xec\xf4\xa9\x23\x4d\x09\x62\x77\xc9\xb1\xe4\xa5\x15\xab\xa5\xd5\xb2\xbc\x64\x29\x3c\xb5\xe7\x83\xc1\x63\x0d\x82\xdc\x3a\xec\xb1\x5b\x23\xed\x77\x98\xc4\x98\x7f\x06\xc2\x29\x08\xc0\xbc\xac\x56\xb3\x8c\x84\x36\xa6\xc0\x0b\x44\xce\x2d\x9f\xc5\x3a\xaf\xb9\xa4\xa5\x26\x46\x6e\x0e\x5b\x1a\x22\x65\x53\x88\x44\xa9\x63\xb3\x16\x5f\x87\x32\xac\xef\x4e\x40\x07\xeb\x76\x67\x11\x95\x49\x4f\xd6\x98\xea\xd2\x2a\x60\xf6\x2d\x9c\xb9\xc1\x3d\x4e\x06\x30\x73\x38\xc6\xa7\x1f\x2b\xc7\xaf\x56\xd9\xc5\x20\xfa\xb9\x62\x97\x61\x28\xa3\x43\xa3\x4d\xf6\xe8\xb3\x73\xb3\xac\xa7\x67\xf4\x4c\x6b\x6c\x72\x84\x35\xde\x6e\x6e\x21\xbf\x02\x72\x5d\xb0\xa4\xcf\xe6\x37\x6c\xf3\x20\x2c\x32\x63\x1b\xf6\x0d\x2f\xab\x52\xac\x3b\x3d\xc8\x79\xc9\xfc\x68\x85\x95\xfb\x9e\x07\x85\x64\xef\x23\xce\x0e\x9c\xe8\xc6\xe5\x58\x6a\x79\x68\x62\xf8\x3c\xb5\x22\xd7\xbd\x94\x49\xbc\xc3\xde\x8e\x68\x1e\x4d\x73\xba\xc9\x1e\x4b\x57\x16\xb3\x90\xee\x72\xd6\x9b\x9c\xca\x60\x81\xfc\x6e\xb5\xfd\xb2\x62\x78\x2d\x2d\x90\x08\x5b\xab\x01\x45\x65\xe2\x6f\x9e\xb7\xe7\xeb\x3e\x64\x76\x3e\x7f\xf6\xe6\x94\xbf\x29\xf5\x35\xc9\xc8\x0e\x31\x9a\xc7\xba\x4d\xf0\x70\x3a\xa3\xe3\x20\x30\x1e\x36\x81\xd4\x14\x71\x67\x80\x73\x3d\x7a\xe6\xda\xb2\xc3\xde\xdb\x7b\xdd\x6c\xd8\x7f\x26\xcf\xcd\x59\x23\x0c\x7a\x1e\x3d\x9b\x74\x03\x74\x26\x1b\x7c\x67\x44\x75\x46\xbc\x38\x33\x15\x5a\xd3\x93\xb9\xd3\x4b\x52\xc6\x11\x35\x5a\xf3\x4d\xab\x08\xcf\x5e\x34\x1b\xb7\xa6\xe9\xc8\xa4\x9c\xaa\x96\x9b\x28\xba\xea\x6d\xc9\x54\xef\x50\x7c\xc2\xa6\x06\x8d\x9d\x19\x4f\xd0\xd8\x4e\xca\x42\xb3\x6f\x8f\xdd\xd7\x4a\x93\xcc\x29\x08\xe1\x0f\x8b\x3d\xce\x07\x5f\x00\xc2\x98\x9d\x30\x64\x6b\x2e\x0e\xeb\xf9\xec\xf3\x54\x78\xd4\x15\x03\xf0\x42\x2b\x45\x99\x38\x78\x25\x23\x18\xc6\xd6\x36\x6b\xc0\x34\x6a\xfe\x6e\xa2\xee\x6d\x22\x2f\x34\xdf\xa4\xd5\xa2\x09\xf0\x53\x70\x2f\xa4\x2f\xc0\x89\x1c\x3d\x9c\x2c\x59\xb2\x50\x43\x6d\x8a\x51\x61\xaf\xca\xc4\x6e\x88\x29\x08\x0e\x08\xa4\xed\x98\xde\x2b\xac\x06\xdc\xab\x5c\x4a\xfb\x4f\xed\x93\xd6\xed\x5f\xac\xcf\x31\x06\xff\xe4\x49\xfe\x5d\x73\x80\xc7\xb6\x0c\xf4\x3a\x68\xd0\x90\xb6\x37\x0a\x3d\xb4\xcb\xbc\xbc\x22\x27\x82\x18\x4c\xee\xd5\xfd\x3a\xa0\x0e\x1f\x23\x52\x56\x22\xd8\xb2\xed\x16\x88\xe6\x07\xe5\xae\xd1\xe8\x8e\x34\x77\xfc\x49\xb3\x01\xa3\x8e\x12\xee\x2a\xf6\xab\x94\x91\xf3\x7c\xdf\x45\x15\x6f\x62\xb5\xec\x61\x60\x83\x5e\x92\x24\x86\x7c\x53\x45\x61\xc8\x93\xe7\x68\xf3\x1d\xdc\x97\xfc\x00\x16\xaf\x6c\x5d\xa2\xab\x02\x05\x75\x46\x10\x81\x05\x6f\xc0\x09\x35\xe9\x92\x9e\x45\xc3\x9b\x5b\xb0\x34\x47\x80\xc6\x20\x4d\x75\x52\xad\xc1\xd3\x71\x3e\x5e\xf8\x0a\x96\xa4\x98\x0f\x23\xca\x73\x9d\x57\x11\xed\x4e\x9e\x14\xce\x76\xce\x2d\x36\x7a\x2d\xad\x0b\x1c\x35\x1d\x3b\xdc\x42\xbf\x63\x26\x5e\xdf\xeb\xa6\xbe\xa2\xf1\x27\x50\xc3\x28\x0b\x35\xc5\x43\x24\xd7\x21\x61\x67\x09\xc6\x50\x90\x2b\xd8\xc2\x66\xd8\x67\x4d\x4e\xe0\xac\xd7\x29\x9c\x43\x6a\xbf\x0d\xac\x6c\x16\xd8\x98\x8f\x5a\x9e\xf1\x4c\xb4\x43\xf1\x48\x5d\x12\xb8\x6c\x2c\xa0\x52\xe8\xc4\x5c\xe0\x57\x57\x34\xc3\xb7\x03\xbd\x16\x7e\x3d\x52\xa4\xc4\xdd\xbe\xdd\x73\x1e\xa9\x44\xb3\x65\xad\x00\x33\xeb\x58\xcf\xc6\xa3\xe0\x51\xdd\xf6\x4e\x3f\xa0\xe9\xe9\x83\x68\x4a\xe6\x21\x8b\x26\x73\x6f\x9a\x1d\x3d\xda\xbf\xbd\xba\x18\x12\x90\x0d\x54\x25\xd5\x26\xf9\x27\x72\xa4\xd7\xf6\x49\x6b\xb9\x0e\x29\x3e\x6d\x46\xe6\x8d\x44\x61\x63\xe2\x3d\xf5\x1d\x4a\xb7\x44\x00\xb5\xa0\xef\xf7\x9a\xcf\xbd\xec\x64\x9d\x6e\xc2\x12\xc7\x4e\xc9\x2f\xb8\xe8\x6d\xe9\xf8\x71\x52\x93\x9c\x04\xf2\x44\x51\x62\x71\xd7\x76\xbd\x4d\x48\xba\xbe\xf9\x35\x8d\x71\x3d\xc3\x1d\xdb\x41\x10\x45\x68\x44\x9e\xfd\x58\xe7\xad\x68\x21\x32\x4e\xea\x02\x9c\xc0\x70\x92\x50\xd6\x4f\x4e\x31\xce\x58\xa7\x53\xee\xcd\x7d\x41\x6c\x58\xb3\x3e\x4e\xfa\x10\x02\x8e\x26\x11\x11\xa3\x49\x6f\x13\xb8\x1b\x10\x30\x5b\xa7\x1f\x5a\x55\x94\xe7\x83\x9f\x29\x44\x1c\x4b\x11\xb3\x11\xd8\xd8\xb7\x9b\x8f\xa4\x3c\x24\x94\x13\xbf\xd4\x75\x4b\x8e\x46\x1a\x34\x19\x50\x07\x52\x4c\x3e\x09\xbd\x45\xfe\x04\x74\x02\x47\x8a\xc2\x32\xa3\x58\x93\x9b\xc1\x47\xa8\x16\xaa\x03\xa1\x7b\xfc\xfa\x00\x35\x0e\x6f\x61\x17\x87\x55\x7e\x22\xf9\xe0\x73\xee\xf8\xd1\x8e\xce\x1f\x6a\x11\xd4\x49\x06\x54\x37\x0d\x89\x30\x0b\x37\x91\x0e\x25\x5e\x62\x38\xb9\x5b\x41\xd9\x6f\x19\xa3\x96\x38\xe3\xa9\x93\x01\xad\x4a\xa7\x11\xab\x1c\x7b\x57\x49\x46\x7e\xd4\x7c\x53\x89\x16\x3c\xe5\xda\x02\xbc\x1b\x10\x06\x87\x5f\x25\x03\xab\x1e\xf0\x7a\x7d\xf4\x94\x18\x1e\xf7\x4d\xb4\xe6\xc2\xb5\x07\x15\x21\xd5\x4e\xfd\xc9\xa3\x52\xe8\x71\xef\x54\x28\x28\x80\x24\xb3\xff\xb5\x13\xa1\x90\x34\xdd\x8d\x75\x6f\x55\x9d\x0f\xb4\xfb\x9f\xca\x86\xe6\xaf\x45\x86\xcc\xf9\xc7\x8a\x66\xff\xd3\x6e\x88\xb6\xa7\x0a\xba\x67\x15\x3a\x8e\x60\x5d\xa0\xe6\x5e\x03\x76\x00\x7c\x86\x77\x3c\x0a\x15\x88\x87\x20\xd9\x0a\xf5\xbf\x1f\x41\x71\xde\x0f\x15\xc8\x30\x8b\xa5\xc3\xe9\x99\xd8\xd1\x8c\x95\x3b\x8a\xd4\x4a\x34\x33\x3b\xb5\xfd\x67\x88\x43\x94\x87\x9c\x92\x88\x59\xf9\x02\x0f\x61\xfb\xbc\x5d\xcb\xab\x07\x11\x96\x86\x85\x6a\x23\x69\x98\x84\xe2\x0a\x00\xc3\x2b\x0c\x5f\x7b\xb8\x64\xe1\x5d\x4c\x0e\x3a\x2b\x24\xa4\x19\xed\x07\xe7\x9f\xe8\x77\xbc\xd1\x5c\x1d\x9a\x1f\x32\x8f\x86\x5b\x47\x46\xa3\xe5\x0f\xaa\x46\x4d\x6c\x9c\x9b\xc2\xc7\x53\xdd\xcf\x54\x5d\x8f\x2d\xba\x62\x3b\x5a\xad\xd8\x08\xb5\x09\xea\x8f\xbf\xee\x10\xef\x37\x96\x82\x79\xbc\x47\xd5\x82\xca\x94\x03\x23\x94\x00\xbb\x04\x15\x56\x2b\xe7\xff\x63\xef\xcd\x7b\x17\x47\x96\x05\xd1\xaf\x82\x46\xba\xaa\x37\x62\xc0\xfb\xf6\x9e\xfa\x0f\xef\x18\x2f\x78\xc5\x36\xff\x5c\x79\xc3\x36\xde\x00\xef\xfe\xf4\x4f\x36\xbf\xaa\xea\x73\xba\x7b\xce\xb9\xba\x3d\x52\x8f\xd4\x25\x7e\x59\x26\x33\x32\x32\x32\xb6\x8c\x08\x8c\x31\xcc\xeb\xde\xa4\xd5\x62\x99\xd2\xd2\x97\x20\x33\xe0\x66\x38\x76\xa1\xf7\xd3\x35\x1f\x46\x83\x5d\xc5\x3c\xf2\xa5\xe6\xed\x5e\x9b\x46\x18\x8c\x2b\xcb\xd2\x0f\x91\xbc\x54\x23\xa4\xa6\x22\x49\xab\x60\x6f\x31\x0e\x67\xd5\xec\xbd\x00\x24\x91\x3d\x8d\x77\x84\x63\x39\x80\x70\xb2\x8b\xc2\xbc\x85\x82\xeb\x93\x27\x90\x71\x8e\x65\xb1\x03\x94\xfb\x0d\xfa\x96\xee\x2f\x71\xac\x1c\x4b\x3b\x3f\xee\x7e\xc8\x44\x86\x9c\xdd\x46\x30\x60\x54\x1a\x65\x50\x52\x19\x99\x8c\xe9\x80\xe8\xa2\x91\xe0\xa9\x52\x29\xcd\xc0\xef\x5e\x0a\xb8\x65\xd1\xa3\xf6\x58\x69\x2e\xb7\x04\xc6\x6b\x39\x89\x7b\x50\xb7\xe4\x26\xc6\xa2\x13\x76\xcd\x60\x33\xa7\x33\x5f\x8e\x48\xae\xbc\x42\x8e\x6e\xcd\xbd\xa0\x8a\x73\x7a\x2d\xd5\x14\x02\x74\x9c\x61\x71\xee\xe5\x19\x32\xf9\xd0\x6e\x9c\x47\xfb\x34\x74\x87\xa1\x2b\x82\x8e\xaa\xe7\x0b\x41\x3e\x5f\x47\xf0\x7d\x9e\x17\x25\x6d\x91\xab\x5a\x4c\x97\x87\xd8\xe5\x13\x70\x05\x3d\x97\xcd\x6e\x60\x24\xc1\x39\x10\x2f\x83\x17\xdf\xed\x18\x34\xee\x14\xc4\xa8\xfa\x3b\x9b\xb5\x25\x95\xef\x97\x6a\x0a\xdc\xf9\xf9\x0a\x38\xef\xb4\x3d\x82\xb0\x63\x15\xd2\xbb\xdc\x05\x94\xf8\x73\x1f\x30\x96\xfd\xd5\xbe\x52\x94\x64\xbf\xfe\x8a\xde\x00\x82\xb4\x73\x92\x01\x4f\xbe\x43\xf3\x2d\xe6\xad\xab\xc9\x78\x0f\xd3\x32\x85\x97\x6a\x5c\x19\x1a\x93\x22\x4c\x42\xb8\x7a\xe4\x81\xf4\x59\x2b\xf5\x2c\x22\x4a\xea\xa5\xe5\x84\x3a\x1c\xa7\xdc\x2c\xd0\x74\x0b\x4e\xc9\xcd\xc9\xea\xdb\x82\xea\x71\xd1\xb3\x92\x2a\xac\x94\xd4\xbd\x85\x1e\xb1\x87\xc3\xfa\x56\x52\x70\x95\x82\x69\xd3\x20\x21\x72\xbf\x5f\x80\x3a\x8a\x87\xf9\xfe\x38\x25\x77\xfc\x05\x73\xe5\xd0\x92\x06\x5c\x74\xcb\x1b\xc2\xf7\x96\x82\x83\x8f\x2c\xbb\xe1\x54\x78\x21\x3d\x92\x2d\x15\x96\x18\x29\x2f\x96\xcf\x57\x91\xbc\xf7\x2a\xf8\x86\xdc\xf7\x59\x44\x53\xef\x91\xbe\x1c\x88\x03\x55\x31\x82\x0b\xc6\x9e\xbb\x72\x4f\x04\x94\x57\xb9\x51\x09\x49\x0c\x90\xdd\x64\x3e\x8b\xa2\x17\x16\x9c\xcf\x40\x82\x84\x76\x4f\xb8\x29\xc4\x5e\x1b\xe2\xad\x29\xa5\x99\x39\x19\xda\xa8\xb5\xd7\x70\xcd\x9c\x35\x27\x71\x76\x2b\x27\xd9\x1b\x4e\xc8\x2b\x52\x40\xb8\x24\xfb\xa0\x85\x52\x5d\xe2\x25\x89\x0a\x76\x14\x34\x10\xa7\x6d\xba\x26\x8d\x8a\x53\x55\x55\x2c\xd5\x7e\x74\xfb\xe8\xd9\xb0\x27\x2d\x41\x1f\x4c\x41\xab\x49\xfd\xf0\x07\x53\xb7\xe7\x1c\x43\xce\x43\x3f\x7a\x24\xfd\x2c\xcb\x84\x4e\x98\xc8\x14\xde\x27\xfc\xed\x49\x93\x92\x57\x94\x04\x3c\x73\xc4\xa0\x21\x33\x93\x03\x7f\x8a\x1f\x85\x46\x4a\x63\x50\xb2\xaa\x34\xba\x2d\x55\x3e\xd4\x22\x03\xc7\xa5\xd8\x53\x2a\x31\x42\x04\xc5\x22\xca\x4d\xf3\xa6\x21\x81\xb3\x7d\x84\x9c\x7a\x0a\x3a\x25\x72\xc5\x01\xf8\x72\xba\x28\xf5\x48\x22\x79\xc9\x40\xc0\x15\x64\x93\x97\x83\x18\x24\xa4\xb0\xc0\x05\x85\x42\x98\xe9\x26\x58\x83\xcf\x57\x1d\x7e\xe7\x26\x99\xd8\xce\x54\xe5\xa0\xbd\x30\xe3\x5b\xbe\xa9\xed\x4b\x6d\x16\x52\x6d\xb9\x0c\xe0\xdc\xde\xcd\x1c\x46\x76\x4c\x45\x9e\x0c\x29\xb9\xa8\x6e\xf3\xec\x7c\xbd\xb6\xa0\x68\x2f\x69\x37\xa6\x18\x25\xab\xa9\x2e\x56\x5b\x31\x37\x8b\x60\xf6\x88\x7b\x59\x6e\xc3\x5b\x16\x97\x42\xa5\x73\xf1\xa5\xcd\x44\xba\x57\x2a\xb3\x30\xef\x49\xc1\xd4\xb4\x36\xc3\x10\x8e\xe8\x37\xef\x6d\x85\x6f\x03\x1f\xa2\x0c\xa2\xbc\x85\xbc\xeb\xdc\x69\x56\x10\xca\x58\x8a\x13\x23\x27\xb1\xf7\xb8\xf7\xfb\x19\xa1\x69\xd1\x41\x08\x49\x63\xce\x5d\xf3\xa7\x3e\xa0\x2b\xeb\xaa\xf2\xaf\x65\x74\xd5\xaf\x9e\x5b\x5b\x7c\x85\xaf\x95\x8f\x83\x58\xb0\xcf\xb2\x9b\xcd\x68\xb2\xc9\xd1\x4f\xed\xed\x4a\x26\x8d\xd6\x3c\xe3\xdc\xe2\x8a\x4d\x15\x2d\x47\x55\x19\xbb\xef\x05\x2d\xee\x91\x50\x18\xa0\x33\xc4\x7b\x17\x8e\x35\xa1\xf3\xd9\x52\xed\xf1\xf9\x72\x74\x5c\xcb\xd9\x67\x02\x81\xb7\xc7\x82\x64\x0f\x4c\xad\x90\xdc\x9a\xc3\x12\x21\x1e\xf5\x7d\x1f\x61\xc8\xa3\xe2\x42\xed\x99\x53\x49\xb8\xc7\x85\x2e\xca\xdb\x24\xa0\x40\x2a\x74\xb5\x45\x0b\xf1\x78\x5c\x2c\x4f\x2e\x32\xa9\x03\xde\x10\xba\xc7\x20\xec\xc5\xbc\x2a\x8e\x26\x4f\xcf\x97\x1c\xa4\x19\x86\x78\x41\xf3\x0c\xcb\xa7\x14\x26\xfb\xa2\xe6\x9e\x02\x9d\xe9\x41\x08\x73\x23\x68\xcb\x83\x29\x13\x65\xa5\x5d\xb8\x25\xd1\x99\xf4\x56\xca\x54\xe3\xb8\xd6\xb3\xba\x6a\xf1\x53\x72\x48\x7b\xff\x08\xd4\x76\x56\x03\x0e\x55\xb9\xe8\x76\x0d\xf6\x5a\x76\x56\x35\x10\x1c\xc1\x1a\xbf\xc2\xb5\x86\xcc\x46\x1a\x9f\xdc\x5b\x90\xee\x95\xc5\xd7\xed\xa6\x92\xe8\xce\x06\xed\xd3\xb5\xd3\xc5\xa0\xae\x42\x80\x09\x1f\xa3\x22\x14\x43\x0d\x4b\x5d\xe7\x8b\xfe\xb3\x94\x67\x6e\x69\x4f\x19\x91\xc6\xd5\x4c\xbb\xf7\x0c\x5b\xae\x2f\x50\xec\xb3\x14\x35\xe9\x93\xe0\x64\xd5\x7d\x78\x21\x0f\x08\x41\x03\xaf\x21\xc3\x78\x2f\xd9\xb5\x2a\x5c\x2e\xcd\xe0\x60\x8f\x50\x34\x9b\xdb\xa8\x4d\x42\x0d\x45\x53\x4f\x4b\x11\xe7\x02\xed\x8d\x7b\xd0\x40\x84\xf6\x7b\x46\x9b\x2e\xe7\x79\x7a\x05\x49\xfd\x94\x90\x85\x24\x53\xf5\xae\xd7\xd4\x69\xa0\xc8\x93\x71\xb3\x70\x33\x58\x5c\xbc\x78\xf0\xd3\x20\xe4\xa4\x2d\xc6\x9c\x35\x03\x2e\xc8\x57\x77\x54\xb1\x70\x93\x6c\x07\x08\x62\xaa\xfb\x62\x8d\x85\x1e\x55\x04\x7a\x6e\x28\x3e\x07\xc5\x5b\x5a\x15\x23\x69\xb0\x97\xa0\x21\x2f\x5e\x14\x2c\x00\x36\x58\xb7\x5a\x8f\xeb\x27\x42\x3f\x5f\x0c\xa3\x14\xee\x99\x07\xd9\x9c\xa0\xad\x25\x94\x32\x56\x51\x0c\x88\x07\xf3\x4b\xc1\x70\x19\xa5\x3d\x9d\x34\x77\x92\x47\x43\x36\x5a\x7f\x1e\xe7\x1a\xf4\x75\x9a\xe9\x6a\x0c\x72\x7c\x41\xc8\x07\x11\x99\x19\x91\xd5\x6e\x45\xcf\x75\x11\xa0\xba\xfb\x9b\x21\x21\x97\xb7\xff\x64\x02\xda\x64\xe5\xfe\x5e\x20\x0c\xe6\x49\xb9\x3b\x67\xb9\x82\x5d\xb8\x27\xb9\x17\xee\xc2\xe9\xb4\x97\x73\xd9\x7a\xb9\xb2\xa3\x90\x77\x16\xd0\x96\x5a\x2c\x75\xa6\x5a\xf6\x30\x1a\xdf\x1e\x72\xcd\x81\xec\x99\x8b\xd4\x66\x76\x41\xeb\xe5\xf5\x6a\x77\x62\x84\x91\x78\x67\x12\xcd\x41\xfc\x5b\x73\xb4\xe0\x3e\xc9\x8c\x96\x0c\xc1\x73\xec\x71\x4c\x7b\xf2\x06\x2a\xbc\x3c\x89\xde\x9b\x6a\x8c\x5f\x47\x46\x47\x22\xdd\xe2\xcd\x10\x4f\x15\x49\x90\x5e\x38\xb4\x77\x5e\xa2\x1b\x08\x71\xf4\xbe\x76\x40\x59\xd1\xbd\xfb\xa0\x73\x38\xc8\x55\x61\xac\x2e\x7e\x2f\x9d\x01\x0d\x57\x61\x72\x18\x25\x95\x56\x1b\x8d\x9b\x53\xf4\x1d\x39\xd4\x9f\xe9\x14\xf2\xe8\x2f\xf6\x31\x5b\x66\xfe\xca\x27\x08\x3f\xbe\xdb\x1b\xbf\x03\x22\x54\xb4\x67\x0e\xd3\x93\x21\xc8\x41\x59\x9c\xdf\x4d\x26\xb1\xb9\x5f\x78\x7a\x90\x7a\xef\x89\x20\xb9\x3b\x2a\xa3\xa4\xc8\x31\x4b\x65\x24\xba\xa2\x66\xce\x85\xd3\x26\x03\x8b\x72\xbe\x38\x5f\x5f\x5c\x77\x16\x2b\xd5\x7d\xb3\xb4\x6d\x86\x31\x9c\x44\x8d\x85\xf5\x48\xd0\x0c\x55\xb2\xb4\x43\x4f\x6d\xb7\x24\xeb\xb9\x5c\x66\x12\xea\x29\x44\x04\xbf\x31\x5f\x21\x21\x45\xe2\x15\x3e\xcd\x14\x1a\xe9\x81\x3e\x9e\xaa\x53\xad\x57\x77\xd1\x05\xdc\x7e\xd8\x2b\xcf\x71\x8c\xeb\xc4\xf4\x99\x89\xd0\x13\x57\x06\xc7\x8b\xef\x67\x91\x37\xcd\x51\x21\x5e\xa1\x7b\x1c\xd6\x61\xaf\xb6\x5e\x54\x94\x2f\xdd\x3c\x1b\x93\xa0\xca\x0b\x6a\x4e\x1c\xdb\x5d\x2f\x68\x8b\x4c\xa5\xf9\x70\x1b\x06\x14\xfc\x47\x69\xe8\x3c\xcf\xe4\x09\x9b\x8d\x32\x6b\x8a\xea\x84\xf5\x4a\x17\xc8\x2d\xa4\x63\x49\x4e\x4a\x72\x29\x06\x80\x5f\x78\xaa\xe7\x77\xa5\xcd\x73\x65\x5b\x8f\x40\xa5\x7a\x19\x73\x8e\x5e\x93\x25\xe3\x43\x19\x3d\x03\x54\xe7\x9f\x6c\xd3\x38\xb3\xf4\x6a\x31\x15\xcf\x44\xa8\x81\x59\x67\x7c\xd1\x20\x05\x45\x42\xe4\x2b\x77\xa0\x7d\x2c\x91\x47\x60\x7c\x6e\x48\xcd\x43\xa2\x08\x82\x62\x6d\xdf\x44\x1f\xa3\xcd\xdc\xf7\x6f\x42\xa4\xc7\x0c\xa4\x27\x36\x57\xf0\xb3\x6a\x03\x20\x3a\x18\x04\xd5\x93\x81\x04\x5f\x00\xe2\xbc\xa4\x38\xd5\xe0\x62\x43\x26\xe3\x1c\x39\x41\xd6\x31\x4f\x9a\xcb\x4e\xb2\x52\xcb\x40\x72\x0d\x0b\x35\xee\xcc\x8b\x2b\x0d\x24\xa8\x19\x70\xab\x67\x75\x44\x53\xa7\x6e\xac\xf7\x54\x76\x13\xc1\xb7\x8f\x73\x8b\x30\x9e\xcb\x3d\x11\x67\x2d\x78\x6b\x3b\x67\x26\xdf\x71\x3f\xea\x16\x30\xc6\x40\x92\x55\x39\x52\x8c\x2f\x74\xb1\x4f\xb9\xeb\xa4\xde\x73\xcf\xf7\x0a\x29\x0c\xda\x20\x94\x98\x59\xa3\x13\x6d\x91\x78\x3e\x4b\x70\xe1\x23\x35\xa8\x0e\x28\xc9\xcc\xef\x8b\x51\x05\x75\x14\xe7\xa4\x04\xca\xd1\x03\x71\xb0\xbc\xa7\x61\x6a\xa2\x93\x52\x66\x9d\xd3\x9b\x97\x82\x79\x29\xbb\x20\x15\x61\x1e\x95\xc6\x42\x08\x8c\xd4\x62\xf1\xd2\x97\x40\x2a\xd7\xc0\x98\x94\x3a\xac\xd7\xae\x58\x2d\x62\x6d\x7a\x8e\x65\x9f\x05\x45\xd2\x6d\xec\xcb\x6d\x60\x24\x4b\xd0\x78\x66\xaa\xdb\x7e\xe1\x10\xd1\x43\x87\x3b\xd7\xb6\xcb\xf2\xa2\x7b\xf0\x51\x9c\x0d\x2e\x89\x59\x31\x64\x70\xda\x4d\x31\xf4\xe9\x87\x7a\xb3\xd7\xc4\xab\x1e\xdb\x29\xcd\xd3\x2f\xe3\x45\x80\xfa\xdc\x49\xca\x9f\xfb\x5d\xfc\xbc\x6d\xfe\x5a\xf6\xfa\xf8\xf9\xb1\x78\xd1\x76\x6f\x07\xe4\x9d\xe9\x0d\x14\xd8\x2b\x3c\x2f\xb1\xee\x3f\xc5\xe2\xd9\x3a\xe7\x86\x86\x5f\x50\xa3\x3c\xec\x38\x17\x73\x89\xc5\xa2\xde\x3b\x95\xd3\x40\x31\xfc\xe5\x6d\x75\xf6\xc0\xd4\xc6\x60\xbe\x2d\x5a\x6a\x93\x07\xfb\x2e\x5f\xcf\xd6\x31\x5f\x70\xc6\x9e\x35\x63\x6f\x6b\x4a\x85\xf5\x11\x30\x21\x6a\xb5\x9c\x33\x04\xad\x38\xad\x1e\x86\x5a\x5b\x2e\x3c\x2a\x8a\x97\x71\x22\xf7\x1d\xe5\x19\xc5\xbd\x06\xaf\x3c\xe8\x63\x00\x71\xdb\x17\xa7\x6a\x1f\x0f\x97\xac\x8b\x11\x9d\x8a\x80\x69\xd4\x6e\x19\x51\xbb\x81\xea\x77\x9a\x53\x79\x80\x10\x75\xc0\x50\x6a\x04\x40\x9e\xb1\xc0\x34\xd3\x79\xa9\xc8\x69\x79\x9a\xdc\x43\xe4\xe1\xe0\x7a\x42\xaf\xf6\x2b\xae\x1b\xc7\x4f\x01\xaf\xe0\xb3\x6a\xde\xb3\x13\xc2\x65\x34\xd6\xa9\x4b\x2c\x34\xe5\x90\x62\xea\x84\x08\x13\x65\x55\x27\xf0\x2c\xa5\x04\x75\x6b\x90\x07\x2a\xd2\x9c\x2a\x35\xc5\xd9\x96\xbb\x47\x78\xa7\x65\xcf\x41\x7d\x00\xd6\x06\xeb\x3e\x9f\x08\xcc\x16\x2e\x53\x40\xb1\xd5\x1b\xf4\xea\x52\x5f\x95\x3a\xb9\x18\xf8\xa8\xa9\x5c\xdb\x08\x1e\x4e\xf3\xe5\xcb\x28\xb5\x2b\x24\xb7\x97\x5e\x1b\x2d\x80\x16\x85\x07\xef\x48\x5c\x56\xd5\xd8\x1e\xe0\x54\x15\xc2\x01\x2a\x4f\x99\x66\xa4\x17\x41\x96\xda\x51\x3f\xbf\x71\xf5\x51\x4e\x82\x8e\xb4\x57\x10\x4d\x03\x9d\x30\x17\x55\x4f\xf0\x69\x70\x55\x28\xd5\x1c\x46\x85\x1d\xd9\x2f\x99\xa0\x47\x8c\x34\x13\x9f\x59\x0a\x53\x67\x24\xe5\x4a\x45\xc9\x4b\x57\x8b\x39\x24\x2d\x06\x49\xe2\xcc\x8c\xa3\x66\x31\x81\x2b\xf6\x0a\x5e\xd1\x93\xe0\xf5\xec\x64\x63\x5c\x39\x90\xdc\x82\xb4\x52\x41\xb7\x20\x22\x04\x25\x37\x31\xaa\x61\x75\x14\x63\x32\x6a\xa6\xd5\x51\x7f\x21\xe2\xd9\x81\xc9\x88\xea\xea\x3b\xc9\x02\x06\x78\xeb\x4c\x54\x94\xaa\xc4\xb0\x1e\x24\xad\x2b\xe6\x89\x20\x60\xc6\x8d\xd2\x5c\xb8\x70\xf7\x3b\x3b\x00\x1d\x79\xa2\xba\x0b\x38\x20\xe6\xe3\x4a\x5c\x5f\x45\x92\x78\x4a\xb9\x70\xf7\xc1\x7f\x3c\x48\xb2\xf7\xb2\x12\x3d\x8f\x52\xfb\xf6\xa7\x4a\xf1\x01\x15\x4a\xfd\xa8\x94\x06\x91\xa7\x1e\xb7\xbb\xa6\xbf\x2d\x96\x43\x00\x23\x55\xe6\xc4\xb5\x69\xee\x8a\x8f\x2e\x45\xbf\x67\x29\x62\x0b\x06\xec\x21\xfa\x05\x34\xac\xde\x57\x65\x7f\x6f\x04\x02\x1e\xa4\x2c\x09\x14\xee\x8d\x8e\xac\x22\x5d\x5e\x6f\xbf\x76\x14\x1a\x42\x75\xab\x79\x3d\xad\x33\x4f\x3b\x79\x79\x7b\x73\x04\x61\x0d\x88\x22\xef\x23\x09\xdc\x33\x09\x19\xd1\x61\x58\xd1\x97\x77\x13\x82\x41\xda\x71\x28\x05\x29\xfe\xbd\x68\x5e\x43\x3d\xdf\x94\xd6\x35\x0b\x1a\x48\x8b\x91\x66\x14\xd4\x42\x14\x82\xf4\xfc\xf9\xcf\x3c\x8f\x1f\xc1\x10\xfc\xc5\x0c\xfc\xfb\xc3\x6b\x9e\xa4\x03\x7e\x32\xe3\x5a\xcb\x2f\xfc\x9c\x3c\x8d\x39\x33\x9a\xd0\x64\xac\xf2\x2a\x35\x26\xcb\xf0\xba\x4d\x3f\x8c\xd6\xe2\xeb\x84\xdf\xd3\x35\x29\xa8\xab\xa1\x2b\x5a\x63\xc4\xbd\x44\xf3\xc8\xab\x79\xca\xda\x99\x96\xa4\xe7\x93\x86\x1f\xef\x8b\x49\x21\x41\x80\x03\x1a\x39\xdf\x7a\x67\x9a\xa7\xe2\xed\xbd\x91\xfb\xbd\x5a\xa2\x25\xb4\x0d\x93\x49\x4f\x2c\x89\xd4\x0f\x24\x0a\x60\x32\xe6\x10\xbc\x9e\xf0\xfb\x50\x2f\x6f\x4d\x7b\xc7\x4f\xb4\xc9\xc3\xd9\xa9\x92\x99\xba\xeb\x4c\xb2\xf0\xa9\x7b\x15\x7a\x5f\x89\xe7\xa1\xd4\xc5\x40\xf5\xd1\x7b\x09\x05\xa6\xb5\xa7\x9e\x2f\x1d\xe3\x4b\xba\xa8\xa2\xba\xa8\xcd\xb1\xbf\xec\xb5\xb9\xc3\x7a\x80\xb7\xfd\xfb\xfc\xa0\xdc\xf7\xf3\x56\x57\xcf\xa9\x86\x81\x4b\x01\x59\x63\x0d\x88\x43\x57\x9f\xb0\x02\xb5\x95\x3e\x41\x0b\xed\x3e\x35\x42\xf3\x7c\x9b\xc6\x00\xd9\xcb\x25\x24\x23\x5a\xea\x91\xfd\x8d\x23\x1a\x0e\x38\x55\x84\xd7\x4f\xcb\xcb\x74\x34\xae\x8b\x8b\x5e\xc9\xed\xca\xc5\x3a\x61\x8a\x12\xc6\x2b\x6b\x2f\x35\x6c\xfa\x62\x5a\x6a\x07\x37\x62\xb2\x38\xe7\xf3\xf9\x2d\x53\xb1\xe0\xc6\xfc\x89\x90\x0a\x34\x66\x60\x4a\xc9\x79\x2b\x71\xf8\xb3\x17\x13\x40\x4c\xdb\x88\xb2\x87\xf2\xa1\x64\x4f\x6f\x52\xe3\x97\xbe\xf3\x6c\x66\x08\xcf\xba\x6e\xc5\x17\x80\x3e\x61\xe3\xdc\xb2\xd9\xa8\x02\xd0\x69\x61\xc5\xb2\xa2\x97\xcb\x5b\x9e\x7a\x38\x61\xfb\x18\xbf\xc1\xe7\x22\x81\xd5\xd1\xce\x9e\x8e\xa7\xe0\x5a\x0d\xdf\xe8\xab\x60\x97\xda\x48\x80\xa1\x0c\xe6\x66\x2d\xd2\x2f\xea\x35\x9e\xba\x4b\x65\x9b\xcf\x76\x82\xb4\xf6\x34\xcb\xe6\xb5\xd6\xf4\xba\x51\x1b\x99\x2a\x22\x98\x79\x84\x1c\x3a\x14\xd8\x59\xbc\x62\x5a\xc1\x34\xbc\xae\xa2\x82\x50\xb3\x5d\x72\x16\xdc\x8b\x85\xd6\xdd\x89\xbf\x3f\x33\x63\x96\x03\xc1\x76\x05\x65\x24\x40\x94\x3e\xc3\xf8\x4d\x98\x80\x1b\xcf\xe7\x88\x3f\xfb\xe0\x05\xe2\xaa\x37\xca\xcb\xb6\x73\xa2\xc9\xcc\xa9\x2d\xbc\xcd\x25\x90\xe3\x4f\x8a\xdf\xa4\xfb\x10\x26\x4d\xd6\xec\x35\xcf\x04\x72\xc3\x79\xea\x0e\x6f\xec\xb5\x6b\xd6\xe1\x4a\x13\xd7\x8f\x69\x2f\x7a\x7e\xca\x59\x82\x59\xbf\x28\x82\x8f\xe1\xa7\x67\x95\x5e\xe6\x58\x89\x98\x4d\x7e\x84\xb5\x72\xa9\x79\x2a\xc6\x89\x8a\x09\xdb\xea\x78\xbf\x5d\x75\x5a\xca\xf7\x4d\x27\x6a\xee\x0c\xdd\xf9\x9b\x23\x27\x0d\xfa\x8a\xd0\xb9\x95\xf6\x02\x79\x2d\x5a\x45\x3a\xdf\xfd\x88\xa3\x0c\x3f\x65\x00\x4e\xd3\xd5\x67\xe7\x32\x65\x5b\x33\x92\x45\xe2\x53\xc5\xa9\x36\xd3\x00\xba\x1b\x83\x0a\x83\x5f\x6f\xf7\x98\x0f\xcf\xf6\xe7\x79\xb9\x34\x76\x92\x96\x72\xa0\xe2\xee\x4f\xbd\x77\xe5\xf1\x4c\xd2\xff\xf5\xfd\xf2\x2f\x76\xeb\xfd\xcf\xdb\xdc\x5a\x87\x2c\xfb\xed\x29\x76\x2d\x1a\x57\xea\xb3\x70\x27\xb3\x79\x8a\x67\x4d\x0b\xf3\x31\x2b\xa0\x4e\xea\x42\xb1\xf3\x4e\x6f\x30\x8b\xc6\x6a\x48\x2f\xb1\x5b\xc6\x38\xd3\xa3\x51\xe3\xf5\x55\x67\xf2\x3c\x72\x93\x7b\x52\x48\x2f\x25\x0b\x15\x52\xe3\x75\x2c\xf2\xd0\xef\x71\x04\x90\xba\x23\x3d\x97\x82\x51\x89\xf1\xbe\xbf\x03\x59\xdb\xb5\x30\xea\x47\x4b\x9f\xbc\x45\x27\x02\xab\x86\x29\x7d\x9b\xf6\xe9\x7d\x4e\xb6\x61\xec\x75\xf4\xf2\x90\x6c\xb3\x7b\x21\x54\xf1\x26\x90\x16\x10\xdf\x14\x67\x3b\xbe\x7e\x49\x82\x6a\x1c\xfc\xfa\x14\xbf\x63\x13\xb4\x6a\x2e\x09\x7b\x36\x6c\xc7\xb3\xf8\x7a\x7a\x7b\xe7\x4e\xc1\xa7\x57\x45\x64\x8b\x40\x8b\x00\x6a\xa7\xda\x1b\xea\xd9\x91\x3f\x83\xdd\x3b\x62\x58\xd2\x01\xe5\x10\x02\x74\xa3\x38\x75\xd4\x79\xb2\x94\x5a\xc1\x6d\x66\xef\xc3\x0b\x2d\xa9\x97\x33\x23\x2a\x5e\xd5\xb5\x21\x4f\xfb\x4c\x3b\x91\xa7\xfa\xc5\x8a\xf2\xd5\x8a\x68\x8f\xec\x4f\x77\x8c\x7f\xbe\x2a\xd1\x1b\x20\xf2\x7a\xc1\xaf\x1c\x9f\x80\xba\xd7\xca\x34\xa3\x91\x7b\x9a\x4e\xf3\x20\xac\x7c\x41\xd3\xce\x36\x67\xc0\xa6\x23\x44\xe4\xfe\x46\x89\xe0\x45\x37\x51\x5d\xf6\xc7\x41\x0e\xde\x39\xa8\xd2\x69\xee\x03\x17\x43\x64\xe9\xe0\x91\xa9\x9a\x89\xd6\xdc\x4d\x0d\xa4\xfa\x2d\x33\x62\x49\x80\x96\xae\x5a\x51\x54\x40\x9a\x21\x22\x1e\xc0\xd2\xae\xc2\x23\x40\x4c\xbb\x57\x2c\x33\xcd\xab\x74\x9e\x2e\xae\x56\xb4\x6f\x1d\xa2\x5a\x21\x55\x5f\x2f\x3a\x09\x1d\xd1\x3f\xa9\x12\xde\x25\x2f\x90\xa6\x1a\xfb\x4a\x67\xaf\x1e\x6e\xef\xa1\xef\x3f\x5a\x85\x28\xd9\x1b\x82\x93\x48\x4a\x13\xa0\x8d\xd8\x32\x01\x30\x28\xa3\x35\x17\x10\x06\xc5\x91\xa1\xec\xe7\x78\xa7\x17\x52\x49\xd5\xce\xad\x01\xad\xb8\x92\xfc\x29\x6d\x18\x12\x8a\x88\xda\x1f\xd9\x99\xb9\x95\xfc\xb5\x38\x45\x2f\xd5\x96\x85\xba\xa8\x92\xec\x6c\x5f\xf4\x81\xbf\xb3\xb5\x9c\x83\x77\x7a\xa6\xb9\xb3\x7c\xf7\x58\x20\x23\x11\x3b\xb8\xd9\xe7\x47\x10\x60\x25\xad\x29\xcf\x8b\xf0\xa2\xbd\xae\xe4\xe9\xe6\x4d\x8f\x17\xaa\xc7\xda\xd9\xc6\x9c\x49\xb9\x3b\x60\x82\x34\x90\xa0\xf6\xd0\x84\xf6\xc4\x85\xf3\x5f\x20\xcc\xc8\xb4\x4a\x5f\xad\xb7\xa5\x29\x6f\x19\x52\x33\x45\x7f\x90\x95\x26\x80\x83\x50\xed\x59\xe8\x36\x8c\x32\x33\x7a\x1e\x8f\xd2\x62\x0d\xb9\x34\x6d\x05\x0b\x6f\x8d\x8e\x8b\x30\x8b\x63\xa9\xc9\x19\x6e\x20\xfa\x85\xb6\x7a\xff\x1e\x0c\xdf\x32\x95\xb1\xcc\x4c\x13\xaf\xac\xc0\x9f\x73\xd0\x2d\x9f\xf4\xf9\x2d\x98\x13\xd2\xf4\x19\x06\x94\x6a\x87\x95\x03\xc9\x28\xa3\xf7\x04\x55\xb4\xb4\x9e\xbe\x02\x11\x09\xdc\xcb\xd8\xa8\xe3\xe5\x03\x88\xe0\x9b\x5e\xc3\xa6\xb4\xf7\x6d\xf2\xce\x0d\xaf\x66\x4e\xd2\xab\x32\xdf\x45\xac\x60\xe4\xc0\x30\x2e\xf7\x41\x80\x9f\x4a\xb4\xcf\xef\x34\xcd\xe4\xc6\x09\xd1\xf9\x08\xca\x81\x3f\xd5\x55\xfc\xc5\xd2\xf3\xf9\xfc\xbc\x0a\x19\xe8\xf2\x90\x7a\xa9\xb4\x21\xb4\xb0\xec\x03\x8f\xa1\xa1\x47\x7f\xfe\x71\x23\x90\x9c\x98\xcc\x87\xbb\x32\x66\x99\xfc\xe6\xc6\xcf\xf0\x01\xe6\x04\xd1\x03\x52\xce\x3c\x6f\x1c\x98\x5f\x97\xab\xa6\xf2\xd0\x68\xc0\xd7\x26\x70\x32\x3c\xaa\xae\x76\x52\x60\x8e\x8f\x3c\xdf\xfe\x52\x16\xd2\x83\xdc\x4b\xdc\x84\x5e\xea\xac\x8b\x44\xa8\x8c\x45\x3e\x4d\x44\xa8\x0d\x6b\x15\x4f\x38\x30\xf7\x5d\x73\xf0\x2b\x07\x5f\xdf\x87\xee\x15\xf4\x2d\x32\x97\x4e\x29\x9e\x88\xd0\x18\x8b\x2d\x25\x15\x42\x11\xc2\xe7\x52\x12\x32\xcd\x61\x19\x2e\x44\xce\xa5\xc4\x39\xbd\x3a\x43\x0f\x95\xe3\x27\x89\xf3\x61\xe5\xc1\x83\x9a\xed\xc3\xaa\x35\xa6\xea\x83\x9e\xd4\x9c\x1c\xd7\x3f\x2d\x07\x27\x8d\x6b\x20\xed\xd1\xcc\xda\x4c\xa7\x12\xfb\xf5\xf7\x40\x53\xfd\x74\x2e\x6e\x8f\xa7\x65\xf2\xfe\x0f\x7a\xa2\xca\xac\x74\xeb\xdc\xc4\x27\x73\xbc\xe4\xe4\x10\x23\x31\xa2\xd4\xd1\xa2\x54\xd4\x7c\x9b\xc9\xe9\x62\x17\x98\xb2\xd0\xb3\xb2\x48\xb3\xe2\x9d\x8b\x5b\x0e\x2d\x89\x8b\x81\xbe\x97\x76\x61\xad\x3e\x7e\x85\x97\xbf\x79\xda\x23\xaa\xca\x31\x16\xcb\x21\xcc\x99\xf9\x26\xfa\xb8\xef\x9e\x87\xd8\x33\x28\x29\x97\x7e\xf2\x40\x84\xc6\x5f\xaf\x19\xd6\x6a\xff\xc5\x93\xde\x87\xa9\x4e\x41\xb2\x2c\x62\xc9\x49\x79\xd0\x83\x94\x33\x68\xe8\x4e\x7d\xb4\x3c\xd1\xd0\x63\x34\xdb\x06\xf3\xe0\x64\x82\x11\xd7\x0c\x0a\x8c\x2d\x4a\xf5\xe1\x95\xb2\xc9\x93\x42\x7d\x8f\x1e\x54\x0b\x1d\x15\x18\xea\x94\xf9\xe7\x9a\x11\x62\x5a\x37\xd7\xa7\xa4\x2a\x03\xe3\x13\x8d\x2b\x33\xd5\x47\xf3\x0f\xf9\x3f\x42\x18\x1c\x12\x51\x18\x95\x85\xef\x55\x96\x5a\xae\xa7\x72\xbc\x59\x94\x75\xf3\xb4\x21\xf6\xce\x8f\x55\x97\x6e\xb9\x9a\x4b\xa7\xac\x8b\xb8\x27\x17\x55\xd7\x2c\x16\xa9\xf9\x2a\x52\x43\xc8\x81\xb9\xf1\xa1\x3f\x75\xc4\x6c\x88\x45\x6a\x09\x44\x6a\x94\x78\xcd\xd6\x72\xba\xb9\xc2\x65\x7f\x13\x29\x24\x9a\x8b\xcf\x7c\x1e\xd2\x2e\x45\xd9\x47\x88\x99\x85\x95\x56\x5a\x8e\x41\x49\xab\xae\xb0\xd8\x33\x70\x0d\xdc\x74\x0c\xec\xc2\xf9\x98\x01\x6a\xa2\x6a\xf3\xa8\x6a\x0b\x82\x6a\x97\x9c\xf9\x28\x39\x0d\xbc\x32\x86\xed\x23\x86\x5d\x80\xaa\xcd\xff\x0a\x9f\x39\xf8\xc8\xb5\xbb\xb9\x18\xf8\x2b\x7c\xc5\x3f\xe2\x33\xff\x25\x3e\x3d\xa7\xc9\x55\x3e\xb6\x03\xe2\xa6\x78\x9d\x03\xef\x56\xde\xf8\xdb\x1c\xc2\x60\xfa\xc5\x43\x3c\x70\xb1\x25\x16\x85\xde\x87\xaf\x67\x93\x03\xf3\x15\x5e\xa9\xca\xe7\x8d\x7b\x0a\x26\x57\xa0\xda\xa3\xe4\x0c\xc7\x9f\x54\x3b\x9d\x54\xc7\x99\x2e\x8e\x26\x5e\x1c\x15\x31\x1d\x81\xd1\x1e\x31\x73\xb1\x8d\x49\xb5\xa4\x1f\xf8\x6e\x22\xf5\x88\x5d\xa8\x0c\x6b\xf3\x57\xf8\xcc\x7f\xc4\x57\xfc\x4b\x7c\xc3\x4a\xbb\x82\xfc\x8e\x2e\xae\x3a\xca\x52\x9b\x3e\x3a\x85\x29\x7e\xe0\x3e\xf6\xb6\xd9\x9f\x8d\xa6\x3a\x47\xa1\x91\x28\x3c\x02\xf8\x0a\x4a\xe2\xb5\x5f\xed\x3c\xca\x25\x40\x6f\x6c\x72\x31\x48\x9a\xa6\xcf\xb1\xe5\x98\xcc\xf5\xf4\x08\x62\x41\x0b\xed\x49\x33\x79\x1d\x83\x3b\xc2\x02\xc3\xa2\x0b\x65\x81\x1e\x6c\xe7\x1a\x8c\x38\xe8\xbf\x0a\x7e\x72\x6c\x0e\x6f\x38\x02\x7d\xb1\xc0\xcc\x01\xe3\x13\x48\x33\x92\xc4\x3c\x9e\x97\x40\x93\x9e\x7d\xc3\xc9\xd5\xa7\xc4\x88\x6a\xe1\x9b\x78\x03\x23\x41\x98\xef\x09\x24\x81\xfb\x08\x44\x07\x48\x31\x2f\x6a\x57\xdf\x74\x5b\xbf\xdf\x07\x5d\x3b\xa9\xe2\xac\x70\x0c\xd1\x86\x67\x38\xca\x1d\xab\xcc\x11\x07\xc1\xda\xaa\x90\xa9\x8e\x98\xa8\x81\xa8\xe1\x98\x03\x52\x52\x76\x0a\x67\x74\x5f\x89\x8e\x0c\xf2\x02\x23\xda\x74\x16\x97\x74\xbc\x4c\xa0\xc9\xe2\x95\x95\x4a\xcd\xde\x95\xfb\x41\xc7\x70\x35\x71\x96\x19\xae\xcf\x7c\x41\xea\x6e\x2b\x9e\xaf\x57\xbb\xcf\xdc\xf0\x99\x56\xa7\x11\x25\x04\x86\x88\x29\xd2\x4d\xd1\xea\xea\xca\x18\xdc\x40\xed\x74\x49\x91\x6b\x80\xe8\x95\xf0\xdc\x1b\xb0\x1e\x3b\xaf\x3e\x10\x1c\x95\x02\x86\x71\x7f\xc1\x23\x1d\x09\xa7\xb1\x42\x71\x6f\xcc\x20\x5a\x31\x11\x60\x50\xa0\x64\x9f\x89\x8f\x28\xa2\x8a\xc6\x8a\x9c\xd6\xb6\x5e\x48\xd4\x5c\x39\xa3\xf5\xce\xc8\xb8\x34\xd8\x89\x6f\x91\xa8\x4c\x6f\x9a\x6a\x5f\xe3\x09\xb2\x41\xfe\x49\x44\x35\x0e\x00\x86\x69\x8c\x6a\xb6\xd0\x78\x7f\x3b\x29\x1c\x16\x49\x02\x2f\x58\xb2\xe4\xec\x45\x52\x91\xf7\x5d\x08\xca\xad\x78\x93\x23\xfb\xdc\xf0\xb3\x0e\x92\x74\xf8\x8c\x94\x19\x6f\x43\x5d\x8e\x8d\x77\x9e\xc6\xb4\x18\x70\xa3\x3b\xb5\xdc\x09\xea\xc5\xb0\xcc\x59\x5f\x4a\x23\x95\x74\x05\x3d\x25\xd5\x17\xa6\x3f\x96\x78\xee\xd1\x87\x1f\xb2\x3c\x8a\xf2\xc9\xd3\x53\x4f\xfd\xe8\x25\x7b\x9a\x9c\x10\x59\xf6\x27\xbf\x7d\x0c\xa1\xb3\x54\xa5\x2e\x3e\x99\xca\x8f\x53\x09\x6a\xfc\x87\xa5\x86\x0a\x4a\x37\x90\xef\xa9\x45\xa4\xc2\x5c\x0c\x4f\x75\x48\x07\x95\x6e\xd0\xaf\x14\x12\x2f\xa6\x72\x8b\xe7\xa8\xf2\xed\xf8\xfc\x12\xe4\x02\xe6\x74\x13\xb9\x57\xa3\x84\x2e\x0c\xf7\x4e\x29\x93\xe6\x5e\x93\x4e\x87\x3a\x93\xdf\x61\xd7\xb6\x18\x8c\x44\x01\x04\xb0\xc5\x24\xe5\x2f\x79\xb7\xe7\xcc\x68\x30\xd0\xcb\xed\x9c\x43\x4a\x3a\x4a\x86\x7c\x65\x53\x38\xc7\xeb\x10\x92\x88\x13\xc2\xbc\x11\xc3\x7d\xd9\xd4\x93\xa1\x75\xaa\xe7\x7c\x6c\x7a\xc4\x96\x8a\x98\xaf\x29\x69\x9c\xe2\x7e\xad\xf9\x8b\x4b\x3a\x85\x32\x17\xbc\x69\x6b\xde\xa3\x50\x89\x0c\x91\x46\x5c\xd3\x86\xd3\x88\x9f\x1f\x7d\x46\x67\xd7\x77\x18\x80\x7b\x23\xa1\x95\x66\x89\x24\xaa\x36\x4c\xf0\x3a\x68\xd3\x39\x02\x6e\xd5\xc4\x6a\xe2\x20\x31\x46\xf4\xe4\x44\xbc\x8f\x69\x18\x2e\x66\x17\xa6\x4e\x2c\x01\x8f\x3d\x39\x8b\x18\x41\xdd\x9a\xdc\xf2\x7b\x13\x28\x67\x78\x3f\x53\x2c\x8d\x6a\x51\xe2\x2a\x55\x43\x40\x84\x0d\xe5\x98\xa3\xbc\xce\x5a\x17\x3c\x15\x92\xe5\x68\xb6\x2d\x26\x5d\xb8\x99\x93\x32\xfe\xc9\xdf\xaa\x29\x92\xf9\xaf\x15\x4f\x94\xb7\x9f\xe9\x06\xf4\xfd\x2e\x57\x95\xe8\x01\x98\xee\xce\x8e\xeb\xe0\xaf\x3c\xb7\x7c\xbf\xc1\xf8\xaa\xd2\x39\x96\xa3\xe8\x13\x8a\xb7\x13\x19\xf1\xf5\x23\x00\xf6\x2c\x43\x91\xd4\xb2\xf7\xf7\xaa\xac\xa7\x6a\xce\xb5\xbe\x41\xb3\x51\x18\xb8\x0c\xd3\xa4\x3e\x47\xc1\x1d\x7c\xeb\x63\xe2\x56\x1a\xb7\x36\x5c\xe4\x1b\x12\x96\xc8\xf2\x40\x6a\x44\x1a\x53\xa9\x7b\xf8\x09\x77\x41\xd4\xd2\xad\x12\x30\x7c\x38\x5d\x46\x26\x24\x70\xbf\xc0\xdd\x69\x99\x10\xef\xe5\xf0\x05\x06\xf6\xf7\x6e\xaf\xc5\x75\x16\x57\x11\x62\x95\x77\x4d\x2f\xae\x37\x9b\x64\x67\x1c\x04\xb9\x46\x92\xac\xe1\x7a\x97\xfd\xae\x1c\xee\x1e\xf4\xc4\x71\xaf\x6a\x24\xbf\x55\x6f\xf1\xe3\x6c\xb8\x03\x2f\x84\x61\xcb\x02\x16\x96\xc2\x46\x66\x15\xb2\x23\x13\xef\x09\xbf\x2c\xc3\xf2\x52\x9e\x6d\x33\xd0\x59\x40\x4f\x34\xc2\x39\x8c\x51\xc3\xb6\x23\xb4\x25\x21\x8f\xf6\xbd\x42\x6f\x14\xd7\x5c\x30\xc5\x25\x7a\xdd\xcb\x29\x77\x7a\xde\x6b\xe2\x26\x0b\x20\x35\xcc\xfa\xe4\xc1\xb9\x1b\x69\x66\x21\x8b\xb7\x05\xac\x0c\x57\x6e\xb4\x96\xa4\xaf\x0c\x3e\x41\xb3\xe9\xc3\x4f\xbf\x8e\x60\x45\x69\xee\xbd\x67\x4f\x6a\x4a\x97\x55\xe9\x49\xf7\x3a\x65\x27\x6d\xe2\x17\x75\xcf\xe9\x03\xae\x19\x01\xcc\xcc\xa9\x92\xde\x66\x0f\x3f\xdb\x24\x52\xa7\x27\x5b\xc3\x44\x5a\x01\x5d\xab\x71\x8c\xc2\x32\x6a\xaf\x98\x99\x0c\x28\x40\x31\x49\x6d\x3a\x28\x63\x15\x06\x5b\x5b\x1e\x92\x6e\x3f\xcf\x99\x74\xa3\x9f\x3c\x1b\x54\x0a\xf8\x70\xac\x04\x69\x04\x36\x9b\x10\xbb\x79\xaa\xa8\x9d\xf0\x86\x0e\xa7\x11\xce\xa4\xa2\x34\x86\xa8\x2c\x6a\x9e\x99\xb1\x85\x2a\x4e\x69\x0a\x17\xe7\x64\x0e\xcd\xfc\xa6\xa2\x18\x47\xba\x38\xf4\xe2\xe9\xa2\xb6\xaa\xe7\xc9\xea\x98\x6c\x1e\x7b\xb0\x16\xae\xfd\xd3\x62\x6d\x3a\x52\x51\x9e\x1d\x5f\xc1\x73\xd8\x6b\x06\xae\x58\xd4\x59\xa4\x95\x46\x95\xa4\x46\xf7\xb9\xc7\x2d\xe7\x15\x68\x3a\x75\xe1\x7c\x41\x28\xda\x70\xb8\x80\x26\x4c\x88\x3e\xc1\x08\x67\x25\x16\x8d\x66\x03\x66\x8a\xc0\x95\xce\xa6\xa6\xd2\x7d\x9d\x6c\x47\x8a\x46\xed\x80\xa5\xf2\x53\xf1\x70\xdc\xea\xdd\x89\x43\x38\xb3\x10\xc9\x28\x09\x22\xea\x60\x85\xb8\x2e\x2a\x61\x19\xcf\xf0\xb5\x2e\x59\x9d\xb7\xcc\xbd\x20\x4d\x21\xca\x17\x2a\x7f\x26\x28\x23\x00\xa6\x53\x28\x4f\x6c\xac\x12\x53\xc2\x8e\x8b\x73\xbb\x3c\x52\x7e\xac\xfb\xa5\x23\x5e\xca\x82\xdb\x48\x34\x5b\x95\xa1\xa3\x48\xbf\x3c\x12\x29\xb7\xcf\x2a\x9e\x37\xb8\x0b\x51\x0c\xa0\x29\xac\xac\x5e\x45\x76\x29\xd8\x46\x31\x01\xb0\x7c\xdf\x2f\x12\x7c\xd7\x66\x20\xeb\x65\x16\x12\xec\x56\x06\xde\xa1\xa8\x25\xaa\xc9\x92\xf1\xe9\x79\x62\xe6\x7b\xbf\x1f\x68\x5a\xa5\x15\x00\x7c\x0c\xf4\xf5\x66\x94\x7f\xee\xc7\x08\x65\xf2\x57\x7b\x9e\xe8\xdf\x89\xc5\xdf\x89\xc5\xff\x0d\x89\xc5\xe3\xc6\xaf\x7f\x9a\x7d\xfe\x99\x08\x38\xd2\x78\xe1\xaf\xbc\xf9\x30\x30\xd5\xbe\xf1\x6a\x71\x65\x4c\xde\xf9\xf7\x12\x8b\x1f\xf8\xb4\x7f\x89\xef\xbf\x99\x58\x88\x5a\x61\x8a\x5a\x61\x40\x2a\xff\x33\x11\x30\x1e\x34\x6a\x3a\xa6\xa8\x71\xc5\xa4\x15\xc6\x6c\x3a\x02\x6f\xfe\x7b\x89\xc5\x4f\x7c\xce\xbf\xc4\xf7\x7f\x26\xb1\x28\xa1\x91\x22\xa4\x35\xb1\xc8\xbe\x27\x16\xd9\x59\x53\xc2\xe9\x6c\x0a\xea\x1d\x18\x75\x5a\x52\xa5\x49\x2d\x1c\x89\xe8\x2c\xf0\xea\xbf\x4a\x91\x53\xc4\xec\x11\xcb\xc5\x6b\xbe\xc1\x68\x74\x16\x34\x0a\xc6\xe2\x64\xef\x45\x17\xed\x14\x9c\xcc\x07\x5a\x10\xb6\xc6\xe1\x96\xd8\x40\x57\x77\xac\x9e\x70\x61\x29\x59\xf9\x52\xb9\xd0\x20\x89\x74\xd1\xfd\xc8\x27\x0a\x54\x4c\x9b\x33\x1e\x56\xf1\x88\x51\xe4\x32\xd4\x83\x0a\x50\xd8\xd3\xb9\xfa\xd7\x2a\xd8\xb7\x43\xd4\x0c\x13\x39\xab\xb5\x70\x3b\xd1\xea\x59\x3f\x33\xe7\x3b\xb7\xbc\xb0\xe1\xe9\xe1\x7b\x60\xe0\xb0\x57\x03\x2c\x04\x77\x51\x99\xb8\x01\x62\x18\xef\xf0\x87\xa0\x9e\x91\xdc\xc7\xa0\x07\xb3\xa0\xea\x5e\xd1\xf1\x71\xe2\x47\x14\x14\x67\x18\x79\xf9\x0b\x83\x9c\x2e\xc4\xfd\x59\x3d\x6f\xec\xa5\xba\xf3\xf7\x80\xf0\x42\x54\x9b\x2e\x6f\x16\x2d\x87\x50\xb7\x9b\x04\x9e\x65\x1e\x91\x21\x3d\xd6\x47\xed\xd2\x9d\xe2\x89\xa0\x78\xeb\xfe\x42\x5f\x72\x91\x3f\x18\x0c\xf0\xd2\x64\x90\xe6\xd3\xc4\xb3\x30\xea\x58\x55\x32\x72\x2f\x0e\xa6\x99\xa9\x0f\x03\xc5\x2c\xee\x2e\xbe\xa8\xaf\x87\x2b\x96\x19\x01\x30\xf3\x4c\x77\x93\x5f\x5f\xf4\x5a\x01\x0d\x78\x9f\x8a\x62\x25\x9b\xc1\x48\x2e\x0e\x73\x0e\xec\xdc\xc2\x8c\xcb\x05\x12\xce\x75\x2f\xd1\x2a\x99\x45\x79\x20\xb9\xa7\x34\x27\xb5\xc8\xd2\xba\x7d\xd9\x5c\x39\x9f\x3c\x33\x5e\x06\x37\x8f\x33\x6d\x42\xa7\xd0\xb6\x34\x41\x75\x9e\x57\x92\x9b\xa2\x47\x0a\xd6\x96\xcf\xbc\x3b\x66\x8a\x95\xf0\x25\x41\x79\x96\xb0\x4e\x46\x79\x9e\x26\xf6\x6f\x7a\x64\x63\xbe\x08\x29\x63\x66\x2c\x21\xa8\x28\x9b\xbb\x37\xfa\x0d\x52\x9c\x14\x62\x4e\x30\x29\xe5\x23\x4f\x9b\xf6\x95\x36\x2e\x4b\x24\xc8\x66\x70\xba\x3d\x95\x26\xa0\x82\xc1\xc7\x15\x48\xbc\xb7\x60\x84\xc0\x1d\xaa\x3b\xb8\x0b\x97\x6e\x7b\x25\x15\xba\x79\x8f\x20\xdb\x42\xb5\x77\xef\x7c\x97\x47\x5e\x37\xf1\x35\xba\xa7\xc5\x7f\xc2\xcb\x52\xa6\x49\xd7\x37\xe5\x55\x18\xba\x9c\x53\x42\xd3\x94\x05\x9b\x76\xd9\x69\x82\x45\x40\x2e\xd5\xce\x13\x32\x57\x7f\x5d\x96\xd6\x3d\x9d\x47\x66\x22\xde\x93\x3c\xc0\x26\x51\x8d\xc2\x82\x94\x23\x05\x60\x9c\xc2\x02\x2a\xea\xb4\xb0\x39\x5e\xc1\x14\x71\x30\xd2\x3b\xd7\x02\x11\x0f\xef\x96\xb9\x34\x1e\x48\x87\x61\xf2\x00\x38\x41\x36\x55\x59\x42\xc0\xbb\x7d\x15\x59\x04\x76\x69\xb8\xc2\x4e\x54\x64\x18\x43\xe6\xe7\xe0\xc0\x03\x84\x1b\xa7\xd1\x52\xe3\xb4\x49\x53\x86\xce\xbc\x99\x76\x89\x9e\x80\x37\x3c\xe7\xd7\x94\x84\x8b\x01\xda\x65\xfb\xc2\xcb\x72\x52\xb2\x84\x0a\x39\x34\x12\xd4\x37\xe5\xc5\x0f\xe5\xf4\x54\x86\xbd\xa5\x88\x8c\x7f\xa2\xfd\xfc\xda\x43\x85\x76\x79\xd2\x81\x69\xbb\x21\x9b\x3c\xd2\x7c\x04\x17\x31\x13\x44\x4b\x86\x82\x71\xbf\x78\xe1\x70\x97\x01\x86\x49\x69\x7d\xa4\x39\xba\xc5\xc4\xa7\xf8\xe7\xde\x02\x5f\x36\xe9\x1f\xdd\x9c\xf0\x3f\x7e\x15\x55\xb4\x43\xba\x9f\xaa\xf2\x7f\xfd\x07\xc2\xb6\x43\xba\x9b\xaa\xb2\x6e\x7f\xf9\x96\x75\xdd\xf3\xff\x05\x80\x71\x1c\x8f\x23\x72\x6c\xde\x29\x00\x83\x20\xb8\x02\x7f\xdb\x0d\x79\x32\x32\xcd\xf4\xcb\x37\x70\x07\xee\x30\x0c\xd9\xc1\x08\x76\x44\xbe\xfd\x07\xc2\xff\x07\xc2\xc6\xc9\xbd\xfd\x5c\x01\x3f\x2f\x9f\x41\x97\xed\xee\x79\x59\xfe\xf2\xed\x3f\x60\xe4\xbe\xfd\xfb\xb6\x8b\x7f\xf9\xa6\xc2\x08\xb5\xc3\x91\x0c\x22\x8e\xe4\x00\x81\xd8\x09\x46\xa8\x2b\x8e\x2c\x15\x82\x1d\xf1\x1d\x98\x21\xf8\x11\x89\x88\x23\xb5\x03\x77\x10\x7a\xc4\x8e\xd4\x0e\xa2\x8e\xf8\x0e\x3e\xe2\x2d\x75\x84\x77\xe8\x11\xda\x41\xf0\x11\xda\x11\x47\x28\x80\xd1\x23\x8a\xed\x3e\xed\x4a\x19\xb4\xc3\x8f\xf0\x0e\x02\x57\x38\xf0\x48\x60\x5f\xed\x67\x08\x3a\x92\x9f\x99\x28\x76\xc4\xa9\xaf\x76\x1b\x3a\x7c\x0d\x51\x3b\x18\x3f\x62\xe4\x57\xfb\x19\xfa\x42\x48\xee\x10\xf0\x88\x51\x5f\xed\xd7\xac\x0f\x19\x48\x74\xc0\x8e\x1b\xfa\x03\x04\x1d\xb1\x1d\x7c\x24\x0e\x10\x75\x44\xd6\x8b\x6c\xbd\x82\xae\x10\x4e\x66\x07\x88\x38\x62\x9f\x8d\xae\x38\x31\x28\x40\xc8\x23\x42\xec\x3e\x2d\xf8\xc1\x09\x1d\x57\x62\x90\x1d\x84\x7f\x6f\x3e\x03\xf8\x91\x3c\x20\x47\x62\x07\x21\x47\x04\xfd\x6a\x3f\x23\xc8\x11\x3b\x60\x47\x72\x07\x53\xeb\x4e\x3f\xed\x17\xb2\x03\xb1\xf2\x0d\x3b\xe2\xe4\x57\xbb\xf5\x1f\xd6\x7e\x62\x07\xc1\xeb\xeb\xd3\x83\x1c\xf1\x03\x76\xc4\x76\x10\x71\x84\xbe\xb7\x9f\x11\xfc\x48\x1d\x90\x23\xba\x43\xa1\x95\x23\x9f\xf6\x0b\x0b\x78\xa4\x0e\xd0\x11\xce\x0e\x10\x79\xc4\xae\x10\x84\x66\xeb\xc5\x52\x41\x10\x75\xa4\x0e\x18\x34\x40\xd8\x11\xc9\x0e\x28\x75\x84\xaf\x10\x48\x66\x28\x7e\x44\xd6\x3e\x34\x3b\xac\x97\x3f\x78\x42\x5e\x71\x24\xc3\x89\xa5\x82\x57\xb6\x10\xf0\x91\x8a\x8e\xe4\x0e\x3f\x52\x3b\x64\xe5\x01\x74\xa4\x76\xc4\x11\xdd\x41\x58\x0b\x81\x2b\x25\x2b\xed\xe4\x11\x5f\x2f\x02\x04\x3e\xe2\xd0\xee\xd3\x7e\x6d\x1a\xfc\xe2\x20\x0c\x1e\x31\xf8\xab\xfd\xce\x43\xfc\x80\xac\xbb\xfc\xb9\xf3\x2f\xee\xc1\xab\x8a\x81\xe4\x57\x0b\xfe\x90\x3c\xba\x8a\x00\xfa\xde\x7e\xf6\x7d\x24\x0e\xe8\xaa\x93\xf0\x91\x24\xbe\xda\xcf\x00\x7c\xc4\x0f\xe8\x8a\x1e\x5f\x35\xe4\xd3\x7e\x46\xb0\x23\xb4\xb2\x78\x87\x60\xeb\xeb\xd3\x47\x1e\xe1\x03\x7c\x44\xcb\x03\x84\x1c\xd1\x03\x7c\xc4\x02\x92\x3a\x12\xf8\xee\xd3\x7e\xe9\x17\xba\x4d\x24\x76\x08\x72\xc4\xa0\xaf\xf6\x6b\x08\x3c\xa2\x1f\xb1\xc3\x47\xf8\x7b\xfb\x9d\x6c\xe4\x40\xae\xfa\xba\x5a\xca\xd6\x7c\xfa\xe1\xe3\xa6\xb1\xc4\x0e\xc6\xd7\xd7\x97\x4d\x40\x47\xe4\x00\xc3\x47\x74\xb7\x9a\x1d\xf6\xd5\x7e\x8d\xc1\x9b\x5a\xe0\x3b\x1c\x3b\x92\xd4\x57\xfb\x35\x84\xad\xca\x7e\x24\x23\xe2\xb8\x72\x72\xd5\xc7\xd5\x82\xc8\x23\xbc\x5b\xb7\x82\xa0\x47\x14\xdf\x7d\xda\x1f\xeb\x50\x3b\x7c\x35\x0e\xf2\x08\x43\x5f\xed\x77\x8b\xa5\x76\xab\xb5\xa0\x9b\x69\xc2\xdf\xe9\xdd\x21\xab\x58\xa0\xf2\x00\xe1\xeb\x36\x8f\x78\x74\x80\xb6\x3d\xaf\x0a\x49\xac\x0c\x80\x0f\xc4\xba\x25\xe4\x08\xb5\x07\x72\x13\x0b\xb2\x02\x43\xeb\x45\x80\x22\x47\x6a\xb7\x35\x3f\xd4\x15\xdb\x41\xab\x99\x52\x47\x94\xf8\x6a\xbf\xeb\x38\xb9\x43\x8e\x1b\x8d\x38\xf2\xd5\x7e\xb7\x0b\x62\x87\x1e\xf1\xd5\x0f\xad\xda\x81\xfe\xd0\x8e\xc3\x8a\x09\x3b\xa2\x11\xf8\x19\x3f\xc2\xbb\x15\x07\xb1\xaa\x1f\xd2\xae\x3b\xda\x8c\x14\xde\x61\x47\xa4\x5c\xbd\xd8\x0e\x3e\x92\xd1\x6a\xdd\x3b\xf8\x43\xc3\x3a\x84\x6f\x9e\x6b\x9d\x83\xb5\xc4\x77\xe7\xb4\x5e\xc0\xd0\x11\x0d\x10\x68\xf3\x0e\xd0\x77\xef\xb0\x4a\x10\x5d\x61\x90\xd5\x9a\x61\xe2\xab\xfd\x6e\xe9\xe8\x8e\x5a\xf7\xb1\x79\x2e\xe4\x57\xfe\x0b\x5a\x67\xe1\x47\x7c\x87\x6d\xaf\xaf\x4e\x62\x73\x4f\x68\xb4\xa9\xdf\xaa\xbc\xab\x0e\x92\x07\x04\x3a\xe2\x07\xf2\x88\xb5\xab\x27\xa3\x56\x05\x44\x0f\x9b\x1a\xac\xb6\x99\x41\xe4\xf2\x0d\xf8\x3d\xef\x8e\xe2\x54\x12\xc0\x1f\xef\x8e\x80\x9b\xe7\x42\x4b\x12\xdd\xa1\xe4\x11\xdb\x91\xe8\x61\xfd\xff\x8a\x13\xe5\xe1\xeb\xfa\xf0\x35\x36\x50\xc4\x1f\x60\xc4\x83\x28\x88\xa1\x0f\x46\x08\xc4\x56\x1b\x00\x8f\x50\x79\x58\xad\x1d\x3b\xc2\x01\x44\x1e\x21\x6a\xf7\x69\x3f\xea\xb2\xda\x32\x52\xe2\xe0\xea\x37\x36\x08\xf4\x88\x61\xbb\x4f\xfb\xa5\x83\xeb\xa6\xc1\x0d\xe4\xf0\x47\x48\x0e\xc8\x11\x51\x20\x18\xd9\x96\xfb\x2d\x8e\x0f\xe3\xc0\xa5\xfa\x6c\x00\xfe\x4e\x10\xbe\x82\x12\xc8\xee\xd3\x7e\x40\x57\x1f\x05\x61\xe5\x2a\x52\x30\x80\xb0\x23\x46\xec\x3e\xed\x67\x29\x78\x15\xf8\x11\xff\x35\x39\xff\x8c\x62\x47\x1e\xf1\x03\x84\x5d\x09\xea\x48\x05\x30\xb8\x83\xc1\x1f\x8a\xb0\x5a\x1f\xbe\x54\x07\x08\x03\x8f\xe4\x11\xfd\x2d\xfe\xc3\x17\xfe\x81\x00\xd7\x61\xf4\x88\x6c\x0a\x8c\x90\x3f\x51\xaf\xd4\xfd\x8a\x5f\xbf\x47\xe1\xba\xca\x70\xf8\x7d\x14\x87\x0f\x75\x0a\x42\xae\x1e\x9a\x3c\xfe\x91\x28\x7f\xad\x1c\xd0\x3a\x7f\x07\x53\x25\xb1\x9e\x98\x28\x72\x44\x07\x12\x3f\x12\xe5\xe1\xc7\xfb\xed\xea\xb0\x5e\x5d\x09\x78\x95\xc5\xd7\x8c\x6a\x33\x61\xa4\xfc\x95\xea\xfc\xb3\x86\x0d\x07\xea\xa7\x8a\xfd\x9b\xc4\x50\x3b\x08\x59\x8f\xb0\x23\x4c\x43\xd8\x11\x5f\x79\xb2\xb6\x1f\x06\x50\xe4\x0e\x5a\xcf\xe0\x61\x1d\x0f\x7e\x3b\x0e\x61\xab\x23\xc2\x8e\x44\xf6\xc7\xe3\x5b\x33\xfc\xee\xf8\xe1\xc7\xf8\x52\x81\x3b\x1c\x3d\x62\xd9\x8f\x85\xb0\xdd\xa7\xfd\xf2\x36\xab\x6b\x21\xcb\x8d\x15\xab\x8f\x5d\x2f\xd6\x13\xe4\x0f\x40\x0f\xe4\x52\x91\xeb\xc9\xb6\x32\x22\x3b\xac\xda\xf9\x4f\x6b\x83\x87\xdf\xd0\x06\x21\xbb\x4f\xfb\x51\x32\x78\x47\x1c\xf1\xf2\x6b\x21\xe4\x0a\xa1\xe4\xaa\x6d\xdb\x5b\x6a\x05\x5f\x0f\xe4\xe3\xf7\x33\x71\x03\xfe\xdd\x4d\x82\x3f\x99\x90\x1d\xf1\x2b\x4e\x1c\x61\x05\x22\xd7\x43\x09\xa3\x96\xea\x80\x53\x47\xf2\x80\x82\xab\x9c\x41\x70\x0d\xbb\x36\xdc\xc4\x46\x0a\xf1\x9d\x94\xcf\xee\x8f\x50\xf6\xbb\xcc\xd9\x7d\x76\x5c\x1e\xbe\xd3\xba\xa8\xe8\xea\x93\x31\xf2\x48\x7d\x3a\x77\xe4\x1a\x78\xe0\x47\x24\xfb\x3d\x4e\xfc\x94\xe2\x97\x98\xf1\xed\x14\xc0\x7f\x9e\x02\xeb\x39\x43\x2c\xab\x63\xa3\x76\x10\x4a\xae\x0c\x5d\xd1\xc1\xdf\xe5\x81\xfc\x33\x3f\x76\xeb\x0c\x7c\xf8\x5d\xad\x02\x77\xdf\xf1\xfc\x81\x86\x82\x24\x12\x62\xe8\xb7\xad\xe3\xd0\x3c\x83\x28\xef\xe6\x5f\xbe\x81\x47\x08\xfb\x07\x13\x5a\x4f\x97\x55\xe1\x57\xa7\xf8\x5d\xff\xd7\x77\xff\x45\xac\xe0\x77\xac\x14\xb9\x45\x3b\xc8\x91\xfc\x6e\x46\xbb\xdf\xe2\x5c\x73\x81\xf5\x0a\xa4\xff\xc7\x7f\x27\x4b\xa9\xf2\xf8\x1f\x92\x94\xff\xea\xf4\xe2\x8f\x9e\x13\xf2\x3b\x39\xce\xff\x17\x65\xc1\xbb\x4d\xba\x5f\xfa\xee\x4e\x7e\x4f\x78\xf2\xf8\x97\x6f\x4a\x30\x27\xef\xff\x84\xbf\xfd\x97\xb3\x1f\x62\xcd\x07\xc0\xaf\xd4\xa7\xed\xe6\x32\xf9\xe2\x4e\x99\xd7\x49\xf0\x16\xdf\x41\x9c\x27\x75\xb7\x2d\x62\x5d\x45\x89\xfb\x4f\xe8\x3f\xbf\xed\xd2\xaf\x6e\xa7\xce\xbb\xf6\x97\x6f\x7d\x9b\xbc\xad\x67\x10\x25\x97\xda\x69\x93\x6f\xbb\x09\xfa\xe5\xdb\x9a\x16\x7c\xdb\xcd\xd0\x2f\xdf\x20\x10\xfa\xb6\x9b\xe0\x1f\x5d\xeb\xd5\x11\x5c\x65\xf5\x1d\x8d\xfd\x0e\xea\xf6\xde\xbc\xab\x5f\xbe\x55\x41\xf7\xce\xa7\xff\xe7\x13\x36\x1d\xa0\x2d\x06\x86\xff\xe7\x0f\xfa\x9a\xe7\xae\xb9\xdf\x57\x0e\x7c\x03\xbf\xed\xd6\xf7\x87\xa8\x29\x9b\xf7\xa6\x16\x09\x1c\xc5\x09\xfa\x5d\xbc\xff\x00\x7c\x84\x88\xdf\x82\x83\x51\x90\xc0\xbf\x0f\x8e\x40\xc8\x6f\xe0\xe3\x30\x02\xe3\xf8\xf7\xe1\x51\xf4\xb7\xf8\x63\x38\x84\xe2\x3f\x20\x07\x23\xb0\xdf\xc0\x47\x04\x15\x47\xc4\xef\xc3\xe3\x14\xf9\x1b\xf8\x30\x20\xd1\x90\xfa\x7d\x78\x12\xa2\x7e\x03\x1f\x84\x38\x19\xfc\x01\x3c\x85\xa0\xbf\x81\xa7\x22\x14\xa3\xc8\xdf\x85\x87\x7e\x0b\x8c\xc0\x01\x99\xfc\x30\xad\x7f\xd4\x9e\x5f\xd9\xf0\x6a\x9e\xe8\x96\xa3\x94\x6b\xd0\x87\x1f\x89\x2b\x45\x9d\xd6\xa4\x2a\x43\xb1\xe5\xdb\x97\x8d\xaf\xea\xff\x1f\x30\xf2\x5d\xe1\xfe\xe7\x3f\xb8\x81\x7f\x07\xc5\xaf\xbc\xc2\x4a\xea\xbb\x29\x92\x8d\x4c\x92\x84\x11\x12\xf9\xde\x75\x18\xf3\xb8\xcb\x7e\xf9\x06\xff\x06\x3f\x71\xc4\x76\x14\x74\x84\xae\xc4\x9a\x14\xe2\x47\xa8\x5c\x53\x1e\x68\x4d\xb8\xd6\xf4\x72\xbd\x58\x7b\xaf\x14\x94\x1d\x90\x23\x79\x25\xb0\x35\xfb\xd9\xdc\x26\xbe\xf6\x50\xe5\x01\x5d\xdd\x30\x7e\xa5\xa0\x13\xb1\x26\x92\xf0\x3a\x17\xfc\xe0\x43\xaf\x24\x58\xae\x39\x13\x79\x24\x33\xec\x88\x2a\xe8\x1a\x4b\x13\x54\xf9\x89\x9a\xb3\x35\x89\x2b\xd7\x1c\xf6\x40\x1d\x91\x2d\x63\x45\x8e\xc8\x80\x67\x07\x74\xa9\xd6\x38\x79\x07\x2a\x28\xb5\x23\xa0\x23\x92\xa1\x47\x54\xc1\xb6\xb0\x01\x2f\xd1\x4f\x90\x4b\x64\xe8\x1a\x5a\x10\x9f\x44\x90\xcc\xd6\x0c\xe2\x07\x67\x3f\xd5\x8b\x6f\xff\xca\xc8\xe1\x7f\xcf\xc8\xd7\x1c\xe9\x63\xe4\x18\x78\x04\x61\xe4\x63\xe7\x5f\xbd\xf0\xcf\xde\xff\x96\xa1\x7f\x7c\xc5\x3f\xeb\x1b\x8e\xa0\x14\xf4\x6f\x2a\x27\x01\x42\x08\x1e\xfe\x6f\x95\xf3\x0f\x39\x81\xfc\x9b\x9c\x80\x8e\x18\x04\x7d\xf1\x02\x3a\x12\x10\xfe\xe1\x05\x8e\x1d\xe1\xad\x1f\xfe\xd9\xff\xd7\xe7\xc6\x0f\x3b\xc0\xd7\xe3\x19\xc3\x8e\xd8\x9a\xaf\x12\x87\x23\xbc\x6a\xe3\xe1\x88\x6c\x55\x80\x23\x72\x20\x8e\xc8\xe1\x93\xb6\x22\x6b\x7c\x7e\x80\xb6\x5c\xfd\x48\x58\x30\xb5\xa6\xda\xab\xd1\xc0\xeb\x18\x8e\x46\xeb\xcc\x2d\xcb\x45\x0e\x9f\xb4\x6c\xcd\x00\x0f\x5b\x54\x8e\x1c\xb6\x82\x10\x76\x80\x0e\x6b\xf8\xb2\x25\xea\xc4\x61\xc3\xb7\xae\xba\xc2\xad\xa9\xca\x91\x3c\x1c\xa9\x1d\xfc\x55\x12\x59\x09\x81\x8e\x5b\x31\xe5\x08\x6d\xa9\x3a\x71\xc4\x77\x6b\xe6\x4e\x1c\xa1\x4f\x71\x09\x3f\x1c\xd1\x1d\xbc\xe6\xb7\xd8\x0e\x5f\xf3\xe9\x4f\xb5\x6d\xcd\x0e\x37\xdb\x58\x2f\xf0\x0d\xd1\x11\x5e\x33\xf7\xc3\xa7\x3a\x86\xec\xc0\xea\x40\xad\x71\x0d\x76\x44\xa2\x23\xb6\x75\x6f\xfb\xdd\x41\x1b\xd0\xc6\x06\xe2\xb3\xfd\x15\x2d\x74\x84\x0f\xf8\xd6\xbd\x26\x9a\xab\x15\x12\x3b\x78\x7d\xbf\x43\x77\x47\x74\x4d\x82\xd7\xa9\xf0\x0e\xdd\x28\x20\xb6\x2d\x61\x9f\x8a\x04\x7c\x24\x2a\x78\xab\xbd\x65\xd0\x11\x8a\x56\x52\x56\x2e\x41\x1f\x6c\x5b\x51\x0a\x5b\x19\xb2\xd5\xf4\x3e\xc5\xb4\x23\xb5\x54\xc8\x46\x47\x06\x1d\xb1\x2b\x42\xae\x81\x36\x12\x81\xbb\x95\x75\xe8\xd7\x2e\xd6\x30\x1c\xdd\x81\x1b\xf5\xeb\x5c\xf0\xc3\xa5\x4d\x78\xbf\xc6\xbf\xcd\x1d\x36\xf0\xad\xde\xb7\xc6\x95\x54\xf4\x1b\xb0\xad\x5a\xb1\x31\x7c\xab\x0f\x7c\x38\xb9\x12\x72\x58\x77\x18\x1d\x89\xe3\x9a\x5f\x6d\xd4\xad\xec\x5a\xa3\x45\x22\xfa\xd0\xfe\x91\x12\xbe\x09\xe1\x33\x65\xf3\xa0\x47\x68\xa5\x18\x3f\x6c\x88\xd0\x0f\xc9\x47\xec\xf8\x99\xf2\x61\x37\xbc\xbd\xf0\x55\x53\x3e\x68\xa8\x75\x2f\xab\xce\xac\xa3\xd4\x11\x5d\x71\xad\x34\x93\x1b\xc1\xd0\xb6\xc5\x95\x3e\x64\x07\x1e\x56\x6f\xbb\xaa\xe6\x4a\xd6\x46\xef\x61\x25\x72\xdd\xed\x77\x45\x38\x42\x4b\x05\x93\xab\xf2\xa1\xd1\xba\x5d\xe4\x23\xe6\x8d\x55\xd4\x71\x95\x27\xfe\xd9\xd1\x1f\x92\x82\x7c\x84\xb5\x22\xde\xb6\xf5\x29\x8c\x7c\x58\xfe\x25\x86\xef\x20\xe4\x3a\x6d\xeb\x5c\xd9\x91\x1d\x8e\xd4\x67\xcd\x0f\xd9\xc8\x61\x53\x60\x68\x2b\x1b\x52\x5b\x2d\x6a\xab\x70\x6d\x15\xb3\x75\x70\x3b\x0c\x56\x3c\xeb\xf9\xb3\x06\xe6\xc4\x8a\x11\xdb\x41\x1f\xcd\x47\xb7\x82\x01\xbe\x95\x71\xc8\x23\xa2\x22\x5f\x55\x4e\x3c\x3a\x62\xc7\xad\x46\xb3\x69\xe3\xc6\xe2\x2f\x46\x7d\xc8\x5b\x99\xbd\x49\x83\x5a\x89\x83\x3f\xc2\x5a\x99\xb4\x4a\xf6\xf8\xc9\x83\xa8\xe8\x48\x6d\xc0\xf0\x67\xce\xb6\x89\x9f\xfc\xdc\xbc\xc2\x46\x2c\xf6\xc5\x5f\xe8\x63\x97\x4b\x75\xc0\x8e\xc8\x4a\xc3\x76\xf4\xad\xfe\x61\x35\xc7\xd5\x38\x57\x95\xfc\x88\x19\xfb\x81\xec\xb0\xc9\x05\xfe\x81\x6d\x93\xdb\x91\xda\x7a\x3f\x0c\x5e\x0f\x65\xf8\x04\x11\xd1\x36\xf0\x91\xc3\x4f\x78\x74\x5b\x17\xfe\xa0\x41\x56\xe9\x6d\x98\x77\xdf\x7d\xc4\x66\x35\xe8\x0e\x8a\xd6\xdc\x7c\x15\xd0\x56\xa7\x83\x8e\xe8\x57\x4a\xb6\x5b\x61\x57\xe2\xe1\xe3\x56\x51\x83\x0f\xe8\x4a\x1d\xb6\x9e\xe6\x47\x68\xab\xc9\xad\xac\x81\x3f\xb6\xfe\xf1\x76\x2b\x6b\x37\x3b\x59\xbd\x05\xb2\x54\xeb\x04\xf2\x87\x21\x63\x1f\x69\xc3\x5b\x39\x15\x5a\x2d\x0d\x8b\x3e\xbb\xff\xc9\x8b\x8d\x49\x9b\x8b\x88\xb6\xe2\xe3\x46\x39\xfa\x31\xb6\x6d\xc5\x0d\xcb\x2a\xda\xc3\x86\xe8\xa3\x4d\xe8\xca\xd2\xd5\x0e\x56\xe6\x7f\xfe\xc3\x36\x35\xfa\x52\x87\x8f\xe5\xad\xcb\x60\xdb\x32\xe8\x16\x42\x90\x1f\x74\x2b\x05\xab\x8c\xa9\xdd\xe6\x26\x8f\x5b\x15\x75\x9b\x0f\xef\xd0\xef\x4a\xbb\xd5\x85\x37\xb3\x21\x3f\x85\x93\x4f\x2e\xbe\x7a\x35\xe4\xb0\xb9\x6d\xec\x63\x84\xd4\x91\xdc\x3e\xcf\xc0\x36\xc7\x8f\xaf\x24\xfe\x8a\xce\xc3\x8f\xfd\x12\x5f\x2a\xbe\xfa\xcd\xcf\x9e\xd0\x23\x55\x6d\xc9\x2d\xb2\x79\x32\x28\x5a\xd7\xfd\x78\x1d\xe8\xbb\x99\xfc\x74\x4e\xeb\x9e\xd0\x61\x75\x74\xeb\x6e\x3e\xe8\x56\x97\x02\x0e\x1b\x5f\x0f\xdb\xd2\x1f\x66\xaf\xbb\x86\x97\x8a\xd8\x7c\x01\x9a\x41\x9f\x9a\x07\xf6\x71\x93\x47\xe8\xa3\x8c\x9b\x42\x62\x9b\xd7\x3b\x40\x2b\xde\x5f\x91\x80\x7e\x57\xeb\xed\xbf\xdf\xb8\x9c\x55\x76\x2b\x9e\x2f\xf7\xf0\x51\x2f\x62\xdd\xf4\xd7\xce\xa8\xef\x0e\x03\xdb\x50\x91\x87\xef\x18\x37\xd6\xe2\x1f\xbd\xfe\xb8\x0c\x74\xb7\x2e\x8e\x7d\x3c\x28\xfe\xb1\x09\xe4\x7f\x3b\x9d\xfc\x4c\x47\x17\x15\xc2\x76\x18\x11\xfd\x5a\xf8\xc4\x66\x0c\xab\xf8\x36\x9a\x37\x25\xdd\xd8\x8e\x6c\x9e\x65\x3b\x40\xa2\x8f\x4c\xd0\x23\xf9\xe1\xe0\xe7\xb3\xa9\xcd\xbd\xed\x3e\x1f\xd8\x10\x87\x2f\xed\x25\x3f\x33\x3e\xa4\xae\x07\x6c\x04\x7d\x87\x25\xbf\x60\xa9\x1f\xae\x0c\xfd\x95\x2b\xfb\xe9\xe7\x37\x99\x11\xd1\x77\x47\x8f\xfc\x70\x3b\xe4\x26\xb1\xdd\x46\x36\xfe\x39\xe1\x90\xf5\xfd\xaa\xf6\xdf\x79\xad\x42\xf8\x0e\x07\x57\x67\xf8\x65\x0d\xf0\x61\xf3\x30\xeb\xba\xab\xda\x7e\x4e\xfd\xdd\x8f\x53\x11\xfa\x84\x08\xd1\x26\xe1\x23\xf4\xe5\xb6\x90\x75\x1f\x3f\xdd\xd7\xba\xbb\xaf\xf3\x69\xf3\xe2\xeb\xfa\x9f\xad\x1c\xc9\xef\x5e\x6f\x3d\x9a\xc8\x7f\x70\x1e\xdb\x89\xf2\xbd\x6f\xb5\xc5\x4d\x53\xbe\x8e\x96\x4d\xe7\xa8\x2f\x0a\xa1\xdd\x86\x6d\x55\x41\x15\xa2\x76\x38\xfc\x51\xbf\x75\xb9\xed\x48\xc2\x3f\xe2\xfc\x08\x19\xfa\x3a\x71\x3e\x3e\xfa\x6b\x1b\xab\x31\xad\x16\x81\x7c\x77\x74\xc8\xe1\xeb\x10\xfa\x7e\x60\x6f\x32\xfc\x72\xca\x5f\xec\xfe\xdd\xd4\x09\xf9\x99\x3a\xfd\x61\x80\x8b\xfe\x7b\x01\xee\x9a\x71\xe1\xd4\x27\xc0\x25\xd0\x23\x0c\x7e\x05\xb8\x18\x79\x24\xb6\x7e\xf8\x97\x6f\x24\x71\x24\xc0\xff\x6e\x80\xfb\xdb\xbc\xfe\x4e\x25\xf7\x3b\xfe\x07\x89\x3a\xf1\xdb\xc4\xf8\x4e\x26\xf1\x1d\xfb\x83\xc4\x1b\xfa\x6d\xf4\x7c\x47\x12\xfc\xfe\xfb\xd1\xf3\x11\x87\xe0\xdf\x16\x0e\xa2\x38\x4c\xc2\x3f\x4a\xd4\x7f\x0b\x8e\x44\xd1\x1f\xd5\x19\x08\xec\x77\xea\x06\x44\x48\xc6\x7f\x50\x07\x20\x7f\xa7\x8e\x11\x05\x01\x14\xfd\x51\x1d\x80\xf8\x6d\xfc\x1f\x46\x24\x19\xfe\x01\xf9\x14\xfc\x5b\xf8\x20\xc1\xa3\xe0\x8f\xe0\xf1\xdf\x49\x46\xee\x68\x4c\xfd\x3e\xfc\x9f\x59\x37\xc8\x0e\x30\x71\x85\x7e\x5f\xef\xd1\x7f\x59\x32\xf8\x87\xd9\xff\xd5\x6a\xc1\xf7\x8e\x95\xda\x47\x93\xd7\xbf\x7c\x0b\x93\x21\x29\xff\xa9\xb0\xf8\xdf\xab\x2a\x36\x7f\x97\x05\xff\x2e\x0b\xfe\x5d\x16\xfc\x6b\x95\x05\xd7\x0c\xeb\x4f\x2b\x0b\xe2\x5b\xbe\xb7\x46\x7d\xd4\x91\x8c\xc0\x03\xbc\xdb\x8a\x7d\xc4\x91\x3a\x7c\xf2\x60\xe8\x7b\x8c\xb5\x25\x5d\x6b\xbc\x43\x7e\x02\xaf\x35\x7a\x5f\xc3\xfa\xaf\xb2\xc0\x27\x2f\x84\xd7\xf0\x83\xdc\xa1\x5b\x8c\xb8\x5b\x63\xa9\x35\xfb\xa2\x76\xc4\xf6\x31\x3d\xf1\xb9\x61\x68\xbb\xf9\x80\xd8\xa1\x9f\xfb\x79\x76\xc4\x16\x83\x21\x5b\x98\xb1\xc3\xb6\xf8\x1e\xdf\x7d\x72\x84\x0d\xf8\x80\x7e\x26\x1f\xbe\x90\xb4\x6b\xb2\xb2\x86\x24\xc4\x27\x4f\x8a\xb6\xf0\x74\xfd\x83\xbf\x6e\x59\xf8\x00\xa3\x4b\x85\x6e\x11\x4b\x04\x6e\x85\x10\x6c\xb7\x06\x51\xf8\x6e\xbb\xb3\x68\xf7\x3d\x67\x5d\x83\xe6\xad\x6d\xb7\x90\x19\xdf\xea\x23\x44\xb4\xa5\x71\x5b\xe8\x0a\x6f\x5b\xc7\xb7\x7b\x5a\xc0\x4f\x60\x8b\x1d\x3e\xc9\xcd\x9a\x7d\x40\x5b\x58\x04\x7f\x22\xdf\xad\x9f\x68\x0f\xc8\xee\x88\x1f\x36\xac\xd1\x57\x9a\xbf\x85\xf1\xbb\x0d\x68\xb7\x25\x06\xf0\x26\x34\x70\x2b\x91\x1e\xb6\x12\xe9\x57\x15\x95\xf8\xbb\x8a\xfa\x77\x15\xf5\xef\x2a\xea\xdf\x55\xd4\xbf\xab\xa8\x7f\x57\x51\xff\xae\xa2\xfe\x5d\x45\xfd\xbb\x8a\xfa\x77\x15\xf5\xef\x2a\xea\xdf\x55\xd4\xbf\xab\xa8\x7f\x57\x51\xff\xae\xa2\xfe\x5f\x58\x45\x7d\x22\x7f\xad\x6f\xa5\xd7\xd3\xcf\x1f\x58\x22\xa9\xcf\xd3\xa8\xdf\x01\xe1\xb9\xd3\xd3\x6a\x05\x5f\x0a\xaf\xc6\x9d\x32\x79\x81\x39\x15\x3e\x63\x4a\xfa\x39\x43\xc7\xb7\x86\x70\xed\x0d\x55\x75\x51\x27\x43\x60\x74\x58\xe9\xc4\x8f\x18\xef\x83\x23\x88\x36\x94\xa4\x44\x26\x2f\x56\xe3\x35\x0d\xbc\x30\xf4\xe2\x3a\x86\x43\x4f\x9b\xf4\x20\x13\xe7\x68\x49\xc2\x6e\x79\x2f\xc8\x90\x50\x14\x81\x82\xae\x69\x9e\x0a\xb7\xc6\xa2\x24\xec\xd1\xe8\x84\x5b\xaa\x4f\x24\xe0\xc3\xa4\x4f\x6f\x2a\x42\xdc\xa9\xbd\x0e\x51\x52\x55\xe1\xdc\x52\xb3\x8d\xef\xfb\xd3\x99\x6c\x02\x3d\xb9\xcf\xcf\x58\xe7\x38\xcc\xaf\x32\x6c\x84\xf1\x51\x2d\xf8\xac\x21\xcf\x1d\xd7\xf5\xfc\xe5\x26\xb0\xef\x41\x03\xd0\xfe\x7c\xd3\xc4\x13\x68\x63\x14\x87\x91\x1e\xc0\xc2\x44\x20\x68\xb4\x82\x78\x5d\x56\xb5\xe3\x88\x89\xec\xac\xa1\xfb\x7e\xe9\xf7\x17\xa5\x33\x5a\x47\x9f\x74\x63\xc2\x6f\x2a\xdd\x18\x0f\x86\x1e\x89\xcb\x3e\xe4\xaf\xac\xaa\x1a\xe9\x6b\x4e\xdb\xbd\x22\xb4\x50\xb6\x8f\xc5\x98\x0c\xeb\x00\x34\x2a\xef\x42\x5d\x14\xfc\xec\xa7\x23\x3d\x5c\x84\x9b\x2c\x37\x33\x72\x45\x51\x36\xd5\xee\x03\xe1\x4f\x64\xa3\x9c\xf6\xa5\xc3\xa7\xc3\x22\x90\x7e\x9c\x2d\xfb\x1a\x85\xda\x9a\x16\x4d\x11\x4b\x1d\x7e\x64\xcb\x25\x3b\xc5\x43\xd7\x09\xd3\x5d\x9b\x7d\x19\xe4\xeb\x73\xb3\xc8\x32\x96\x88\xd1\x1d\x7a\x9d\x1a\x48\xbc\x4c\xdd\x63\x94\xf6\xcf\xfb\x50\xa1\x29\xe0\x42\xaf\xee\x9c\x26\x6f\x9f\x87\x2d\xef\x6c\x49\x0a\xb5\x77\xc1\x47\x01\x56\xac\x95\xd1\x13\xe7\xa5\x86\x9c\x86\x9a\x37\xdd\x5e\x08\x36\x18\x32\x9b\xcb\x46\x61\x39\x5e\x1c\x41\xfb\x34\x8a\x32\x48\x38\x89\x7a\x25\xf7\x52\xca\xb0\x71\x84\xe3\xaf\xb3\x71\x52\x45\x6a\x0f\x69\xd2\xd3\x99\xcb\x69\x3a\x75\xbd\x8b\xa7\x7c\x6e\x4b\xfd\x5e\x43\xe7\x47\xec\xa6\xdd\x0c\xce\x76\x15\x6b\x0f\x61\x89\x46\xf9\xe1\x83\xaa\x43\xa8\x4a\xb7\x97\x1e\x8d\x15\xc4\x10\x2e\x34\xd2\x84\x84\x00\x0d\x72\x37\x42\xf0\xbd\xba\x8d\x9f\xf4\xc3\x51\xb9\xcb\x43\xc2\x16\x3d\xbd\x37\x4c\x6b\x36\x71\x96\x89\xd9\xed\x74\x67\x18\x07\xa8\x45\x5a\x34\xbb\xc9\x95\x2e\x29\x56\xda\xb0\xd6\xbd\x25\x2c\x56\x40\x8b\xc1\xe4\xf3\xa2\x34\x71\xd9\xbc\xbc\xe0\xc2\x07\x9d\x2e\x2e\x9d\xfc\xc2\x52\x11\xb1\x30\x4e\x7b\xf4\xb4\x8c\x69\x0f\xf9\x7c\xd7\x7d\x59\x02\x9b\x82\x29\xac\x38\xa9\xd8\xdc\x4a\x2d\xe0\x5d\x18\x9a\x62\x25\x7a\x37\x31\x0f\x54\xb1\xd8\xf1\x2e\x74\x3c\x58\xbc\x5f\x2f\x8f\xb8\x5d\xd5\xba\xa7\xbc\x52\xf5\x66\xb8\x24\x54\x1e\x8c\x17\x1a\xc9\x69\xcd\x98\x7d\x7c\x70\x27\x16\x87\x1c\x1f\x85\x16\x7b\xd6\x22\x3f\xc3\x01\x83\xd5\x3c\x63\x58\x72\x2c\x36\x11\xaa\x3e\x5d\x5f\x10\xe3\xcc\xbd\x2a\xd2\xb4\xd9\x0a\x78\x17\x86\x09\x9a\xcb\x1c\xf4\x26\x2e\x15\x96\x0b\x4c\x5c\xb9\x90\xd5\x72\xf7\x52\xc9\x7b\x06\x6f\x3d\x0a\xdc\xd3\xb4\xc1\x9d\x68\x97\x88\x5d\xd0\xaf\x97\xf4\xcf\x7d\xb4\x4d\xf5\x44\xff\x62\xbe\x83\xf9\xf1\x68\x9b\x82\xa2\xde\x20\x44\x3b\x13\x41\x3d\xdb\xb7\xfd\xee\x1f\xd7\x2e\x8c\x92\x20\xac\x6f\x2d\xca\xf9\xe6\xc9\x6a\xd4\x0c\xe7\xd2\x17\x41\xbc\xeb\x3d\x7d\x6f\x68\x60\x2f\xdd\x51\xa6\x29\xf6\x2c\xc8\xde\xa7\xab\xc9\x31\xd9\x5e\x82\xb5\xd7\x6d\x79\x3f\x2d\x88\xbb\x0e\x61\xe7\x20\xd6\x59\x8f\x2a\xd2\xb2\xca\xf6\x5c\x26\x51\x5b\x53\xb1\x4d\x20\xf5\x85\xc4\xae\x00\x43\x9d\xc0\x13\x41\x5e\x94\xb8\x03\x14\xae\x34\xa0\x5e\xf5\xe7\x50\xa2\x53\x89\xaa\x29\xea\xe2\x4e\x4d\x42\x22\x75\x91\x2f\x54\xc6\x71\x41\x82\x20\xe0\x79\x98\x14\x94\xbc\x60\x90\x37\x55\x40\x52\x6b\x9d\xac\x87\xd7\x91\xce\x40\x3e\xc2\x0b\xff\xe9\x05\x1d\xa5\xd7\x25\x2c\x32\x2c\x08\x9f\xde\x0f\x15\xf3\x89\x0c\xd5\xc9\x3d\x21\x74\x92\x70\x3d\xa3\xd4\xe5\x3d\x39\x75\x49\xf4\x91\x14\xdf\xb3\x61\xcf\x48\xdc\xb0\xbf\x33\xd1\x83\x78\x02\x9d\xa7\xea\xef\x16\x36\xbd\xab\x3d\xa2\x17\x0f\x76\x6a\xc1\x7e\x7b\x6c\x18\xfa\x44\xf7\x54\x5b\x1a\x42\x04\xce\xe2\xe8\x8b\x81\xa6\x67\x5e\x74\xce\x4a\x0b\xea\x7a\x46\xb1\x05\x6f\xb5\xfa\xbb\x9a\x26\x7e\x81\xcb\x77\x80\xbc\xeb\x27\xdd\x21\x22\x1e\xc4\x69\x6c\x64\xcc\xb3\x4a\x94\x42\x68\x62\xad\x6a\x1f\xcf\x8b\xde\x78\xcc\xdb\xb0\xb9\xa8\x12\x04\xcd\x22\x72\x44\x35\xb9\x65\xd1\xd9\x11\x18\x86\x80\xcc\x0b\x67\xcf\x5e\x8c\xa9\x82\x50\x86\xc9\x86\x73\x74\x52\xc4\xa7\xa8\xdb\x17\xfa\x3c\x4d\x89\x12\xbe\xcd\x94\x2e\xfc\xfe\xc1\xc4\x27\x54\xc4\x1a\xf7\xec\x7a\x8e\x0b\x51\xbe\x82\x7a\xaf\xbe\xa6\x55\x21\xab\x5f\x10\xf3\x72\xfd\x34\xf0\x21\x95\x3e\xd1\x96\x71\xc9\x1d\x0a\x5f\xe4\xa5\x70\x08\xbc\x1a\x93\x2c\xb8\x0c\x13\x9e\x51\xbd\x3a\x10\xb2\xa0\x21\x66\xf3\x54\xd0\xc6\xa6\x25\xf4\x64\x66\xe8\x68\x95\x08\x45\x4d\x65\xb0\x07\x41\x3f\x34\x91\xf9\x2d\x2d\x2a\x65\x2d\xd6\x2b\x3d\x9f\xeb\x46\x8e\x8a\x52\x7c\x9f\x40\x92\x8d\xce\x8f\x9a\xe9\x14\x85\x6d\x2d\x5e\x14\x9f\x96\x7b\x1e\x4e\x1d\x27\x6b\x8d\x90\x3f\x9f\xed\x19\xec\x4b\xa9\xe5\x38\x87\x65\x69\x5b\x2d\x19\xa3\xd0\xfa\x20\xe3\xe7\xfc\x66\x47\x4a\x5b\x09\x8c\x1c\x18\x53\x70\x29\x30\xc4\x2e\x4f\x89\x5c\xa8\x04\x48\x3f\xe8\xa6\x61\xd3\x8c\xb9\x14\x54\x2b\x77\xd2\xf0\xea\xf4\x97\x85\x0a\x4c\x60\xd6\x67\xcb\xf4\xc8\xee\x01\x73\x97\x0c\x29\x05\x06\x33\x46\xf8\xa6\x09\x32\xa6\x98\x0d\x3e\xa2\xad\xdc\xc1\x69\x4a\x2f\xf3\x98\xc7\xb4\xaa\x01\x54\x2f\xe9\xd6\x2d\x2d\x19\x85\xbb\x60\x82\x80\x54\x26\x87\x8c\x27\x9e\x7a\x5d\xcd\xa1\x39\xbd\x9d\xe7\xbd\xde\x3b\xbc\x21\x83\x00\x61\x65\xdd\x18\x36\xa7\x33\xfe\x88\x4f\xa4\x79\x9b\x2c\x16\x23\x32\xeb\xca\xdb\x21\x91\x60\x00\xdc\x83\xc2\xf4\xd2\x4f\xe7\x66\xdc\x93\xf9\x0d\x2d\x7d\x1f\x36\xc4\x1e\xc9\xe3\x4c\x9a\x2e\x84\x4f\x3c\x49\x9a\x1e\x69\x85\x15\x6f\x32\xaf\x33\x22\xfb\xa7\xfb\x8a\xbf\xd8\x53\x77\x7f\xc6\x19\xed\x8f\x1f\x72\xec\x43\x33\x2e\x5f\x55\xed\x30\x61\x13\x80\x4e\xe2\x18\x5c\xee\x88\x2c\x0b\xed\xcf\xd5\x3b\x9b\x1a\xaf\x03\x52\x66\xf0\xce\x39\x81\x4a\x77\x94\xbe\x82\x3e\xd2\x6a\xb2\x00\xfa\x4e\x20\xb1\xaf\x46\xba\x3f\xd2\xab\x95\xf1\xb9\x28\xa0\x1d\x77\x89\x2f\xc0\xfd\x92\x5c\x02\x4b\xee\xf2\x04\x13\x6f\x3d\xf1\x5c\x16\x24\x01\x22\x62\xe9\x64\x51\x0a\x24\x74\xa8\xa9\xe7\x1e\xd0\x91\x0c\x4d\xd0\x00\xd7\x07\x9c\x6f\x0c\x86\xcb\x67\x42\xc7\xde\x11\xa4\x43\x5e\x87\x7b\x3a\xa9\x62\xc9\xc3\x6b\x70\xe7\xbc\x8f\xdd\xb0\x4f\xaa\xc4\x16\xe3\xe7\xa0\xc5\xb9\x96\x50\xfe\x60\x28\x09\xca\xe4\xa8\x5e\xd9\x4f\xb3\x9a\x5f\x64\x45\x40\xef\x27\x81\x32\x93\x2c\xc0\xdd\x28\x9d\x06\xba\xd9\xdf\x81\x12\xb2\x61\xff\x09\x2e\x51\x72\xca\x97\xfb\xe5\x16\x00\x93\x59\xdf\x19\x33\x13\x51\xd6\x78\xd1\x7e\xde\x30\x6e\xe2\x71\x33\x4d\x72\x39\x9e\xe4\x50\x94\x78\x2c\xe4\x31\xe3\xdb\xf6\x4e\xfa\x09\x1e\x5c\x3c\x8a\x18\x86\x51\x25\x9c\x69\x2d\x87\x37\x96\xa0\x10\x15\x7c\x62\xc0\x6e\x78\xcc\xcc\x64\x6a\x53\xcc\x76\x11\xec\xdd\x49\x93\xb2\xe7\xc0\xb5\xb9\x4b\x0a\xdb\x8f\xfb\x35\x1e\xf1\xec\x25\x35\x3c\xc5\xc0\xe2\x54\xf0\x9c\x5b\x2a\x27\xa2\x45\x93\xae\x4b\xe0\xc2\x7a\x82\x0d\x67\x26\xcb\xdb\x18\x59\x49\xa2\x91\x46\x3c\xbd\x41\xa0\x92\xd5\x8a\xc7\x90\x57\x03\x23\x2e\xba\x1f\x31\x6b\x6f\x60\x4d\xd6\x84\x94\x87\x74\xe9\x29\xbd\x90\x8e\x62\xd0\x2c\x4b\xa4\xea\x44\x55\xb2\xa1\x23\x20\xef\xe2\x15\x7f\x7a\xfa\x39\x39\x45\x67\x3e\x5e\xd4\x3e\x7a\xdc\x0b\x39\x7a\xdb\xf7\xea\x55\xe5\x82\xcf\x4c\xac\x00\x48\xf1\x3e\x0d\x66\xf9\x3c\x36\xc6\xe3\x14\xbf\x69\x6c\x40\x4f\x32\xc1\xbe\x50\x54\x7e\xdc\x34\xf7\x19\xa4\xc1\xeb\x49\x18\x34\x5b\xb4\xe0\x89\x5a\x6a\x17\xc1\xb1\x3c\x80\x51\x6e\x69\x78\xae\x59\x2e\xe7\xf0\x44\x26\xd7\x4e\xb4\x32\xef\x64\x8f\xda\x3b\x88\xea\x56\x24\x5e\xb8\xb2\xb4\x7c\x1f\xd0\xa5\xa6\x57\x54\x46\x70\x96\x75\x7d\x28\x33\xab\x16\xcf\x27\x27\xed\x45\x0b\xb6\x3a\xc3\xa5\x21\xb3\x94\x1b\xd0\xc3\xf0\x1a\xf6\xf6\xb8\x71\x2a\xaa\x82\x0b\x8a\x49\xa0\x5c\xf5\xfa\x72\x67\x79\xdf\x02\xcc\xbb\xcb\xfc\xfb\xe0\x2e\x90\x93\xee\x17\x87\x8b\x1e\xaa\xae\x56\x60\x86\x3c\x27\xfe\x21\x54\x01\xc2\x86\x52\xcf\xf6\x31\xd1\xa8\x11\xa8\x28\xd0\x2d\xad\xf8\xf2\x29\x9e\x3b\x17\x61\xf6\x10\x76\x92\x44\x4d\xb2\xdf\xaa\x2f\x95\x97\x13\x91\x83\x0e\x0a\x69\x6f\xa9\x36\xf9\xb9\xf4\xc3\x1e\x35\xb1\x97\x11\x1a\x4c\x40\x67\x14\x76\xbf\xca\x37\xb6\x7e\x1a\x6c\x1d\x52\xdc\xdb\xbd\xcd\x6f\x93\x37\x2d\x5c\xe3\x12\x67\xdc\xcf\xa7\xc0\xcb\x88\x77\x87\xb2\x28\x43\xce\x7b\x6a\x28\xc6\x9a\x28\x46\x59\x33\x9f\x5c\x13\x50\x41\x2e\xc8\x8c\x5f\x0b\x7b\x33\x32\xf9\x97\x01\x27\x50\x85\x98\x24\xc3\xa4\xf4\x4c\x15\x0b\x35\xb7\x2c\x8f\xff\xc9\x8f\xd0\x6b\xe2\xbf\xd8\x8f\xc0\xe6\xd8\xcf\x1c\xa5\xf8\xfc\x02\x07\x50\x85\x3d\x22\xdc\x79\x48\x36\x4d\xe7\xe9\xfa\x59\x42\x2b\x1a\xe3\x98\xce\x75\x26\xa2\x99\x72\x8a\x0b\x2e\xbb\xc0\x5e\x09\x3b\x50\xd2\x5b\xa1\xcf\xd1\x54\x61\x42\xe7\x25\x04\x4e\xef\xf1\xb6\x23\xb3\x7e\x00\x3e\x2c\x6c\xbe\xc1\xb7\x0b\x86\x11\x21\xa6\x56\xb6\xf7\x4c\x2b\x4e\xaf\xef\x97\x88\x78\x2c\xb5\x2a\x4a\x9a\xaf\xe7\x60\x9c\x60\xce\x50\x3d\x2f\xe6\xf4\x7c\xf0\x40\x11\x6a\x2f\x17\x04\x31\xee\xda\x93\xb6\x3b\x42\x27\x02\x23\x6f\xf7\x42\x48\xb5\xf4\x5c\x75\xf3\x64\xe9\xe6\xe3\x2c\x3d\x40\xc3\x76\x01\x93\xcb\x7d\xd1\x13\x43\x82\x2b\x11\x18\x48\xbc\xf7\xb5\x8d\xc1\xd4\x62\x27\xaa\xca\x5f\x2c\xd8\x34\x6c\xfc\xc4\xa9\x14\x33\x80\x0c\xbf\x28\x1a\xd8\xcd\xd8\x5c\x4b\xc5\xb8\xa8\xa3\x33\x66\xb0\x30\xaa\x71\x14\x72\x39\x7b\x33\xbc\xb2\x78\x09\xec\x93\x6f\x74\x1b\x18\xf3\x0c\xf2\x1f\x8a\x54\xd3\x5a\xc4\xed\x01\x27\xa0\xc2\x1e\x19\xcf\xa5\x38\xbc\x99\x1b\x5b\x25\x1d\xbc\xb7\x70\x95\xc7\x52\xb4\x11\xcb\x1b\x45\x03\x12\x01\x7a\x2c\x97\x65\x5c\x32\xe9\x9a\x2d\x54\xed\x78\x66\xc6\xa8\xee\xbd\x42\x20\xf1\xab\xa5\xdd\xa6\x6b\x0f\xf6\x85\x62\x89\x35\x13\xbd\xca\x5a\x43\x4f\x0d\x6b\x48\x81\xd4\x4b\x03\xa1\x38\x4d\xa3\x5e\x52\x83\x78\x11\x98\x42\xd3\x21\x46\x31\x54\xca\x32\x27\x4b\x4e\x5f\x49\x65\xbe\x29\xb4\x52\xe5\x8a\x83\x51\xf6\xae\x92\x75\x48\xd8\x27\x5f\x34\x34\xe5\x49\x17\x4e\x11\x85\x38\x7e\x9e\x45\x46\x10\x78\xd7\xbc\x2a\x18\x91\x0a\xfc\x09\x7b\x3d\x48\x65\x1a\xa3\x00\x7e\x59\x08\x7f\x0b\xfa\xb4\x5a\xe8\x11\x46\x63\x13\xf0\x8c\x74\xec\x5a\xd6\x62\x1e\xba\x14\xbb\xa1\xd3\xf0\xb0\x73\x56\x98\xfa\x4a\xd2\x11\x10\xe5\xee\xa9\x95\x29\x20\x2d\x5d\xa5\xe6\x70\x19\x1e\x52\xbd\x5d\xda\x4b\x7c\x31\xe2\x7b\x62\x40\x11\x60\x67\x72\x63\xa7\x5c\x4d\xd1\x88\xec\xf0\x1c\x80\xc1\x31\x8a\x4d\x51\x76\x6b\x87\xe6\xcd\xe1\xcc\x1d\xb8\xe1\xe0\x3b\xbf\x22\x06\x75\x03\xc0\x30\x14\x1d\xa8\x6f\x9e\xbe\x58\xdc\x79\xd3\x32\x90\x6b\xd8\xaa\x63\x09\xbe\xca\x57\x23\xd1\x66\x65\x35\xba\xef\xb9\x33\x18\x37\x6f\x82\x52\xee\x8c\xca\xf3\x31\xf9\x10\x5b\xa0\x37\x10\xbf\x24\xf4\xb3\xa0\x09\x61\xcf\x7b\xf9\x15\x7e\xe7\xec\x9d\x24\xef\xcd\xe0\x65\x4c\xd8\x00\xc3\x4b\x47\x3a\x10\x06\x6e\x7e\x75\x16\x5e\x76\xb1\xf8\x10\x0e\x8a\x0d\xd5\xf3\x6a\x28\xc8\x64\x66\x02\xda\x3b\xf6\x3a\xa1\x77\xae\x55\x5d\xcd\xd6\x55\x4c\x2f\xe8\x80\x2a\x2a\x4d\x3f\xcc\xb4\xba\xec\xad\xf3\x39\xff\xd3\x6d\xfa\x2f\xf6\x34\xbc\xa4\xfb\xf9\xf3\xad\x0f\x48\x3a\xf1\xbc\x03\xe4\x44\x4f\xdc\x60\x24\x21\x3b\x31\xad\x13\xd1\xd0\x47\x24\xbb\x71\x45\x91\x19\xb9\xd5\x8f\xaa\x61\xf2\x61\x80\xf6\x4e\xfa\xbc\x96\xec\x7b\xe0\x8d\xac\x7f\x17\x6c\xe3\xbe\x22\xc3\x00\x1b\x9b\x0e\x7c\x59\x7b\x9d\xe7\x54\xe4\xc7\xf4\xc4\x5a\x0c\x2e\xd7\x35\x91\x78\x51\xec\x01\x62\x15\x9f\xae\xba\xeb\x0b\x3a\x92\x8e\x04\x09\x11\x08\x30\x54\x83\x5f\x18\x46\x4b\x8f\xda\x10\x15\x2f\x0a\x77\xea\x59\xe2\x33\x02\x88\xd1\x8e\xe8\x9d\x18\x20\xe7\x3d\x89\xe9\xfb\xd0\xb3\x3c\x34\x7d\x17\x99\x84\xd7\x67\x5f\x77\x09\xe5\xed\x3d\x19\x19\xf6\xa7\xdc\x6a\xac\x2c\xeb\x51\xa6\x7f\x41\x9a\xde\xdc\xb9\xe4\x15\xbf\x6f\xc8\x0d\x8d\x4a\x82\x43\xcf\x88\xd3\xd1\xd8\x75\xbe\xd2\x65\x75\x6e\xde\x3d\x45\xdf\xe1\x1b\xfd\x84\xca\x88\x35\x90\xfa\x95\xe6\xbd\x5c\x85\x3e\x52\x02\xcc\x69\xaf\xd6\x27\x95\x12\xaf\x8a\x0e\xba\x23\x22\x6b\x34\x82\xb0\x46\x63\x18\xb5\x42\x22\xa8\xf0\x20\x50\xdd\x11\x0b\x5a\xe3\xa5\x82\xb5\xac\x36\x20\x53\xe3\x95\xfa\xb6\x14\x31\xa0\x7e\x6d\x3d\x06\x81\x48\x51\xaa\xcd\x9c\xbd\xba\x6c\xf1\x34\x05\xba\xdd\x3f\xb0\x54\x2e\x69\xb4\x83\x29\x31\xcd\xd0\x18\xec\x40\x54\x90\x3b\x4a\x37\x5e\x02\xea\x08\x69\x5f\x59\x3c\x4d\xd2\xcf\x24\xe0\xcb\xbc\x74\x43\xdf\xbc\xce\x40\xd9\xd6\x2d\xa0\x41\x06\x53\x4c\x1d\xaf\x09\xe8\x63\xd2\xa7\x28\x8d\x18\x88\xbd\x5d\x2f\xef\x41\xed\x12\x19\x93\x0c\xae\x3b\x9f\x25\x31\xdb\xef\xf5\xc8\xa3\x72\x7f\x74\x1f\x9e\x8c\x10\xfb\x41\x07\xdd\xd8\x2f\xc0\x53\x47\x51\x8f\xce\x23\xcf\xf3\xe8\x0a\x85\xc1\x66\x23\xda\x45\xfb\xe8\x16\x0d\xa5\x40\xbc\xd5\x25\xd9\xf7\x61\x38\x69\x28\x78\x57\x83\xd2\x54\x39\x1d\xc0\xbb\x31\xc8\x9d\x44\x26\xc6\xf2\x06\xda\x1c\x18\x60\x99\x62\xbb\x53\xb3\x1a\xaf\x2c\x3f\xdf\xaf\x93\x7c\x6e\x4b\xbb\x9d\x61\x90\x6a\xe1\xb1\x56\x05\xc6\xf6\xb5\xdb\x23\x02\x1a\xa5\xa3\x52\x7d\x50\x2e\xd3\x3e\x9b\xae\x67\x49\xb6\x60\xdf\xc5\x9e\x6c\x68\xcd\x67\x5b\x54\x65\x82\xc0\x2e\xe4\x75\x66\x46\xee\x1c\xc3\x8a\xcd\x95\x80\x87\xe5\xe4\x80\x58\x84\x76\x1d\xa9\xe1\x1c\x62\x50\x27\xd9\x1c\xef\x8c\xbc\x48\x6b\xec\x04\x03\x6f\x8f\xe7\x05\xe1\xcf\x7c\xba\x7d\x13\x77\x7f\x31\xbb\x7b\xfe\xb0\xbb\x62\x81\x24\x09\xe2\x8d\x09\xd8\x13\x6c\x80\x9f\x8b\xd7\xec\xe7\x67\x32\x92\xf9\x27\x6d\x84\x8c\x21\x70\x15\xd8\x5e\x2f\xad\xc0\x58\xd4\xd8\x61\xf2\xc5\x4e\x79\x11\xeb\xc6\xe9\x5a\xde\xf6\x1e\x5f\xcb\x5a\x2e\xa5\x99\x2f\x4f\x13\x56\x8f\xfc\x53\x92\xbc\x20\xb7\xe8\x54\x16\x2b\x35\x07\x63\x18\xe9\xc9\x3d\x62\x13\x97\xe0\x76\xe6\x55\x45\xa4\xaa\x4a\xaf\x35\x60\x84\x06\xc6\x69\x9e\x8a\xae\x69\x99\xe9\x7a\xa7\xcb\xcc\xa5\x60\x3d\x91\xb0\x9d\x9f\xbb\x9b\x5e\xea\xd7\x46\x7b\xde\xbb\x08\x8e\xec\xa6\x8b\x4e\xdd\x34\x81\x78\x11\x7b\x4f\xcb\x00\x98\x61\x91\x79\xeb\xb6\x48\x5c\x45\x2f\xf8\xbc\xd7\x9a\xc7\xe5\xf1\xe4\xd4\x5b\xfe\x32\x75\x29\xd5\x1a\x61\x76\x55\x92\x7b\xcb\xce\x95\x08\xa6\xcb\x3e\x8d\x9e\x68\x4a\xd0\x4b\xf5\xea\xe9\xf8\xaa\xbd\x2e\xa9\x02\x2f\xb0\x84\xe6\x31\xc4\x80\x63\x9a\xcc\xe9\x65\xee\x80\xb7\xa2\x60\x61\xaf\x8c\xb4\xc4\xa5\x34\x15\xfb\x7b\x65\xcf\x15\x3d\x67\x5c\xd8\x77\xa1\xa6\x4c\x6b\xa6\x65\xa4\x5e\x5e\xb4\x38\x4a\x6d\xa7\xa6\x24\x5d\x88\x6d\x7f\x53\x35\x47\x35\xd5\x42\x79\xf1\x7b\x43\x2c\x9b\xb4\x1c\x4a\x9a\xe8\xa5\x0b\x2d\x0f\xb7\xe0\x61\x82\xaf\x73\xe1\x3c\x00\xef\x94\x80\x8c\x2d\x45\xe5\x55\x96\xcb\xf7\x5d\x6e\x1f\xd4\x8b\x26\x53\x1a\x1f\x5f\xd6\x79\xd1\x03\xaf\x7c\x13\xc5\x0c\x00\x5d\xac\xf0\xce\x3d\x18\x5b\xe6\xc1\x4f\x8e\x20\x5e\xdd\xd0\x26\xe8\xc9\x0a\x50\xf8\x04\x2b\xea\xfd\xc2\x55\x43\x8c\x8c\x72\x48\x98\xb4\xaa\xf8\xa3\x4a\xd6\x5d\x4b\x4e\x67\xe0\xc9\x27\x04\x80\xe8\x95\x0e\x27\xe2\x80\x70\x7b\xaa\x6f\x43\x04\x73\xc1\x50\xd2\x1a\x9a\x30\xab\x87\x67\x9d\xda\x13\x78\xc7\x6e\x40\xe5\x58\x37\x50\x7a\xc3\xe7\xaa\x65\x3a\x8e\x04\x5b\x12\x78\x8b\xf3\xe2\xb6\x8a\x60\x73\xd8\xec\x18\x6c\x78\x77\x98\x53\x49\xc5\x08\xa9\x0c\x45\xcc\xd9\x9e\xd4\x9f\xae\x4c\x03\x16\xfb\x30\xec\x40\xfc\x75\xd9\xcf\xb4\x2e\x7a\x63\x02\x44\x34\x3a\x5e\x28\x5d\x43\xd1\x87\xcc\x89\x04\x28\x36\x7d\x2a\x2d\x39\xdc\x19\x20\x58\xb5\x04\x80\x94\xcf\xb1\x66\x98\xf4\x71\x43\x08\xb9\x88\x21\x2b\xcb\x3a\xcc\x5b\x18\x49\x28\x81\xfe\x2e\x74\x75\x6e\x03\xa5\x90\xd2\x3c\xed\x00\x40\x8a\x17\x43\x2c\x32\xea\x9f\xfa\xa4\xb6\xa6\xfb\x8b\xfd\xfc\x72\x74\xfe\x69\x77\x6d\x3b\xd0\x10\xef\x4c\xef\xfd\xed\x51\xc4\xe1\xfb\x2e\xc8\x57\x0e\x82\xf9\xb7\x6f\x99\xbc\xe2\xc3\xf7\xe2\xb4\x67\xd4\x56\x89\x8c\xc0\x1a\xdb\xb7\xb5\xdc\x72\x94\xe0\x1f\x67\xde\x28\xf8\xcc\x2f\x84\x32\xf3\xad\x6b\x27\x3c\xe3\xd7\xe5\xf5\x2c\x08\x88\x28\x91\x87\x7c\xab\x72\x76\x8f\x55\xb7\x72\x19\x80\x96\xa0\xb0\x71\x61\xfb\x28\x32\xea\x68\x41\xfc\xc4\x64\x1e\xa8\x7c\x5a\x40\xc0\x7c\x5f\x6e\x09\xac\xb5\x37\x5d\x87\xc1\x06\xc6\xb0\xc5\x40\x78\x7a\x5a\x78\x9a\xdd\xe3\xfd\x62\x8f\x10\x22\xa2\xb6\x60\xd0\x41\x41\x03\xac\xdb\x49\x7e\xe8\x83\x32\x73\x79\x4f\x84\x34\x8c\x9c\xf1\x2a\x4f\x57\xa8\x41\xfc\x49\xb6\x2f\xc3\x4c\x3b\xc3\xdd\x60\xef\xaf\x8a\x46\x12\x34\x2f\x66\x0b\x58\x4c\x5a\x1e\x2e\x4d\x47\x24\x8d\x75\xe2\xd3\x9b\x74\x7a\xb3\x77\x83\xbe\x33\x2f\x26\x90\x0d\x83\x93\xad\xc8\xa0\xf9\x29\x26\x93\x8a\xa1\xb5\x74\x0f\x3b\x30\x3b\x68\x6a\x51\xa4\xd6\x8b\xa9\xde\xec\x24\x30\x64\xd5\x3f\x92\xe6\xcc\xb8\x23\xff\xa2\xcd\xa8\x6d\x65\x9b\x3e\xf3\xe2\xbb\x0a\xc4\xf7\xe3\x25\x43\xcb\xa0\x15\xf5\x09\x65\xba\x79\x92\x4b\xf8\xea\x3c\xcd\xa9\x7a\x60\xee\x7e\x22\x90\x17\x45\xf3\xc1\xed\x6d\x02\x1a\x07\xf1\xe7\xb2\x97\x9e\x16\x92\x53\x67\x23\xa7\xf7\xc1\x3e\xb3\x5e\xe4\x69\x3c\x3f\xec\x56\x79\x9b\x81\x9b\x77\xba\xcd\x5e\xca\x8c\xbf\xd5\x98\x46\x60\xad\x0a\xbd\xf2\xb4\x2a\xb5\x7d\xcc\x20\xfd\x7e\x6f\x04\x0d\xe6\xa2\x76\x17\xf2\x9e\x27\xb5\x79\xbb\x88\x0a\xaa\xd3\x20\x98\x77\x76\xdb\xe3\x5e\x6d\xb8\x0d\x68\x3f\x54\x9b\x3e\xbf\xee\x2a\x37\x01\xef\x99\x39\x47\xc1\x75\xb1\x34\xeb\x84\xde\x5d\xd0\xd8\xbf\x0b\xe9\x8e\xbd\xdb\x87\x99\xe7\x31\x31\xcf\x1a\xd1\x37\x9e\x85\xdc\x6a\x30\x49\x79\x50\xcb\x2f\x26\xad\x51\xa1\x01\x42\x04\x07\x29\xef\x9c\x60\x95\x52\x87\x79\xee\x8d\x98\x77\x82\x05\xf2\x6e\xb9\xc2\xe1\xdd\xa3\x81\x3c\x55\xb8\xc0\xcc\xa7\x7b\x9d\x0a\x99\xc5\x36\xcd\x09\x9e\xae\x3a\xeb\x32\xe5\x28\x47\x34\x3d\x32\x1e\xd4\xf6\x34\xde\x25\xfb\x4d\x31\xff\xcc\x98\xb1\xfb\x8b\xc5\x8c\xf7\xdb\xaf\x7f\xf2\xff\xcd\x43\xbc\xa3\x5b\xe4\x52\x05\xe1\x39\xe6\x64\x06\x4e\x4b\x7e\xf4\x29\x7e\x3a\xfb\x39\xe3\xf0\xc6\xf3\x0a\x63\xaf\xb4\x63\xbc\x7b\x1a\x3e\xf9\xce\xeb\xfb\x87\x7c\xdf\xd3\x77\x5e\x36\xe3\xf8\x12\x5f\xc4\x13\x5b\x86\xfe\x95\x1d\x66\xd9\x0d\xf2\x87\x0d\x57\xd9\xe3\x99\x15\x84\x15\x13\x6a\x42\xe0\xb7\x8b\xf6\x04\xf9\x36\x67\x7c\x37\xbc\x0c\xf7\x84\x24\xf7\x38\x95\x9d\x2e\x17\x9a\xa7\xee\xc8\x62\x5f\x6b\xee\xff\x67\xef\x3d\x76\x2d\x46\x96\x2d\xb1\x7f\xd1\x94\x03\x7a\x07\x8d\xe8\xbd\xf7\x9c\xd1\x7b\xb7\xe9\xf9\xf5\x42\xd5\x7d\xba\xb7\x1a\x50\xe3\xb5\xa0\x12\x50\x83\x1e\x70\x70\x0e\xb8\x17\x13\x99\x19\x11\x2b\x82\x2b\x92\x9a\x29\x5f\xed\xb8\xd7\x9b\x7d\x83\x3f\x03\x5a\xae\x37\xda\x5d\xb1\x8c\x55\x61\xe5\x95\xd7\x9d\xbd\x31\xfc\x20\xcd\x1b\xa6\x2b\xcb\x33\xb5\x8a\x3d\x95\x15\xda\x86\x51\x3f\x9f\x37\xdf\x7c\x3a\x82\xd9\xc3\xed\xa9\x01\xc7\x9f\x09\x91\x32\x6e\x1a\x60\x0d\xa3\xe2\x62\x34\x6b\xcc\x7b\x67\xdb\x29\x9d\x86\x3a\x1d\xa1\x07\x67\x13\x93\x22\x93\x67\xb6\xbd\xab\x5b\xad\xc8\xb2\xc6\x79\xc7\xe6\xee\x71\x28\xda\xce\x99\x36\x3c\xcd\xb2\x4f\xdf\x0d\x5e\xe7\x6e\x18\xcc\x30\x43\xc3\x39\xec\x17\xd6\x10\xc0\x46\x02\x1b\x93\x12\x3b\x41\x4f\x23\xcb\xc1\x36\xc0\x41\xce\x9e\x42\xa1\xbc\xe5\xf7\x72\xe9\x68\x29\x74\x22\x48\x27\xff\xdc\xe5\xc9\x71\xdd\xe1\xa1\x71\x8b\x5e\x1d\x97\x62\xfd\xaf\xd8\x30\x5b\x25\x78\x5d\x2e\x09\xf2\xe8\x8f\x93\x61\xe2\x2e\xc7\xb1\x92\xb3\x43\xa1\x76\xf0\x58\x29\xba\x01\x84\x40\xa4\xe3\x2b\x2b\xf9\xd0\xa2\xe2\x9e\x5f\xb7\x1b\x1d\x0d\x61\x2f\x14\x77\x86\x23\xd6\x03\x4b\x8e\x0d\xf1\x88\xf2\x2d\x3e\xc2\x16\x18\x21\x94\xcd\x81\xc9\xea\x91\x36\x51\x4f\x89\x76\xd8\xc7\x46\xd6\x37\x31\xde\x03\x05\x7b\x17\x51\x28\x3c\x31\x38\x1d\xf7\x13\xd0\x92\x32\x67\xbd\x4d\x79\xc6\xf2\xa6\x66\xc7\xc7\x90\xf6\x52\xbd\xb4\x33\xc7\xbd\x7f\x2e\x21\x0f\xf6\xe0\xee\x16\x13\x6f\x45\x7a\xb3\xcd\x3a\x87\x33\xc8\x7d\x7b\x3e\x60\xcf\x94\x4f\x20\xf4\x98\xfd\x61\x2c\x08\x0d\xe7\xf7\x49\xc7\x0c\x79\x15\x2f\x3a\x21\x40\xe3\x67\x81\x0b\x63\x47\xfb\x1b\x68\x36\xce\xf6\xbc\xec\xac\xde\xab\xd0\x79\xb2\xd7\xfb\xdd\xa1\xc7\xeb\x40\x79\xcd\xf9\x12\xcd\x15\x87\x23\xca\x07\xdc\xd0\x34\x77\x73\xb0\xa7\x58\xa1\x43\xed\x05\x2f\x7c\x75\x95\x15\x9a\x63\x4d\x5e\xc8\x00\x40\x7a\x79\xd6\xa4\xff\x3c\x75\xf4\xae\xf9\x91\x0b\x03\x27\x33\x99\xbf\x39\x96\xfd\xc3\x38\x64\x29\xfe\xc7\x0e\x29\xfa\x07\x21\x4c\x52\xaf\x2a\xd4\x85\x13\x29\x6c\x6d\x68\x3d\x37\x59\xf2\xac\x50\xae\x1d\x27\xc0\xee\x22\xa4\x6e\x59\x5e\x41\x93\x2b\xd5\x03\xba\x3b\x59\x55\xed\xba\xbe\x22\xa6\x5b\x9f\x73\x62\xc6\x73\x27\x2b\x24\x37\x5e\xab\x9c\xf7\x1d\x84\x1e\x17\x1e\x23\xb4\xc3\x68\x56\x8f\x80\xf7\x7d\xc5\xfa\xa6\xc9\xf4\x8a\x24\x56\x99\x38\x85\x82\x3b\x60\x5b\x80\xed\x09\x8a\x52\xa9\x69\xf9\x3b\x9c\x50\x81\x69\xae\x99\x06\xd0\x6e\x51\x3a\x5f\xc7\x31\xde\x28\xa4\x21\xf7\x46\x7f\xc2\xe2\xd5\xcc\x74\x55\x1e\x2a\xe8\xf1\x13\xff\xbc\xbe\xa0\xa5\xe5\xa2\xd2\xae\xe5\x5c\x8e\xe2\x01\xd2\x9a\x31\xb5\xf8\xaa\x4b\xaa\x7c\xc9\x13\x14\x0e\x2b\x0e\x4d\x3d\x58\x98\xf7\xed\xb8\x83\x3b\x8e\x13\x06\x65\x82\x05\x71\xe4\x10\x61\x82\xba\xfb\x88\xea\x6e\x8e\x07\xe5\xeb\x3b\x0b\xea\xc7\xfe\xe0\x39\xdc\x3f\xea\x51\x52\xa4\xc0\x31\x58\x60\xaa\xfb\x0c\x5c\xec\x0b\x71\x1f\xda\x30\xfa\xd5\x28\xd5\xc7\x8a\xdf\xd8\xbf\x87\xe2\x56\x86\xea\xae\x37\x07\x76\x0e\xc7\x64\x51\x0a\xff\x0a\x05\xc6\xc4\xdf\xe1\x54\xcf\xee\xe5\x84\xe7\xbe\x7b\x8a\x89\x79\x0d\x88\x44\x25\xdf\x8c\x5f\xd0\x8d\x2a\x99\xcc\x84\x77\x9c\xaf\xe3\x33\xa8\x73\xa1\x2b\x31\xbc\xb9\x54\x99\xb4\xc8\xdb\x9b\xc5\x3d\xde\x6c\xce\xe8\x5b\xfc\x72\x15\xb7\x39\xe0\x4c\x4f\xdc\xf1\x16\x52\x15\xb7\x90\x02\xdf\x1a\xcb\x2c\xc8\x6b\x04\x94\x29\x47\x36\x81\x44\x53\x3c\xda\x54\x0b\x79\x0d\x39\x04\xb7\x74\xc1\x5c\x76\x79\x90\x84\x25\xbd\xf0\x33\x1f\x41\x89\xe6\x7d\xf0\x30\x03\x0a\x8c\xc8\x2e\xab\xa0\xd8\x1c\x3f\x1f\x32\x02\xd5\xac\x79\x6f\x82\xf2\xde\x01\x49\x44\xfe\x9e\x67\x01\x4c\x0d\x68\x51\x96\x8c\x5d\xd3\xc2\x82\xdf\xfd\x4e\xce\x61\x28\x43\xe9\xb3\x01\x34\x01\x3b\x6b\x65\xaf\xbe\xcf\x94\x96\x91\xd4\xe4\xf0\xfd\x03\x56\x14\x29\xc7\xf6\x90\xc3\x40\xb0\xd7\x36\x4a\x02\xcc\x94\xc2\xb5\x0d\xee\xe7\x30\xb3\x39\x31\x57\x75\xe8\xc4\xfd\x08\x11\xfe\x63\x8c\x6a\x1d\x69\x66\xf4\x03\xb4\x8b\xf2\x13\x86\x19\x82\xb3\xaa\x1e\x55\xc4\xc8\xbf\xb9\x26\xb2\xfe\xd3\xea\x9c\x53\xfb\x9f\x3a\x27\xf4\x67\x9d\x33\xb1\x7d\x1b\xc5\xb7\xe4\xc9\x22\xc2\x1f\xf9\x6c\x35\xb5\x48\xca\x9b\x05\x11\x07\x91\xfa\x31\x2e\x4b\xfc\xf2\x25\x3e\xf7\x1a\x12\xc1\xf6\xdd\x90\x00\x1b\x7e\xe2\x76\x36\x9b\xc7\x56\x27\xf3\xea\x98\x27\xb8\xec\x7e\xc8\x19\xd4\xb9\xb4\xaa\xa5\x2a\x39\x5d\x27\x60\x40\xc7\x96\x4a\x83\x8c\x3e\x14\x4d\x91\xfd\xc7\xcf\xf3\x84\x88\x9e\xb7\xb5\xd8\xbc\x43\x61\x00\xbf\xb9\xf8\xf5\x04\x89\x45\x19\x7a\x46\x5d\x94\xd7\xbb\xe8\xe4\x40\xff\x93\x7a\x2a\xbe\xf2\x09\x92\xc7\x2b\x09\xdd\xa7\x55\xd7\xdf\x3a\xf9\xa3\x34\x9d\x13\x60\x19\x13\x7e\x9a\x76\x6a\xe6\x47\x3f\xa0\x23\x85\x54\x2f\x05\x00\xd4\x12\x61\xea\x05\xca\xe0\x5d\x32\x18\x4e\x34\xc8\x70\xc9\x34\x2d\xbe\xb2\xd9\x9d\xcc\x87\x94\x77\x3d\xe6\x26\x95\x44\xd4\x67\x68\xb8\xb4\x80\x9c\x51\x3b\x03\xd3\xae\xd2\xfe\x3a\x8d\xcb\x1d\xe3\x2a\x60\xbd\x12\xe8\x2f\x47\xd7\xf2\x77\x92\x86\x6d\x0b\x23\x88\x77\x39\x11\x59\x02\xa4\xaa\xa6\xdb\xc9\x72\x43\xf4\x14\x31\x19\xfd\x0d\x22\x8d\x84\xe5\x43\x9e\x60\x76\x84\x25\x3d\xd2\x13\xd8\x3e\x30\xb8\xe8\xcb\x65\x26\x18\x76\xc7\xa8\xfb\x77\xc0\xbb\x06\x95\xd8\x7e\xaa\x53\x82\x0d\x10\xa0\xf0\xbc\xe4\xd6\xdb\xd4\x42\x27\xde\x54\xbd\x53\x00\xb5\x44\x55\x09\xa7\x64\xad\xeb\x70\x42\xdd\x38\xb3\x72\xb6\xc2\xd0\x1c\x96\x29\x00\xa5\xf4\x24\x8f\xc0\xf7\x30\xdf\x76\xc2\x5b\xa4\x00\x9c\xc4\x65\xb0\xca\xdc\xe6\x1b\xaa\x92\xbc\x82\xe0\xe1\x59\x1c\x3b\x7b\x87\x0c\xc9\x28\x09\x5d\x86\x4b\x87\x1c\x6a\x33\x90\x4d\x36\x5b\x71\xe7\x61\x37\xb5\xce\xe8\xf6\x19\x9e\xc0\xc3\x87\xf6\x8d\x08\xce\x2b\x0a\xbd\xcc\x37\x6d\xbe\x26\xe6\x04\xbf\x5a\x18\xce\x49\xef\x5b\xd5\xaf\x5a\x67\x4c\x20\x62\x67\xd2\x25\xcf\x1e\xec\xc1\x8a\x23\xc6\x37\x3c\xbe\xe8\x1d\x61\x60\xb2\xcd\x3a\x09\xa0\x03\x7f\x45\xc1\xe8\x87\xa8\xe3\xa8\xae\xa0\x3f\xc8\xdc\xe0\x94\xc7\x40\x23\x4e\xd5\x2c\x4a\xc4\xea\x07\xd8\x71\xa7\x16\xc4\x8e\x2a\x42\x93\xa0\xc0\x69\x27\xa9\xec\x33\x05\x23\x1b\x00\xef\x0b\x54\x33\x1b\x30\xe7\xbd\x19\x77\x8e\x17\x20\xfd\xdc\x97\x3a\x93\x57\x18\x8b\x13\x8b\xeb\x56\x0f\xd7\x61\x35\x8f\x19\xe4\x25\x06\x0f\x4e\x28\xcb\x45\x19\x25\xa2\xf9\xfb\xb8\xec\x8d\x98\x61\x4a\xd4\x2b\xaa\xb8\xa1\xce\x69\xef\x4e\x00\x93\xcf\xbd\xc5\x7a\xb0\x3c\xd9\x54\x91\xfa\xa5\x5b\xe8\xba\x71\x08\xca\xed\x17\x1d\x81\x37\x45\xf7\x14\x8d\x90\x3b\x99\xd8\x45\x12\x56\xb0\x49\x8c\xf7\x9b\x0c\x6b\x98\x0f\x4f\x16\x29\xc3\xda\x69\x1c\x2b\x7d\x88\x8a\x10\x8c\xba\xbf\x64\x57\x79\xeb\xec\x5d\x96\xc9\xb6\xad\x1b\xfc\xd5\x5d\xc0\x6d\x2b\x21\xb0\xbc\x68\xb2\x40\xf5\xec\xa0\x6b\xc6\x49\x18\xec\xa3\xf2\x98\x9e\xfd\x3d\xa7\xfe\xd6\xb8\xbb\xb6\xff\xb0\x1c\xb2\x35\xff\x93\x43\x8e\xc7\x4f\x80\x84\xe0\x01\xc1\x34\xe7\xb3\xd8\xfc\x5d\xe9\x57\x2a\x43\x9e\x58\xb1\xd4\x3c\x89\x33\x72\x1a\xa2\x28\x09\x21\x9c\xf5\x23\x95\x2c\xc5\x5c\x18\xc6\x94\x2e\xc5\xd8\x18\xa6\x5a\x36\xeb\x02\x42\xb3\x40\x36\x37\x06\x9a\xef\xc7\x8e\x37\x0d\x7e\x72\x58\xc3\x74\xe6\x9e\xfa\x73\xe0\x0a\x95\x91\x57\xf5\x04\xd5\x54\xf8\x1d\xb5\x27\x1b\xa8\x29\x7a\x7d\xd3\xa2\x9c\x10\xbe\x28\xa3\x6b\x26\xf5\x6f\x78\x44\x79\xe2\x94\x5f\x46\xce\x13\x7d\x8e\x0d\xf5\xea\x29\x44\xbf\xb1\x65\xe2\x38\xdf\xf4\x07\x6c\x1b\x48\x67\xef\x10\x90\x45\x60\xfd\x2b\x5b\x2a\xff\x42\x04\xf4\x2e\x87\x9d\x39\xbd\x13\xb6\x42\x7c\x74\xcd\xf3\x96\x72\xfc\x05\xf4\x58\x75\x92\xdd\xb5\x51\x65\xb3\x43\x53\x09\xa9\x45\x78\x2d\x87\x94\x1c\x93\x9a\x67\x4d\x30\x7c\x0e\x8c\xa5\x83\xdb\x81\xfd\x60\x2c\x27\x16\x4d\x5c\x97\xf7\x86\xd9\x2d\xcc\x1f\x25\xce\xa5\x1d\x66\xde\xa4\xe7\x74\x46\xc9\xc5\xc3\x48\xbc\xa1\xf2\x82\x0e\x1b\x35\x45\x00\xbc\x0f\x18\xef\xba\x86\x14\xa5\x3c\x97\xdb\xa8\x81\x63\xfa\xc8\xa0\xb5\xaa\x16\x59\xcf\x7b\x94\xa0\x1f\x98\x30\x1b\xd0\x40\xfe\x24\xaf\x4b\x20\x23\x17\x8b\xdb\x71\x47\xb9\x3c\x2d\x2e\x30\x94\x31\x13\xaf\xfa\x4d\xf4\x2a\xa7\x75\xae\x93\x86\x52\xbf\xdd\x2e\xac\x6b\x53\x2d\x14\x8d\x23\xe1\xba\x75\x75\x9f\xb4\x47\x76\x43\xe1\xae\x41\x43\x8b\x46\xf0\x0d\x8c\x9a\x9a\x94\x71\x89\x4c\xea\x77\x03\xbb\x0d\xf1\x84\x2d\x3b\x53\x72\x7e\x92\xd9\x70\x6e\x67\xa4\x21\x03\x93\x8a\x13\x62\x96\x92\xf9\x0a\x95\x9f\x32\xc8\xa8\x86\x58\x42\x88\x3f\xac\x10\x6e\x5b\x58\x3f\x47\x57\x81\x93\x02\xcf\x28\xe6\x41\x9e\x70\x4c\xd9\x39\xad\x35\x0a\xa0\x3f\xf9\x85\x16\xa2\x4b\x3d\xfd\x43\x30\x0e\x90\x03\xfe\xb3\x83\x9b\x4e\x6b\x95\x3d\x76\x2d\xc2\x19\x2c\xb7\xd1\x48\xdb\x20\xdb\x77\xc0\xcc\x12\x92\x32\x9e\x3c\x65\x5b\x6e\xad\xfc\xb9\x79\x53\xac\x62\xb5\x95\xc1\x3a\xae\x97\x13\x61\x62\x3b\x31\xdd\x93\x82\xfb\xbd\x63\xec\x12\x71\x06\xb8\x4d\xbb\x80\xc7\x9b\x80\x96\xbc\xc3\xfd\x36\x89\x49\xa2\xb2\xea\xb7\xb4\xd1\x08\x29\xe0\x29\x40\x09\xe9\xb0\x69\x59\xd1\x84\xdd\x54\xbd\xd0\xdd\xc7\xcb\x8e\xbd\x39\x18\x6f\xe6\xa2\x69\x38\x5b\xd5\x44\x28\xe2\x6c\x93\x2a\xcf\x19\xc7\x7b\xa7\x0c\xd7\x0f\x4d\xe6\x91\x1e\x2b\x86\x71\x18\xb5\xed\xa4\xec\xe0\x69\xc6\xfa\x9b\x35\x0f\xeb\xfc\x0f\xd3\x3c\x4c\xc7\x7f\x34\x0f\xf4\x61\xfd\x91\xaf\xc6\xb1\xbd\x42\x1c\x13\x44\x93\x9a\x73\x10\xf6\xb0\x8c\x69\xfa\x22\x30\x68\xad\x44\xd5\x0a\x4f\x64\x9b\x0e\x36\xb6\xd0\xd3\x02\x34\x2a\x35\xc1\xf7\x28\x24\xeb\x10\x7a\x3f\xce\x11\x2a\x1c\xe4\x68\xbf\xe8\x31\x25\x98\x1f\xe8\x14\xb1\x7a\xf8\x7b\x66\x78\x82\x16\x25\xf5\x67\xe5\x4d\x29\xb0\xaa\x00\x04\x86\x3f\x5c\xde\x27\x28\x4f\x1a\x84\xae\x75\x69\x7d\x22\x45\x8d\xd8\x44\xf2\x70\x85\xa1\xe6\x42\x6b\x7d\x3a\xba\x6a\x2c\xd9\xd6\xdf\x71\x02\x37\xba\x51\x78\x4c\xd6\xb4\xf3\xec\x15\x0f\xae\x53\x6a\xa4\xfb\x63\xd2\xf5\x5c\xe0\xaa\x79\x43\x0c\x27\x49\x17\x71\xd8\xde\xf5\x5e\x02\xaf\x09\x4b\x94\xe2\x09\x65\xc9\xb2\x95\x6b\xeb\x59\xdc\x18\x0b\x1d\x68\xd6\xcc\x23\xc6\xa9\xf6\x8e\x24\x83\x13\x9e\xd8\x4e\xc0\x96\x9b\xb1\x9b\xa1\x16\x14\xc7\xb3\x9d\xe2\xb6\x6d\x68\xd0\x79\x9c\x94\xe1\x96\x30\x85\x4c\x19\xa8\xd9\x78\xd0\xe1\x38\xbb\x58\xd4\xa5\x26\x0b\xf9\x35\xfe\x88\xe8\xcd\x5c\x30\x24\x4e\x5b\xb3\x5f\x92\xc5\x78\x9f\x1c\x83\x0d\x33\x82\x6e\x35\x46\x15\x31\x6c\x3e\xd0\x8e\xb0\xd6\x52\x64\x9e\x41\x9c\x5d\xcc\x7c\xb0\xcc\x27\xae\x72\x4a\x4f\xe2\x0a\x64\x68\x46\xd5\xcd\x88\x7b\x9a\xb2\x4d\xd5\xac\xe1\x15\x9a\x4f\x1b\x7a\x53\x06\xe1\x3d\x5c\x6f\x28\x37\xdf\x13\x9d\x43\x52\x74\x2f\x6f\x0a\x4a\x0f\x3b\x03\x38\x0a\xe8\xb5\xeb\x73\xf8\x94\xd7\x52\x51\x9c\x1e\xe8\xc4\x85\x2a\xa7\x64\x11\x6d\x62\xf3\xf5\x8e\x17\x03\xe5\x97\x12\xf6\x06\x1e\xd8\x8b\x55\xc8\x56\x4e\xc1\xf9\x72\xdd\xd5\x56\x51\x8b\x3e\xeb\x9d\x34\x6b\x8a\x5e\x01\xf0\x45\xaf\xb0\x70\xa8\x9c\xf9\xed\xd2\xa6\x54\x64\x24\x26\x58\x95\x2c\xbd\xcd\xd1\xeb\x5f\xd3\xf4\x37\xc4\x38\xb7\x0d\x80\x52\xd5\x70\x8a\xbc\xae\xe6\x22\x32\x8d\x60\x58\xb0\x9b\x6a\x0b\xc9\x3a\x8d\xdb\xa1\xaf\x09\xe1\xc5\x7c\x97\x0c\xc4\x3a\x06\xd3\x88\x28\x47\xac\x73\x7e\x9a\x0d\x8d\x18\x34\x23\xfa\x70\xd9\x79\x5c\x4b\x0c\x39\x20\x4a\xd7\x82\x18\xcf\xcc\xb2\xf4\x52\xa4\xcf\x8a\x19\xf3\x0e\x17\x65\x85\xc0\x28\x4b\xc1\x15\xf9\xdc\x4c\x31\x62\xfd\x90\x65\x90\x12\x78\x7d\x50\xea\xea\x3d\xe8\x90\xd0\x13\xa1\x77\x31\x3f\xee\x20\x47\x0a\xee\x86\x93\x90\x7e\xda\x9c\x08\x01\x45\x87\xea\xe8\xa9\xee\xe4\x42\x7c\xf6\x00\xb5\x42\xef\x67\xf2\x78\x0b\x26\x8f\x91\x72\x13\x06\x2a\xb2\xe3\xcc\xd3\x49\xdb\xc5\x59\xfe\x5a\xbb\x55\x29\x9b\x44\xfa\x1f\x5f\x29\xc9\x42\x08\xbc\xb4\x02\xa9\x9d\x23\x83\xa6\x6d\x32\xd5\x02\xd3\xf0\x74\x0a\x0e\xf2\x30\x08\xd3\x2a\x9a\xdf\x89\x7d\xd9\x51\xbc\x21\xfb\x30\x74\x6a\xf8\x54\xfb\x76\x40\xf1\xd7\xb4\xab\x66\x50\xb3\x1b\x86\x88\x7e\x21\x1b\x5d\xe9\xca\xe5\xd5\x0c\x63\x30\x59\x56\xcc\xe3\x58\x88\xec\x94\xfc\xbd\x31\x7d\xfd\x87\xe5\xd2\x43\xfb\xd7\xcf\x03\xfe\x19\xd3\x6d\xdf\x20\x5f\x2f\x5d\xa7\xcf\xa7\x79\x33\x9b\x22\xe6\x10\x6b\xaf\xe3\xba\xe6\x07\x30\x8f\x48\x7f\xda\x71\x81\xed\x55\x83\xcc\xd0\x01\xa2\xd0\x11\xcb\x85\x29\x47\x24\xdb\x52\x77\x77\x6c\xa0\x2d\xc1\xb6\xf9\x81\xaa\x4c\x93\x8b\x1a\xa4\xf1\xa1\x07\x34\x98\x15\x3c\x61\xba\x72\x17\x00\x81\xe3\x34\xfe\x8d\xcf\xd8\x0b\xea\x57\xba\xf8\x59\xe3\xb3\x3e\xed\x57\xfa\x10\x8d\x77\x1d\x25\x1d\xd1\x79\xdf\x8a\x64\xdf\xe0\xa5\x4c\xcf\x6e\x01\xde\x1b\x95\xc4\x99\xef\xa9\xeb\x91\x43\xcf\x30\x3e\xa4\x92\x2e\x5a\x20\x7c\xf0\xa7\x22\x7c\x10\x10\x34\x88\x92\x97\x98\xfc\xf8\xa7\x6a\x54\x3a\x80\x46\x0e\x60\x16\x45\x09\xa5\x86\xe6\x74\xe0\x52\xac\xdc\xc6\x28\x8d\x77\x66\xf2\x81\xf6\xa8\x0b\x67\xf5\x37\x0b\x7c\x43\x6a\xd2\x00\x74\x20\x53\x60\x5d\x60\x80\x2e\xc9\xee\xd3\xcf\xf3\x9e\x23\x36\xea\x0c\x8d\xc9\x6a\xa4\x00\xad\xaf\x51\xad\x0e\x54\xa0\x73\x55\xe7\xc6\x6e\xc8\x2f\x28\xd6\x7d\x23\xc9\x65\xd2\x04\xfd\xb1\x2e\x18\x8e\x1c\x10\x0b\x3c\x92\xbd\x7c\x9b\xbe\xf1\x08\xa1\x40\x6b\x32\xa8\xff\x98\xce\xde\x8e\x92\xce\xcc\x02\x86\x7d\x9c\xa2\xc3\xcd\x12\xa7\x77\x67\x98\x17\x0b\x77\xc4\x97\x73\x90\x76\x32\xfb\x63\x2a\x7e\x74\x37\xa6\x91\xd8\x95\x1e\xaa\x9d\x28\x58\x34\xf3\xeb\x65\xa6\xbb\x7e\x4c\x6f\x7e\xec\x0f\xab\xb1\xeb\x56\x9a\x52\xe2\xb1\x24\x60\xb8\x3d\xe5\x04\x8a\x39\x12\x29\xf2\xf1\x9e\xdb\x25\xe7\xc6\x6f\x21\x51\x3f\x1b\x5c\x7b\xdc\xbd\x13\x9f\x81\x73\x88\xac\x9c\xa9\x4e\x29\x1b\xda\x9b\x32\x33\x10\x71\x4c\xee\x68\x30\x1a\x63\x1d\x09\xd3\x61\x2c\x14\x95\x34\x03\xba\x77\x27\xaa\xc7\xa1\x85\xcf\x4d\x0a\xa7\x30\xca\xc7\xbd\xe3\x07\xe7\xdd\x9e\xbb\xce\x1a\x14\xf7\xb6\x62\x38\x32\x21\xf2\x03\x5f\x4e\xcc\x07\xeb\xa4\x00\xe4\x10\x1a\x60\xaf\x18\xd3\xd7\x13\xe4\xc8\x2f\x69\xc4\xbe\x6a\xbc\x84\x40\xa3\xd7\xdb\xe5\x37\xbe\xd7\x44\x0e\x20\xa8\xab\x4e\x28\x67\xac\x53\xcd\x59\x9f\xf9\xb1\x43\x17\x6b\x5d\xc6\x4c\xfe\x57\x5c\xae\x1a\x84\xb4\x40\xfd\xac\x66\x63\xef\x6d\x4c\x28\xc6\xdb\x41\x26\x2b\x73\x57\xcf\x29\xee\xab\xfc\x3e\xe4\xc6\xad\x3e\x08\xb1\x64\x61\xf2\xd0\xc9\xb7\xe2\x72\xd1\x65\x93\x45\xff\x11\x97\x0b\xda\x9d\x27\x8c\x38\x9b\xaa\x19\xcb\x43\xa0\x8b\xcc\x83\x95\x6e\xb5\x83\xd4\xbb\x76\x00\x90\x27\x38\x80\xa4\x7b\x8a\x10\x5c\xea\x04\x10\x7d\x9e\xb5\x08\xb0\x7a\x48\xb6\xcc\x00\xd6\x80\x59\x5d\x62\x5e\xa2\x86\x27\x43\x5e\x57\x5c\x72\xa0\x22\x3b\x87\xb7\xf8\xb3\x06\xbc\x35\xc3\x28\x42\xa6\x94\xf0\x04\x5c\x67\xf9\x77\xbe\x83\x5d\xf7\xf2\x9f\x65\xf3\xdb\x5f\xb5\x90\xb0\x21\x40\x42\x52\xe7\x47\xa9\x69\x91\x74\x2c\xca\xe1\x46\x8d\x2a\x33\x2b\xc7\x0a\x88\x02\x25\xe7\xd2\x79\x59\x56\xf1\x6a\x65\xa9\x2d\x06\x27\x26\xea\xd7\x7e\xf7\x14\xee\x6d\xd4\xc2\x40\x55\xbc\x2a\x31\x80\xd0\x40\x89\xef\xbb\xae\xd1\x40\x88\xa6\x89\xcb\xc2\xb1\xb9\x3f\x0e\xaa\xee\x91\x35\x69\x03\x27\xaa\xd1\xf1\x42\x75\x91\x8f\xa6\x33\x8e\xef\x30\x89\x82\xa7\x64\x40\x90\x1f\x8e\x77\x5a\x7f\xea\x77\xf0\x34\xd2\x69\x16\xbe\x9c\x97\x23\x39\xb6\x5c\x38\xbc\x89\x52\xd0\x35\xf2\xf6\x8f\xc0\xeb\x52\xef\x31\x2b\xc3\xe0\x8e\xde\xc5\x03\x78\x8f\xc6\x24\x17\xf2\x5e\x24\xeb\xb7\x35\xd7\xb5\xea\x6c\x26\x52\x57\xd4\xc3\x66\xc0\xdc\x92\x1f\x9a\xae\x81\x4e\x35\x7e\x05\xe0\x97\x05\x40\xfc\x7a\xce\xe9\x6f\x8a\x18\x7f\x74\xfb\x4c\x92\x3e\x2c\x1e\xf9\x03\x92\xe7\x0a\xe7\xdf\x27\x7d\x63\x13\xdb\xbc\xf5\x05\xa9\xed\x63\x13\xca\x1f\xa0\x36\x2a\xa7\x4c\x2f\x41\x1f\x27\x82\x9c\xa6\xe0\x6d\x35\x92\x93\x76\x98\x9f\x16\x92\x82\xb7\x7a\x0e\x88\x16\x00\xf8\x9e\xe5\x69\x85\x7e\x14\x06\x03\x95\x0a\x24\xd8\xbf\x15\x03\xe4\x63\xae\x25\x7c\x1f\xba\xa0\x2a\x78\xe9\x76\x04\xc4\xe8\x99\xbb\x93\xa2\x51\x0c\x4d\xfe\x03\x0e\xe5\xe5\x96\x41\x6d\x6d\x2d\xf1\x62\x52\x2a\x3f\x44\x81\xe0\xd6\x52\xcb\x39\xa1\x7f\x2a\x48\x06\xb7\x2d\xf9\x42\xb7\xf5\x47\x3e\xa9\xc7\x8d\x19\xc9\x43\x52\x1e\x88\x45\x19\xda\xe2\xc1\x62\x25\xb7\x5d\x2a\xd6\x8a\xc4\x1d\x78\xc0\xd4\x6f\x14\x9b\x82\xbf\x89\x05\x78\xcb\x2e\x0a\xdd\xdb\x62\x67\x6e\xd5\xa1\xc5\x62\x8c\xc9\x14\x5a\x3d\x55\x1a\xaf\x5a\x9e\x5b\xf2\xa2\x42\x70\x97\x19\x34\x68\x7e\x61\x87\xd9\x57\x4a\xd3\x31\x19\x63\xb6\x30\x5b\x09\xa5\x55\x11\xe3\xe2\x9e\xcb\x91\x41\xac\x43\x2a\x30\x83\x79\xa6\x11\x21\x57\x8c\x51\xce\x31\xf2\x94\x8c\x76\xc2\xac\xec\x12\x6f\x51\x9f\x76\x63\xd5\xee\xd2\x9a\x16\xcf\x4a\x74\xa0\x9b\xec\x26\xd4\x72\xe4\xb3\x6d\x23\x37\xfc\x56\xcc\x04\x13\x11\x44\xce\x74\xd9\xe3\x53\x67\x60\x23\x12\xb1\xe8\x82\x4d\x44\xe1\xea\x58\x25\x70\x1d\x99\xcc\x48\xc3\x84\x4e\xbe\xdb\x83\x08\x46\xd2\xb4\xc8\xa4\x71\x2a\x37\x8c\xbf\xb9\xad\xf2\x17\xf8\x33\x8f\xf4\x8c\xc8\x36\xd8\x24\xe5\x26\x0d\x73\xf3\x02\x0f\x51\x26\x73\xd7\x84\xd5\x2b\x85\xfb\xc0\x47\xa9\xad\x9d\x92\x19\x1c\x56\x58\xce\x1c\x35\xcd\x1f\xaf\xd6\x8c\x52\xb1\xa7\xfb\x2b\x7e\x09\xfe\x48\x0e\xe2\x8a\x2a\xd3\x8f\x10\xa4\x50\x36\x57\x0c\x6a\xc0\xb1\xf4\x06\x07\x2e\x3b\x0f\x28\x15\x0b\xaa\x70\x0e\x18\x7c\x4c\xd2\x9d\x32\x35\x41\xec\x70\x68\xb5\xc8\x50\x6d\xf7\x12\xcc\xc9\x2a\x3e\xb2\x8c\x75\x9a\xd2\x3d\x83\x89\xca\x5f\xe8\x68\x36\xda\x39\x2e\x88\x4b\xbc\x66\x9c\xc9\x24\xa0\x2d\x50\x3f\xa8\x21\x6f\x2d\xbc\x1b\x59\xfd\x1d\xb2\x7e\x76\x5c\x03\x89\xcc\x00\x9d\x62\xa9\x6f\xc5\x2a\x25\xb8\x23\xc4\xb9\xcf\x81\x3d\x39\x29\x59\xd8\x17\x30\xc5\x3f\x67\x1d\x1c\xac\x0a\x5e\x45\xd3\xec\x00\xda\xf5\xe1\x07\x42\x5e\xc2\xb0\xbb\x2a\xdb\xc1\x71\x82\x56\x72\xff\xcd\x39\xc9\x3f\xed\x6b\xc4\xe1\x7f\x5c\xcf\x70\x5c\xc2\xf4\x07\xdd\xb0\x3f\x3f\x10\xa7\x46\xd5\x90\x60\x8b\x1d\x51\x42\x57\x86\xb0\x58\xfd\xe7\x3c\xd9\xa4\x16\x59\x72\x9f\x27\xca\xaf\xd0\x48\x77\xb1\x7e\x85\x87\x7b\xf2\x6b\x73\xa0\xaa\x92\x64\x7e\xe8\x65\xcf\xe1\xca\xbb\xba\x21\x8f\xa0\xb2\x72\x13\x4f\x5d\x5d\xe5\x46\x2b\x70\xf1\xa7\xa5\x53\x05\x54\xd5\x1f\x94\x03\x26\x5a\x04\x40\xb8\x19\x29\x72\x24\xbc\x5f\x79\x84\x11\x10\xbc\x6c\x04\xfc\x2c\x27\x13\xb1\x93\x27\xa5\xc8\x24\xe8\x0a\xd4\x97\xa6\x36\x27\x72\x08\x76\x9b\x15\xb3\x38\xb9\x65\xa3\xd2\x20\x6b\x5c\x1d\x41\x65\x56\x59\xf5\xcf\x77\xf8\x34\x9a\x2a\x05\x9a\xb2\xa0\x5c\x37\xc0\xc0\x6c\x7e\xd8\x85\x22\x7c\x0f\xd7\xb7\xe7\xb8\x07\x5d\x7a\x7b\x3d\xa5\x85\x91\x41\x3f\xe5\xa2\x2d\x3e\xdb\x92\x9f\x1d\x3e\xa7\x3d\xeb\x30\x77\xc2\x99\x90\xab\x27\x59\x5f\x4a\x1a\x28\x0b\x96\x6f\x55\x98\xb8\x5f\xf1\x5e\x20\x55\xa0\x9e\x9d\xac\xb7\xc0\x1d\x60\x39\x9d\x98\x65\xa2\x42\x89\xf9\xef\xa4\x00\xad\x53\xc0\xe9\xb6\x08\x80\x08\x9c\x8e\xce\xef\xb3\x3c\xde\x83\x66\xec\x85\xea\x37\x23\x56\xa9\x56\x47\xd7\x4f\xaa\x57\xb6\xd9\x4a\x0f\x91\x3a\xae\x2b\x6e\x32\x16\xf6\x69\x26\x79\x17\x94\x10\x85\x53\xa8\xfa\xfa\xcb\xa0\xb4\x39\x95\xa8\x6a\x36\xbe\xbb\xc9\x31\x61\xd9\x34\x24\x9e\x4e\x1a\x68\xde\xe1\xf5\x4f\xc3\x73\xe1\x15\x31\xb9\xa3\x43\xdd\xd8\x97\x44\x21\x55\x42\xd8\x71\xbb\xaf\xe4\x7e\xb6\x46\xfc\xcc\x37\x39\xcb\x54\x89\x15\x86\x2f\x7f\xd6\xd1\x61\x34\xa5\xe1\x27\x56\xae\x06\xde\x6f\x14\x60\xd3\xb4\x4d\xd3\x2f\x93\x59\x9b\x49\x68\xde\xed\xd4\x77\x98\x5b\xc4\x19\x49\xb0\x42\xa9\x74\x75\x1d\xfa\x85\xd3\x87\x68\x0e\x3c\x45\x44\xd5\x47\xf1\x94\x60\xe7\x7a\x66\x79\xda\xc8\x54\x75\x56\x51\x77\x34\xa9\xe2\x1e\x84\xa8\x25\x49\x07\x4b\x4b\x08\x5c\x1e\x47\x01\xcc\x07\x63\x4a\x70\x08\x4b\x82\x12\x83\xe4\x60\x43\x54\xcf\x7b\x23\xa2\x0b\xed\x49\xef\x62\x01\x4c\x8d\xc4\xe1\x02\xa0\x8d\x35\xb4\x85\x8f\x65\x2b\x4d\x13\xda\x05\x2b\x7f\xb5\x63\x3b\x78\x87\x18\x62\xfe\x2e\xb2\x92\xf7\x10\x9f\xfd\xbd\x2b\x36\xc7\x88\xfc\xc4\xfd\xae\x6a\x44\xce\xcf\xa9\xc2\xa4\xd5\x44\xf8\x2b\x9e\x18\xc6\xd4\x15\x33\x6e\x71\xd8\xf8\x1d\x66\x74\x5c\x2c\x5d\x34\x40\xeb\x30\xec\x11\xf5\x9a\x8e\xf4\x32\xe8\xa3\x52\xc3\x16\x09\x1f\x0b\x02\x31\x15\xbc\x41\x78\x63\xa6\x32\x92\x11\x0d\xdd\xa5\xb3\x1c\x8e\xa5\xcd\xdc\xa5\x19\x2f\x7b\x0a\xf6\x33\xbd\x6d\x26\xc1\x03\xbe\x9a\x41\x52\xed\x3b\x8d\xeb\xda\xab\xb8\x7e\x40\xba\x20\xfa\x68\x60\xf6\x7c\xc7\xa4\x2a\xa2\xaa\x29\x41\x62\xb8\x31\xe8\xc0\x6d\xe9\xac\x02\xfd\x9b\xdd\xc0\xf6\x0f\xcb\x3a\xe6\xbf\xb8\x01\xea\x38\x21\x88\x09\xec\x00\xdf\x57\x0c\x36\x87\xcf\x7d\xd6\xda\x4b\xb7\x0e\x0a\x15\xc5\xd9\x04\x41\x0d\xd2\x24\x16\xb8\x2b\xbf\xb9\xe8\x49\xf6\x93\xe5\x01\x86\x0d\xc4\x1f\xff\xe3\xe6\x88\x75\x04\xb5\x54\xa2\xae\x61\xbd\x1f\xb1\x6e\xae\x0a\x57\x79\xfb\xfc\x04\xda\x20\xb3\x74\xf2\x7e\x0e\xb6\xe0\x10\x5e\xcb\xc6\x1c\xdb\xb6\x1d\x95\x52\x97\x29\x4a\xf2\x7b\x28\x5a\xd2\x56\x93\x2f\x9f\x29\x1e\x02\x34\x13\x16\x6e\xf7\x81\x8f\x8a\x60\x6f\x42\x60\xa0\xe7\x56\xb5\x38\x1c\xff\xca\xaa\x5f\x0f\xf8\x31\x19\xe5\xb3\x0a\x40\xc5\x5a\x9f\x31\x35\x39\xc7\x4c\x49\x8d\x8a\x31\xe9\xa1\x88\x09\x22\x7f\x68\xbd\xf0\xa1\x5b\x34\xef\x01\x14\xae\x5c\x78\x90\xc2\xb8\x2d\xc0\x83\x94\x04\xd9\xeb\x14\x76\xe4\x3e\x95\x50\xa9\xef\x00\xa3\xf4\xb7\x1f\x0a\x1d\x63\x0a\x49\xe2\xdc\x9c\x3c\x0a\xe9\xab\x36\x8a\xa3\xd5\x4b\x4c\x61\x0d\x7d\xda\x29\x54\xc8\x29\x96\x44\x93\x40\xcf\xe7\x59\x07\xd0\xcd\xb0\x9c\xe7\x29\x93\xb1\x47\x42\xb3\x79\xe7\xb2\x31\x20\x4a\x83\xfb\x9d\x8a\x7e\xa4\xbf\xe4\xe5\x2c\x64\xc9\xcf\xb5\x7d\x45\x91\x93\x51\xd2\x69\x85\xa8\x92\xe8\x6a\x3c\xbd\x1f\xff\x49\x08\xc8\xb5\x29\xba\xde\xb9\x5c\x3a\x08\x41\xb2\x9a\xba\x24\x67\x4f\x7a\x22\x62\x38\x6d\x27\x52\xfa\xc9\x6b\xa0\x54\x43\xbc\xe6\xb3\x9a\x62\x7e\xb0\x92\xfb\xb9\x60\x7c\x01\xcc\x98\x7c\x44\xea\x4c\x2d\x28\x8e\xa6\x34\xec\xce\x8a\x23\xf3\x10\x7a\x62\x7d\x84\xc1\x14\xd7\xc2\x73\x07\x13\x4a\x92\xad\x8d\x4c\xa7\x9c\xa0\x88\xe3\x22\x8a\x8a\x3c\x43\x44\x34\x2a\x58\x8c\x65\x64\x86\x35\x92\x79\xa7\xd9\xb7\x7b\xf0\x94\xb8\x54\x47\x8f\xef\x89\x5d\xfb\xfc\xf1\xf4\x23\x5c\xa3\x41\xbe\xe0\xfd\xe0\x74\x95\x83\x31\x9c\x17\xf0\x48\x27\x60\x31\xbf\x32\x65\x1e\x74\x11\xcd\x93\xc1\xde\x99\x74\x64\x0e\x69\xf3\x64\xfd\x9d\x97\x90\x2e\x7d\x07\x3a\x54\x8c\x6a\x07\x05\x6c\xe2\x5e\x58\x09\xa6\x0f\x31\x0b\xc2\x6a\x21\xe1\x7c\xf7\x98\x56\x2e\xeb\x69\x4c\xd2\x56\x68\x96\xc8\x45\x1a\xcd\xe4\x08\x0b\x75\xff\xda\xef\x5a\x68\xb4\xee\x48\x19\xb5\x71\x57\x44\xf7\x34\x60\x5c\x59\xb6\x3b\x96\x9c\xb9\xad\x14\x95\xed\x6e\xea\x2e\xd5\xde\x4b\x99\x44\x35\xc1\x62\x47\xd2\xf3\xbd\x67\x2d\x97\xbb\x6d\x28\xf2\xf3\x92\x0d\x1c\xdf\xe3\xc6\x18\x92\x5c\xe0\x10\x11\xdc\x8e\x39\xbe\x04\x1f\x86\xe0\xf7\xdb\x65\x00\x75\x44\x44\x4b\x28\x92\xf9\xf9\x87\x73\x3a\xc4\xcc\xd4\xd8\x43\x88\x5c\x08\xf3\x10\x04\x03\x57\x52\xfd\x76\xd6\x40\x2e\x06\x7b\x61\x77\x42\x78\x12\x74\xb3\x02\xf4\x53\xe5\xc6\x4a\x90\x30\x7e\x85\xc4\xd8\x35\xdd\x1e\x26\x34\x75\x6a\x4b\xea\xef\xb9\xbc\xd7\x8f\xfa\x39\x95\x4d\x77\xa1\x5a\xfc\x3c\xad\x51\x01\xff\x27\x16\x6f\x52\xe5\xb1\x6f\x83\xae\xd8\x30\xa2\xb3\xe4\x93\x62\xb1\x4f\xbe\xff\x9d\x19\xcb\x2f\xfb\xfd\xc3\xfc\xc5\xfa\x57\xda\xf0\x5f\xdd\x5b\x04\x65\x35\xef\x98\xc6\x1d\xd4\xa6\x0c\xe7\x04\xb0\x2e\x68\x1a\x67\x14\xd9\x41\xf6\xbe\x3b\x3b\x7b\xfc\xec\xce\x71\x61\x02\x88\x19\x24\x66\xc3\xdd\xaf\x0b\x32\x93\xbb\x61\xb1\x79\xd6\x9e\x53\x1d\x4d\x63\x02\x02\x02\x26\x6a\x52\xe7\xdf\xe4\x8d\x23\x16\x73\x7e\x41\x01\x34\x4e\x7f\x1f\x5a\x51\x94\x64\x15\x05\x95\x6d\x74\x75\x71\xad\x25\x8f\xe0\x78\x19\xcb\x99\x5f\x51\x0c\x7e\x18\xc0\xde\xfe\x98\x5f\x37\x99\xc7\x65\x43\xe5\x64\x4b\xe8\x02\xdc\x48\x11\x60\x51\x14\xe7\x1a\x07\x16\xfb\xa3\x4b\x80\xbf\xe4\x29\x2a\x3c\xbe\xd0\x7e\x94\x65\x98\xae\xed\x81\x3d\x81\x26\x7a\xf1\x69\x2c\xc4\x1c\x8d\x66\xf0\x9c\x2a\xcd\x12\x0c\x55\xc4\x39\xf2\xe1\xc4\xfc\x60\xe8\xd2\x8d\x7b\x71\x49\x34\xa9\xf3\x5d\x66\xb4\xa5\x20\x84\x33\x2d\xa0\x2d\x34\xbc\x4d\x87\xc4\x18\x8a\x77\x18\x25\x7d\x36\xe6\x64\x5a\xd6\x56\x0c\x31\x59\x9d\x90\x09\x59\x8c\xb3\xbd\xb4\x17\xbe\x0b\xbe\x2b\x19\x3f\x72\xde\x31\x01\xd5\x41\x98\xfc\xc4\x28\x6a\x9a\x3f\x25\x67\x18\x06\x5d\xc0\x50\x43\xec\x9b\x57\x17\xf2\x87\x8b\x2f\x99\xba\xe7\xd5\x61\xb6\xe8\x11\xc0\xa6\xc4\x65\x98\xf0\x94\xda\x10\x6c\x50\x9c\x16\x4f\xb1\xcb\xe7\xe9\xa3\x1b\xbd\x5d\xd1\x84\xda\xe0\x1b\x4e\x74\x07\xa3\x28\x42\xd6\x52\xe1\xa2\x62\x0f\x38\xc9\xf2\xa4\x04\x79\xdd\x7b\xbf\x3d\x4f\xe5\x1c\xff\xca\xbb\x35\x54\x55\xe5\xa2\x5e\xf0\x08\x83\x6b\x4d\x01\xc1\xd6\x4e\x22\xaa\x86\x5c\xb7\x51\x1c\x52\x55\x3d\x04\xbf\x1c\x46\x43\xdb\xf0\xf7\x5f\xe1\x3b\xde\xf7\xa4\x20\x30\x43\x52\xc8\x10\x82\x94\x7b\x52\xd9\x48\xf4\xb5\xc0\x78\xdd\x20\xb3\xd8\xe4\x16\x35\x90\x90\xe7\x96\x25\x30\x65\x2d\xef\x53\xf0\x15\x3c\x7e\xea\x8e\x2a\xeb\x1a\x7b\xbf\x51\x34\x07\x09\x31\x77\x79\x35\xe9\x2b\x6c\x0a\xa3\x44\x92\x06\xaa\xd0\x09\xa4\xde\xa8\x48\x69\x42\x30\x9b\x9e\x02\x54\x0e\x4a\xfb\x08\xa4\x56\x38\xbe\x06\x3b\x41\x0f\x14\x37\x23\xbc\x3f\x3b\x05\x94\xb7\xfc\xdc\xe5\xcd\x5a\x60\xd2\x5f\xa4\xdb\xf3\x88\xbd\x2b\xc1\x53\x85\x99\xa5\x37\x00\x76\x0d\x3a\xc8\x69\xc9\xb1\xa2\x81\x61\x1b\x4a\xee\xdd\x1f\x52\xd2\x49\x0b\xb5\xfe\xc8\x8e\xee\x5e\xcf\x00\x8f\x5d\x0d\xc4\x5e\x01\x47\xff\xd2\x4d\xe0\x02\xf5\x1c\x48\xff\xfb\xc1\x5f\xd6\x72\xc9\xc8\x12\xb2\xca\xb8\x82\x7d\xd7\x79\x6e\xb0\x9f\xce\x7e\x7b\x1e\x68\xa1\x02\x6d\x6c\xe3\x0c\x05\xe0\x3d\x4d\x14\x24\x99\xc2\x6c\xd6\xaa\xdd\x5a\xac\x12\x79\x23\x8d\x59\xc6\x87\x60\x72\xc9\x99\x68\xfe\x0e\x7e\xd7\xfc\x3a\xf4\x7e\x6a\xb5\x29\x0d\x98\xbb\xe6\xdd\x17\xa3\xa8\x30\x7a\x65\xa3\x7b\xf6\xe6\xed\x49\x79\x1c\x16\xb2\xf2\x3d\xb7\x6c\x1c\x2b\xda\x52\x24\x6e\xcc\xd3\xce\xc7\x76\x58\xdc\x14\xed\x34\x94\x34\xa0\x03\x9c\xcf\x30\xc6\x76\xbf\x40\xfa\x8d\x27\xdb\xfc\xad\xd5\xcd\x5f\xfe\xcf\x72\x1b\xdd\xff\x5d\xe8\xd8\x84\x3f\xb3\x8d\x51\x08\xc0\x29\x6b\xf0\x1a\x79\xd5\x36\xd6\xef\x47\x39\xa0\xa1\xdb\xf6\x50\x88\xcf\xde\x06\x18\x1a\xc1\x8b\x13\xb6\x8b\x0a\x8d\x8e\x38\x3c\x92\x53\xe5\x44\x52\x3b\xfc\x04\x32\x7f\xa1\xa3\xac\x6e\xa8\x55\xb1\x3f\x18\x85\x45\x8b\x14\x40\xa7\xe1\xb2\xa8\x2a\x3e\x14\x53\x3e\xd5\x56\x45\xa2\x68\x6d\xc1\x49\xce\x2b\x91\x47\x01\x60\x03\x97\x6f\x11\xc6\x64\x04\x3e\xaf\x85\x5e\xe5\x7e\x86\xda\x76\xcd\x02\x29\xa7\x08\x0a\x91\x14\xfa\x1b\xdf\x85\xcc\x43\xb6\x2b\x3c\xe9\xad\xfb\x3d\xbc\x97\x6f\x99\xc2\x5c\xb1\x2b\xb0\x00\xc4\x69\xb7\xe5\x7a\x71\x35\xb5\x84\x65\x9f\x6c\x63\x99\x4d\xfb\x30\x67\xb0\x8c\x81\x31\x73\xe6\xe9\x9c\x43\x42\x97\x6a\xee\xf3\xb1\x3b\xdc\x7b\x50\x9a\x5b\x01\x03\x8a\x03\x1b\xca\xda\xd6\x86\x7b\xbd\x8d\x48\x59\xe4\xb1\xef\xab\xd0\x9c\x46\x15\xfd\x88\x93\xf7\x0d\xb5\xd1\xa5\x40\xbc\x15\xf3\xf9\xb2\xe8\xe6\x18\x7f\x92\xd7\x5b\x22\x60\xad\xf1\x90\x5c\x5d\xf4\x2e\xd4\xc4\x79\x98\x4b\xe4\x9b\x85\xad\x14\x71\x4d\xe8\x85\xd5\x1c\xc6\xff\xec\xfa\xc6\xce\xb9\x87\x63\x93\x50\x2f\x36\x73\x8e\x9e\x6b\xbf\x20\xd6\x30\x74\x0f\x03\xec\x36\x45\x95\xc1\x50\xff\x02\x59\x12\xad\x4a\xe7\xf6\xa7\x5a\x69\x99\x9e\x0b\x75\x22\x28\x6d\xa6\x87\x95\x50\xf4\xb4\x96\x2b\x99\x82\x51\xe8\xdf\xdc\xb3\x1d\xd3\x1f\x6c\x22\x9c\xa8\x24\x54\x2d\xbc\x6b\x2a\x94\xc4\x2e\x41\xed\xa1\x72\xf6\x9f\xd2\x7e\x77\x42\xf1\x02\x2b\xa7\x5b\xa7\xbb\xda\xd0\x15\x0e\x5f\x5f\x0c\xfb\x64\x9d\xe3\x17\x58\x02\x72\x04\xbe\x72\x56\x7c\x17\x7d\x31\x8d\x97\xda\xc8\xc7\x78\x77\x58\xb5\x26\x5d\xdd\x39\x51\x33\x2d\x7a\x7a\xdb\x11\x21\x3b\x12\x44\xea\x4d\x61\x35\x9a\x05\x05\xcd\xc8\x68\x96\x7f\x80\x02\x00\xd1\xf5\xcd\x74\x41\x14\x90\x0e\x23\xd0\x4f\xa0\xa1\x7d\x7e\x2d\xd3\xde\xed\x6b\x1a\xca\x46\x28\xf0\x5e\x5a\xca\x0b\xfd\xe3\x52\x10\x0b\x15\x43\x63\x4e\x28\x96\xfa\x35\x20\x4b\xc4\xa3\xda\x01\x5a\x39\x41\x97\x85\xef\xd7\x6b\x33\xe7\x58\x89\xba\xf0\x00\xff\x9c\xb0\xfb\x76\x77\x30\xe1\x7a\x66\xf0\x0b\x6d\x8a\x07\x52\x6c\xbc\x7e\x25\xd8\xe2\x88\x2b\xe8\xac\xb5\x0f\x1a\xbf\x49\xc1\xc5\x21\xaa\xf2\x03\x30\xd7\xe8\x58\xa0\x1e\x71\x26\x67\xb0\xfa\x94\x6e\xd7\xbb\xdd\xfa\x38\xfd\x0c\x10\x20\xf5\x00\x28\x0a\x72\x74\x7f\x7d\xdc\x52\xe0\x18\xea\xae\x86\x2f\x97\x1e\xc5\xcd\x68\xed\x9d\xf5\x94\x07\x05\x32\x4e\xc2\x7c\x17\xb5\xe5\xd3\x49\x5c\x8d\xf0\xf7\x9a\xf3\xf1\x0f\x13\x28\x55\xf8\x7f\xec\x19\x82\xed\x3f\x0b\x97\x73\x3a\x95\xb8\xe8\x5d\x8d\x9e\x88\xe7\x1d\x1d\xb4\xd0\xc8\xaf\x90\x2d\x0f\x41\x55\xbc\x5a\x5d\xf2\x75\xab\x60\xeb\xdb\xf2\x05\x9b\xa0\xd3\xa4\x88\xc9\x61\x0d\x3a\x04\x8c\xa3\x89\x9a\xd4\xf6\xa1\xb6\xb9\xbf\x2b\x9f\xdd\x71\x6e\x0f\x84\x40\x44\xb9\x7e\x81\x97\xa2\x48\xd2\xb6\x0d\xea\x23\xe1\x95\xe3\xb4\x16\x03\xff\x58\xad\x9f\x5a\xc7\xc2\x87\x10\x61\x5f\x23\xd3\xbc\x9b\xf8\xcf\xd6\xb4\x28\x0f\xd7\x87\x04\x2a\x0d\x8f\x4f\x64\x78\x8d\xc3\xf4\x4b\xbc\xa4\x8c\xb2\xed\x54\x9a\xb5\x2c\x32\x3b\x44\x85\xce\xfa\x17\x0e\x5e\x66\xf3\x6c\xc5\x35\x5c\x22\x9d\x16\xb3\x6a\x4d\xd9\x30\x58\xb2\x8e\xc1\x20\xba\x04\x4d\x34\xf2\x44\x13\x28\xbe\x76\x66\xa6\xbd\xdd\x69\x52\x87\xfc\x21\x0c\xc2\xc3\x81\x68\xd2\x10\xe1\x3a\x57\xa4\xa0\xdf\x60\x40\xe6\x59\x63\xa0\x38\x2c\xbc\x4e\x8a\x99\x0a\x1e\xf0\xbc\xb2\xe1\x0e\xc0\x5c\x91\x8b\xb7\xd9\x7e\x6f\x16\x64\xb4\xf0\xd3\x50\xcc\xed\x65\x33\x19\x9b\x50\xe3\x64\x1c\xba\x49\x81\x65\x97\x26\xf7\x33\x90\x09\x0d\xdc\x6b\xd8\x72\x86\x9a\x02\x61\x0c\xbe\xb7\xf7\x5c\xb4\x3f\xe4\x79\x2b\x3a\xdb\xef\xca\x23\x66\xc0\xf4\x73\x89\x62\x39\xae\x0e\x0e\xbd\xaf\x6b\x24\x5a\xc2\x39\x5c\x19\x99\xf8\x88\xd6\x08\x0e\x55\x40\x85\x77\x48\xea\x55\xc4\xae\xa0\xd3\x51\xc7\xf4\x48\xd1\xa8\x3c\xc6\x71\x59\x39\x42\x7f\xb0\xbc\xd1\x30\x0b\x84\x0b\xeb\xb8\x7d\x87\x01\xc2\x23\xee\xd7\x47\xc8\x1f\xd9\x4f\x64\x72\xe3\x96\x4c\x23\x4c\xa1\x6a\x11\x2d\x61\xde\x22\xb7\x5f\xfb\x82\x63\x9c\xb3\x34\xe5\x4c\x13\xb2\x70\xb1\x89\x47\xd9\xbd\x87\x88\xcf\x70\xc2\xa2\xc6\xdb\xed\x6b\xd6\x9e\xa3\xec\xe1\x22\x67\x31\xa5\x0f\x63\xae\x36\x4b\xa9\x41\xfb\xda\xb3\x1c\xf1\x19\xbf\xd6\x2a\x5c\xa5\x22\xa3\x7f\xaa\x8a\x19\x9a\x85\x1b\xc2\x71\x61\xbe\x17\xb3\x64\x08\xe6\x3f\xaa\x73\x74\xe3\x03\xb2\x71\x72\xd3\x28\xbf\x96\x05\xdf\xe8\xcd\x1d\x9f\xbb\xba\xb9\xa6\x8a\xf3\x0a\xdd\x7e\xa1\x85\xa7\x43\xc0\x83\x74\xaf\x4a\xae\x6e\x67\xd6\x44\x70\x56\x47\x23\x7e\x00\xf3\xf9\x93\x54\xf4\x39\xb9\x3c\xc4\xff\x7e\x87\x6a\x01\xf1\xad\x18\x0c\x1b\xad\xf4\xec\x01\x62\x93\x2d\x7f\x27\x05\xdf\xb3\xfd\x1f\xa6\x7e\x7f\xd5\x35\x14\x5b\x28\x12\x60\xc3\x9a\xcc\x2b\xf7\xf0\xf6\x5f\xf7\xe3\x58\x1e\xff\x4b\x71\xcc\xf0\x37\x58\xc9\x6c\x9b\x20\xc7\x58\x72\x6c\x97\x46\xe5\x9a\xf7\x50\x47\x92\x27\xa8\x74\xec\x9a\xf2\x50\x17\x7e\xa1\x69\x08\xf0\xed\x20\xe1\x92\x05\x2d\x51\x4c\xa1\x5f\x0d\x78\x90\xa0\xeb\x2f\xf9\xc6\x41\xe9\x29\x40\xe1\x1f\xcc\x9a\xdb\xa3\x90\xe0\xb1\x94\x84\xa6\x92\xe0\x3d\x9f\x0d\xa2\xe2\xa1\x2e\x89\xdc\x2b\x99\x02\xe2\x8f\xbf\xf3\x28\x84\x12\x8f\xea\x14\xb9\x21\x2a\x09\xbe\x4b\x69\xa7\x95\x41\x1c\x72\x44\x1d\x15\xb1\x35\x03\x8e\xe5\x73\x54\x1d\x15\x3e\x38\x8d\x17\xee\x0d\x5e\x78\x14\x3e\x41\xf4\x5e\x80\x4c\x3f\x41\x0c\xef\x6e\x8c\x9e\x79\x8c\x8e\xba\xff\xb8\xcc\x0e\x7a\x4c\x7e\x81\xcd\x7e\x79\xcd\x97\x69\x14\xee\xbf\xae\x1e\x6b\x6c\x59\x1d\xd2\x7e\xf5\x5c\x21\xf9\xf7\x78\x8a\xc9\x9d\x6c\x4f\x5d\x4a\xd9\xbd\xad\x8e\xba\x4a\xb4\x44\xf5\xb9\xf8\xf4\x89\x7e\xd3\x97\x7a\x2c\x7f\xc0\xf5\x8f\x79\xf5\x4f\x79\xf5\x58\x1d\xd2\x0e\xfe\xaa\x08\x87\x92\xb8\x39\xf2\xd9\xe8\xff\x82\x2b\xa4\xb1\xf9\x47\x20\xbb\x4b\x69\xbc\xf2\x8e\x7d\x53\x29\x21\x92\x48\xbd\xca\xd8\xa1\x95\x4e\xf9\xcf\x1c\x48\xf0\xfd\xd7\x67\xe6\xb3\x71\xfe\xd7\x9c\x9c\x09\x42\x1f\x3a\xda\xb6\x05\x47\x3d\x7a\xcf\x5c\x4a\xc7\x62\x79\xf4\x9c\xc5\xb7\x62\x79\xcc\x9a\xbe\x0f\x75\x99\xec\x42\x05\xbf\x5c\x3a\x82\x7f\xfa\xf4\xaf\xb9\xd2\xff\x5c\x4f\x1a\x4b\x62\xe6\x32\x3c\xec\xd6\x11\xf8\xd0\xdf\xff\x3c\xb3\x40\x5d\x2f\x8d\x12\x5a\x99\x5a\xa8\x94\x19\x42\x7f\xe9\xb3\x78\xff\xbd\xfe\x7d\x8e\x40\x57\x25\x89\xb7\xfe\x09\xa7\xc1\xd1\x5f\x28\x8f\x77\xea\xd1\x5e\x1a\x9b\x57\x19\xab\xfd\x1f\x7b\x29\xed\x8c\x4e\x91\xdb\xa3\xe0\x57\xbe\x98\xc2\xb6\x94\xe8\x37\x94\xe8\x2b\xe7\xa1\xce\xf9\xd7\xf8\x9b\x40\x6a\xaf\x52\xa2\xbf\x4c\xa2\x6f\x45\x30\x7d\xb3\x63\x96\x10\x19\xcf\x54\xa2\xd1\xe2\x1d\xfe\xf5\x7b\x01\x36\xad\x61\x3c\x0b\xd4\x6d\xf3\xc9\x1c\xbd\xc0\xa1\x95\x3f\xf6\x0a\x87\xaf\x59\xe4\x10\x06\x6f\xf2\x86\xcf\xb2\x06\xa4\x4a\x86\x2f\xe0\x86\x2f\x8a\x86\xdf\xa0\x26\x64\x8a\x96\xaf\xbc\x86\xef\x20\xce\xd7\xb2\xe6\xe0\xfc\x05\xcf\xbd\x12\x34\x3c\xd2\x08\x87\xfe\x82\x37\xfc\x8f\x78\xe6\x7f\x8b\x67\x77\x0c\xf5\xc7\xfa\xf8\x01\x44\xb8\x52\xf8\x66\x71\x3a\xa6\x42\xfa\xe6\x08\xd4\xfc\xd7\x1c\x12\x59\x84\x7f\xa5\x24\x9e\x09\x12\xaa\x2e\x0f\x75\x7f\xdc\xaf\x4f\xe3\x9a\xf2\xcb\x6d\x40\xc6\x63\x08\xc2\x67\x05\xc9\x63\xf8\xc3\x63\x04\xc1\x63\xf1\x05\xea\x40\x01\x6e\xf4\xca\x63\xf2\x29\x6f\x09\x02\xe2\x72\xca\xbf\xf1\x52\x89\xee\xcb\x08\x1e\xf3\xd9\xfd\x0b\x9e\xfb\x3f\xe0\x39\xff\x3d\xde\xf5\xc7\xd8\x75\xf4\xff\x61\x2f\xfe\xb1\x47\x39\xfa\xcf\xfd\x18\x0c\xae\xf4\xaf\xfb\xfe\x65\x6f\x7f\xda\x9f\x8f\x35\x36\x4f\x63\x85\x24\xf6\x19\x12\x42\x8a\x14\x9e\x7f\xd8\x79\xd1\x29\xa0\xbd\x90\x48\x1c\x14\x0c\xc3\xa8\x85\x17\xb8\x6c\x28\xf7\x59\x2b\xaa\xa5\xff\x98\xac\xc8\xc3\xf9\x3b\x14\xe0\x71\x5c\xe5\x71\x1c\x91\xd4\x28\x50\x9e\xfc\x82\x86\x4f\x17\x33\xd9\x5c\x15\x82\xb1\xc1\x70\x63\xf9\xba\x59\xb0\x75\x7c\x19\xbc\xc5\xd6\x70\x05\xb5\x16\x59\xe7\x61\xfb\xd6\x70\x15\x51\x70\x3c\x8e\x19\x9d\x47\x4b\xa4\xa6\xe9\x02\xb4\x42\xe5\x7c\xae\x63\x24\x17\x0f\x58\x08\xe8\x81\x9e\xe8\x29\x1f\x3f\x7c\xc5\xbe\xf1\x13\x4d\xe1\x49\xa6\xf5\xac\xc0\x7e\xf2\xbd\xf4\xc6\xed\x94\x19\x61\x83\xb9\x79\x1f\x2a\x48\x8b\x44\xee\xcb\x2e\xcf\xb2\x32\xb3\xc1\x36\x0f\x2b\x85\x8e\xdd\x20\x8b\xeb\x2b\xad\x02\x7f\x4d\x5c\xe7\x99\xdd\x17\x5d\x46\x1d\x76\x70\xe0\x10\xfb\x11\xdd\xb0\x42\x69\xaf\x23\x6a\x2c\x7f\xab\x8f\x40\x84\x95\x11\x64\xb0\x31\x4d\x98\x12\x71\xd4\xed\x40\xab\x78\x6a\xd2\x3a\x4a\xdd\x58\x35\x1f\x43\x76\x30\xb5\x1b\xb8\x50\x3c\x75\x05\x6d\xe8\x68\x20\x9f\xfd\x74\x6e\xd1\x28\x9e\xac\xe4\x16\x73\x07\x5d\x57\xb1\x82\xfb\x60\x84\xe9\x8d\xf8\x6e\xaa\x8f\x7a\xdb\xed\x08\x17\xd4\xcc\x37\x57\x1b\x8b\xa7\xf0\x74\x98\x68\xd2\x5b\x45\xdc\x97\xd2\xc2\x82\x1a\x52\x0b\x92\x4b\x5f\x1b\x03\x77\x06\x28\x89\xb0\x79\xc4\x4b\x5b\xdd\x70\x7a\x9a\x88\x98\xce\x60\x5a\xc4\x8d\xc5\x44\x53\x48\x6a\x2c\xd4\xe2\xa1\xa3\x84\xe5\xde\x42\xe1\xe6\xd5\xcc\x97\x3e\x88\x56\x1e\x4f\x20\x0e\x4b\x5b\x84\x87\x6e\xa2\x8d\x0a\x12\x3a\x9d\x94\x03\x94\xa8\x76\xd2\x5f\x2c\x42\xca\x2e\x5f\x09\x30\x55\x6c\x09\x51\x6d\xc6\xb4\x4b\x68\x18\x8e\xa8\x6a\x91\x48\xda\x66\x6a\x00\xc3\xd8\xba\xef\xd2\x48\x6d\xbe\x3d\x96\x12\x33\x86\x75\x08\xb7\xc4\x6c\xfc\x16\x16\x4d\x1e\x0e\x83\x00\xe0\xc1\x2e\x30\x51\x76\xd5\x50\xf6\x21\x07\x94\x6e\x97\x86\x6c\x59\xde\x12\x4a\x80\xf6\x4b\xf7\xf1\xb7\xdf\xdc\x55\x0f\xeb\x65\x58\xab\xf1\x50\xe6\x1a\x35\x1d\x88\x33\x64\xaf\x45\xd3\x0c\x04\x42\xc7\xcf\xc1\x08\x05\x1e\xc3\x22\x06\x02\x57\x68\xe7\xdd\x46\xb1\x57\x5f\x61\x86\x87\x9f\x2e\x67\x8d\x73\x3e\xd6\x2f\xa0\x26\xf5\xed\x50\xbf\x80\x2d\x0c\x18\xe9\x00\x22\x66\xf2\xa1\x1c\x90\xb2\xe5\x0f\xe6\x7f\x3f\x46\x3f\xa8\x63\xfc\x41\xdc\x6f\xc8\x55\x43\xf6\x97\x95\xf6\x06\x7c\xd7\x33\xb8\xa1\x74\x80\x71\xd5\x72\xf6\x5a\x8d\x1b\x13\xf4\x38\x67\x93\xa0\x0f\xbc\x93\xf4\xc4\x1f\xb9\x23\x1b\x7a\x31\x21\x9b\xc7\x64\xd8\xd2\x44\x59\x94\x64\xb3\x6d\x5b\x12\x9f\x5a\xdf\x18\xea\x66\xcc\x72\x73\x77\x50\x18\x8c\x48\x46\xde\xcd\x03\x3f\x67\x53\x44\xcd\x41\xfb\xc6\xb6\x4e\x13\xe7\x6c\x2d\x56\x7b\xa1\xd7\x32\xff\x35\x14\xdf\x33\x16\xbb\x8b\x8d\xa0\xab\xd3\x2e\xed\x64\x15\x0d\x43\x81\xe7\x27\xf8\xb0\x0d\x23\x0f\x11\x47\x04\x36\xc3\xfb\xd6\xdf\x4a\x26\x8a\xff\x4d\x26\xfe\x37\x99\xf8\xdf\x64\xe2\xff\x35\x99\x70\x07\x03\x73\xfa\xe4\x35\xf9\xf0\x8f\xe0\x8f\xfd\x2b\xf8\x8f\xac\x2b\xa4\x9c\xc9\x9b\x82\x01\x09\x7f\x04\x56\xc8\x08\x94\xff\x25\x32\xf1\x1f\xbc\xf4\xbf\xc5\xfb\xff\x46\x26\x56\xc9\xf9\x5a\xce\xec\x15\xc8\x80\xfe\x08\xfe\xcd\xbf\x82\x7f\x20\x0a\xee\xf0\xc7\xff\x9c\xcf\xf1\x85\xc7\xf2\x9d\xc7\xe9\xfe\x57\xc8\xc4\x7f\xf0\x4c\xe1\xbf\xc5\xfb\xff\x87\x4c\x0c\x35\xfc\x56\xc6\x1f\x64\x22\xff\x0f\x99\x30\xff\x8b\x4c\x40\x87\x1e\xe8\x2b\x4b\xc3\xe7\x52\x86\x91\x3d\x2d\xbf\x40\x79\x16\x28\xf4\x3a\xd3\x13\x1e\x23\x49\x64\x01\x45\x47\x28\x28\xc1\x6e\x06\x38\xc0\xf2\xfa\xa4\xe9\x5d\x89\x67\x5d\x4e\x53\x95\x60\x50\x84\xa8\x83\x22\x6d\xec\x33\xe8\x1c\x11\x04\xc0\x11\x98\x1e\x55\x84\x68\xf4\xaa\xab\xbe\x33\xbe\x66\xf2\x37\xcf\xf3\x6f\xfc\x05\x62\x10\x4e\x3b\x6a\x5c\x25\x92\xf7\xca\x5b\x7d\x6e\xa8\xb3\x0c\xf4\x49\xcc\xb7\x10\x7d\xdb\x4f\xf0\x51\x01\xa4\x10\xa0\xe4\x4f\xb4\x80\x9b\x54\x47\x23\x3f\x24\xa0\xb2\x55\x7c\xb2\xb2\x74\x2a\x3a\x70\xde\xed\x91\xb0\x4c\xf6\xb8\x61\x2a\xe2\x9d\x82\x3e\xd2\x0d\xda\x57\x32\xe9\xfd\x6e\x8f\x43\xd0\x14\x35\x77\x75\xaf\x4f\x5e\xe5\xa5\xe4\xb7\x5d\x84\xd0\xb2\xff\xce\x8b\x6f\x88\x4b\x56\x23\x91\x59\x7f\x22\xc8\xfc\x9e\x0a\x5c\xba\x79\x27\x80\xe4\xf7\x2e\x1b\xd7\x05\xec\x65\x5a\x16\x40\x8a\xbf\xd8\xe8\xe7\xa8\x80\xa2\x57\x61\x2c\xc7\x86\x4c\xcc\x9c\x29\x65\xdb\xbc\xcf\x7e\x90\xfd\x1e\xc7\x3d\xd9\xd2\x11\xa1\x1a\x8d\xe2\xf9\xd7\xbd\x8a\x47\x8b\x9d\x8b\x25\x1a\xc5\x4b\xd6\xee\x6e\x64\xa0\x17\xc2\x8e\x4c\x98\x3a\xc2\x66\x61\x1b\x20\x5b\xbd\xf9\xa5\x36\xab\x9e\x89\x49\x3b\xab\x90\xdd\x6e\x5b\xd9\x59\x44\x02\x0d\x70\x29\xeb\x10\x76\x86\xab\x75\xb1\xa3\x69\xca\xb4\xe5\x6c\xe0\x80\x5d\x8c\x5f\x73\xcb\x59\xae\x84\x21\xf7\x20\x7d\xf9\xd3\xc4\x7b\x22\x48\x3f\x45\xd6\x71\x2c\x6d\x47\x6a\x90\x42\xc3\xe5\xb6\x52\x02\x23\xcf\xd2\x4d\x0b\x69\x65\x26\x9a\x8e\xe7\x5e\xd4\x30\x93\x58\xe0\x1a\x4d\x41\x1a\x85\x7b\x16\x87\x11\x76\x47\x39\x56\x93\x93\x23\x6d\x6e\x8c\x05\x82\xb3\x9f\x9f\x80\xbd\xa8\x7c\xf9\x79\x78\xad\x7a\xc6\x2d\x2c\x4e\xb4\xa8\x4c\x49\x67\x90\x87\x7f\x68\xd6\x91\x5b\xc0\xb1\x98\xb4\x59\x00\xb5\x83\x7b\x52\x60\x29\x61\x32\x92\xf2\x67\x2b\x32\x92\xb8\x29\xf4\x22\x5a\x0a\xec\xe1\xc7\xca\x33\x72\xc1\xd3\x6a\x48\x0f\xc4\xfb\x99\x8b\xce\x5f\x7d\xd7\x92\xa0\x96\x6f\x2e\x0d\xe5\x02\xde\x42\xd7\xf0\xef\xf5\xe8\x5f\x83\xbd\x9d\xe0\x5d\x4b\x9f\xb6\x75\x89\xd1\x16\x53\xe9\x2a\x52\x56\x4d\xe6\xe9\x8a\x6d\x59\xc4\x75\x37\x5d\x92\x65\xae\xf0\xf3\x5d\x57\x10\xca\x99\x32\x2a\x35\xf7\xc2\x14\x37\xc8\x59\x2f\x4d\xe1\xd9\x44\x3d\x73\x6e\xa1\x16\x6b\x86\x73\x66\x18\x90\xd9\x48\xf3\xb4\x1c\x35\x7c\xcc\x6b\xf6\xe3\x6c\xe9\x2b\xe4\x33\x20\x77\x30\xde\x72\x05\x62\x21\xdc\x71\x5f\x3f\x63\xa0\x7d\xcb\xae\x7f\x40\xdd\xe9\x2a\xa7\x8b\xc9\x0f\xf5\x53\x4f\x33\x0b\x85\x04\x32\xf5\x8e\x9c\xc9\x36\x00\x7f\xd8\xdc\x06\xa3\x8f\x93\xc0\x6b\x50\x2d\x18\xc6\xb8\x8d\x6c\x27\xa2\x40\x2b\xff\xde\xb6\xa4\x7d\x1b\xff\x59\x5c\xa2\xfd\xf7\xf1\x6c\xd4\x50\x1c\x7f\xb6\xe5\x3f\x3f\x20\x7d\x63\x63\x58\x32\xd8\x64\x5d\x2b\xf3\x86\xd5\x67\x05\x04\xb5\xdb\x14\xc1\x8b\x6d\x44\x5e\xbc\x3c\x2f\xc6\x0e\x4a\x49\xf4\x60\xf1\x51\x5c\x97\x8d\xd3\x51\x74\x68\x29\x2c\x79\xc1\x54\x5a\x49\x7d\x06\x7e\xbf\x91\x69\xae\xd2\xd9\xa0\xd0\xdf\x69\xa6\xa3\x35\x99\x0b\xdf\x7e\xe3\xf7\xa1\x25\x48\x7f\x17\xea\x2d\xf7\x43\x71\x2d\xb8\x8d\x89\x86\xc4\x06\x5a\x08\xea\x9e\xcb\x10\xb0\xf5\x21\xf8\x2b\x09\xf0\x24\x32\x13\xa6\x72\xd2\x35\x44\x1f\xa0\xb3\x65\xb7\x41\xe1\x01\xa0\xac\x02\xd7\xf3\x26\x7b\x94\xb0\x47\x4d\x30\x98\xaf\x38\x34\x15\xcd\x24\x31\x9b\x0c\xb9\x0f\xa1\x12\xb8\x0b\xa9\x3b\x06\xe3\xb7\x46\xd1\x4a\xb4\xa1\x52\x74\xc2\x80\x18\x8c\x75\xe7\x41\xd4\x2f\xfb\x03\x05\x9d\xb8\x44\x9b\xba\x4a\xc6\x83\xf3\xe6\x67\xf1\x1b\x9e\x29\xf6\x92\x51\x08\x13\xb1\x0d\x38\xbe\x9a\x6e\xc8\xb0\x6d\xac\x60\x46\xc7\x97\x1e\xeb\x9f\x04\x17\xa7\x38\xb2\x59\xb4\x76\xda\x93\x29\xb6\xa7\x27\x04\x97\x71\x34\xda\x34\x4e\xff\x76\x4e\xea\x0e\x95\xe3\x7d\xba\xbb\x5c\x52\x66\x17\x5d\xa4\x1f\x78\x9d\x79\x91\xc0\x2e\x2d\xa1\xb1\x97\xd5\x76\x99\xd9\x64\x10\x90\xd0\xeb\x9d\x89\xcf\x75\x92\xbf\x7a\xa5\x44\x7c\x8d\xf8\x03\xd8\x4c\x63\x45\x94\xae\x97\xa5\xbb\x2c\x23\xee\x32\x01\x14\x05\xb9\x85\xb1\x6c\x7c\x0e\x4b\x9f\xf6\xca\xc5\x81\xd5\xa1\x69\x3a\x87\x48\xae\x1f\x19\x12\x10\x40\x7f\x64\x36\x30\x48\x53\xcb\x74\xe1\x8a\x54\x6b\xe1\x22\x1e\x56\xd8\xe8\x30\x22\x7d\x9e\x1a\xb0\x89\xfb\x3b\x0e\xdf\xee\x3c\xbf\x22\x73\x26\x08\x6b\xcb\x68\x70\x29\xd4\xf8\xea\x95\xfe\x39\x68\xcd\x88\xdf\x49\xdb\x2d\x01\x44\x46\xdd\x8f\x04\xb8\x4a\x6c\x99\x23\x7d\xae\x4d\x0f\xe5\x8c\x6e\x1f\xbd\xf8\x35\xbc\x6d\x90\xb2\x76\x63\x55\x92\x29\x9b\x34\x93\xf3\xba\x14\x7c\x84\x5c\x21\x6d\xd8\xa3\xfa\x48\x73\x14\x58\x5d\x7b\xd9\x64\x86\x06\xc9\x21\x95\x17\x13\xef\x07\x50\x10\xb0\x49\x5c\x54\xa5\x0b\x98\xba\x34\xcb\x90\x82\xf6\x17\x3f\xc0\xee\xfe\x62\x84\x68\x63\x1c\x08\xb3\xc7\xaf\x9c\x0f\x4e\x92\xcd\xce\x62\xbe\x28\x6d\x04\xcd\xc1\x9d\x03\x1f\x95\x4d\x5f\xfa\x3c\x8b\x3d\xc7\x2f\xdc\x17\xb1\x6e\x1f\xf3\xd2\x6d\x80\x94\x84\xb1\x9a\x21\x8c\x02\x01\x18\x9f\x18\xfc\x72\x88\x5f\x45\x73\x1d\xfc\x6f\x4a\xa7\x5a\x3c\xbe\xea\x6e\xe5\xa4\xa8\xef\x20\x61\x8c\x74\x0a\xb3\xd4\x19\x75\x51\xfe\x3b\x13\x81\xa3\xc9\xfe\x59\xb6\xfb\x3f\x39\xfe\x1d\xc1\x35\x04\x51\x74\x57\xea\xd6\x1c\x41\x56\x43\x22\xd4\xc2\x13\x1c\x3f\x0f\x94\x7b\x5e\xbe\x67\x65\x8b\xab\x5a\x2b\xc1\x86\x34\x7b\x69\x0a\x1f\xbc\x47\xd3\x87\xc9\x41\xd1\x72\x61\xe2\x5a\x31\x48\x34\x29\x3c\x74\xc8\x45\x1a\xfd\x30\xb9\x11\x4d\xe1\x14\x05\x3e\x8f\x19\x97\x79\xaa\x3e\xab\x02\x2d\x80\x26\xd1\xeb\x4a\x85\xfd\xc5\xd4\x64\xde\x4a\xdb\x38\x09\x77\xf0\x9c\xc4\x41\x68\x55\x18\x58\xc0\x4e\x8b\xdf\x3e\xfe\x0e\xbb\x75\x46\x04\x0d\xd3\x82\x02\x8f\x1f\xad\xc0\xaf\x21\x37\x90\xc0\x50\xf2\x8f\x35\x20\x6c\x0c\x45\xa6\x5d\x12\x94\xaa\x3d\x8f\x77\x42\x13\x5d\x6b\x2d\xa4\x24\x52\x2b\x9e\x54\x1e\x89\x0b\x7e\xf2\x71\x0b\xd0\x4a\x2f\xd0\x15\x09\xc5\xb4\xff\x11\x40\xe3\x34\xf7\x3e\xb7\x9f\xe4\x9f\x1b\x61\xb6\x3b\x31\x24\xe9\x16\xf7\xb7\x0e\x49\x62\x2b\x8c\x68\x40\x40\x56\x3d\xdf\xd1\x0e\x4b\xd2\xf1\x0b\x34\xd3\xdd\xb5\x61\xad\x14\x85\x5d\x35\xc5\x3d\x14\x58\x25\x8b\x98\x6c\x63\xb7\x2d\x40\xc3\x6a\xe3\x1b\xe7\x07\x2e\x95\x24\xe4\x8b\x99\xac\xff\x44\x65\x61\x8d\x6b\x6e\xe0\x77\x55\x34\x6b\x9b\x6c\x14\x92\x22\xd4\x52\x84\xa2\x9b\x96\xeb\x1b\x1f\xf7\xc5\xdd\x86\x11\x6f\x5e\x60\x14\x8b\x1f\xb8\x62\x52\x8c\xb2\x73\xf4\xdd\x2c\xa3\xdb\x48\x4b\x75\x6c\xa8\xf5\x13\x82\x03\xce\xd8\x56\xdb\xd0\xb8\x9b\x1f\x2d\xd9\x44\xfa\x75\xf2\xcd\x55\xb6\xdd\x78\x01\x48\x7a\x5f\x8c\xcc\xb5\x26\x88\x62\x4f\x3f\x78\xc1\x14\xf0\xbb\x3c\xce\xcd\x61\xfc\x09\x87\x44\x38\x9c\xed\xdc\x95\x2a\xe1\xa6\xe4\x38\xcd\xbb\x90\xaa\x77\xfe\x52\xe8\xb1\xec\x4f\xfa\x62\x78\xe6\xed\x5a\x5d\xc8\x7f\x11\x93\xb6\xd6\x12\xae\xe8\x47\x74\x1b\x1b\x74\x2c\xcc\x60\xb3\x50\xdf\x9c\xb0\xe7\x83\x0e\x1a\x98\xdf\x08\xf8\x8c\xf7\xfc\x03\x2c\xbe\xf0\xad\x70\x49\x4d\xf4\xc1\x51\x32\x61\x64\x0c\x0b\x75\xd3\xf7\x40\x66\x00\x46\x5a\x90\x08\xc0\xb8\x08\x6f\x76\xf3\xec\x82\x9c\x1d\xe1\x4b\xb5\x4f\x30\x26\xf3\xfc\xae\x87\x22\x9b\x84\x93\xb8\x27\x16\xb4\x50\xbd\xb8\x48\xdc\x25\xb4\x6a\xe1\xcb\x04\x55\xcc\xe2\x09\xab\xc4\x02\x8e\x5a\x20\x09\xf5\x63\xd2\x97\x74\xf9\x56\xe8\x4e\x70\xa6\x4b\xa4\x63\x42\x6c\x49\x11\x03\xbb\xe4\x05\xa4\x42\xb7\x0c\x6f\xac\x85\x46\xda\x6d\x68\x2b\x4d\x6b\x8a\x21\xb6\x93\xb4\x35\x1c\x42\x38\xbf\x41\x78\x8e\x90\x1c\x6c\xb8\xb5\x86\x6d\xbf\x50\x80\x84\xac\x1a\x62\xdd\x01\x09\xcb\x25\xcc\x76\x69\xda\xeb\x99\x70\xa1\x65\x01\xdb\x80\x5a\x33\x05\xe8\x1a\xf1\xec\x3e\xbd\xa5\xed\xab\xae\xcf\xdd\xaa\x0e\x7b\xe6\x08\x1a\xc1\x71\x35\x32\x69\x3b\xda\xd2\x66\x72\xd6\xa2\xf4\x79\x46\xcf\x8e\x46\xd4\xb9\x9b\x39\x53\x7e\x11\xe5\x18\x2d\x8f\x63\xc8\x15\xe4\x0f\x18\x61\xf4\x44\xee\x1b\x76\x61\x8a\xc1\xb0\xd6\xd6\xa1\x42\x4f\xe6\xcc\xbf\xde\x05\xfe\x7d\x1a\xc2\xa3\xf9\xfe\x61\xbe\xe3\xaf\xad\x4b\x7f\xb6\x23\x27\x76\x6e\x7c\x9e\x5f\x97\x87\x32\x8b\xc7\x30\xac\x5d\x16\x87\xd1\x72\x22\xa2\x1b\x08\x33\xb8\x2f\x84\x7a\x36\x3a\xb8\xc8\x20\x53\x03\x2c\x4c\x53\x54\x57\x63\x50\x5f\x88\x82\xeb\x04\xae\xc9\x7a\x4e\xc9\x47\xa3\xee\x98\xc8\xb8\x9f\x15\x35\x57\xe9\x9e\x5e\x05\x29\x2e\xd6\x8f\xdf\x3f\x83\xc4\xbf\xb9\xb6\xa6\x70\x9a\x8e\x20\x79\xaf\xdf\x0b\x00\x28\xf1\xd6\x56\xe5\xc1\xdb\xf6\xf1\xc7\x25\x23\x36\x49\x30\xab\x3d\x46\x28\xff\xcb\x91\x5d\xae\x1e\xf0\x2c\x0a\x39\x62\xca\x3c\x8d\x51\x68\xb0\x10\x80\x45\xf7\xce\x44\xbf\xd6\x9f\xe5\xd3\x21\x08\x80\xc6\x3b\x0c\x41\xfb\x4f\xfc\xac\x70\xec\xd9\x52\xb3\x52\x53\xce\xbe\x2b\xf4\xc9\x0a\xbf\x45\xc0\x4b\xd9\x9e\xee\x52\x15\x40\x55\x18\x9e\xf2\x08\x19\x5c\xca\xc7\xf4\x5b\x1c\x3b\xbc\xc3\xea\xcd\x0f\xae\xb9\xf8\xfc\x60\xa5\x5d\xfd\xe0\x5b\xad\x51\x1f\x68\xc3\x4a\xe1\x8e\x98\xe7\xe7\x0a\x9a\xc2\xc9\xc2\xbe\x24\x49\x6c\xc4\xd7\x58\x44\xd3\x76\xea\xcd\x94\x90\xf0\xcb\x0d\x12\x58\x40\xcc\xc0\xb8\x8d\x6e\xa4\xeb\x6c\xf0\xc6\x2a\x99\x9f\x90\x54\x50\x29\xad\x84\x7c\x5f\x93\xbc\xe6\x32\x07\x47\x35\x6d\xcc\x22\x37\xc8\x8e\xf5\x26\x82\xfd\x79\xea\xed\x20\x2c\x81\xfb\x13\x6e\xa6\x12\x2a\xee\x6d\x37\x11\x77\x6e\x98\xea\x8c\xe5\xab\xbc\xdc\x86\x76\x28\xdb\x82\x70\x56\xe3\x22\x4a\xc1\xac\xc7\xdd\x5f\x45\xb3\x10\x1d\x49\x76\x4d\x2f\x4c\x21\xbf\xe5\x09\xf5\x38\x2a\x1c\xde\x6e\xa0\xb7\x1d\xab\x10\x80\x44\xa7\x35\x5a\xe4\xfe\xd7\x9d\x9f\xc2\x0f\x93\xec\x99\x9b\xc0\xf9\x62\xe3\x78\x85\x50\x24\x67\x81\x98\x0c\xe5\x17\x65\x72\xc7\x3c\x20\x0d\x1a\xe8\x27\xa1\x09\x9c\x24\x12\xfd\x94\xb3\xec\x18\x9e\x5a\x88\x85\x71\x11\xd7\xe5\x26\xc7\xe8\xbb\x1d\xe5\x94\x17\xee\xbc\xd5\x7e\x3d\x2e\x6e\x45\x3f\xae\x5e\xa6\x69\x31\xd6\x05\xd4\xf0\xa6\xd6\xec\xd4\x3b\x88\x5b\xb1\x97\x8b\x69\x86\x86\x44\xa7\x9d\x3d\x36\x39\x66\x11\x1c\x00\x07\x90\x93\x6c\xa2\xbb\xa3\x56\x52\x16\x75\x2e\x9f\xfa\x7a\x2a\xf6\x22\x5c\x68\x4d\x7f\xa7\xbb\x2b\xf7\xf4\xdb\x0e\x3e\x5b\x37\x6d\x95\x55\x0c\x41\x9b\x33\x27\x69\x88\x8c\xa5\x01\x57\x72\xff\xa0\x31\xbb\xd9\xcc\xa6\x16\x1b\x0a\x6c\x96\xaa\x24\xe1\x51\x2d\x7f\x62\xa8\xab\xe4\x40\x15\xd4\x13\xcc\xcd\x1a\x2e\xe5\xc8\x7b\x52\x2e\x26\x3d\x95\x60\xaf\xb1\x5a\x12\x44\x8f\x72\x8d\xbe\x8a\x68\x7f\x0c\x5a\xdf\x72\xea\x33\x4e\x78\x04\x98\x1a\xf8\x95\xc8\xff\xca\x86\x2f\x47\x85\xba\x99\x0f\x77\x5e\x44\x7b\xf6\xdb\xad\x14\xd2\xb1\xf8\xb4\xb0\x7e\x50\x2a\xa3\x1b\xd2\x2a\x3c\xab\x90\xdf\x06\x13\xc7\x39\xa0\xa2\x69\x3f\x8c\x82\xdd\x26\xb0\x0e\x4b\x51\x24\x8b\x80\x25\x6a\x80\x0b\x65\x70\x18\x98\xb3\x1f\x08\x71\x21\x84\x7d\x2b\x06\xa3\xf5\xa8\x0d\x22\x49\xa0\x35\xc9\xdf\xca\x37\xba\xfa\x1f\x26\x21\x98\xdc\xbf\x9e\xe5\xfc\x2f\x25\xe1\x96\x7c\x72\xb4\x1f\xe3\x2f\x51\xcd\x22\x0a\x84\xf1\x0d\xd4\x3d\x33\xa5\x96\x66\xa1\x06\xd6\x17\xe8\xa2\x85\x97\xa4\x84\xfc\x17\x3e\x6c\x1b\x9f\xb2\xd7\x4e\x08\x9e\x48\xac\xea\x3a\x2b\x93\xb0\xbc\x10\xad\x99\x60\x0b\xed\xb0\x97\xe1\x9a\xbf\xfd\x77\x55\x16\x66\xd4\x29\xcf\xf9\x51\x9e\x9d\x55\x5d\x90\x28\x61\x2b\x5f\x67\x31\x24\x69\xd9\x32\xf2\xaa\x5c\xe1\x49\x65\x83\x9c\xc3\xee\x30\xda\x52\x8b\xcb\x09\x92\xe1\xef\x60\x3c\x77\xbd\x42\xf3\x9d\x7b\x18\xad\x89\xd8\x6a\xfb\x86\x92\x99\xd1\xf0\x15\xa7\x7c\x41\xdd\x02\x2e\xf4\x4c\x02\xdb\x1f\x18\x99\xf3\x73\xa0\xd0\x00\x68\x2d\x5f\x49\x23\x80\x36\xbf\x4e\x0c\x7c\xba\x57\xce\xe7\x22\x61\xee\xda\x85\xe9\xb4\xc9\x25\x90\x5f\x2c\x5a\xdf\xbd\xa6\xf2\x4b\x5a\x8d\x97\xa7\x7e\x0d\x09\xf9\x5d\x0e\x5f\xb2\xe3\xad\xa4\xd9\xe9\xe0\x67\xdd\xc2\xc8\x71\x77\x4b\x80\x09\xc0\x9c\x04\xb0\xa9\xb8\x9c\xa9\xf0\x3b\xc0\x1e\x08\x35\xc5\xdf\x43\x85\xe6\x99\x92\x34\xd1\xd3\x1b\xc8\x37\xcc\xbd\x4c\xf7\xc6\x8d\xdd\xc8\x4c\x22\x24\x0a\xd8\xcd\x29\x33\x33\x0e\xbd\x85\xbd\xc0\x0f\x52\x1b\x33\x46\x96\x9d\x88\xed\xc4\x43\x36\xb0\x70\x5e\xa6\x70\xb2\x43\xec\x19\x88\xb5\x65\xc2\x4c\xba\xb5\x28\x98\x5b\x91\x82\x17\xe1\x42\x55\x4c\x95\x79\xd0\xc6\x57\x65\xb6\x07\xf5\x31\x50\x31\xa4\x49\x1d\xaa\x48\x89\xbc\x0f\xd7\x1a\x05\x5f\xcb\x36\x47\x5f\x3e\xf6\x70\xde\xe3\x92\x96\xfa\xf9\x0a\x4f\xed\xd0\x5d\x3d\x55\xc8\x43\xd2\x61\x94\x14\xae\x0d\x43\x55\x8b\x4f\x50\x0b\x18\x69\xc0\xe6\x32\xe4\xea\xc0\xbe\x82\x33\x12\x87\x0e\x2c\x27\xa3\x00\x46\x70\xe7\x62\x3b\x27\x79\xa6\x6a\x05\xe8\x6d\x0d\xf4\x60\x32\x56\xf1\xae\xc4\x33\x38\x51\x07\x16\xc3\x32\x34\x28\xed\xdc\x9a\x29\xf2\xfd\xa3\x44\xab\x75\x28\x04\xb5\x70\xb7\x8e\x08\xe2\x08\x24\x86\x6f\xcd\x17\x75\x4d\x97\xef\x0b\x13\x83\x65\xfd\x29\x1d\x4a\x25\xfa\xc4\xdf\x2d\x47\xaf\x2d\x95\xd5\xb0\x1a\xd8\x11\xf0\x5c\xb2\xa3\x5d\x4c\x49\xf3\x61\xe3\x10\xab\x50\x4e\x9f\x32\xf2\x85\xb4\x16\x36\xf0\x36\xb4\x11\xfd\x71\xaa\xa5\x7c\x7e\x48\x30\xfc\x8e\xb8\xf8\x39\x26\x3c\x6f\x0e\x49\xbf\x42\x7c\xfb\x13\x81\x8f\x0b\x9b\xe1\x46\x61\xe0\x82\x83\xa7\x77\xd2\xc1\x0c\x21\x8f\xe1\x4b\x64\x81\x0d\xe5\x2c\xe8\x66\x4a\x00\x70\xd0\x9f\x1c\x02\x03\x71\x91\x2b\xbb\xa4\x19\x78\xfb\xaa\x60\xd1\xd6\x6e\x7c\xe8\xa7\xd0\xa3\xaf\x69\x80\x13\xfc\xaa\xb0\x3e\x9d\x6d\x30\xe8\x9c\x33\xe1\x77\xff\x45\x5a\xfc\x94\x87\xdd\x5c\x5e\x71\x07\xcc\x84\x53\x71\x8a\x23\x11\x4c\x38\xaf\x7d\x74\xb5\x1b\x7c\x1d\x4e\x51\x52\x2e\x74\xf0\x77\xc8\xf6\x7e\xdd\x0c\x87\x9d\x1c\xbd\xb5\xf3\x9e\x5e\x7f\x73\x53\xc2\xf1\xfc\xc3\xba\x12\x2a\xf5\xdf\x85\x84\xe0\x77\x58\x12\x2c\x38\x76\xbd\xbf\x71\xf7\x7e\x6c\x73\x2a\xaa\xf7\x72\x6d\xca\xb2\x82\xc8\x69\xab\x26\xeb\x0b\x51\x35\xab\xf0\x33\x67\x8a\x93\x1b\x99\x6a\x86\x54\xe9\x7c\x4f\x89\xf5\x56\x65\x22\xae\x19\x16\xa3\x71\x0d\xaf\x73\x59\x4f\x43\xe8\x0f\x7b\x69\x40\x81\x4b\x50\xe6\x7e\x62\x8e\x1a\xbe\x3d\xcf\xfd\xf0\x8d\xab\xe3\xbd\x73\x02\xd4\x36\x09\xd5\x92\x88\x34\xce\xc9\xbe\xdc\x47\x8c\x75\x59\xd2\xc1\xf9\xab\xaf\xdf\xaf\xaf\x76\x82\x24\x90\x2a\x47\x8f\xcf\x21\xe8\x53\x54\x6f\x7b\xdf\x2c\x9b\x03\x0c\x56\x98\x9e\x67\x6d\x3b\xec\x18\x03\xdc\x92\xa6\xdd\xfa\x32\x36\xd2\x0b\xc0\xa2\x17\x45\x09\x5b\xaf\xe8\xca\xe1\x95\x52\xea\x92\x09\x64\x96\x0f\x4f\x1b\x33\x05\x2b\xf6\x8a\xf0\x7b\xa6\xf6\x32\x91\x65\x8b\x62\x81\x84\x68\x7b\xaf\xef\xcc\xa7\xf5\x75\x7e\x3d\x28\xc6\x03\x89\x17\x4e\xe6\x09\x46\x65\xc4\x01\xf5\xbc\x83\xcd\x80\x72\x5f\x82\x04\xa8\xbb\xc5\xdb\x0b\xeb\x81\x74\xa4\xcc\x54\x18\x83\xf8\x95\x9e\x46\x5a\x60\xa9\x59\xa8\x3f\x3d\x42\xf6\x14\x8c\x0a\xb2\xa4\x71\x71\xc6\xa8\xc2\x73\x82\x18\x6b\x95\x1d\x23\xf0\x10\xf5\xeb\x1c\x1e\xf9\x75\xd6\xaf\x7a\x5e\x77\x49\x0a\x94\xbb\x76\x26\xd7\x25\x86\xe9\xd3\x26\x23\xaf\x26\x95\x10\x34\x32\xb8\x92\xbd\x96\x47\x53\x0b\x94\xf0\x5d\x76\x34\xba\x2c\x43\x54\xf6\xed\xcd\x6d\xe3\xa9\x6d\x48\x0a\x78\xed\x79\x8d\xcc\xf2\xea\x05\xc0\xa4\xfd\x45\x63\xad\xed\x77\xbb\x76\x9c\x4b\x13\x99\x10\xd0\x0b\x0e\x38\x02\xda\x20\xcb\x15\x46\xfc\x84\x78\x05\x82\xe4\xac\x33\x51\xd7\x2f\x5c\xf3\xc1\xa3\x7f\x40\xb9\xd8\x3f\x58\xb0\xa2\x34\x73\xa0\x57\x5c\x9e\x06\xb1\x0b\x57\x58\x76\x81\x51\xb0\xf3\x4a\x5b\x75\xfd\x44\xc3\x06\x0a\x2f\x76\xf3\x0d\x80\x30\xd4\x35\xb2\xb9\xfa\x80\xc3\x8c\x34\x43\x21\x24\x13\x6a\xa0\x14\xc2\x64\xd1\x05\xcc\xc5\xe4\x40\xe8\x7d\x48\x98\xac\xea\xd4\xf2\xf2\x2f\x41\x99\xc2\xc5\xac\x15\x61\x24\x06\x5f\xc1\x08\x85\x7f\x74\x05\x5d\x66\xe3\xfd\x5a\xda\xba\xb9\xdf\x5a\x71\xc3\x76\xa5\xe7\xe6\xbf\xf9\xbc\xba\xdb\x03\xfe\x10\xae\xbf\xae\x9b\xe1\x19\x95\x03\xc9\xf1\x63\x3e\x67\xba\xff\xce\x40\x7c\x67\xd7\x3f\xcb\xd6\x56\xf1\xaf\xe4\xfd\x4f\x29\x9f\x1d\x1f\xab\x96\xc7\xd0\xbd\x05\x8e\x16\x3a\x86\x58\x2a\xb1\xc3\x9a\x9c\xc3\xee\x10\xa1\xd9\x00\x4b\x59\xbc\xd4\x02\x46\x8d\xe5\x20\xa6\xec\x92\x80\x63\xc3\xd3\x16\x06\xfb\x1b\x56\x32\xb1\x9c\x86\x75\x2f\x4f\x93\x48\xb4\xbe\xf6\xdf\x41\x59\xc7\x92\xed\x03\xf4\x4c\x25\x90\x93\xdf\xfa\x7c\xd7\x44\x09\x28\x58\xb8\xbd\x3d\xcc\xdd\x7b\x8e\x11\x64\x8a\x2f\xa2\x2e\xfc\x20\x30\xcd\x69\x8d\x6b\xac\xd2\x2b\xaa\x53\x06\xbb\x85\x23\x66\xd1\x89\xfc\x64\x15\xb5\xc6\xa7\x3b\x16\x92\x45\x6a\xb6\xde\xd6\x3e\x1f\x06\xdb\x1d\x34\x5d\xa3\x1a\x2f\x16\xb5\x13\x31\x0a\xb1\xdc\x3f\x49\x8d\xc6\x33\xfb\xba\x26\xe4\xb0\x20\xa2\x65\x32\x99\x1f\xc6\x18\x3c\x8b\xab\x32\xc5\xbb\x69\x6d\x69\x65\xed\x41\x02\xbc\x8a\xd1\x33\x80\x14\x97\x3f\xdf\xef\x1c\x17\x7b\x0e\x0c\xfa\xad\x62\xbf\xa6\x9e\x0c\x9e\x97\xa2\xed\x9a\xab\x98\x4b\x3d\xda\xe4\xc9\x69\xb9\xf0\x96\x36\x79\x0a\x37\xe8\x97\x22\xf8\xf5\x58\xf0\x5f\x30\x9c\x2a\x66\xbf\x68\xc2\xab\xeb\x4b\x38\x92\x6a\x67\xef\xc6\x02\x66\x5f\x03\x18\x1a\xeb\x08\x02\x1d\x50\x71\x5b\xe9\x50\xff\xcc\x78\x67\x83\x2d\x3d\x1b\x9e\x21\xb8\x5c\x2f\x99\x8e\xb9\xb3\x6a\x80\xd3\x80\xd6\x27\x9b\x72\x5b\xc2\x23\x29\x69\x97\x9e\xde\x5a\x69\xb1\xfc\x34\x35\x0b\x53\xa5\x65\x1b\x89\xf1\x3e\x0d\x81\x6f\xf0\xce\x7f\x33\x37\x50\xd5\x19\x03\x82\xc1\xf4\x59\x96\x9e\xb6\x5c\xde\x41\x29\x87\x62\x32\x9e\xa7\x64\x4b\x9c\xe5\xde\x77\xd2\x37\xf8\x6f\xb3\xee\xa2\x70\x07\x00\x0d\x1e\x48\x11\xae\xf9\x98\x76\x53\xc2\xd2\x29\xc8\x5e\x1d\xbc\x99\x5b\x93\x03\xb3\x4e\x6b\x5f\x33\xa1\xd4\xcd\x6e\x9a\x5b\x6a\x6a\x53\xc1\xd5\x60\x5e\xf9\xc5\xb5\x81\x31\xfc\x61\xf7\x04\xf5\x98\x8d\x6a\x36\x48\xc1\x08\x8d\xec\xce\xab\xde\xf8\x54\xb7\x25\xc8\x3c\x16\x92\x4e\x89\x05\x26\xf4\x23\x1c\xf2\x04\xa6\xfd\xb6\xbb\xde\x7e\x6c\x11\x3f\x58\x68\xb5\xc9\x84\xb9\xbb\xd9\xc8\xf9\xae\x8a\xd6\x9b\xfa\xbb\x03\x1b\x26\xd0\x16\x4b\xbb\x04\xe3\x20\x76\x86\x0f\xf8\x8e\xb8\x1a\x77\x6c\xf5\xd2\x1a\x37\x02\x10\xdf\xd2\x00\x9b\xf8\x2a\xaa\x87\x66\x72\xd5\x76\x36\x89\x74\xae\x9c\xcb\xe6\x1c\x6f\xad\x90\xc6\xaa\x05\x16\x2e\x36\x5a\xbe\xac\x65\x46\xd4\xf9\x96\x6c\xc3\xca\x81\xdf\x6e\x8e\xc6\x0d\xe8\x24\xa4\xa0\xbe\x04\x12\xe0\xb2\xdd\xa2\x5a\x64\x98\xfd\x98\x5b\x60\xe6\xa3\x4f\xec\x2e\x08\x22\x5a\x6e\xb1\x1c\x71\xc9\x81\xee\x69\xaa\x35\x51\xf2\x89\x1e\xaa\x96\x98\x03\xad\x14\xa6\x0c\x0d\x7d\x06\xce\xe0\x02\x72\x44\x73\x2b\x8f\x4b\xfb\x54\xed\x58\x3a\x0f\xe9\x5e\xf9\x90\xa5\xfe\xc1\xac\x76\x5d\x25\x29\xd1\xa5\xcb\x51\xd0\x98\x55\x90\xfc\x59\x07\x50\x44\xe1\xfc\xf3\x34\xfe\x67\xa8\xef\xd0\xd5\xb7\xc9\xae\x5c\x23\x80\x01\x33\x8e\x42\x7c\xd6\x13\x7f\x52\xe9\x58\xd7\xd7\x35\x51\xb1\xe7\xd0\xd0\x9f\x67\x1e\x41\xab\x48\x16\x10\xb5\x60\xf6\xdf\x5c\x14\xb8\xa7\xff\x99\x5f\xf9\x3f\xfe\xe2\x58\xf6\xab\x01\x9e\x69\xfc\x3f\x8b\x36\xfb\xed\xd5\xff\xc5\xdc\x7f\xf5\x3a\xaf\xa4\xe9\x82\xe0\x5f\x59\xe8\x41\xa1\x2e\x38\xdf\xa2\x77\x55\xa8\x0b\x7a\x23\x1a\xd1\x53\xbc\x19\xd0\x7b\x23\x3a\x91\x6c\xcc\x7f\x1f\x48\xeb\xdb\x99\x79\x7a\xe7\xee\x73\x1a\x27\x07\x28\x60\x01\x12\x43\x11\x6f\xbc\xf6\x89\x60\x2c\x51\xcf\xfa\x5f\xdb\x5a\x50\xff\xef\x7f\x43\xb9\x65\x2f\x3f\x8c\xb1\x5a\x7c\xe6\xf3\xff\x07\xf9\xf7\xaf\xa3\xef\x86\xe5\xbf\xfe\xbd\x5a\xd7\xe9\x3f\x40\xf0\xf5\x7a\x7d\xbf\xd0\xef\x71\x2e\x41\x04\x82\xa0\xb7\x98\x7f\xff\xda\xeb\xfc\xc5\x8e\xc7\x7f\xfd\x3b\xf4\x05\x7d\x91\xc8\x17\x0c\x41\x7f\xa3\x7b\x3d\xbb\xfc\x7f\x46\x4b\x0b\xff\xaf\xd1\xd2\xa2\xc4\x37\xf2\x43\x4a\x0b\x43\xf0\x0f\x23\xed\xef\xa6\xf7\xbb\x6f\x08\xc2\xff\xe5\x74\xb4\x39\x92\x66\x39\xf6\xcf\xf9\x50\xff\x09\x3d\x6b\x0e\xa5\xf1\x5f\xd1\xbf\xa2\x30\xfa\x67\xfa\xd7\x24\x85\xb2\xec\x9f\xf7\xc7\xb0\x7f\x42\x17\x8b\x24\x70\xf6\x17\xea\xe0\xe4\x9f\xe9\x59\x53\x92\xce\xd2\xbf\xa0\x97\x25\xe8\x3f\xb3\xe9\x26\x31\x85\x25\x7f\x45\x2f\x0b\xff\x99\xee\x36\x4e\x08\x2a\xfe\x8b\xfe\x34\x8a\xfd\x99\x01\x36\xc5\x70\x9a\xfa\xff\x3b\x5d\xac\x4f\xd3\xf2\x37\xe2\xc3\x15\x86\xff\x73\xce\x58\xf8\x7f\xca\x19\xfb\x67\x11\xff\x4f\x89\x63\xff\xaf\xf2\xe9\x6f\xf8\x8b\x86\xbf\x61\x0d\xfb\x26\xbf\x48\xe4\x1b\xaf\xd0\x6f\xba\x43\xbe\xa9\x2f\x18\xf9\xf0\xa9\x63\xbf\xde\x6f\x2a\xec\x1b\xef\xd0\x6f\xf4\x0b\x46\xbf\x90\x6f\xfa\x17\x8c\x56\xe8\x37\xd5\xfd\xc2\xbe\x89\x2f\x98\xfa\x26\xaa\x5f\x98\x06\x93\x5f\x24\xf9\x8d\x74\xbf\xd0\x6f\xf2\x0b\x46\xbf\x69\x99\xfe\x86\xaf\x1e\x41\xbe\xe1\x2f\xc8\xff\xc8\xc6\xbf\xc9\xee\xc3\x38\x8e\x7c\x93\x7f\xc8\x26\xdf\xad\xfb\x8f\x10\xf4\x1b\xf7\x49\xe2\x1b\xfb\x2d\x03\xfb\x26\xdf\x6d\xe4\xe7\xf2\xd7\xfb\x72\xff\xa3\x8d\xf8\xd0\x89\x93\x5f\x50\xf7\x8b\x78\x7f\xf6\x1e\x8e\x7d\xc3\x1d\xf6\xd6\x1c\xfd\xa6\xbe\xb0\x1f\xc2\x78\xaa\xc2\x34\x02\xf9\x98\x58\xfd\xc2\xbe\xff\xce\xd6\xfb\x6f\x08\x5a\x14\xc5\xff\x94\x9b\x1a\xf9\x5f\x03\x01\x98\xfa\x03\x04\x70\xe8\x1b\x42\xd0\x1f\x1c\xf8\xdd\x8a\xfc\xbd\xf5\x7f\x0b\x08\x7e\xb0\xe4\xff\x9a\x8f\x04\x8a\xd1\xff\x9c\x3a\xfa\xcf\xc9\x4b\x42\x30\x4a\x24\xff\xb7\xc9\xfb\x97\x9e\x40\xff\x17\x3d\x01\x7f\xe3\x30\xfc\xdb\x17\xf0\x37\x09\xff\x66\xe9\x26\xf0\x6f\xe4\xd3\x8e\xfc\xbd\xfd\xbf\xbf\x37\xfe\x56\x27\x04\xf6\x8d\x7e\xe1\xf8\x37\x9e\xfe\xc1\xcc\xff\xe1\x9b\x47\x7f\xe1\xdf\xf0\xfb\x85\xfc\x46\x3f\x4c\xee\x9f\x1a\x79\xa7\x1e\xf5\xa9\x1e\xd2\x41\xe8\x6f\xe2\x8b\x40\xbf\x89\x2f\xe4\xfd\x19\x81\xa5\xef\x91\xdf\xc8\xcf\x08\xe2\x17\xf5\x8d\xff\x42\x3e\x5c\xf9\x3f\x94\xec\xd4\xbb\x08\x7e\xc1\xbf\xf0\xf7\x2c\xc4\xe7\xe5\x23\xef\x3d\xeb\xbb\x1f\x4c\x7e\xff\x70\xcc\x7f\x21\x3f\xdc\xf8\x3f\x75\xf4\x9b\x25\x1e\xfe\x21\xa4\xff\x26\xbe\xde\x35\x40\x7e\xc3\x3f\xa5\xf8\xa1\xf5\x47\xa0\x5f\xf0\x37\xfe\x45\xfc\x90\xb9\xbf\x6b\x10\xfb\xf5\xe1\x6e\xa7\x3f\x6f\x88\x8f\xa0\x6f\xe4\x8b\xf8\xa6\x3f\x3c\xf5\xd0\x37\xfa\x05\xf5\xbf\xe8\x6f\xfa\x0b\xc6\xbf\xd1\xf4\x1b\xff\xa1\xaf\x7f\xdb\xfb\xf5\x61\x9b\x47\x3e\x6e\x20\x7f\xcc\x7f\x8b\x85\xbf\x91\x5f\xc4\xa7\x19\x86\xbf\xe9\x37\x4e\x90\x5f\xc8\x87\x20\x1e\xfb\xfa\xc6\xbe\xc8\x6f\xea\x3d\x14\xf9\xc2\x3e\x1a\x90\x1f\x93\xf0\xb7\x26\xc4\x2f\xe4\x9b\xec\x91\x37\x1e\xc0\x15\xfc\x0d\xa7\x6f\x55\xb0\x0f\xdf\x3c\xf2\xc3\x3a\xff\xeb\xc3\xd9\x4f\xfc\x82\xdf\x05\xfe\xeb\x6f\x4c\xf5\xe8\x47\x8f\x0a\xfe\xc6\x7d\x94\xfa\x61\xa6\x87\xbe\x3e\x0c\xfb\xbf\xad\xf8\x26\xaf\x1e\xfb\x82\x3e\xda\xbf\xc7\x42\x3f\x5e\xfa\x04\xef\x1f\xe5\x7f\xc6\xee\x9f\xee\x6f\xe5\xf1\x6f\xb4\xfa\xa6\xd3\x3f\x75\xfb\x05\x7f\x34\x7b\xc7\xe4\x43\xe8\xff\xf1\xe4\x5b\x91\x5f\x6f\x0b\xd3\x6f\xf2\x1b\x79\x47\xe7\xad\xdd\xdb\x5d\xbf\x79\xf7\x3f\x17\x3f\x51\x22\x3e\x41\xf8\x19\x82\x7e\xae\xe0\xb7\xc6\xc4\x0f\x13\x3f\xf6\xa3\xf2\x37\xfe\xfd\x33\xe4\x37\xd5\xff\xe7\x8f\x78\x67\xca\x8f\x18\xfa\x6d\xcb\x3b\x67\xde\x9f\xd2\xdf\xd8\x5b\xd6\x5b\x67\xea\xa3\xf0\x87\xd2\xff\xa3\x1f\xfa\x05\xfd\xe6\xfc\x87\xdf\x99\xf5\xa3\xef\xaf\xb7\x92\x6f\x6b\xff\x48\x84\x0f\x4a\x53\xef\xe4\xc3\xd2\xb7\xb9\xe8\x4f\x98\x3f\xae\xa2\xbf\xdf\xf1\x24\x7e\x2c\xfa\x4b\x55\xd0\x9f\x60\xbd\x05\x7f\xcc\xc2\xbf\x90\xb7\x2e\x1f\x97\xff\x0e\xc3\x1f\x5d\xa8\xf7\xb0\x4f\xe3\xdb\x1d\xd5\xaf\x6f\xfa\x67\xce\x1f\xb5\xd1\x5f\x9f\x04\x86\x3f\xe8\xfe\x76\x36\x95\xfe\x7a\xe7\xf9\x37\xfc\xeb\xd3\x07\xf9\x85\x7f\xfc\xfa\xb6\xea\xbd\x90\x40\xef\x9c\x7a\x5f\xc0\x3f\x99\xff\x6e\x41\xbf\x89\x6f\xf8\x0b\x7f\xe7\x9a\x8e\xbe\x3b\x61\xf0\x37\x91\x7e\xe3\xdf\xc8\x67\x0a\xe4\x0f\x17\xff\x76\xd4\x8f\x7a\x6f\x67\x7f\xa2\x41\xbf\x95\x43\x7e\x82\xf5\x76\xd2\x3b\xb2\xef\xb0\xed\xf0\x3b\x1d\xe8\x4f\x67\xe4\x67\xcc\xc7\x88\xbf\xfb\xf3\x83\x0a\x1f\x65\xf1\xdf\xfe\x85\x7f\xea\xf2\xea\x7f\xe1\xdf\xe8\x5b\x87\xb7\x67\xde\x5e\x25\xde\xe5\xf8\x2e\xce\x77\x4a\xfe\x84\x19\xff\x9b\xb0\x5f\x9f\xb8\x20\x7f\x93\xf6\x89\xdb\x37\xfd\x69\xfd\x71\x30\xf9\x76\xb0\x0c\x93\xe9\xe7\x83\x9f\x38\xfc\xbd\x3f\xf6\x99\x17\xf9\x11\x83\xbe\xa3\xf7\x91\xfc\xf5\x07\x46\x7c\xaa\x06\xfb\x82\xd3\x6f\xf8\x0b\x7b\x07\xe8\x8b\xfe\x44\x17\x7b\x23\x05\xf9\x45\x7d\xbd\xfb\xbe\x95\x7f\x2f\xd2\x30\xfd\x8d\xfc\xc2\xde\xda\xe1\xbf\xd0\x6f\xfa\x1b\xfe\x45\xbd\x35\x24\xdf\x95\xfa\xa9\xf5\x1f\xb4\x7b\xbb\xf6\x53\x27\x6f\xb4\x40\xaf\xfe\x3d\x80\xfa\x5b\x21\xe3\x3f\xd1\x46\xbe\xa0\xb7\x86\xef\x4a\xc3\xd3\x1f\xeb\xff\xee\x8b\x8f\x93\x3e\x10\x91\xbe\x3b\x7e\x7d\x34\xc7\x7e\x8a\xed\x33\xe3\x47\xca\x3b\xb4\xbf\x3e\x82\x7e\xb2\x09\x7b\xbb\xf4\x5d\x07\x6f\xe7\xff\xbc\xe0\x9f\x34\xfa\x9d\x0e\x3f\x95\xf7\x9e\x06\xff\x4c\x83\x7d\x63\x5f\x1f\xf8\x7c\x8b\x7b\x6b\xf0\x8e\x31\xfd\xf5\x81\xc9\xef\xb7\xbd\x1f\x3c\x7a\xe3\xd5\x1f\x49\x4b\xfe\xc2\x3e\x89\xf8\x36\x9c\xfa\x81\x62\xf4\x83\x5b\xc8\x37\xfa\xeb\x03\xdb\xf8\x4f\x11\xd2\xdf\xd4\x1b\xe4\xdf\x55\xf8\x4e\xc1\xb7\x8a\xff\xa0\xe7\xaf\xbf\xd9\x4b\xfe\x4e\xf1\x37\x6e\xfe\xd8\x84\x7d\xd3\x3d\x86\x7d\x00\x18\xf9\xf1\x19\xfa\x07\xea\xc0\x7f\x94\xc9\xdf\xc1\xe9\x6d\x13\xb6\xbf\x81\xee\x6d\xcd\x8f\xb8\x37\xa4\x40\xfb\xc7\xaf\xbf\x3e\x53\xff\x38\xfb\x6d\x35\x72\xf5\xe4\x07\x0b\xb0\xea\x2d\xe3\xdd\xf6\x03\x93\xdf\xf0\x4f\x32\x7e\x12\x12\xff\xa0\xde\x2f\xf8\x2d\xf7\x1f\x54\xc0\xfe\x48\xeb\xcf\xcb\x9f\x20\xe7\x1d\xbb\xb7\x9c\xdf\xf0\xf0\x93\x5e\xe4\xdb\xe8\xdf\x96\xd1\x7f\x00\x06\xfe\x11\x45\xfd\xfa\x43\xe2\xc7\xb5\xc4\x4f\x5e\xff\x40\x06\xf6\xf5\x9e\x1c\xff\x41\x50\xe2\xa7\x26\xd0\xff\xdb\xe1\xd4\xcf\x70\xec\xd2\x61\xfc\x0b\x27\xd3\x7f\x0c\x3e\xf9\x29\x86\x77\xf8\x3e\x3a\x7f\x92\xf4\xe3\x76\xf4\x83\x2c\x9f\x05\x24\xfd\x89\x09\xf6\x16\xf4\x49\x10\xfa\xe3\x8a\x37\xbc\x7d\x70\xe1\x33\xd3\x4f\xf6\x52\x3f\x23\x7e\x54\x7d\x2f\xb0\x29\xfc\x47\x5f\xea\x77\x5f\xfa\x6f\x50\x86\xfd\x03\x94\xfd\x1d\xe7\x3f\x31\x23\xd3\x3f\x80\x1e\xfd\x1b\xec\x50\x9f\x88\x7d\x7d\xd4\x26\x7e\x56\x38\xf4\x7d\xfd\x4e\xfb\x3f\x7c\xad\xc3\xc4\x17\x01\xbd\xc1\xf0\x77\x35\x20\xbf\x3e\x08\xf3\x9e\xf7\x9d\xb6\x3f\xab\xfe\xd7\xdf\x56\x45\xf8\x67\x8b\x90\x7e\x22\xfc\x0d\xff\x86\x2d\xf4\x6d\xc7\xdf\xe1\xeb\x6d\xdd\xef\xf5\xe9\x83\xe2\xef\xf9\x7f\x4c\xf9\xa6\xfe\x40\xbd\xf7\xd2\x44\xfd\x0f\xe0\xf1\x59\x51\xfe\x68\x7b\xd7\xe2\x27\x53\x7e\x2f\x2d\x9f\x9c\xa3\x7f\x6b\x08\x7f\x7d\xa4\xbd\x53\x50\x87\xe9\x2f\x02\xf9\x49\xbf\xf7\x74\x9f\x25\x89\xf8\x09\xe7\x4f\x90\xe1\xdf\x2b\xce\x0f\x46\xff\x36\xe3\x5d\x4c\xef\x8a\x40\xff\x00\x3a\xf4\xd7\xef\x45\xe8\x8f\x05\xfb\x13\xc3\xdf\xa0\xfc\xdb\xdd\xff\xf4\xd6\x0a\xfd\xfb\xad\xd5\x5f\x6e\x70\xb1\xff\xb5\x0d\xee\xfb\x8e\x8c\xa0\x7f\x36\xb8\x24\xf6\x8d\x40\xbf\x37\xb8\x38\xf5\x4d\x7e\xda\x91\xff\xfa\x77\x8a\xfc\x26\xa1\xff\xdd\x0d\xee\x9f\xef\xfb\x0b\x3a\x2f\x0a\xe2\x2f\x6e\xe4\xc9\x3f\xdf\x38\x17\x54\x9e\x15\xf8\x5f\xdc\x98\xc3\x7f\xde\x3d\x17\x68\x4e\x14\xff\x7c\xf7\xfc\x4d\xc0\xc8\x9f\x0f\x16\xd2\x2c\xc9\x93\xbf\xba\x91\xff\x73\x77\x34\x4d\xff\xea\x1c\x82\xc4\xff\xc9\xb9\x02\x99\x50\xd9\x5f\x9c\x13\x50\xff\xe4\x9c\x23\x8d\x63\x38\xfd\xab\x73\x02\xf2\xcf\xfb\xff\x24\xa5\xa8\xe4\x2f\xd4\xa7\x91\x3f\xf7\x8f\x73\x22\x8d\xff\xaa\x3f\xf1\x4f\x6e\x46\x0a\x2c\xa3\xff\x79\xff\x7f\xe5\xb9\x42\xf5\x0b\x21\x7d\xf8\x9f\xe7\x3d\xf6\x3f\x3d\x52\xf8\x1f\x46\xff\x3f\x3d\x4d\xf8\xa3\xe1\xad\x6d\x33\xd6\xc3\x7f\xfd\x7b\x92\xef\x79\xf7\x37\x2b\x96\xbd\xfc\x37\x54\xf8\x3f\xfe\x77\x8e\x0d\x8f\xee\xbf\xd9\xf3\x48\xfd\xff\x40\x0f\x3f\x8b\x82\x60\x81\x7d\xdc\x47\xe8\x03\x01\xa0\x4b\xe1\x10\xbd\x57\x5c\xa1\xf5\x1e\xa5\x60\x8b\x96\x30\x1f\xb8\x40\xdd\xdc\x1a\x4e\xc0\x8a\xa4\x19\x10\x73\x02\x74\xe0\x8c\xfc\x51\x96\xec\x23\xf2\x05\xdd\x03\x4f\xd4\x40\x23\x52\x27\x4d\x97\x07\x25\xa2\x6b\x91\x79\x7b\x21\xd0\x65\x67\x1e\x18\xfa\x34\xec\xfb\x12\xd4\x3b\xd6\x0d\x44\x07\x38\x06\x9b\xf6\x24\x32\x22\x5c\x50\x89\x0a\x42\x07\xb8\xbf\x70\x83\xd0\xc2\x46\xd3\xa4\x6d\x88\x41\x92\x1a\xa3\x5b\x3e\x4b\xcb\xe3\xb6\xce\xbc\x00\x13\x9b\x2a\x69\xb0\x46\x97\x82\x69\xa6\x8f\x0c\xc9\x34\xb8\x30\x5e\x73\xa2\x81\x60\x1c\x1a\xa4\x0a\xe7\xc5\x5d\xef\x5b\xef\x29\x88\x4c\x0b\x31\x90\x1f\x19\x89\x28\xc4\x16\xc7\x2e\xa9\xd2\x86\x0d\x4d\xe2\x27\x6a\x68\x4f\xfa\x38\x73\xea\xce\xb6\xcc\x50\xcb\xa6\x38\x55\x40\x83\xe4\x1c\x36\x2f\xd9\xc8\x62\xda\x22\x6e\x89\xd4\x81\xf2\x93\xb2\xd7\xfd\x11\x4c\x87\x6b\xd5\x38\x32\xc2\xcd\x1e\xd4\xd2\x23\xb6\x23\x0d\x02\xe3\x8d\x2f\xcd\x96\x03\x23\x8a\x7d\x68\xc8\xfd\x79\xd4\xd9\x04\x8b\x51\x5b\x8d\xfc\x31\xe5\x71\xc8\x80\x5e\xb3\x35\x20\x26\x8f\xe6\x71\xbb\x35\xb5\x59\xe7\x2d\x69\x7b\x4e\x65\x1b\x56\x10\x1b\x0f\xd1\xda\xf4\xb8\x98\x1f\xdc\x51\xbf\xb0\xf1\x8a\x6e\xc5\x33\x79\xa8\x90\xb0\xdb\x59\x07\xf3\xd6\x79\xba\x0b\x15\x72\x86\xcb\x10\x9e\xa0\xe9\xaf\x9d\x3f\xb5\x29\x0a\x94\xc8\x30\x3a\x43\xeb\x2c\xf3\x86\x68\xba\x6a\xab\x00\xce\xb4\x5b\x04\x3b\x24\x27\x8a\x97\x7a\xb5\x38\x21\x0d\x5e\x63\x05\xdd\xa3\x7d\x72\x23\x1b\x88\xc9\x38\x08\xfe\x23\xd5\x34\x06\xcb\x8c\x81\x25\x10\x4c\xb2\x16\x9e\x0d\x94\xb2\x88\x54\xa1\x3c\xda\x91\xab\x2e\x47\x0c\xf6\x01\xf3\x27\x2b\xef\xf9\x1e\xf1\x5f\xa1\xb5\x84\xea\x4b\x84\x2b\x22\x05\xca\xa3\xb3\xf0\xa6\x74\x6a\xc1\x13\x56\xe8\x95\x29\x39\xb9\xea\x82\x03\x0c\x82\x84\xc4\x9c\x56\xab\xba\xa9\x28\x81\x32\xd3\x39\xc4\x0b\x82\xc6\xe8\xde\xfc\xb2\xb7\xb4\xf2\x99\xd3\xbd\xd5\xbd\xf7\x32\x49\x5c\xed\x09\x5f\x44\xd9\x9d\xac\x7b\x33\x6e\x80\x23\x23\x3d\xf1\xe6\x3b\x20\x7b\x57\x45\x38\x01\x05\x6c\x2d\xdd\x59\xc0\x68\x95\x80\x4c\xbc\x4d\xf3\xd1\x78\x8e\xaf\x7a\xdb\x1e\xb7\x98\x2a\x2b\x39\x64\x30\xca\xa3\x75\x17\x61\xac\xf2\xb4\x77\xd7\xad\x04\x14\x4a\xac\x3a\x76\xa4\x18\x3b\x35\x5d\x35\xc4\x11\xe0\x9f\x20\x92\xca\x2b\xc6\x3d\xba\xd7\x29\x16\x88\x43\xb0\xf2\xeb\x35\xeb\x2f\x30\xde\xf9\xc4\xc6\x39\xb8\x25\x2b\xf8\x61\x61\x37\x19\x65\xfa\x02\x60\xd2\xe8\x14\x92\xe8\xbe\xd3\x9c\xb2\x5e\x16\x0f\x77\xb4\x90\xac\x0f\xb3\xd9\x49\x6c\x07\x33\xd4\xa4\x6d\xfd\xee\xf2\xf3\x22\xbc\x24\x25\x30\xa8\x27\x72\xb6\x64\x68\x3c\xec\x85\x56\x67\x7b\x47\x7d\x8c\x61\x19\xde\x91\x6c\x22\xb7\x20\xd0\xb0\xfe\x95\xff\xb2\x3c\xba\xe5\xf8\x6f\x06\x12\xdd\x3f\xfe\x6a\xe1\x53\x14\x04\xef\xee\x87\xd9\xfd\x01\x6e\x79\x10\x29\x63\xfd\x88\x6a\xe7\x71\x7b\x54\x6a\x4a\x08\x49\xb6\xc9\x66\x55\xd6\xcd\x88\xae\xe0\x4b\x86\x2f\x82\x85\x54\xa6\x32\x4f\x5d\xd1\xaa\x63\xc4\x5a\x55\x6a\x61\xc6\x71\x99\xe3\x15\x88\x90\x3e\x4d\x78\x26\xa7\xf4\x2e\x7a\xd7\xe3\x55\x67\xf1\xf8\xec\x9f\x00\x45\xe3\xf4\x71\x25\x97\xb4\x1a\xe9\x41\xd0\x77\xd7\x41\x08\xc1\xed\xad\xd5\xa1\x67\x4c\x3e\xc1\x63\x27\xf5\x80\x6c\x7c\x60\xad\xf0\xf3\xf2\xd3\xf8\x71\x0f\x41\x00\x50\x56\x7a\xc3\x0c\x66\x07\x38\xdf\x26\x56\xd6\xf3\xdc\xa7\xae\x2e\x7c\x6a\x5f\x4d\x64\x46\x3a\x15\xd0\x91\x76\x3b\x00\x30\xe4\x13\x2c\xc7\xc1\x56\xc6\x95\x01\xea\x26\xe5\x11\x48\x5e\xcc\x41\x0f\x37\x1a\x58\x8e\x11\x6e\xa3\x6f\xa3\x1a\x4a\x8c\xfc\x48\x1a\xc9\x52\x76\xd1\x2b\x79\xf2\x44\x6d\xdd\xcc\x50\xd4\xd3\x9b\xe7\x17\x6c\xcb\x32\xa3\x55\x75\x3c\x7e\x1c\x3a\x3f\x81\xf2\xec\xec\xe8\x13\x56\xcb\x47\x80\x5e\xd0\x01\xdd\xc2\xab\xe5\x1e\x6a\x6c\x47\x5d\x33\x6f\x08\x1a\xcb\x81\x7b\x69\x75\xdc\x33\xe7\xb9\xee\x90\x49\x7b\x8f\xac\xc0\xef\xcc\xfc\xec\xa6\x89\x2d\x74\xaf\xd8\xb0\x91\xd1\x84\xf9\x19\xb1\xb7\xd4\x3b\x74\xd1\xe6\x64\x61\x82\xf8\x32\xc0\xb5\x11\x33\x68\x43\x31\xe0\x4c\x84\xc2\x70\xf1\x45\xd3\x3b\xef\xaa\x7b\x8d\x40\xc0\x32\x06\x5f\x67\xcd\x44\xad\xa5\x2c\x49\x68\x0a\xf1\x83\x8a\x6d\x8a\x5d\x60\xa9\xc9\xb1\x71\x80\xdf\x9a\x07\x75\xb4\x91\x46\x22\xc7\xa4\xd2\x56\x2a\x5e\x78\xe2\x3f\x65\x4f\x95\xe4\x48\x43\x1e\xab\xd1\xdf\x6a\x88\x78\x70\x67\xed\x8b\x09\xb6\x80\xbb\x70\xd3\x5d\xa6\x80\x27\x2c\xcf\x4c\x89\xe8\xf5\x1a\xbe\x6c\xe2\x25\x4c\x99\xf7\x0a\x81\x5d\xe3\xd7\x96\xbb\xe4\xf1\x46\x4e\x6b\xaa\x13\xf1\xa9\xf5\x52\xdc\xec\x6b\x7e\x60\xf7\xae\x3e\x47\xa5\x15\xed\xa6\xf7\x04\xc6\xc9\x3b\x16\x99\x43\xcb\x76\x8a\xc6\xe3\x5c\xfa\xde\x05\x53\x1f\x20\x70\xed\x4a\x17\xc7\x9c\x42\xeb\xd5\xc7\xdd\x7e\x86\x55\x8e\xf8\xd8\x75\x4b\xaf\x24\xeb\xcf\xa4\x6c\x27\x15\x4a\x25\x73\x63\x88\x07\xa2\xbb\xdc\x72\x1b\x6b\x5c\x5e\x8c\x1b\x69\xa0\xba\x01\x6c\xaf\x47\x1c\x24\xc7\xa3\x18\x35\xad\xbd\xb2\x23\xcd\x5f\x44\x37\x3f\x82\x38\xaa\x7a\xea\x2e\xcb\x12\x7a\x15\xc5\x04\x7b\x2f\xd5\x91\x2f\x7a\xdf\x5b\x8f\xba\x8f\x59\x92\x5a\x8f\xc7\xc3\xc1\xbb\xe3\xd1\x1e\x6e\x2b\x65\x3e\x6f\x65\x3e\xac\x2e\xf0\xf3\x5e\x3e\x78\x42\x55\x36\x45\x20\xcb\x25\xf4\xf3\x42\xa9\x94\x4d\x87\xf0\x96\xcc\x5e\x7a\x92\xeb\x7d\x45\x91\x31\x4c\x6d\x85\xa2\xc7\xfe\xe3\x9c\xe6\x59\xd5\xca\x26\x47\x8c\x19\x80\xd7\xeb\x11\x32\xc6\xc0\x0f\x33\x7a\xd9\x95\xdc\xa0\xa4\xbf\x9f\xc8\x6b\x0a\xe5\xd5\x60\x32\x1c\x7c\x59\x0f\x86\x27\x02\x7d\x73\x11\xee\x35\xfa\xff\x52\x80\xe8\xff\x9b\x3d\x88\x54\x77\xff\xc0\xb1\xfb\xf3\xf3\x44\x47\x05\x94\x68\x80\xac\xb0\x04\x2d\xc2\xf3\x54\x9c\x73\xf0\x44\x75\x71\x4c\xa7\x32\x5e\x5c\xdc\xd7\x8f\x48\xe0\x51\xad\x54\xda\x74\x3b\x46\x03\xac\xb9\x28\xbf\x47\xf5\x43\xe2\x91\x58\xe2\x81\x1a\x12\xf1\x9b\xce\x55\x47\xe7\xc7\x71\xcb\xdc\x90\xb3\x4d\x99\x15\x08\xae\x4c\xdb\xc8\xab\x3d\x46\x34\x88\x34\x6c\xda\xa8\x3e\x2c\xfa\x3c\x7f\xa0\x14\x85\x6f\x25\x41\xce\xe3\x5d\xac\xf0\x61\x4f\x09\xa4\xa7\xab\x66\xb4\x01\x5c\xd3\x27\x52\x9f\xdb\x47\x81\x92\xd0\xd3\x5f\xd3\x11\xf0\x57\xce\x13\xa7\xa4\x01\x1a\xc9\x06\xd4\x24\x18\x48\xc2\x3c\x2d\x2a\xbf\xbf\x96\x88\x71\xad\x84\xb2\xc1\x2a\x1e\xa2\x52\x31\xca\x86\x56\x9e\xf9\xc6\x8a\xdc\x0b\xbe\xad\xb4\xa9\xb3\x83\x11\x70\x0b\x82\x85\xaf\x56\x02\xad\xdb\xc3\x13\x6a\xa9\xf1\x99\xbb\x35\x16\xe0\xfc\x84\x48\x0a\x9d\x9c\x4a\x70\x51\x4f\xb3\x46\xea\xb8\xa0\xda\xc1\xc5\x98\x31\xfa\x59\xb6\x64\x84\x1f\x9f\x0f\x80\x77\x34\xe7\x22\xec\x2b\x4f\x83\x6e\xe0\xe9\xb3\xf7\x30\x52\x7b\x34\x19\x66\xe2\x6c\xf8\xe4\x5d\x09\x13\x75\xd4\x98\x5c\xa1\x9d\x32\x15\x9f\x5f\x10\x33\xbe\x34\x5b\x4f\x2a\xaf\x98\x70\xaa\x74\xcd\x98\x07\x3d\xb9\x37\xac\xbb\x42\x3c\xfa\xfd\x16\xd8\x21\x7c\x09\x8e\xec\xa9\xe0\xc8\x2e\xc8\xd0\xdf\x63\x40\x2f\x5f\x50\xa4\xe8\xe8\x1a\x9d\xd2\xa8\xbe\xce\xf2\xb2\x0c\x16\x69\x8c\x53\x55\xa2\x90\xac\x59\xa8\x9b\xee\xa3\x4e\x42\x9e\xd4\xdf\x43\xea\xe2\x3c\x60\x96\x28\x83\xf7\x0f\xf9\x95\xd5\x1c\xde\xdf\x96\xbb\x27\x7a\x06\x58\xee\xab\xa6\x15\x28\xbd\x88\x97\xff\x8c\x0d\xf4\x31\x73\x84\xdb\xb0\xf1\xf6\xac\xc2\xca\x60\x60\xd7\x1a\x9f\xdd\xe8\x93\x7c\x0c\x4c\x4d\xf4\x84\xc5\x25\xf4\xb8\xb8\x17\x11\xba\x21\xb4\xfd\x51\x15\x68\xad\x38\xef\xcd\xa4\xb8\xd3\x7c\x94\xef\xe3\x56\xd9\xd7\x75\x2f\x4c\x09\x40\xab\x89\xa1\x3d\xda\x3b\xeb\xd1\xec\x5c\x95\x9c\xc4\xdc\xe5\x2c\x87\xb0\xa5\x4b\xc9\x93\xb3\x00\xc6\x0b\x9f\x9c\x2b\x58\x7b\x27\x80\x05\x93\x9e\xaa\xdd\x82\xa0\xa5\x63\xed\x15\xa4\x9e\x36\xca\x8f\x41\x16\x26\x75\x7a\x79\xb5\x7b\x65\x38\xc2\x03\x6b\xa6\xf5\xa7\xcb\xc2\x58\x7f\xc0\xb3\xce\xdc\xfc\xd4\x9e\x04\xc6\x6d\xe6\x65\x13\xba\x3c\x07\xe5\x50\xea\x07\xd1\x3e\x59\x53\xe2\x51\x88\x03\x07\x81\x6f\x8c\x72\x21\x39\x7e\x5a\x1e\xfd\x20\x4b\xe8\xe3\x9e\x97\x2c\xb7\xcb\x0b\x3d\x3e\x8a\x9e\x1a\x24\x3a\x53\x63\x3c\xc4\x6e\x14\xc3\x58\x97\x15\x62\x37\xc7\x40\x5f\xe9\xbf\xf4\xa1\xc2\xf3\xbf\x5b\x2d\x67\xc7\xdf\x39\x46\xbb\xdf\xbc\x28\x89\x4e\xf6\xf8\x03\x8a\xa6\x75\x43\x60\xe6\x15\xbc\xaa\xc7\x54\x38\xbc\x58\x01\xed\xbe\xdb\x19\x65\x40\x72\x81\x29\xe0\x11\x64\x05\x68\xc1\x38\x25\x42\xd6\x68\x01\x9c\xbf\x88\xb7\x58\x73\xac\x69\x05\xb3\xa9\xdf\xaf\xd5\x35\xaf\xab\x3d\x89\x34\x5f\xcd\x29\x48\xa3\x68\x4f\x4c\x54\x37\x71\x1c\xc6\x9c\xe3\x12\x3c\x74\xc6\x49\x10\x95\xa6\xa0\xeb\x74\x70\xbd\x63\x8b\x86\xba\xf1\x6c\x9b\xe1\xe6\x8c\xa0\x13\xa8\x7b\x48\xc2\xde\x46\x41\x5c\x51\x75\x33\xe8\x62\x5e\x16\x88\x8c\x5f\x64\x43\xbf\xb8\xde\xba\x67\x77\x14\x37\xbd\xd3\xba\xf9\x83\x5a\x86\x3c\xeb\xa6\x0d\x5d\xa7\xcc\xe2\xe6\x5a\x7c\x90\x0d\x08\xe1\xc8\x53\xa7\x60\xaf\x79\x05\xb0\xa5\x86\x42\xa5\x8b\xd0\xf3\x31\x18\x34\x54\x64\xc3\x9e\x8d\xe5\xa3\xdf\xd5\xbb\x07\x81\x3e\xcb\x4c\x3c\x56\xa5\xfc\x1c\xd8\xe9\x59\xb7\x05\x0b\x93\xcb\x7c\x95\x01\x99\xd0\x76\x05\xef\xc2\x7e\xf0\x9d\x82\xad\xfe\xb9\xc6\xac\x23\x08\x6b\x1f\x54\xd0\xb1\x78\xfa\x6b\x7a\x0d\x41\xf3\x64\xba\xf4\x18\xcb\x5a\xce\xe0\x57\x6d\x9d\xdc\x46\x91\xb5\x02\x02\x8b\xba\x16\x04\x60\x84\x4b\x39\x65\xa4\x18\x70\x3a\x8b\x43\xb7\xbd\x7d\xe8\x92\x9e\x68\x59\x54\x6a\x5d\xd1\x00\x37\xfa\x86\x01\xe2\x20\x5a\xc8\x41\x06\xc3\xaa\x2c\x38\xc4\x24\x7d\x2d\xbd\x34\x45\x5b\x81\x16\x10\xf6\x6b\x77\x0c\x45\xbe\xb2\xba\xcc\x54\x90\xe9\x43\xcb\xae\x6a\x79\xc7\xef\xa6\xf3\x7a\xf4\x12\x90\x3e\xef\xfe\x08\xc9\x4f\xfe\x68\xa8\xcd\xbd\x21\xfb\x00\xcb\x45\x0c\xa8\x7d\xa6\xb4\xfb\x1a\x81\x58\x61\xde\x43\x0f\x0d\xee\xfc\x24\xe6\x46\xe0\x9f\xde\x6d\x3e\xb9\x5a\x91\x74\x03\xbb\x16\x99\xa6\xca\xb3\x47\x39\x25\x35\xdd\x97\x0b\x84\x72\x16\xce\x99\x65\xc9\x4c\x34\xae\x02\xd5\xb2\xf7\xc9\x79\xde\x0d\x79\x65\xcd\x19\xa3\x64\xaf\xd7\xcc\x34\xb4\x55\x97\xce\x02\x43\x97\x1e\xaa\x98\x9c\x34\x3c\xc9\x26\xb3\xc7\x67\xeb\xc4\x8a\x5b\x02\xc9\x2b\x2f\xb2\x6a\x5e\x73\xde\x76\x75\xe7\x75\xce\x22\x57\x2a\x14\x25\x40\x38\x21\x9f\x00\xc6\x54\x48\x1a\x5a\x91\x86\xda\xef\x0d\xb5\xe6\xe5\x64\xf1\x0c\x03\xd1\x20\xfe\x95\xeb\x65\x91\xaf\x69\x55\xff\xab\x48\xca\x64\xec\x5d\x6d\xc0\x4f\xa1\xf1\xb5\x42\x04\xca\xbb\x03\x17\xb2\x4a\x10\xea\x0c\xc3\x2c\x2e\xc3\x30\x9a\xf0\x12\x98\x7e\x7a\xfd\x3c\x55\x10\x1a\x8e\x0d\x29\xcc\xbc\x60\x29\xf1\xb9\x57\xb0\x07\xcb\x83\x59\x86\xe1\x8e\xe6\xb5\x53\x0f\xcb\x63\x18\x8e\x61\x7f\x9e\xee\x2f\x59\xd2\x87\xe7\x96\x75\xc3\xcc\x25\x87\x6c\x01\x4d\x6d\x5c\x2c\xa1\x6c\x2c\xe6\x25\x72\x0a\x77\x5d\x88\x10\x28\x15\xca\xb6\x42\x1a\x74\xf6\xad\x73\xa2\x5b\x51\x17\xa4\x90\x1d\x44\xe0\xa8\x3e\x7e\x7b\x3a\x23\x08\x0a\xc6\x0d\xf7\xe8\x34\xf5\xdb\x52\x35\xb6\xaa\x9d\x2c\x47\x45\xc1\x9a\xaa\x4d\x64\x24\xdc\x25\x90\x58\xcd\xd1\x98\x85\x64\x30\xab\x55\xfc\x36\x9c\x37\x27\xe7\x50\x2d\x45\xb3\x7e\xdb\x40\xc3\xcc\x8b\x7c\xd8\xba\x0c\x51\x91\xfc\xc0\x54\x24\xbb\x08\x75\xc8\x89\x3c\x27\x57\x92\x9c\x07\x14\x95\xef\x77\xe2\xbc\xe9\x8f\xe6\x72\xe1\x55\x76\x4a\x33\x41\x4a\x05\xa7\xaf\x57\x9a\x31\xd5\x98\x8d\xa5\x2a\x80\x1d\xde\x38\x2a\x05\x48\xf7\xc9\x1b\x13\xa4\xc7\xd5\x49\x8e\x6c\x19\x37\x87\x32\xa7\x64\x4a\x32\xf2\xf1\x35\x49\xcb\x08\x63\x57\xe0\x95\x04\x3b\xef\xc2\x11\xfa\x3a\x93\x96\x40\x9b\x9e\xd8\xbd\x50\x82\xbd\x3a\x26\xbb\x24\x60\x4d\xd7\xf9\x02\x82\x96\x31\x7a\x44\x44\x86\xae\x20\x77\x92\x32\x4e\x8b\xc3\x2e\x5e\x94\x54\x3d\x9f\xf8\x22\xe3\x3e\x1e\xc3\x60\xe9\x3c\x59\x5d\x19\x0f\x25\xce\x00\x22\x9b\xa7\x02\x08\x1c\x7e\x29\x00\x37\x7d\xf8\xc6\x65\xd9\x32\xbb\xc5\xc9\xd4\x8a\x7a\x6c\x3e\xad\xb1\xb8\x91\x0e\xe8\x1f\x22\x0d\xb1\x35\x7e\x3d\x9d\xba\x6b\xfd\xa4\x19\x09\xd6\x6d\xf9\xa7\x54\x77\xb2\xaf\xba\xc4\xcd\x44\xcd\x28\x6e\xbd\xce\x37\xa8\x46\x56\x40\xa2\x6a\xca\x31\xbf\x9d\xf4\x84\x31\x6b\x49\x78\x9c\x73\x07\x11\x3c\x3f\x45\xea\x62\xdc\x9a\xbb\xb7\xdc\x2d\xdb\x32\x96\x02\x87\x6d\x0e\x46\x9a\xab\x9c\x86\xc4\x5d\x4c\x85\x54\x12\x10\xe6\xf0\x16\xf2\x8b\xf4\x58\x8b\xe9\xfe\x12\x25\x19\x0b\x24\x1d\x52\xe7\xa4\xc9\x36\xb7\x47\xb5\xd4\x0f\x56\x96\x7e\x31\x63\x1d\xd9\x77\x85\x5f\xc3\xa4\x5e\x93\x50\x27\x9d\x9a\xc1\x94\x36\xb8\x63\x00\x5e\xb1\xe5\xb1\x61\x20\xe6\xd7\xcc\x40\x81\x46\x79\x80\xd8\xa2\x84\x1c\x83\xd1\x57\xa5\x85\x8f\x97\x7b\x2f\xe6\x5c\x54\x41\x1b\xda\x78\x76\x7d\x16\x05\x11\x95\x86\x18\xa8\x9e\x30\x61\x0a\x0c\x2b\x98\xe5\x78\xc6\x73\x53\x1b\x8b\x9e\xe4\x14\xba\x47\xea\xd3\x01\x6d\x13\xae\x1d\xe8\x61\x84\x44\x65\x11\x3c\x2b\x11\x73\x8b\x88\xe3\x9c\x10\xa8\xea\xe2\x2b\xc9\x59\xe7\x8d\xb8\x0d\x4b\x14\xda\xc3\xc4\x88\x29\xf8\xa2\x1f\x81\x5f\x4b\xa8\xce\x8a\xd5\x1a\xeb\xed\xd1\x54\xfb\x18\xde\x85\x52\xd1\xb1\xe5\x44\xf8\xe5\x58\x28\x54\x8b\x0f\x6b\xd3\x48\x5d\x9c\xd9\x3b\x0c\xab\x12\xfa\x62\x98\xe4\x7c\x6a\xb9\x29\xe9\x37\x56\x72\x56\xc9\x93\x15\x27\x90\xeb\x49\x1c\xaf\x99\xe9\x6e\xe2\x9d\x53\x6c\xa5\x6f\x68\x27\xab\x15\x2b\x98\x9f\xd5\x84\x19\x4c\xc4\xdc\x02\xde\xd7\x52\x83\x8b\x1d\x01\xe2\x5b\x16\xe4\x0c\x83\x6d\x34\x16\x54\x61\x42\xb2\x94\xbd\x8d\xcf\x92\x03\xe5\xd2\xde\xa5\x7d\x5f\x81\x17\xb7\x6b\x21\x0d\xc0\x79\xcb\x73\xdc\xa3\xed\xa9\x6d\x20\x0f\x5f\x65\x39\xe6\x7e\xbb\x71\xea\x8b\x96\xe0\x45\xda\x38\x70\x41\x06\x13\x05\x93\x05\x1d\xcd\xfb\xae\xcd\x10\xd8\x1b\x16\xdb\x3e\x3a\x2c\x91\x19\xa0\x77\xac\x30\x9b\x4f\x4f\x5a\xe4\x46\x92\x96\x04\xd8\x3c\x79\xc4\x11\x74\xeb\x3b\x07\x37\x35\x14\x50\x17\x8d\xaa\x56\x95\x8c\xc1\x69\x7a\xe1\x05\xec\x9c\xf9\x18\xbb\x6b\x79\xea\xc2\xe1\xf4\x3c\xab\x2d\xb1\xac\x91\x77\x16\x45\xc4\x19\xe7\x1f\xfb\x06\xe4\x4f\x3d\x5d\x1b\xfa\xc9\x39\x54\x2b\x93\xf0\x1e\x1e\x1e\x32\x07\x0d\xed\x44\x0c\x1a\x53\x8c\x8d\xbe\xda\x36\x25\x1d\x59\x4e\xc1\x85\x90\x2a\x35\x1d\x69\x1b\x09\x1d\xbb\x62\x49\x35\x32\x75\xd1\x1f\x6b\x58\xbd\xef\x26\xb1\x46\xa6\x30\x48\x0a\x20\xd7\xe6\x45\xa3\xe9\x9d\xee\xee\x4f\x37\x77\x04\x99\x86\x9a\xaa\xad\x36\xee\x0a\x1c\xf1\x08\x31\x6c\xce\x62\xf8\xbe\x90\xd1\x16\x08\xa0\xc8\xef\x91\xb3\xc9\x27\xb4\xd1\xe9\x6b\x2d\xc6\x67\x0b\x16\xb1\xa9\x62\x39\x09\x03\x65\xbb\x6b\x27\x0c\xc7\xa7\xee\xa3\x8f\xe2\x61\xeb\x6a\xc6\xd6\xb1\xe1\x69\xd4\x58\xaa\xa3\x5e\x0f\x64\x31\x9f\xc0\xbd\xb8\x77\x63\xfe\x34\xd0\xfe\xd1\x64\x4f\x50\xbe\xfc\xb2\x90\x2b\xf9\xa8\xa3\xfa\x9c\xb8\x4d\x2f\x7a\x38\x97\xb0\x19\x1b\xf1\xfb\x6b\x66\x94\xaa\x5b\xb2\x0b\x0c\xeb\x07\x4e\x00\x1d\xcc\xf6\x3a\xb6\x34\xf2\x88\x1e\xdb\x9a\x42\x0b\x7b\xc8\x47\x86\x64\xb9\x24\x1e\x00\xcc\xf2\x00\xe0\x9d\x7d\x9e\x21\xf9\xa5\x4f\x43\x06\xa0\xf8\xfd\x86\x36\xea\xf3\x36\x5e\xdd\x10\xeb\x81\x01\xa0\x92\xc1\xf5\xd8\xd8\xed\x55\x8c\xcc\x18\x74\x2c\xe1\xb8\xf3\x5c\x02\xa8\x10\xc1\x52\xb0\x4d\x02\x2f\xc7\xa7\x89\xb8\x93\x50\xa6\x7a\x66\xce\x70\x7b\xc0\xd7\x33\xdc\xf3\x16\x80\x50\x03\x17\xf2\x9e\x82\x2b\x67\x79\xb5\xad\x13\x51\x32\xc7\x3a\x68\x53\xc1\x24\xc9\x76\x7d\x51\x43\x43\x07\x34\xc5\xfd\x75\x88\x01\x7c\x64\xd1\x00\xee\x50\x23\x54\x78\x5a\x84\x77\x05\x2d\xe4\x99\xe7\x74\x4a\x48\x46\x57\x7a\x06\x02\x62\xe0\xcb\xd2\x93\xa6\xee\xad\xf7\x91\xcd\xf3\x07\x24\x1c\xfc\x32\xf8\x6c\x7c\x83\x4b\x50\xa5\x11\xb6\x5c\x90\x57\xcd\xcf\x78\x6d\xd2\x06\x76\x38\x14\xa2\x88\x02\xa3\x3a\x26\x51\x0a\x77\xb8\x56\x50\x5a\x3a\xaa\xd9\x95\x11\xa2\xaa\xb9\xc3\x31\xee\x1c\x2d\x1c\x1a\xb5\x55\x22\x80\xea\xa8\x19\x56\x80\xa8\x0b\x4b\xd4\x8f\x79\x76\x57\x6d\x14\xd1\xd4\xa9\x4b\xea\xbb\xb8\xed\xaa\x93\xe8\x60\xdb\x06\xaf\x1a\x3a\xb3\xc7\xf2\x1a\x09\xc4\x47\x20\x6a\x4d\x94\xe7\xd6\xde\x25\x32\x6c\x67\x48\x20\x29\xbd\x00\xd2\x11\x07\xc8\x4b\x26\x9e\xc9\x1d\xef\x8d\xf9\xb5\x00\x7b\x6c\xce\x8f\x29\xca\xb3\x73\xad\x89\x67\x20\x01\xca\xd5\x42\xe3\x04\x9f\xbd\x9a\x68\x50\x60\xeb\x48\x53\x9c\x88\x6b\x02\x8a\x50\x10\xf8\xfa\x02\x53\xe9\xd9\x50\x1d\xd2\x08\x57\x97\x43\x08\x43\x74\xcb\xa1\xe1\x87\x29\x31\x4f\xdd\xe7\xfa\xe4\xe6\x45\xad\x64\xac\x39\x8e\xe4\x36\x89\xcb\x22\x7b\x7f\x98\xa9\xb4\x8f\xd3\xab\x1e\x48\x13\x3c\x06\x45\x06\x03\xc3\x7c\x76\x6c\x11\xa1\x9b\xe1\x19\xc0\x79\xf2\x72\x95\x08\x2a\x75\x82\x90\xf7\x5a\x2f\xe3\x8e\x22\x36\xdd\x94\x9c\x1e\xc1\x45\x05\xa1\x64\xa1\x9e\x13\xe0\xd2\x61\xf5\xb2\x5f\xe5\x6e\x83\x01\xc3\xef\xa9\xe3\x9d\xf2\xb8\xbe\xba\xaa\x6d\x2b\xeb\xaa\x8f\x04\xe4\x1e\xdc\x23\xcb\xdc\x11\x02\xdd\x2c\x4d\xa6\x13\x79\xb2\xa0\xa1\x06\x60\x7a\x67\x93\x27\x9c\x17\xd2\x70\x81\x7c\xb2\x90\xc3\x1c\x67\xe2\x0b\xd1\x19\x40\x34\x8d\x94\xe9\x07\xd7\x28\xa6\xa7\x40\x71\xa7\x4e\x82\x0e\x33\xf0\xe9\x83\x59\x4e\x50\x88\x14\x83\x63\x1e\x2f\xfd\x1e\x19\x72\x75\x67\x62\x00\xc8\xed\xa0\x50\x83\x94\x37\xaf\xc5\x7e\xa5\x35\xa6\x48\x42\x2f\x1d\xe7\x75\x27\x34\x5e\xe2\x31\x42\x1e\x64\x60\x93\xc1\xc6\x48\xba\x13\x21\xdb\xdd\x8a\x8e\xaa\x33\x0e\x4a\x5f\x76\xa2\x87\xd7\x28\x15\x8d\x6d\x1e\xb4\xa3\xb6\x4c\x7f\xf5\x96\x41\x90\xf9\xf1\x8a\x0d\xfa\x51\x8d\x23\x59\xd6\x0c\x8c\x4c\x62\xad\x73\xa6\xc8\x53\xea\x94\xae\xa4\x55\x04\x7d\xa1\x84\x1a\xb4\xd3\x1a\xe4\xbe\xd2\x61\x41\x62\xf8\xae\x72\xc5\x6b\x4d\xb9\x34\xd1\x5e\x4a\x66\x0b\x48\x10\xdd\xe7\x10\xe2\x44\x44\xe3\xc8\xf4\xd8\x6d\x70\x92\xc7\x42\xb2\x94\x86\x9c\x65\x1b\x06\xc7\x17\xaa\x5b\xc0\xce\xe7\x53\xe5\x6b\xe8\xf8\x88\x5f\xee\x20\xa5\x37\xea\x69\xea\x83\xa7\xbb\x27\x54\x27\xbd\xfe\xb2\xca\x29\x65\x27\x78\xab\xf7\x33\x61\x95\x5b\x9c\x94\x5a\x5c\xd6\x81\x58\x3e\x73\x8f\x6f\x36\x76\x00\x44\x9d\x09\x77\xb9\x90\xd7\xf2\x90\x19\xe7\x45\xad\x24\x27\x02\xa8\x94\xc9\x0a\x01\x00\x59\x00\xa9\xcd\x2b\xe8\x58\xc3\x96\x0b\x51\x2b\x19\x0d\x00\x5b\xdf\x8d\xb2\xc5\xcb\x4d\xcb\x7f\x72\xce\xd2\xc1\x65\x97\xb3\xe5\x63\x2e\xda\x38\xa5\xcc\xdb\x73\x9e\xe5\x09\xf1\x11\x39\x6e\x57\x6a\x2b\x06\x85\x5e\xcf\x83\x65\xe1\x84\x2e\x34\x45\x26\x45\x44\x79\xa1\x8e\x59\xc4\x5c\xe6\x8f\x92\x73\xdf\xf0\xdc\x67\x66\x93\xeb\xc4\xa1\x34\x60\x95\xb9\x4b\x0d\xc9\xf5\xde\x8e\x68\x36\x86\x41\x43\x83\xd3\xc9\xdc\xcb\x60\x7d\xab\xe9\xeb\x38\x22\xbf\x08\x9c\x33\xda\x33\x1d\x96\xee\xe2\xa4\x1e\xf9\xbc\x88\x4e\x54\x03\xc1\x8a\x66\xd7\x55\x97\xc5\xe8\x12\x7b\xef\x3e\xac\x93\x15\x0d\x82\x46\x3c\x76\xd7\x9a\xfc\x39\xf5\x33\xa7\x93\xe2\x7d\x70\x4f\xb2\x51\xa3\x9d\x43\x57\x33\xc4\x2b\x8d\xae\xc2\x40\x54\xd6\xb9\xd7\x62\x44\x55\xed\x23\x0e\x46\xf4\xa2\x96\xb1\xbb\xb6\x6b\x5b\x6f\x5e\x67\xce\x11\x80\x3b\xb7\xc4\x35\x4f\x91\xad\x12\x4c\x27\x0b\xcc\x14\xc3\x93\x2b\xaf\xea\xf0\x68\x0b\xf7\x5d\x8e\xd1\xf5\xfc\xf0\xec\xb0\x4d\x90\x67\x68\xae\xf2\xc6\x0d\x39\x44\xa2\x68\xab\x37\xa8\x1e\xc7\x96\x6d\x39\x87\x8c\x39\xda\x93\x14\x5c\xe7\xa1\xa0\xc3\x39\x2a\x19\xff\x7c\x3e\xcf\xdb\x52\xda\xa7\xdc\xbe\xf2\xce\x12\x84\xd3\x15\xf0\x84\x56\x50\x8b\xba\x3a\xa0\x7b\x2a\x4f\x94\x71\x57\x2a\x27\x5e\x58\xe1\x42\xaa\x91\x31\x6a\xb5\x4f\x91\x48\x9b\x2f\x74\xe2\xec\x03\xab\x19\x5f\xab\xc9\x0d\x7f\x64\x8f\x4d\xdb\xc0\x5d\x38\xb6\xdc\x5a\x0c\xe0\x39\x21\x2e\x1d\xc3\x77\xb0\x70\x06\x81\x7c\x00\x94\x67\xfb\x41\xb3\xb1\x92\x5c\xeb\x39\x9c\x4c\x41\x82\x55\xac\x97\xc1\x72\x33\xb3\x4f\x77\x96\xe5\xe5\xea\x55\x55\x80\xd2\x02\x45\x16\xc5\x2e\x68\x70\xae\x0c\x01\x23\xef\x07\x02\x83\x24\xbf\x6d\xa1\x45\x36\x89\x2a\x29\x33\x2b\xd3\x83\x0a\x3d\x9e\xda\x88\x49\x70\x5b\xbf\x2c\x51\x2e\xe5\xae\x88\xd8\x5d\xd7\x36\x70\x43\x9b\x63\x0b\xfd\x35\x27\x31\x06\xbb\x21\x2a\x39\xe5\xb9\xb1\xc1\x01\xf0\xac\x63\x4a\x8c\xc6\x48\x19\xf2\x87\x5a\x97\xbb\x64\xb2\x73\xe6\x09\x52\x48\x38\x9e\x37\x78\x24\xef\xa9\x20\x74\x24\x08\xf5\x92\x5a\x1c\x0b\xfb\x76\xd3\xac\x38\x8f\x0d\x73\x1c\x59\xb1\xeb\x63\xac\xbd\x67\xaf\xe9\xba\x28\xfd\x9e\xd9\x35\x20\x01\x2e\x75\xcf\x40\x7a\x4f\xc3\x01\xdb\x33\xfb\x1c\x1c\xad\x5c\xfc\x07\x80\x49\x32\xef\xea\xd5\x3a\x13\xbb\xa8\x47\xd3\xb0\xd8\x50\x00\xc5\x74\xbc\xdc\x6e\xf6\xb5\xd4\x5d\x00\xb7\xbb\xb3\x58\xe0\x3c\x98\x57\xb3\xf1\x83\x86\x85\x8e\x57\xf3\xba\xf4\xba\x99\x05\x5b\x0c\x9c\x04\x6d\xba\xf8\x5a\xb9\xee\x3e\xeb\x73\x3e\xa9\x29\x60\x02\x7d\x32\xe2\x4b\x11\xc8\x47\xfb\x54\xb2\x2e\x25\xfb\xad\xea\x2c\x7e\x63\x2a\x8f\x63\xd8\x79\x5c\xa4\xcb\xee\xa0\xd5\x1c\xb5\x23\x22\x88\x49\x58\x70\x02\x27\xc0\x64\x0b\x75\xa7\x37\xac\xa2\xe2\xfb\xf4\x66\xdf\x5c\xb8\x9c\x68\xf1\x6e\xe4\x86\xb2\x9d\x40\x74\x07\x70\xf9\xea\x22\xef\x05\xe4\x26\xd8\xf9\x0e\x2c\xb7\xd7\xd9\x70\x31\x33\xcc\xa4\x67\x39\xdd\xd9\x2b\xb7\x53\x8d\x74\x60\x3f\x96\x05\xcb\xdb\xcc\x87\xe9\xb2\xaf\xd2\xeb\xc6\x5a\xf2\xdd\xa6\x43\x48\xd1\xc2\x52\x4e\x63\xc1\x66\x33\x81\x4c\xe4\xa1\x06\x8d\xcc\x88\x58\x7e\x2a\xd2\x24\xe1\x6b\x2a\x4a\x43\x0e\x83\x99\x3e\x1c\xe6\x71\x4c\x48\x78\xbb\x56\x82\x10\x45\xb7\x0a\xf5\x71\x86\x1f\x8d\xfc\x7c\x31\xb1\xbb\x78\xb0\x2a\x30\x31\x0b\x1d\x63\x25\xdc\x9f\x6c\x6a\xf9\x67\xfa\x3a\x02\x3f\xea\x6f\x7a\x00\xa6\xac\x4c\x02\x1c\xea\x9f\x2f\x82\xc9\x7c\xce\x3a\x0a\x83\x87\x0d\x6c\xf5\x0a\x74\x9e\xba\x36\x01\xce\x7a\xd0\x1d\x63\xdb\xb0\x8d\x0b\xcb\x8c\xa4\x1d\x24\x59\x1c\x1e\x25\x68\x1f\xd1\x2d\xd7\x20\x8a\xe5\xe5\xea\x05\x98\xdd\xfb\xe3\x00\xef\xd6\x08\x33\x6d\xcf\xdc\x23\xe0\xaa\x48\x81\xa1\xee\xfd\x52\x21\xfe\xd6\x47\xb1\xaf\x4d\xf9\x1c\x03\xb5\xfd\x7a\x75\x30\xab\x88\x0a\xd1\xa3\xfb\xbd\xde\xe6\x9a\x79\x75\x2f\x02\x47\x50\xcb\x42\xaa\xce\xa0\x57\x1d\xce\x99\xb0\x90\xba\xfe\x39\xf5\xf4\x75\x82\xc1\x2b\xe1\xea\xe7\xed\x20\x51\x23\xe4\xe0\xa3\x1b\xd2\x65\x26\xd1\x74\xc9\x8e\x50\x6a\xac\x74\x1e\x1f\x91\x65\xe6\x66\x90\x5c\xb1\x51\x5a\x33\xe4\xde\x4a\x76\x5c\x29\x7b\x8a\xb9\xe9\x81\x96\x7a\xd3\xdc\xa1\x1d\xcc\x2c\x07\x2c\x08\xba\x3d\x03\xbb\x25\x5c\xff\x21\x3d\x11\xb8\x3a\x16\x20\x3c\x72\xd7\xaf\x69\x38\x23\x1a\xb5\x2b\xc7\xca\xf6\x1e\x1e\x0c\xd1\xe5\xa3\xbe\x5b\x2a\xb7\x54\xf2\x1d\xd8\x47\x0b\xf3\x86\xa7\xda\xdf\x6f\x8e\x60\x82\x7b\x93\xed\x18\x97\xfa\x52\xd0\xe9\xd7\xf6\x6a\x90\x80\x10\x77\xdb\x6c\x69\x07\x1d\xee\x10\x2c\xe7\x84\x20\x3d\xf4\x0e\xc0\x00\xc2\x4c\x69\xb7\x99\xe6\x5b\xde\xb9\x95\xd6\x24\xc7\x61\xcb\xd9\xd0\x56\x55\x7f\x78\x4d\xf3\xb0\x0a\x0e\xe1\x14\xa2\x04\xdb\x2c\xf6\x46\xa4\x55\xa5\xde\x88\x7b\x7b\x7a\xf2\xd8\x59\x1a\x90\x4d\x46\x17\xc7\x87\x56\x3e\x40\x1b\xcc\x72\x15\x65\x61\xe1\x3d\xdc\x0d\x51\x4d\x96\xd1\x9a\x15\xb3\xc3\xa8\x9a\xb7\xfa\x36\x6f\xf9\x90\x50\xad\xa3\xba\xec\x07\xde\xac\x0d\xef\xa8\xde\x9d\xc3\x1f\x68\x74\x9f\x35\x33\x81\x90\xec\x26\xb5\x84\x98\x5d\x12\x59\x1d\x33\x5b\x09\xd2\xfd\x9e\x69\x35\x60\x40\x18\x45\x63\xe4\x68\x3d\x4b\x3f\xab\x8f\xc6\xa9\xed\x73\x4d\xd0\xd1\x36\x56\xd3\x90\x1e\xac\x82\x8c\x64\x1c\xe8\x86\x58\x18\xd4\x23\xbc\x71\xf3\xd3\x3f\xa6\xd6\x59\x16\x67\x0a\x88\x02\xd2\xe2\x50\x23\x21\xc6\xc0\x14\x79\x83\x22\x91\xee\xa6\xfe\x22\x7b\x9d\x25\x2f\xd6\xe3\xe0\xc7\xa5\xda\xcd\xd9\x94\xa1\x19\x14\xab\x79\x9c\xfa\xeb\x68\xd9\x67\x23\x92\x66\x85\x9b\x6d\xc2\xbf\x98\x11\xa9\x5b\xb3\x80\x2b\xbb\x05\xe8\xf4\xf4\x34\x37\xc2\x86\x27\x78\xc6\x79\xfa\x78\x42\x68\xfd\x38\xe4\x36\x39\x05\x31\x45\xca\xd5\xf3\xad\x5b\x2e\xb8\xc1\x2b\xe6\x9c\x94\xa9\xa9\x28\xec\x06\x7f\xab\x50\x49\x4f\x1d\xf9\x15\x0c\x1b\x37\x1d\x67\x7d\x56\xab\xab\x66\xf2\xcc\x93\x6d\x16\x22\x63\x84\xde\xbc\x1b\x6d\x31\xa4\x84\x1b\x34\x79\xbc\xb1\x01\xb9\x8d\xf2\x33\x0d\xbd\x27\x74\x3d\xa5\x32\x91\x37\x38\x20\x61\x13\xe5\x6e\xf4\x1d\xba\x07\x32\x30\x04\xd2\xb3\xe6\x5b\xb6\xa7\xf1\xe7\xb2\xd9\x83\x60\xcb\xcf\x65\x7d\x1d\x09\xd1\x9e\x3e\x4d\x86\x44\xfe\x20\xc0\xd9\x59\x97\xf6\xa5\x66\x70\x7d\x5c\x8d\x57\xc7\x0d\x7d\xdf\xbd\x69\xed\x9b\xac\x49\x8c\x47\x6c\x15\x2f\xf6\xc9\x6a\x53\xe3\x5f\x52\x39\x5f\xc9\x06\xb7\x51\xfd\xca\x57\xa9\x5f\x1c\x65\x8b\x5b\x43\xe1\x5f\x73\xe2\x9d\x0a\x9b\x9a\xba\x55\x83\xb4\x6b\xec\x40\x3f\x1e\x04\x8f\x0c\xbe\xc0\xc3\x8f\x76\x09\x51\x3f\x95\xb7\xb5\x30\x61\xfc\xce\xe3\x94\xcd\x14\xe1\x6b\x7f\xc4\x46\xa7\x84\x28\xe8\xc7\x4b\x75\x7f\x10\x59\x06\xb7\x43\x5c\x04\xf3\x83\x12\x89\x91\x49\x94\xca\x29\x1e\x08\x85\x49\x30\x70\x9f\xf2\xc3\xd1\xf3\xc4\xc3\xf4\x07\x8d\xce\xc4\x69\x02\x42\x77\x88\x80\x9a\xc5\xc1\xfc\x8c\xd0\x74\x6f\xcf\xbc\xbf\x04\x3b\xbe\x8d\x48\xe0\xb7\x02\x7b\x13\x1d\x68\xf0\x0d\x8f\x00\x08\x95\x4c\x42\x24\x70\x5f\xc9\x50\xc9\xa2\x81\xa5\x04\x8b\xef\x19\x1f\x54\x81\xbe\xbe\x50\x0c\xcb\x7a\x72\xf4\x46\x8f\xa9\x07\xbb\x72\xcb\xd9\x4f\x04\xa7\x82\x86\x15\x6a\x40\xdf\x46\x97\xa8\x96\xe0\x55\x1f\x9d\x70\x2e\x1e\x00\x23\x00\xa8\xd9\xd0\x7d\x29\x5b\x2e\x87\x76\xfe\x0d\x3e\x46\x74\xab\xab\x28\x3c\x2f\x32\x84\x89\x5b\xe7\xdc\xe2\x9b\x6e\x39\x26\x7e\xd8\xe4\xbc\x9c\x81\x90\x0f\xa3\x75\xb0\xd3\xd3\xcc\x0f\x63\x77\x7b\x4b\x17\xbb\x73\x9f\xf7\x90\xee\x26\x5f\xdb\xd6\x64\x25\xe7\x83\x39\x89\x20\x39\x1e\x54\x14\x7b\xf0\x9d\x94\x49\x6d\xe7\xc6\x35\x1b\xab\x76\x1c\x7c\xcd\x66\x33\x05\xc8\x9f\x37\x17\x47\x44\x7d\x94\x2a\xa0\xe9\x69\xc0\x07\x88\x97\xda\x80\x9a\x1f\xe4\x8d\x6b\xf5\x0d\xbd\x8d\x94\xf4\x7a\x3c\x10\xb6\x39\x8a\x27\x72\x3d\xf5\x5d\x43\x80\xc8\x23\xa6\xe5\x2e\x82\x39\x74\x9b\x37\x81\xa1\x62\x39\x1c\x5a\x61\xa5\xc0\x3c\x14\x6c\x9f\x69\x5d\x6b\x28\x58\xd6\xa7\x72\xb9\x7b\xb4\x48\x40\x47\x6b\x6e\x0f\xbc\xfd\xcc\x63\xaa\xe1\x61\x18\xcf\xf7\xcd\x0e\xa7\xf4\x79\xb5\x69\xac\x5b\xb1\x26\xeb\xf7\x3d\xda\xad\x6c\xe8\x9c\xd1\xc3\xd3\xae\xca\x1a\x5a\xb7\x84\xb0\xb0\x7c\xc7\x78\x5e\x65\x50\xaf\xde\x7e\xdb\x90\x23\x33\x28\x13\x76\xf8\x6e\x70\x02\x9e\x2f\x52\x02\x49\x35\x03\x30\xfa\x9b\x5a\xac\xfb\xec\x57\xa7\x2a\x75\x91\xb1\xde\x1e\xbb\x64\xa3\x43\x09\x37\x02\x94\xbb\x64\xdb\xb9\xfa\x68\x3f\x4f\xea\xe9\x8b\x74\xb2\x11\x5b\xe5\x0c\xd3\x38\xc5\x88\x67\x64\x20\x0d\xe7\xe0\x6e\xa0\xf0\x89\x90\xfc\xe1\xd5\xa2\x6d\x66\x79\xe6\x9e\xfb\xb4\xa6\x28\x9b\x61\x46\x0a\x79\x54\x64\xd5\x9a\x2a\x55\xd6\x14\xd7\x7c\x60\x9a\x85\xb9\xc0\xaf\xb2\xc3\xc1\x86\xa5\xf7\xaa\xb2\x88\x6a\x6c\x06\xa0\x90\xb6\x69\xbb\x1a\x73\x14\x55\xf5\xa6\x91\x81\xa5\x6d\x00\xc3\x2d\x92\x03\x16\x53\x36\x84\xa8\x87\xe3\x13\x0e\xb4\xcc\x7d\x2e\xf7\x4a\x72\xf0\x19\x16\xa5\x51\x81\x04\x73\xd0\x07\x83\xc5\x16\x3c\x6a\xec\x1d\x83\x43\xfa\xae\x00\x04\x72\x21\x69\x87\x21\xbe\x03\x46\x70\xa7\x04\xd8\xdd\x27\x10\xf8\x38\xb7\x45\x68\x0e\x49\x59\x61\x0f\x49\x1c\xa0\x29\x92\x27\x3c\xbb\x39\xce\x3f\x6f\x7b\xdc\x9d\x83\x8b\xac\xbc\x93\xec\x21\x8e\xe2\x64\x11\x92\xf9\x84\x11\x79\x9b\xf5\x41\x67\xd9\x50\x27\xf8\xed\xab\x51\x4b\xdc\x45\x46\x59\xbc\x49\xc0\xfd\xd0\xf7\xa3\x9d\x48\x70\xf2\x0d\x7f\x72\x2d\x55\x37\x4b\xdb\x38\x4e\xb3\x88\xf5\x64\x22\xb8\x9d\xd4\x2a\x5c\x20\x9e\x30\x7d\x8f\xae\xa7\x78\xcf\x5b\x84\x60\x66\x48\xc8\x54\x5b\x32\x00\xae\xd4\xa2\x7e\xb8\x4f\xd1\x92\x6c\x44\x9d\x44\xca\x82\x72\x6c\xc4\x8b\x19\x7b\x13\x61\x07\x5d\x76\x7d\xe8\x4c\xc0\xb3\xa2\x20\xf6\xd2\x1b\x6b\x72\xf9\x99\x8c\x0f\xc2\x69\xd5\x0a\xb2\x0c\xf9\x6a\x57\xff\x21\x3f\x8a\xdb\x46\x31\x1c\x62\xd6\xc7\x69\x6a\x68\x31\x23\xe7\xda\xd1\xe6\xb4\xaf\xe2\x8e\x30\x1e\xe9\x25\x4e\x68\xa7\x4a\x3e\xb1\x86\xd2\xd9\xf5\xcd\x3e\xfa\x63\x19\xe2\x66\x5a\x81\x86\x2c\x5d\x50\x3c\xba\x7b\x13\xc1\x8a\x61\x29\x59\x93\x21\xdd\xbd\x9f\x59\x74\xeb\x7b\x3a\x06\x57\xcb\xc6\x93\x7a\x46\x90\xd4\xb1\x80\x08\x6b\xc4\x5b\xa6\x52\xc7\x02\x44\x68\x77\x42\xb4\x35\x84\x18\xd0\xcd\x49\x80\x6f\xf8\x53\x93\xa3\xea\xc5\x3e\xa9\x9b\x71\x83\x0b\x39\x9b\x9d\x80\xda\x65\x91\x3a\x65\xda\x33\x0c\xab\x2f\xc3\x22\x7a\xde\x6b\x02\x6f\x46\x80\x67\x75\x89\x8e\x44\x31\x49\x0a\x24\xce\xfc\x67\x76\x82\x6a\xba\x1f\xcb\xb3\x7c\x64\xc3\xcb\x19\xe2\x3e\x98\xa7\x38\xb9\xdf\xb8\xa7\x03\x21\x76\x5f\x23\xcb\xc0\xd8\xd3\x94\xca\x7d\x3c\xeb\x76\xa4\xbe\xd0\xa6\x7b\x65\xaa\x39\x31\x53\x76\xa9\x82\xd6\x79\xb8\x0e\x30\xfc\xa9\x5e\x67\x96\x36\x27\x27\x86\x1d\xde\x2d\x8f\x59\x69\x5e\x53\x49\xce\x51\xe2\x8c\xb5\x84\xd0\x09\xb3\x05\xad\xa3\xc9\xf8\xf5\x3c\x78\x7b\xeb\x96\xe8\xa5\x9c\xd0\xe0\x62\xb3\xa6\x64\x25\x78\x54\xa1\xbf\x0f\x74\x8d\x6a\x01\x1d\x7b\xb7\xaa\xb8\x47\x38\xfd\xb8\xb3\x2a\x92\x50\x2f\xb5\x8e\x0f\x7f\x37\x97\xf0\xba\x3d\x3d\x34\xd4\xcb\x29\xf1\x2c\x67\xc7\x48\x6f\x25\x9e\xcb\x04\x9d\xae\xe9\xc8\x6d\xce\x84\xa9\xac\xf1\x4b\x70\xb6\x8a\x37\x02\xde\x32\x29\x29\x1c\x3c\x0c\xd5\xe2\x2b\xf7\x31\xdb\x65\x85\x04\xb8\x94\xdf\xa8\xad\x52\xba\xe3\x68\x5d\x1f\xc1\x5e\xb6\x59\x59\x51\xc7\x3c\x11\xab\xad\xeb\xb2\x1c\x21\xc8\xe3\x14\x19\xe5\x2a\xd9\x71\x0c\x03\x65\x10\x7a\xe6\xbb\xfc\xb6\xbe\xce\xd4\x02\x91\xf0\x3e\xe9\x58\x90\x48\x29\x47\x93\x02\xf5\x14\x1c\x5c\x3c\xbb\xce\x6c\x1d\x7e\xec\x3c\x9c\x34\xc3\xfd\xc5\xce\x71\x27\x23\x59\xa8\x52\x32\x2b\xf5\x5d\xbc\x62\xdc\xf2\x3a\x16\xde\x5e\x3a\x2c\x0e\xeb\xd5\x9f\x27\x1e\xe6\xca\x48\x86\x6a\x8b\x99\x81\x21\x24\xa9\x57\x3a\x01\x02\x52\x83\x70\x7a\xb7\xc0\xbd\x18\x12\x19\xed\xfa\x7d\xee\x88\xf8\xe4\x2c\x0c\x53\x7c\x75\xa9\xd3\xa6\xc9\xee\x7e\x40\xf5\xc0\xcc\x5a\x53\xb3\xe2\xaa\x4e\x08\x98\x42\x21\x9a\x2e\x60\x87\x79\xf3\x27\xd6\x19\xad\xbb\x78\xea\x4e\x56\x94\x13\xc1\xb5\x0c\xc5\xc1\x98\x3c\x00\x48\xda\x80\xcd\x02\x64\x91\x4a\x29\xd3\x5e\x4d\xc4\xd4\x59\x6d\x2b\x25\x7a\xe3\xa3\x25\x22\xf5\x06\x0b\x95\xe1\xb4\xf9\xac\xa2\x2f\xd8\x73\xb8\x75\x7c\x27\x45\xc9\x96\x79\x2d\x6f\x0e\x81\x53\xf0\x12\xf1\x78\xa0\xb7\x70\xbb\xa6\xda\xb9\x9d\x78\x8d\xdd\xa3\xdd\x15\xab\x87\x43\x61\xc2\x2b\x36\x24\x34\xd9\x86\x17\xde\x59\x5b\x48\xcd\xa3\xf6\x6c\x8a\x42\xb9\x41\xf9\xa9\x41\x8e\x30\xde\xf0\x99\xc4\x55\x56\x49\x1d\x34\x8a\xe1\xc5\xc5\x47\x6f\x5a\xf1\xda\x24\x11\x3d\x5f\xb5\x91\x70\x7a\x43\xf4\x1a\xbd\x0d\xac\x62\xf7\xdb\xa8\x6d\x90\xdd\x4e\xfd\xeb\x32\xac\x69\x38\x38\x1e\x03\xf4\x59\xd9\x4f\xb4\x3b\x29\xe2\x91\x5c\x65\x16\xa3\x46\x0f\xcd\x03\xf8\x84\x1c\x12\xde\xe6\x05\x2c\xcc\x60\xa7\xc0\x70\x01\xc2\xb6\x78\x59\x91\x2b\x0c\x72\x0c\xe4\x64\xfd\x8a\x9e\xdd\x6c\x1e\x85\x7c\x5b\xfa\x90\x1c\xfd\x71\xbc\x33\x67\xd5\xad\x2f\x0c\xe6\x3c\xd7\x01\x63\xbb\x35\x6f\x4e\x40\x47\x30\x6d\x0d\xa5\x3d\x1f\x6a\x32\x52\x85\x65\x59\x71\xbe\x69\x67\x50\xb4\x93\xd7\x5e\x99\xd5\xa5\x92\xb0\xe5\xb3\x8c\x74\x58\x6f\x2e\x55\x62\xb1\x82\xee\x8a\xd5\x3e\x81\x2b\xc8\x79\x4d\xd6\x9f\x5c\x54\xa4\xc9\xb9\xd5\xc4\xe3\x12\x8b\x22\xd0\x1b\x9a\x21\x42\x32\x18\x47\x58\x71\x5f\xb3\x76\x0f\xa7\x93\x34\x6f\x62\x6e\x8f\xe8\xcd\xa8\x5e\xfa\x6e\x03\x23\xea\x92\x4a\x91\x97\x3d\x9d\xda\xbc\xdd\xe9\x7b\x79\x46\x3c\xdb\xba\xce\xfd\xc5\xd6\xfc\x55\x1a\x0b\xa2\x86\xd1\x2a\x0f\x62\xbb\xa5\x8e\x1f\x32\xf3\xf8\x9c\x22\xd0\x07\x57\x62\x3a\xee\xf1\x7a\x0d\xbe\x37\xd3\x40\x36\x67\xbd\x53\xdc\x9e\xce\xc6\x76\x0a\x4b\xb7\xe9\x2d\x53\x27\x5a\x98\x45\x8c\xcc\xc9\xf3\x21\x89\xfe\x94\x1f\x4b\x12\xa7\x8f\x1a\x72\x74\x0b\xa4\xb7\x87\xaa\x6a\x92\x38\x3d\x7c\xaf\xf0\xaf\xbb\xd1\xcd\x78\xb5\x87\xc0\xcd\x21\x43\x4a\x45\x48\x52\xdd\x18\xa9\x6a\x70\xc4\xb2\x54\x08\xb0\x5f\xcb\x44\xd4\xec\xc1\x38\x14\x13\xc5\xe9\xe3\x14\x5a\xd7\x11\x19\xd5\xc0\x63\x96\xf0\x47\x10\x70\x9c\x07\x98\x5c\x63\x00\x75\x0c\x94\x07\x94\x1a\x16\x30\x0c\x6d\x63\x7b\xa1\xd9\x5c\xa9\x9d\xb9\xe3\xcc\xd8\x8a\xea\x49\x3d\x7a\x77\xd1\x1f\xad\x84\xcd\x87\x7c\xaf\xf4\x00\x4a\x67\x30\xab\x77\xef\x3e\x08\xb5\xc5\xca\x0c\xd2\x60\xc3\x02\x88\x0b\xd7\xa9\x4e\x91\xa1\x00\xcb\x3d\x27\xbe\x02\x81\xc2\x05\xfa\x33\xd2\xc1\xea\xda\x8d\xe5\x41\xf5\xb7\x13\xde\x0d\xc3\x7d\x2c\x37\x79\xd8\x3a\xa7\xe9\x00\x65\xc9\x52\xc9\x9b\x62\x65\xe4\xe0\x27\xa8\xde\xce\xd9\xa4\x96\x0e\x3e\x5e\x12\x21\x99\x67\xe7\x20\x25\x28\xc7\x7d\x13\xd2\xb0\x92\x9b\x75\xb2\x5d\x8d\xe0\x3c\x42\x85\x84\x48\xc5\x07\x94\x7c\xe9\x40\x0c\x78\x38\x87\x9d\x3d\xa8\xf9\xd8\x88\xa9\x61\xa4\x27\xd2\x94\x00\x84\x96\x34\x42\x1e\x43\x4e\x2c\x5c\xeb\xe7\x4f\x60\x92\x03\xff\xa8\x89\x44\x14\x86\x28\xcf\x70\x4c\xb5\x3c\xaa\xd1\x6c\xc4\x13\x32\xe7\xe0\x8c\x09\x67\x8f\x67\xa9\x11\x00\x07\x50\x36\x9c\xe8\x62\x63\x8f\xd2\xec\x0d\x33\x52\x59\x13\xc2\xe6\x47\x02\x5b\x6a\xba\xe9\x98\xfb\x04\x57\xbf\x0c\xe1\x36\xa2\x3c\x8b\xf0\xc8\xe7\xe1\xea\x64\x6b\x0a\xae\x29\x71\xfa\x03\x4a\x4e\x84\x47\x55\x56\x09\xf0\xc1\xd6\x85\x9e\xab\x14\x61\x6e\xbd\x33\x23\xac\xb1\xb9\x75\x35\x94\xbe\x4e\x0f\x7c\x96\x25\x4e\x1d\x38\x68\xcb\xe4\x56\x16\x37\x27\x01\x8a\xe9\x79\x12\x73\x86\xe7\xba\x03\x78\xa2\xb7\x26\x78\xe1\x28\x5e\xc3\xbb\x5d\xbe\xf6\x41\xd9\x37\x6b\xa3\x89\x81\x59\x25\xe7\x5d\x43\xcb\x23\x80\x65\x69\xba\x7b\x86\x3b\x2f\xeb\x44\x02\xed\xc8\xb0\xce\xd3\x57\xa4\x9c\xdd\xfc\xac\xf0\x55\x48\x35\x2e\x4d\xe5\x5f\xa3\x15\x69\x7e\x3b\x38\x9e\xeb\x42\xb2\x4d\xbc\xe6\x4e\x48\x51\xe8\x41\x54\x04\x39\xd8\x15\x49\xab\x4e\x40\x6a\x30\xb7\x37\x97\x99\xc0\xd5\x3d\x15\x4b\x35\x6e\x27\x4c\x8e\xe7\xe2\x36\x64\x9a\xe2\x12\x4f\xd6\x1b\x9e\x86\x87\xeb\x2d\x62\xef\x34\xde\xa3\x60\xe5\xe7\x63\x25\xee\xb2\x57\xcc\x16\x89\x39\xb0\xea\xbe\x5e\x1b\x69\x9b\xc7\x33\x4b\xd1\xf1\x40\xfb\x0d\xf0\x35\x24\x14\x6b\xa2\x15\x02\x60\x3b\xa5\xc9\x42\xdb\xf1\x4e\xe2\x4e\x35\xdc\xa4\x7e\x9f\xa2\x7b\xa3\x2b\x69\x78\x2e\xcd\x15\x30\x96\xf9\x20\x45\x15\x90\xf2\x16\xc8\x42\x35\xee\x07\x4f\xf6\xc5\xf6\x29\xdb\xba\xf6\x72\x6c\xd4\xa2\x91\xb9\xd5\xe1\x33\x24\x97\x8e\x9d\x31\x87\xe4\x80\x74\x1d\x90\x8b\x41\x48\x5a\x63\x07\xce\x85\x1d\xb3\x48\x55\xbb\x9f\xf0\x28\xd4\x17\xf5\x46\x67\xcd\x9a\x12\xd7\xac\x0a\xd0\x3d\x49\x0c\x40\xa4\xf0\xd1\x1d\xd6\x02\xa2\x19\x0e\x11\xc5\x96\x75\x75\x79\x51\xa5\x9d\x60\x04\xe4\x5e\x38\xf4\xda\xb8\x3c\x3f\x09\xf5\x91\x8e\x76\xaf\x23\x44\x46\x33\x5d\xb5\x05\xe6\xb1\x79\x89\xc0\x9c\xa9\x42\xcd\x20\xdc\xf1\x85\x5c\xeb\xba\x10\x64\xab\x7b\xde\xee\x00\x21\xb3\xb0\xcf\x34\xd9\x51\xef\xd2\xbe\xcf\xbc\x29\xf9\x4a\x54\x12\xcd\xd8\xbb\xfa\x95\xaf\xc0\x02\xe6\xc8\x6e\xa4\x83\xef\x64\x1c\x15\x53\xf4\xaa\x76\x0e\x35\xa0\x48\x8e\x95\x9d\xbb\x89\xb3\x15\x52\x60\x68\x53\x8f\xa1\xa1\x59\x77\xdc\x43\xae\x0f\xd3\xc9\xe3\xe1\x0d\x7d\x9e\xbe\x3b\x43\x5b\x0a\x8e\x09\xe6\x78\xe7\xfc\x2c\x04\x18\xde\xcb\x9a\x9d\x09\xcc\x7b\x56\x98\x79\x3b\x68\x46\x79\x64\x49\x40\x67\xea\xa4\x83\xf5\xe3\x0e\x78\xf9\x12\x98\x4c\xd1\xcc\xb2\x95\x51\xd1\x8b\xce\xdb\x2b\x50\x80\x0c\x7d\xbd\x94\xd1\x01\x51\x99\x3a\x74\x64\xa8\x71\x1d\x74\x30\xcb\x81\x98\xc2\xbb\x43\x85\x84\x60\xf9\x84\x6d\x4f\x6a\x7e\x31\x9d\xbd\x96\x4b\xaa\xb5\xc1\x0d\x8d\x0f\x2f\x20\x33\x96\x21\xf5\xaa\x89\x29\xe4\xa1\xdd\xeb\x3b\x4b\xe3\xdb\x6b\xa9\xa4\x69\x5d\x43\x44\x06\xa3\xdc\x82\x8a\xd4\xc6\x67\x41\x2a\x79\xc2\x4a\x67\x1b\xf0\x5c\xc2\x3d\xa4\x63\xb7\xa2\x75\x6e\xf3\x45\xe5\xbd\xbb\xa1\x3f\x66\xe5\x06\x49\x38\x34\xad\xda\x42\xae\xd2\xae\xa8\x2c\xa9\xac\xbe\xc5\xc7\xa3\x1d\x99\x12\x77\xce\x18\x0c\x43\xb9\xca\x07\x37\x30\xa4\xbc\x04\x7b\xf9\xed\xea\x32\x0e\x7a\x14\xe7\x8d\x36\x2e\xc2\x87\xe2\xc6\xbf\xae\x97\xa7\xbb\x81\x6a\xf2\xad\x95\x46\x78\x88\xd9\x77\x37\x97\x87\xad\x58\x6e\x82\x60\x16\x06\xbd\x51\x14\x2a\x67\x22\x04\x4c\x29\x5c\x1f\xf0\x61\x18\x93\x70\x4b\x5b\x35\x05\x1c\xa2\xc5\x72\x29\x2a\xeb\x1e\x21\x6e\x76\xcc\x00\x7b\xba\x3a\x4c\x60\xbe\xa0\x81\x83\x94\x7c\xce\x87\x90\x5c\x46\xdd\xa4\xf3\x6b\x23\xc3\x73\x14\x8a\x3e\x57\x17\x3f\x86\xaa\xc0\x05\x67\x4a\x3f\x14\x9c\x70\x6c\x72\x43\x25\x36\x37\x2e\x47\x81\x5b\xf9\x26\x44\xb5\x71\x84\x8a\xdc\x54\xfd\xc8\x1f\xe5\x63\xda\xaa\xcd\xcf\xf5\x72\xee\x6b\x71\x6c\x07\xee\xd2\x10\xf0\xa9\x66\xb2\x56\xc7\xe9\x7d\x2b\xfa\xb8\xbe\x19\xbe\x13\x61\x13\xd4\xc4\xc9\x68\x31\xb5\x06\x62\x83\xe1\x29\x9e\x28\x68\x86\x52\xb0\x70\x59\x60\xe7\x41\x50\xc2\x28\xb1\x08\xb2\x4a\x68\x3e\x36\x26\xad\x28\x19\x7e\xab\x1e\xcc\xad\x3d\xda\xa3\x0a\xf2\x87\xd3\x03\x61\x04\xaf\x83\x94\x12\x3a\x10\x77\x4e\x16\x3a\x39\xab\x5d\x9c\x64\x11\x99\x52\xfa\x2e\x4a\x64\x45\xca\x0f\xcf\x80\x8b\x03\x6c\x31\x73\x1e\x76\x9f\x14\xd3\x0c\x42\x0e\x9a\x8d\x82\xa5\xad\xce\x10\x11\x68\x1c\x69\xb6\x79\xb1\x6c\x0b\xf4\x59\x49\x44\x2f\x7a\xa8\x1a\xf8\xb6\x22\x9e\x0d\x97\x9c\xd4\x12\x71\xac\x7d\x37\x9e\x7b\x6c\x6a\x3b\xa0\xbb\x76\x85\xf3\x31\xf8\xaa\x15\x06\x89\xd2\xde\x3a\x58\x98\x09\xb4\x9a\x50\x5e\xd7\xa8\xcb\xac\x21\xc5\x55\x58\x81\x7c\x5a\xcb\x43\x14\x36\x38\x48\x6d\xb6\x61\xd0\xa8\x63\xbf\x8c\xba\x2c\xe1\x99\x9f\x12\xa7\xdf\x09\x43\x04\x0a\x9d\xb0\x81\x67\x53\xda\x8e\xd5\x33\xb2\x5c\x22\xfe\xe8\xdf\xda\x5d\x3b\x16\x2c\x94\x62\x98\x04\xaa\x7e\x3e\xe5\x38\xb6\x4d\x0f\xbf\x32\x05\x70\x33\x12\xed\xdb\xfa\x40\xb8\xa7\xb4\x5a\x79\x71\x1a\xed\x88\xf8\x93\x2e\x29\x53\xc1\x6c\xc1\x81\xc3\x2f\x9c\xdb\x47\x07\x35\x61\xc2\x31\x93\x40\x25\x93\xfa\x72\x05\xcd\x94\xba\x11\x7d\x5a\xf1\x43\x7f\x98\x44\x69\x47\x77\x26\x6f\x23\x2d\x6c\x10\xd7\x59\xe1\xfb\x8e\x06\x67\x1a\xf5\x38\x6a\xd7\xa8\x2d\x2b\x88\x43\xe6\x92\x3f\x3c\xfa\xf2\x28\x77\x5d\xf0\x89\x60\xc3\xba\x9e\xb0\x9a\x13\xf2\x6e\x6b\xa3\x87\xed\x21\x3e\x23\x7f\xc6\x61\x0e\x9b\x1d\x59\x44\x43\xc7\x58\xf1\x5d\xe1\xc5\xcc\x8e\xbd\x00\xec\xf9\x36\xbc\xc2\x80\x42\x20\x75\xc5\x19\x40\xb1\x95\xeb\xbc\x00\xd0\x5f\x7b\xeb\xa4\xcf\x7a\x44\x60\x5b\x8f\x2f\xfc\x24\x52\x7e\xa5\xb3\xc0\x19\xcd\xc6\xd5\xb2\xc7\x82\x8a\xae\xde\x5c\xf6\x7d\x84\xb8\xce\x59\x9b\x93\xa2\x79\x9b\x06\x59\x6e\x53\xe8\x18\xa0\x73\x2c\x7b\x6e\xf2\x6d\x02\x4a\x83\x84\xac\xbb\xd8\x31\x8d\x46\x30\x88\x1a\x6b\xbc\x6a\xee\xc9\xc3\x78\xc9\x3c\xb0\xd4\x89\x03\x77\x23\x86\x95\x10\x5e\x75\xbb\x3e\xfa\x3a\xca\xe9\xf9\x2a\x00\xb7\x81\xe3\x70\xa5\xa2\x1f\xfe\xb4\x17\xa8\x3f\x01\xb1\x3c\xe0\x01\x2b\x80\xf9\x34\x05\x1a\xac\xc1\xe2\xd2\x0d\x33\xef\xd5\x5e\x47\xe4\x91\xb5\x81\x80\x69\xe1\xa1\xd9\xdf\xa0\xe6\x68\x6e\xcf\xa8\xa2\x35\xa5\x6d\xf9\x5b\xdf\x1f\x57\x50\x12\x00\xb0\x46\x89\x41\x84\xf4\xb4\x77\xd3\x85\x53\x45\xd5\x3f\xd8\x5c\x66\x8a\x26\x3e\x49\x43\x7a\x0c\x52\x44\x40\xaf\x3b\x56\x2f\xf7\x25\xb3\xfb\x71\x30\xaf\x20\x89\x59\x02\x56\xe0\x41\x9a\x34\x31\x79\xd9\xee\xc0\x68\x84\x9e\x1c\xda\x00\xdc\xe8\xde\xc8\x87\x62\x27\xbb\x25\x59\xbc\xe8\xe5\xec\x62\xcd\xde\xf2\xf6\x90\x96\x24\xdf\x17\xef\xbc\x32\x09\xef\xd5\x04\x49\x7d\x72\xe5\x17\xb0\x1d\xef\x7d\xd9\x3b\x8f\x2e\xef\xad\xd2\xef\x26\xa8\x9c\x58\x8a\x4a\x4d\x9e\x39\x0a\x47\x20\x5f\x84\xfd\x0a\xc7\x26\xbf\x1b\x8e\xc4\x7a\x33\x90\x5d\x77\xd7\x7e\xad\x68\xa7\xe6\xb6\x6f\xa9\x12\x28\xae\x6c\xea\xa5\xa9\x2c\x1f\xe1\x99\x91\x63\xd6\x66\x7c\xad\x94\xe6\xba\x95\x80\xb9\xef\xfb\xb4\x92\xd4\x1d\xbd\xae\x94\xda\x36\x93\x94\x1b\xe9\x2e\x2a\x52\x05\x1a\xd7\xca\x97\xd2\xb2\x37\xae\x69\x46\x0d\x05\xbe\xc0\x26\x09\x5d\x19\xde\x3c\xc2\x6e\xaf\x95\x4b\xf3\x68\x7b\x39\xbe\x99\x0c\x0e\x51\x5c\x88\x13\x6b\x48\x76\x6c\xab\x56\x85\xb7\xd0\xdb\x13\xd7\xe9\x3d\x5c\x18\x82\x7c\x63\xe5\x5c\xd8\x17\xf0\x64\x94\x0e\xc8\x56\x1c\x61\x66\xca\xba\x5a\x24\xaa\x72\x88\x62\xdb\x39\xf7\xa9\xce\x48\xca\xcc\xdf\x27\x38\x79\xc0\x4d\xb5\x47\xe9\x06\x96\x19\x5c\x81\x34\x9e\x17\x7d\xbd\x02\x82\x5b\x56\xc2\x50\xdc\xc6\x89\x76\x30\x98\x52\x27\x05\x11\xb9\x89\x9d\x92\xad\xa2\x0e\xdc\x45\x8d\xc2\xc0\x90\xdb\xd3\x51\x98\x1e\x54\xdd\x89\x3f\xe9\x07\x95\x42\x5d\x40\x02\xab\x94\x53\xa6\x63\xb1\x57\xcc\xf7\x03\xbe\xcf\xd5\xd1\x4e\x4a\x7d\xee\x4f\x55\xba\x88\x64\xc2\x98\x67\x81\x2f\xe7\x2d\x6c\xd2\xe7\x09\x4d\xb9\x75\x1f\xc6\x0a\xc8\x31\x6e\xf0\x09\x44\xb6\xf8\x2d\xdd\xf0\x5c\x32\xbc\x7d\x6f\x5e\xee\x00\x3a\xe6\x83\xc3\x2d\x1f\x7c\x8a\xd0\x36\xca\x3d\xfc\x58\xf6\x0d\x77\x7d\xfb\xa6\x9b\xa6\x0c\x16\x9b\xcc\x35\x68\x7f\xc9\x92\x93\xed\x59\x58\xa1\x97\x9e\x6e\x44\x1e\xdc\x83\x2d\x8c\xc8\x07\x5d\xe1\xda\x32\x20\xd3\x3e\xd0\x73\x2a\x53\x98\x29\x1d\xcf\x01\x6a\x2c\xa2\xbf\x9a\xc5\xaa\x9e\x03\x74\x75\x99\xda\xee\xd5\xaa\x4c\x96\xd2\xe5\x4a\x80\x01\x0a\x1e\xc6\xe7\xe2\xea\x2f\x1e\x8a\x93\x81\xcf\xe2\xb8\x1b\x3a\x0e\xcd\xa5\x86\xa8\x28\x96\xe8\x18\x7e\xcc\x58\xb2\xbf\x43\xb0\x80\x7a\xee\x3d\xb5\xf5\x08\x68\xe7\x33\x63\x57\x26\xf2\xb2\x8d\x08\xb6\x53\x8e\xed\x93\x5f\xc6\x7a\x01\x0d\x49\x06\x35\xb4\x96\x79\xb0\x14\x30\x72\x14\xdc\xe8\xb6\x64\x63\xdd\xc2\x4d\x77\xba\x3a\xea\xa7\xe0\xcc\xc5\xcd\x93\xc2\xeb\x88\xd3\x8c\x9e\x77\xee\x51\xdc\xad\xb8\xb0\xef\x0b\xb2\x0e\xbe\xce\xa7\x82\x25\x82\x6c\x2f\x38\x79\x66\x47\xd9\xae\x40\xea\x9d\x67\x47\x6a\x9a\xd5\x13\xa8\xa6\x6e\xbe\x1d\x8b\x4d\x98\x74\x4f\x9b\x88\x69\x7a\xe2\xd6\x6e\x27\x29\xea\x05\x04\x78\x77\xe2\xee\x78\x09\xc8\xd3\x27\x0a\xb3\xd1\xb6\xf3\xe7\x45\xd6\xc1\x02\x00\x10\x89\x7b\x79\x51\x33\x54\x67\xdc\x21\x6b\xbd\x63\x1d\xce\x3b\xe7\x53\xbc\x55\x9a\x47\xb2\xd7\x16\x0e\x6d\xa8\x79\x2c\x60\x47\x4f\x40\x46\xe0\x88\x4a\xdb\x3e\xab\xae\xb0\x2a\x98\x10\x38\x23\xd8\xed\x47\x00\x44\x9b\xaa\xec\x64\xf4\x75\x70\x82\x48\x0d\xb9\xf1\xf2\xc5\x9a\x29\x88\xfa\x94\x66\xc7\x67\x1e\x86\x45\xad\x51\x54\xb8\x50\xa8\xa9\x44\x02\x31\xe4\x8d\x68\x08\x39\xf2\x22\x25\xd1\x62\xe8\x26\x4e\x44\x99\xc8\xb1\xd7\xc1\x5c\x17\xfb\xf6\x65\x17\x7b\x4d\x66\xe6\x73\x6b\x48\x00\x8e\xf4\xa4\x03\x64\x23\x82\x8c\x0d\xdb\x40\x73\x3e\x18\x27\x10\x96\xec\x11\x3d\x0f\x82\xb7\x36\x1b\x30\x04\x60\x6d\x1e\x30\xc1\xf9\x04\x31\x03\x92\x33\xa3\xc7\x39\x6b\x22\x49\x29\xe3\x21\x7b\x15\x28\xad\x33\xbe\x3b\x3b\x42\xc3\x78\x27\x4e\x4b\x53\x21\x01\x66\x43\x09\x02\x26\xd9\x82\x0c\x38\xb6\xd2\x59\x58\x4c\xb7\x86\xd2\xfa\x1b\x7e\xba\xc3\x1c\xc6\xed\x36\x3e\x94\x79\x25\x03\xc3\x82\xb6\xd1\x3b\x3b\x9a\x0d\x20\x29\xe2\xe4\x32\xcd\xe4\xdd\xa4\xaa\xda\x64\xe1\xa5\x30\x69\x62\x72\x51\x94\x5e\xce\x3c\xa8\xd0\x6d\xc7\x2f\xbd\x30\x80\x84\x70\x0b\xa8\xa4\x30\x44\xa2\x64\xee\x80\x05\x4c\xf4\x4f\x64\xb0\xa1\x5b\x57\x63\x5d\x80\x39\xdc\x86\x0f\xb0\x04\x22\x60\x72\xab\xdb\xd0\x27\xd3\x04\xe6\x52\x1e\xae\x31\xf0\x6e\xbd\xb2\x48\xdd\xa2\x31\xc8\x71\x45\xe9\xf4\x9c\xf6\x53\x7b\xa8\x43\xb2\xc9\xc1\xa5\xa0\x61\xec\x15\xd1\xc7\xd8\x6b\xb6\xe9\x0f\x81\x6c\xf6\xc9\x4c\x73\xbe\x5c\xcd\x8e\x0a\x95\x57\x86\x1f\x37\xd9\xa4\x50\x24\x30\x92\xee\x84\x35\x8d\x61\x37\x44\x33\x40\xfd\xe1\x56\x03\x10\x5f\x32\xe9\x59\x20\x5e\xb6\xaf\xa3\x6a\x88\x41\x85\x48\xdd\x79\x70\xa8\x0b\xdd\xf7\xea\x49\x10\x01\xc0\x01\x20\x4d\x21\x29\x90\x1e\xb2\xc4\xdd\xb0\x04\x73\xe8\x3e\xb9\x30\xe8\xcc\xd7\xae\x0c\x30\xae\xa6\xe0\xea\x29\x77\x11\x0d\x1a\x73\x97\x21\x50\xbc\xca\x48\xe6\xee\x28\xcd\xad\xce\x28\x33\x20\x14\xe1\x20\x33\xd8\x2f\xb7\xa5\x75\x19\x05\x61\x0f\x08\x77\x57\xef\x1e\x08\x89\xef\x82\x07\x59\x4f\xe8\xf5\xb8\x4b\x09\x47\x71\x8f\xe1\x62\x53\xac\x66\x9a\xe7\xd8\x4d\x7d\x18\xa3\xb1\x43\x5c\xad\x71\x64\x57\x07\x63\x85\x43\x39\x26\x03\x08\xc4\xa3\x83\x13\xfc\xc8\x95\xc2\xc1\xc4\x3e\xce\x54\xad\x35\x61\x3a\x9b\x97\xbd\x89\xb0\xa2\xcd\xfa\x60\x7d\xdc\x1d\x7f\x1b\xc1\x91\xef\xcb\xad\x4f\x73\x4a\xb8\x91\xd3\xad\xd4\xae\x9b\x58\x4f\x7d\xa0\xf9\x89\xf0\xe0\x6f\xe1\x82\x67\x80\x2e\xc6\xaa\x9c\x66\xb0\xfb\xf0\xb9\x92\xe8\xf9\x6a\xed\x1c\x85\x5a\x88\xb5\x1a\x99\x06\x7c\xc6\xc2\x44\x04\xb9\x6d\xc6\xd6\x0e\xa2\x75\xd0\xee\xd7\xf1\xa4\x11\x73\xb8\x77\x9c\x1b\x08\x5b\xbb\x07\x4f\x47\x53\xc0\xb2\x5b\x36\x97\xaa\x4d\x87\x6d\x94\xad\x97\xdd\x1c\x9b\x6b\x33\x5f\xc2\x28\x3c\xae\x6c\xac\xf9\x30\x29\x83\xfc\x5c\x21\x22\x01\xe5\x82\x0f\xf2\xd3\x29\x95\x21\x13\x6e\xc9\xee\x43\x33\xa8\xfb\x43\x2d\xce\xaa\xc0\x03\x4a\xfb\x70\xe0\xad\x90\x5e\xa2\xe5\x63\x26\xc6\xfa\x71\x0f\x02\xce\xb0\x83\x26\x5e\xa6\x01\xf9\x30\x8e\x7a\xdb\x72\x50\x28\xa7\x99\xba\x8f\xa9\x16\x5c\xc3\x15\xe7\x84\x4b\xca\x1b\x9c\x05\x2a\x17\xba\xbb\x06\x33\x6c\x2a\xf1\xaa\x79\x80\x62\xc3\x2e\xe2\xa8\x8f\xc5\x21\x27\x5a\xb7\x21\xfa\x1c\x48\x6d\x79\x2f\x9f\x05\xbd\x50\xb7\x62\x97\xc9\xd3\x93\x30\x00\x23\x47\x6b\xb0\xd3\xb2\x82\xa6\x14\x14\xf9\xb6\xce\xa5\x41\x24\x79\xec\xc0\xdc\x62\xb9\xd6\x43\xa6\xb9\xc0\x8d\xef\xe5\xa3\x1a\x9e\xb5\x74\x13\x9a\xf6\xac\x95\x0e\x72\xbc\x13\x83\xc3\x47\x99\x51\x9e\x04\x0f\xd7\x22\x24\x28\x0a\x2c\x51\xf6\x72\x4d\x8c\x9c\xaf\x6d\x8c\x24\x34\x42\xe7\x3a\x40\x72\xac\x30\x5e\x81\xe9\xc9\x60\x4c\xbf\xf4\x0d\x1b\xd3\xe7\xb5\x37\x18\xfd\xa0\xe3\x66\xba\xa9\xc9\x4b\x70\xd0\xbe\x5a\x21\xb0\x3f\xec\x41\x03\xae\xa1\x96\xd2\x31\x25\x61\xe2\xe4\x30\x6a\xdb\xf7\xd9\xc7\x69\x6e\x7b\x62\x6a\x8f\x2b\x43\xb3\xd6\x3b\x96\x8d\x5d\x18\xcb\xd6\xb4\xe1\xb7\x7e\xe9\xa9\x5b\x05\xde\x60\xb2\xdf\x92\xa2\x48\xca\xd9\xaf\x54\xaf\x8d\x93\xc2\xea\x78\xb8\x00\x6f\x83\x55\xad\x46\x5c\x44\x90\x8d\x1f\x4f\xb4\xc9\x5a\xdf\xac\x0b\xd2\x98\xed\x3d\x48\x06\x48\x31\x6c\x5b\x68\x72\x81\xd2\x8a\x3c\x5b\x36\x53\x27\x32\x52\xc6\xd0\x05\xb9\x30\xee\x02\x0d\x0e\x90\x8a\x1c\x1d\xcc\x9d\x44\x3b\xd0\xe6\x9f\xc4\x86\xb1\x68\x67\x3a\xf6\x2c\x41\x37\xde\xe8\xfb\x66\x41\x72\xff\x89\xba\x36\x72\xd1\x2b\x8c\x59\xe0\xa0\x5d\xcf\x32\x35\x2d\x1b\xee\xb0\x47\xfc\xca\x97\x44\xa2\x97\x2b\xac\xb9\xf0\x2e\xda\xd9\x18\x03\x57\xee\x1c\x4f\xc2\xce\x5f\x43\x82\x5f\xc5\xa0\x05\xc6\x42\x40\xa9\x97\xb8\x9b\xc2\x26\xe7\x0b\xe7\x4f\x73\x56\x08\x1a\x7d\xe0\xc8\x3c\x12\xec\x76\x93\x26\xd7\x32\xa8\x7d\xb4\x9e\xfe\xb5\x33\x21\x4f\xe8\x90\x19\xdf\x8d\x41\x86\x6f\x04\xee\x4a\x12\x7a\x23\xe3\x67\xbf\x67\xd3\xbd\x88\x72\x94\x1a\x28\x09\xde\x8a\x27\x6b\x92\xe2\x5c\x55\x95\x4a\x11\x0f\xf0\x96\x2f\x67\x71\xbf\x7b\xa3\x03\x96\xee\xdc\xe8\xfd\x36\x9e\xa2\x2c\x60\x3c\xec\xa7\x11\xc0\xd3\x36\x32\xa7\x77\x34\x84\xc9\x28\xaf\x9a\xe7\x93\xeb\x36\xe9\xb6\x0e\xf7\xfb\x6a\x56\xdb\x95\xe3\xe3\x8a\x18\xe7\x20\xde\x7b\x3c\x1a\x07\x9c\x3c\xd7\x54\x0e\x87\xb9\x2c\x8b\xbc\x6a\x2a\x99\xe7\x1d\x8f\xc2\x80\x9d\xa4\x57\xd4\x41\x6e\xe0\xce\xdd\x55\x76\xc7\x00\x9c\xc7\xb2\x04\x5e\x7d\xdb\x04\x5f\xa0\x11\xf6\xd0\x9a\xbe\x02\xa2\x5a\xfe\xc9\x17\xe2\x7f\x7f\xf3\xf6\xaa\xa7\xff\x5e\xdf\x6e\xef\xe9\xbf\x3d\xc9\xd6\x42\xeb\x0e\x41\x8c\x57\xa5\x80\xfe\x20\x32\xb3\x2f\x73\x51\x2d\x61\x71\xf4\xfd\xc9\x0b\x3a\x91\x83\x16\x44\x11\x45\xb8\x39\xd8\xa4\xba\x35\x18\xeb\x1c\x8f\x6c\x49\xef\x8f\x54\xe8\xad\x5e\xe1\x9f\x50\xb9\x08\xb6\xe2\xd7\x27\xe6\x4e\x3c\xe9\x8b\x97\x08\xcb\x23\xde\xe4\x48\x8c\xad\x95\xf9\x34\xaa\x6e\xc2\xf5\x0b\xbb\xb6\xec\xba\x26\xe8\x35\xd9\x2e\x47\x15\xf7\xb9\x85\x40\x97\x23\x80\x82\x5f\x1f\x75\xaa\xc9\x34\xe9\xc2\xb7\x14\xd9\x2d\x3e\x5d\x80\x59\x46\x73\x8f\x39\xfc\x6b\x18\xa0\x62\x80\x79\x5b\xa7\x04\xa0\x36\xb3\x1d\x4f\x57\x34\xec\x23\x89\x38\xfd\xce\x68\x88\x65\xec\xbc\xa4\xf4\xdd\x15\x7a\xe2\xa2\xb4\x86\xc4\x1e\x3e\x39\xc2\x6f\x3b\x57\x00\x6e\x8c\x09\x9a\x26\x2f\x3b\x8a\x75\xb7\xad\x69\x09\x39\xa0\x6d\x85\x0b\x11\x34\x06\x3a\x97\x75\xa7\xb0\x55\xbb\xce\x2d\x5d\x92\x80\x3d\xeb\x85\x91\x0a\xc0\x62\x1c\x84\xe3\x78\xdb\xd6\x74\x55\xef\x0f\x0e\xa8\x29\x0e\x6b\x32\x8d\xeb\xc1\x4b\x27\x4d\xf3\x26\x4a\xd3\x9a\x9a\x04\xc8\xba\x93\x0a\x9d\xb9\xc5\x42\x0f\xa0\x2f\x6e\xd6\x16\x45\x12\xd4\x58\x65\x6e\x27\x01\x15\x66\x63\x3f\x26\x0b\xad\x19\xd4\x92\xa6\x80\x77\x97\x8d\x13\xe3\x5f\x4f\x4a\xe9\x9a\x71\x55\x14\x4b\xe8\x71\x1a\xb4\x47\xe7\xd4\x61\xee\xd6\x86\xb7\xc8\x60\x7b\xb2\x55\xfc\xf2\xd4\x89\x57\xc9\x0c\x4e\x69\xdf\xe8\xfd\x69\x15\xf2\xbd\xd6\x45\x5e\x7e\x9e\xa2\xbf\x68\x36\xcd\xf5\xd0\x88\x3d\xae\x91\x61\x9c\xd9\x69\xb8\xbc\xb3\x0a\xfb\x9e\xc0\x7e\x8a\x3d\x07\x48\x78\xe0\x1a\x12\xd0\x92\x10\xb3\x5a\x7a\x58\xa5\x28\x4f\x52\xd2\xea\x0a\xaf\x12\x74\x0e\xbc\x1a\x95\xf2\xc3\xa7\x5d\xde\xea\x0c\x9a\xc9\x62\xb5\xb2\xa3\xb5\x17\xda\x79\x32\x48\x5b\x32\x3d\x8c\x39\x8e\x62\x55\xf4\x56\x6d\x9a\x74\xd7\x87\x9b\xba\x80\xf8\xed\xb9\x69\xf0\x01\x19\x90\xcd\x2d\xf5\x55\x97\x23\x1f\x6b\x4f\x36\x93\x47\xbd\x3e\xf7\xb9\x63\x17\xd9\x3f\xe0\xd7\x54\xf1\x18\xcb\xe5\xaf\xf1\x79\x48\xb7\x1b\xff\x62\xca\xd5\x3c\x29\x75\x2b\x1e\x5b\x0b\xe0\xe5\xd8\x49\x52\x2c\x45\xaf\x14\x5e\x14\x69\xe4\x2f\x94\x31\xef\x91\xe3\x6b\xb1\x5c\xfa\xaf\x33\x1b\x55\x5e\x87\x65\x8c\x4f\x2c\x93\xdf\xca\x8a\x45\x1f\x93\xc9\x63\x98\x5c\x8c\x72\x39\x6c\x58\x4e\x3a\x23\xb4\x31\x35\xb4\xac\xb2\xa6\xa2\xcc\x96\x51\x2c\x9d\x4c\xf2\x46\xec\xf7\xd1\xd9\x68\x77\xe5\x3b\x62\xf5\xb6\x51\xac\x95\x87\x59\x6e\x10\x00\xe3\x94\x7e\xeb\xcd\x63\xec\xd7\x93\x47\xad\x1a\x44\x75\xd7\x26\x6b\xea\x06\x09\xbc\x04\x4b\xb2\x89\xf3\x02\x1a\x62\xfc\x6e\xc4\x55\xd4\xa9\xe6\x4b\x68\x4d\xc6\xdd\xb3\x7b\x8a\x80\x60\x75\xa0\x7d\xa8\xe2\x9d\xa8\xc6\x22\x75\xa3\x12\x67\xcd\xa0\x87\x54\x0c\xa6\x9a\xe8\x52\x7e\x20\xf0\x41\xa4\xc0\x59\x30\x8c\xd9\x29\x77\x5e\xbd\x66\x32\xd5\xfe\x45\xdf\xd0\x7f\xbf\xc9\x87\xf5\x57\x56\xef\xff\xe7\x9f\x05\x7c\xfd\xb5\x84\x2f\x0c\xfa\xb7\x7f\x6c\x9c\xc6\xa5\x5e\xeb\x71\xf8\x8f\xaf\x34\x1f\xd6\x7c\xfe\xcf\x2e\x2f\xd6\xff\xf8\x42\xa9\x7f\xfb\xcf\x75\x9c\xfe\xe3\x0b\x41\xfe\xed\x3f\xff\xde\x27\x4e\x96\xb1\xdb\xd6\xfc\x9f\x35\x55\x79\x5d\x56\xeb\x7f\x7c\xc1\x08\x35\x1d\xff\x39\xc5\x59\x56\x0f\xe5\xaf\x8f\x10\x14\x87\xa6\xe3\x3f\x8b\x71\x58\x7f\x2b\xd1\xc5\x73\x99\xff\x34\xbc\x7e\x0f\x1b\xc6\xb9\x8f\xbb\xff\x5c\xf3\x63\xfd\x15\x77\x75\x39\xfc\xc7\x6f\x85\xfe\xbf\xc9\x98\x9d\x5f\xff\xe7\xe7\x57\x12\xfe\xe3\xff\x85\x62\x28\x89\x16\x3f\x43\x8b\xb8\xaf\xbb\xf3\x3f\xfe\x0f\x39\xff\xff\x11\x77\xf5\xcd\x6d\xe2\x68\xfc\xff\x7e\x0a\x0d\x9d\xbb\x6b\xcf\x01\x81\xdf\x8d\xe3\xcc\xb2\xcd\x6b\xd3\x66\x9c\xb4\x4d\xb3\xbd\xb9\xe9\x08\x10\xb6\x12\x81\xa8\x24\x63\x3b\x19\x7f\xf7\x1b\x09\xb0\xb1\x63\x27\xcd\x76\x67\xcf\x3b\x6b\x23\xf4\xe8\xf7\xbc\x3f\x42\x64\x2a\xd1\x0c\x4b\x12\x20\x70\x81\x27\xd8\xd8\x03\xcb\x1b\x7b\xc0\xe3\x04\xd1\x3d\x20\x50\x22\x4c\x81\x39\x89\x2a\x62\x38\xcd\x74\xd6\xa7\x24\xc1\x66\x21\xbb\x63\x35\x1b\xfd\x18\xf1\x11\x49\x5c\xbb\x3f\x65\x3c\x34\x7d\x8e\xd1\x9d\xab\xbf\x4d\x44\x69\xdf\x9c\x62\xff\x8e\x48\x53\xcb\xa9\x50\x4c\x14\xde\x4e\x84\x74\x1d\xdb\xfe\x47\xdf\x8c\xc5\xae\x9e\x72\x1c\x4a\xcd\x31\x19\x8d\xa9\x62\x98\x6f\xfd\xe0\x4a\x8e\x12\x91\x22\x8e\x13\xb9\x40\x4b\x4d\x1d\xa7\x83\xfd\x46\x6e\x90\x10\x07\x8c\x23\x6d\xf0\x84\x25\x78\x81\xdc\x31\xcb\x30\x5f\xd2\xda\xb6\x6f\xe3\xde\x23\xda\x49\x12\x62\xae\x14\x5c\x20\x17\x05\x92\x64\x78\x0f\xb9\x19\x11\x44\xe2\x70\x63\xec\x42\x48\xce\x92\x11\x78\xa8\x3a\xa5\x63\xdb\x0b\x89\x7c\x8a\xc1\x83\xcf\x78\x88\xb9\x12\x98\xa2\x54\xa8\x48\xcc\x2f\xfa\x45\x87\x48\x51\x40\x92\x91\x6b\xf7\x63\x34\xcb\x37\x87\xc8\x15\x5f\x5d\xe6\x50\x2e\x45\x42\x9a\xc1\x98\xd0\x70\x89\xea\x33\x29\x59\x6c\xaa\xd0\x33\x39\x0a\xc9\x44\xb8\x8d\x74\xd6\x5f\xef\xe5\xda\x62\xab\xee\x85\xe4\x6e\x44\xf8\x12\x4c\xae\xf0\x54\xd0\xd9\xaa\x3f\x91\x63\x93\x45\xa6\x9c\xa7\xf8\x0d\xce\x70\xf2\x76\x6d\x5e\x2d\x0c\x10\x75\xa2\x6e\x84\x16\x1b\xe3\x9d\x74\x06\x04\xa3\x24\x04\xaf\xc3\x5e\xe8\xe3\x7a\x19\xd2\xae\xd5\x6e\xe1\xb8\x9f\x61\xae\x22\x8c\x16\xc1\x2a\x59\xba\x78\x9d\xa2\x91\x8a\x25\x14\x2a\xd7\xe8\xfb\x26\x91\x38\x16\x65\x28\xaf\x78\xbb\xaf\x6d\xbf\x81\x5a\x8d\x75\x1d\xdd\xe6\x8a\x69\xbb\x17\x34\x83\xb0\x5f\xca\x18\x45\xfd\x90\x88\x94\xa2\xb9\x1b\x51\x5c\x4d\x26\xc7\x72\xea\x38\x5e\x4b\xa6\x96\x6d\xf7\x55\xe8\x91\x68\x6e\x16\x95\xc2\x55\x0e\xc2\xa6\x8f\xe5\x14\xe3\x64\xa9\x8b\x0d\x1c\x1c\xaf\x0b\xbe\x8a\xc0\x5c\x84\x8d\xde\x22\x90\x96\x44\x3d\x14\x36\x43\x7f\x93\x68\x3d\x3c\xa3\x28\x5a\x23\x30\x29\x1b\x31\xf0\x50\x64\x5d\xdd\xaa\x2b\x83\x16\x79\x67\x75\x70\x0c\x56\x5f\x76\x11\x40\x1d\xcb\x69\x6d\x48\x6a\xc6\x38\x99\x6c\xb5\xf3\x9a\xa5\x4a\x5c\xe5\x35\x60\x6f\x41\x08\x49\x06\x1e\xca\xac\x07\x56\x7b\x2b\x9b\x90\x64\x6b\x91\xbb\xa4\x57\xff\xed\x18\x23\x32\x95\x4f\x84\xd2\xd2\x94\xfd\x65\x9d\xe9\x2e\x15\xd6\xc1\x66\x39\xf5\xed\xda\x89\x6c\x54\xda\x32\x07\x52\xa6\xb4\x54\x97\xd6\xd8\x4c\x10\xe7\x6c\x0a\x1e\x4a\x8d\x75\x75\x78\x5d\xf8\x7c\x29\xa5\xf6\x71\x39\x65\x94\xf1\xf9\x38\x11\x70\x18\xd9\x51\x73\x23\x24\x1f\xe7\xc1\x2a\x49\x76\x65\xac\xea\xda\x4c\xd7\x55\xfa\x28\xb7\x2a\x89\x2c\x95\x96\x26\x09\x58\xb2\xb7\xba\x3c\xf8\x37\x78\x28\x4a\x46\xee\x71\x2b\x61\x7a\x6b\x19\x5d\x82\xc1\xc3\x74\x4c\x24\xd6\xd5\x06\xbb\x09\x9b\x72\x94\x16\x0f\xcd\x63\x24\xc6\xcb\x80\xeb\x44\xdd\x66\xcf\x59\x9b\x25\x62\x96\x30\x3d\x6c\xf1\x5b\x8c\x43\x82\x00\x4b\xe8\x1c\x88\x80\x63\x9c\x00\x94\x84\xe0\xcd\xaa\x70\xb5\x6c\x3b\x9d\xbd\x05\x0f\x4f\x18\x9a\x24\xba\xae\x56\x28\xa6\x24\xc4\x1b\x8e\x28\x59\xa5\x9c\x28\x6f\xac\xd7\x87\xad\x2e\x2b\x7a\xf7\x56\x3a\xed\xad\x4d\x7f\xb6\x6d\x6f\xf1\xe4\x2e\x87\x55\xa9\x4b\xb2\xcd\x7e\xb4\x75\x4a\xb0\x9f\x9a\x4b\xfe\x33\xe6\x38\xfa\xaf\x8b\x22\x99\xe7\x78\x5e\x60\x0c\xf0\xc6\x00\x48\x4a\xfe\x46\x75\xbf\x05\xc6\x5b\x63\x21\x39\x78\xd0\x4a\xe7\x13\x28\x49\x04\x09\xb1\x8b\x32\x46\xc2\x9f\x2d\xcf\xd5\xc9\x71\x77\x89\x56\x9a\x2c\x7e\x53\xd3\x6f\x46\xf0\x34\x65\x5c\x96\x61\x14\xe2\x8c\x04\xc5\x76\x45\x0b\x2b\x34\x55\x4d\x58\x19\x5f\xb5\x16\x96\xfa\x36\x55\x2c\x81\x07\x7d\x19\x51\x36\x75\xf3\xd8\xd2\x6d\x31\xe6\x24\xb9\x33\x9d\xa2\x3b\x6f\xba\xce\xc2\x8a\xa9\x89\x26\x92\x95\x89\xa6\xf3\xc1\x55\x77\x16\x96\x9e\xec\x4c\x8e\x45\xca\x12\xa1\x0b\x66\xc9\xd3\xa7\x2c\xb8\xab\xcc\x8b\x7d\x95\xdf\x8a\xa5\x39\xd3\x63\x97\xcf\x0a\xcb\xfb\x22\xe0\x8c\x52\x95\x3c\x92\x4d\x82\xf1\xc2\xc4\xaf\x00\x00\x60\x1f\xea\x3d\xcc\x0f\xf2\x46\x8c\x25\x02\xe5\x96\xe9\xc6\x44\x46\x66\xd7\x00\xb0\xda\x99\xa0\x18\x0f\x8c\x10\x8b\x80\x93\x54\x39\xd5\x00\x85\xf3\x06\x86\x07\x42\xc2\x71\x20\x19\x9f\x03\x16\x81\x88\x50\x2c\xc0\x98\x09\x15\x16\x2c\x01\x72\x8c\x41\x48\x84\xe4\xc4\x9f\x48\x1c\xee\x81\x10\xab\x5a\xcb\x11\x25\xf7\x38\x04\x53\xec\x83\x89\x20\xc9\x08\x9c\x0d\x8f\x3f\x19\x55\xa6\x29\x67\x29\xe6\x72\x3e\x30\xd8\xc8\x95\x44\x52\x5c\xe1\x7a\xac\xd9\xb0\xe4\x99\x61\xdb\x45\x5e\xfe\x03\xd5\x88\xb3\x58\x4b\x1c\x30\x92\x3c\xc1\x7c\x9e\x56\x79\x4f\xb1\xaf\xa2\x7e\x37\xbd\x5e\x67\x57\x06\x8c\xa5\x4c\x85\x0b\xe1\x08\x49\x3c\x45\x73\x9d\xa2\x16\x61\x50\xfd\xc2\xcb\xf8\xd3\x21\xfe\xc3\xc3\xbd\x78\x84\xda\x17\xe1\x67\x76\xef\x7d\x9b\xcc\x4f\x3e\x34\x2e\x9d\x9b\x5b\xf1\x41\x66\x37\xf5\xee\xcd\xf1\xec\xfd\x69\x97\x0d\x6f\x7f\x18\x8f\xdd\x22\xa7\x44\x4a\xcc\x1f\x99\xe8\x27\xb4\x5c\x07\xf8\x9b\xfd\xbb\xce\x3c\x40\x3c\xac\x70\x15\x93\x38\x46\x7c\xfe\x5d\x3f\xef\x7f\xcf\x0d\xba\x7b\xf0\xff\xc7\xe0\x01\xc7\x48\x32\x5e\xe1\xfb\x9b\x62\xf0\xc4\x08\x1d\x36\xcf\x93\xff\xbd\xea\x94\x75\xaf\x1a\xe1\x7a\x8b\xb6\x6a\x01\xdc\x03\x24\x21\x92\x20\x6a\x8a\x00\x51\x3c\x70\x2c\xbb\x84\xa2\x24\xb9\x03\x1c\xd3\x81\x21\xc6\x8c\xcb\x60\x22\x81\x9a\x8f\x0d\xa0\xaa\xf9\xa0\x7a\x3c\xc3\x4c\x4f\xd4\xe5\xcb\xa7\xfc\x35\xd3\x91\x77\xa4\x5f\x36\x1d\x79\x67\xde\xef\x4c\x5d\x1e\x8f\x3c\xcf\x7b\x37\xd2\x2f\xa0\xd4\xd7\x99\x57\xf6\x97\x9f\x4b\xef\x57\x3e\x94\x75\x7b\xb0\xd5\xfe\x76\x09\xbb\xbf\x84\xe3\x79\xef\xbe\xdc\x1e\x4e\x1c\x8d\xd7\x8e\xc7\x9f\x87\xb5\x7b\x7e\x3d\x84\x49\x4a\x0f\x61\xab\x86\xae\xce\x5f\x80\x7f\x7a\x41\xce\x3e\xb5\xbf\xde\xde\x37\xe0\xc4\x3f\xfe\x03\x76\xda\xce\x57\x58\xcb\x52\xe7\x10\x4e\xf8\xd5\x37\xd8\xcd\x0e\xbf\x45\xf0\x24\x8b\x4f\x73\x7c\xe7\xf7\x8b\xb3\xf8\x09\xfc\xf7\x68\x38\x8c\x6a\x77\xc9\xd1\x2d\xcc\xf8\xb5\x5f\xe0\xd5\xe4\x75\x06\x79\x3b\xbc\x84\x9d\x1f\xf6\xd7\x08\x7e\x98\xd6\x6f\xe0\xbc\x7b\x41\x73\xfc\x9a\x4c\xef\x3a\x39\xfe\x74\x2b\x5e\x9d\x5f\xdf\x3c\xc2\xd3\xed\x76\x2d\xf9\x32\xac\xb5\xe5\x71\x52\xc1\xab\xe2\x9f\xb4\x6f\xb2\x1a\x8e\xbf\x0c\x61\xc5\x7e\x5a\xdf\xad\x78\xdb\xf5\xdf\x90\x37\x6f\x6f\xca\xab\xfd\xb1\x1d\xef\x19\xfd\x2b\xed\xcd\xf8\xc8\xf5\xdf\x8a\x97\xf9\x57\x08\xb6\x74\xbf\xd6\x6f\xbb\xfe\xda\xbe\x3f\xaf\x7f\xeb\xc7\xe7\xcb\x61\x8d\x24\x77\x3e\x64\x69\xeb\x04\xb6\xcf\xc3\xab\xec\xcf\xe9\x2f\x7e\xd0\x4f\xb9\x3e\xf0\xc3\xec\xab\x0f\xed\x8f\x34\x85\xbd\xc3\xf7\x28\x82\xe7\x6a\x3c\x7b\x2f\x8f\x60\xa7\xd6\xb8\x19\x3e\xab\x7f\x4f\x1e\x73\x18\xbf\x3f\xaa\xc1\xce\x89\xf8\x92\xc1\xab\x79\xcc\xa1\x1d\xa4\x3f\x60\xef\xf4\x1c\x3d\x6a\xd7\x66\xfc\xf8\x43\x2e\xdf\x13\xfa\x6b\xfd\xb6\x8e\x7f\x16\x5f\xdb\x67\x6b\xbc\x9e\x9f\xe3\xab\xac\xd6\x10\xd4\x7f\x09\x9e\x23\x8e\x6f\x72\x79\x36\xf1\x2a\x1f\xd5\xff\x45\xe3\xc3\x8b\x59\x1c\x6d\xc7\xeb\x36\x4e\xbe\xc1\x95\xff\x3e\xed\x86\xdb\x8a\xef\xb4\x35\xbe\xf6\xd7\xbc\x7b\x1d\x43\xdd\x56\x78\x68\xf8\x02\xa8\x9d\xf8\x1a\x4f\x06\x3f\x45\x0f\x93\xae\xe7\x0d\x47\x91\xe7\x1d\x4e\x0f\xa7\x9e\x37\xf5\x3e\xaa\xa2\xfc\xbb\xe7\xbd\xd3\xa5\x78\xe4\x1d\x3d\xd9\xd6\xf4\x43\x6f\xe8\x79\x87\xcd\xd3\x69\x81\xe7\x0d\x06\xab\x67\x4c\xfd\xe4\x72\xb0\xfd\x79\x65\x1f\xe6\xbd\xaf\xf6\xa1\x5a\xb5\x1c\xbc\xda\x57\xcb\x9a\x83\x57\xfb\x6a\xf1\x4d\xc2\x81\x51\x59\x1a\x95\x33\xd2\x96\x2e\xfd\xe2\xc0\x00\x01\x45\x42\x0c\x0c\xbd\x46\xd2\x77\x0e\xfe\x99\xf8\x22\xed\xef\xc3\x90\x64\x4f\x8c\x56\x8b\xb5\x02\x7d\x49\x53\x60\xad\xaf\xe3\x8c\x83\x7d\x54\x4c\x7c\xe5\xac\x5d\xcc\xd6\x06\x90\xea\x59\x46\x0e\x8c\xef\x3e\x45\xc9\x9d\x91\xcf\x99\x09\x63\x29\x4e\x30\x07\x09\xe3\x38\xc2\x9c\x2b\x35\x3c\x9f\x4d\xa4\x7e\x62\xda\x87\xe8\xa0\x22\xdc\x9f\x65\xfe\x9a\x24\x42\x22\x4a\x5f\x22\xc4\x59\x3e\xe4\x65\x62\xe4\xab\xdd\xbf\xd0\x0a\x7f\x19\xe7\x5f\x30\xc1\x0e\x19\x56\x2d\x7d\x67\x93\xef\x88\xc8\xf1\xc4\xb7\x02\x16\xe7\x0f\x6a\x21\xe1\x26\x49\x42\x3c\x33\xc7\x32\xa6\x90\x08\x31\xc1\x02\xbe\x44\x9c\x35\x7e\x9a\xa7\xc8\x46\xc5\x59\x56\xc6\xee\xb3\xac\x8c\xe5\x59\x56\x86\x0d\x6c\xe0\x74\xad\x26\xa8\x3b\xc6\xc1\x7e\x40\x78\x40\x31\x08\x66\x03\xa3\x63\xb5\x0c\x10\xcc\x07\x46\xd3\xea\x1a\x80\x0f\x0c\xc7\x80\x6b\x04\x8e\x63\x39\x5b\x28\x8a\x7d\x81\x8d\x8f\xfa\x50\x9e\xae\xd5\x0c\x4c\x5b\x6f\x8e\xdf\xd2\x7b\x79\xd7\xf5\xc6\xff\x2d\x7d\x50\x87\x3e\xf5\xc2\x36\x1b\xc0\x29\xce\x78\x68\x9d\xaa\x41\xf7\x6b\x30\x5d\xab\x05\x7a\x56\xe7\xb4\xa5\x71\x80\x6d\x75\x4d\x5b\xef\x4d\xde\xd1\xbf\x8a\x89\x0d\xea\x7a\x8f\xf5\x2e\x68\xea\xff\xeb\xa0\x65\xd5\xaf\x7b\x9b\x48\x4e\xc3\x6a\x6a\xa8\x9e\xd5\xcb\x3a\x56\x2f\xa8\x5b\x4d\xd3\xb6\x9a\x6a\x84\x96\xa7\xa9\xcf\x83\xa8\xbf\x73\x9a\x96\x03\x9c\xe2\x04\x83\x1e\x70\x14\xdf\x72\xf0\xfd\xa6\x15\x5a\x56\x27\xb7\x82\x53\xb7\x7a\xdb\x0d\xd5\x2a\x0d\xe5\xb4\xac\xe6\x2e\x92\x46\x41\x62\xef\x20\xa9\x3f\xcb\xa7\xb1\xc4\xd8\xc5\xa6\xb1\x14\x64\x8d\x8b\x0a\x89\x8d\xd0\x85\xa8\x12\xd9\x95\x52\x98\x5f\x96\x3f\x65\x61\x2c\x96\x09\xd5\x6a\x5b\xad\xab\xcb\x3a\x5e\xf9\x53\x53\x25\x76\xbf\xe2\x7f\x71\x0c\x38\x96\x9c\xe0\x4c\x91\xc9\x31\x11\xba\xdc\xe7\x85\xff\xb8\x28\xfc\xd6\xbe\xcf\x57\xa3\xce\x24\x98\x12\x4a\x81\x8f\x01\xca\x10\xa1\xfa\x6f\x0b\x7a\xbd\x41\xe7\x16\x18\x52\x8c\x04\x06\xc1\x18\x07\x77\xc0\x47\xc1\x1d\xa0\x48\x62\x6e\x6d\xd3\x02\x16\xf3\x07\x54\x59\x78\xf0\xea\x7f\x01\x00\x00\xff\xff\x7b\x4b\xa7\x14\x40\x96\x01\x00")
+var _fetchingHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\xbc\xc7\x92\xc4\x48\x97\xa5\xb7\xef\xa7\x18\xee\x48\x0b\x0e\x81\x80\x46\x4f\xcf\x98\x41\x6b\xad\xb1\xa1\x41\x6b\xad\x41\xe3\xbb\x33\xaa\xba\xa7\xff\xdf\x8c\xcb\xa9\x45\xc5\x26\x44\x26\x22\x61\x9e\xf7\x9e\xf3\x1d\x77\x07\xfe\xe5\xdf\xfe\x37\xd6\x60\xdc\xc8\xe4\xfe\x4b\xbd\x0f\xfd\xff\xf8\x97\x7f\xf9\xb7\x7f\x7f\xfe\xb7\xba\x48\xf2\xff\xf1\x2f\xff\xe5\xf7\xf8\xb7\x6d\x7f\xfa\xe2\xdf\x5f\xff\xf1\xf8\xbf\x9a\xb9\xdc\xfe\xeb\xff\x9d\xf6\xc9\xd8\xfd\x97\xff\x27\x4d\xb2\xae\x5a\xa7\x63\xcc\xff\x6b\x33\x24\x55\xf1\xaf\xc7\xda\xff\xef\x79\xb2\x27\xff\xfa\xe7\x5b\x60\x1e\xab\xff\x96\x26\x5b\x81\x21\xff\x67\xe3\xd3\x86\x7d\x81\x8a\x50\x4d\xd4\xef\xa1\x3b\x5e\xcd\x79\xd5\xef\x15\xfd\xc7\x5b\xca\x62\xa8\xe8\x8f\xe7\x92\x00\xc8\xfa\x8f\x17\x42\x68\xf3\x81\x68\xbb\x29\x14\x83\x39\xc4\x3f\xb1\x45\xd3\xb1\x40\x36\xb1\x43\xcb\x69\xc0\x8f\xb1\x2f\xf7\x51\x60\xa3\x59\xd6\xf7\xe6\x1f\x07\x04\xb4\x6c\x73\xbc\x57\xe8\x0b\xe7\xb5\x5f\xf5\xe6\x28\x6b\xcc\xbf\x9a\x4f\x21\xfd\x23\x71\xc1\x8d\x79\x92\xc2\x09\x1e\x77\x0b\x5b\xca\xc0\xbb\x5a\x5e\x32\x50\xbb\x40\x85\xfb\x3d\x33\x1e\xe9\x72\x87\xf4\x55\x01\x16\x52\x5b\x26\xa0\xd6\x8a\x8a\x8e\xf1\x41\x6f\xe7\x21\x94\x01\xaa\x09\xcf\xf1\x6a\x78\xfb\xe6\xa7\x7f\xd8\x28\x5b\x45\x63\xfc\x29\x49\x32\x69\x70\x7f\x47\x77\x52\x21\x8f\xf1\x40\x44\xf5\x2a\x24\x78\x09\xa2\x5b\xc2\x4c\x76\xda\xf2\x07\x2c\xeb\x6c\xdc\x6f\x03\x41\x98\xcc\xa0\x5f\x93\xc2\x87\x92\x7b\x0e\xf1\x2a\xf1\x71\xb7\x3a\xa4\xcd\xc6\xe7\x32\x6e\xa9\x2f\x6f\x83\xd1\xa3\x44\xf5\xab\x14\xcb\xc2\xb4\x73\xf3\x5a\x0e\x17\xc8\x91\x7a\x5d\x63\xc0\x5b\x5a\x6a\x31\x7b\xd9\xb4\x21\x0c\xff\x9d\x55\xc7\x4b\xc0\xa0\x48\xed\x72\x68\x2a\x55\x5c\xda\x2f\x97\x99\xf1\xf8\xfb\xd2\x2a\x65\xa5\x35\xf2\xed\x87\x6b\xc6\x63\x6d\xb1\xd6\xa5\xc5\x47\xc2\xe7\xc5\x5f\x79\x6b\xe1\xd2\x1d\x73\xb9\x21\x26\xcf\xaf\x6e\x53\xb2\xce\x7c\x6f\xf7\x77\xae\x56\x0d\x68\x83\xed\xf5\x03\x6f\x71\xbf\xb3\x37\x82\xef\xb8\xd0\x90\x1b\x74\x5f\x00\xde\x2b\xd9\x07\xaf\xc4\x3f\x3b\x5e\xe2\x8a\x38\x15\x17\x84\xeb\x1f\xe5\xa5\x06\x2e\x34\x6e\xac\x1a\x39\x5f\x3e\x02\xfa\x1d\x3a\x5b\xa6\x6d\x33\x12\x62\x9a\xdd\xfc\xc4\xe8\x67\xa7\xf2\x65\x0e\x7a\xfc\x84\x2a\x00\xb0\xbb\x01\x38\x79\x69\xdb\x00\xfd\x2d\xd5\xf8\x98\x83\x19\xd6\x89\x95\xab\x88\xcb\x48\xf2\xab\x0f\x1b\x63\x27\x5e\x93\x02\xeb\x1b\x57\x6c\x5e\x80\x39\xdd\xca\x01\x94\x54\x75\x51\x6a\xca\x52\x61\x89\xb3\xb9\xd5\xfd\x59\x28\x8e\xe7\x1b\xb6\x82\x32\x91\x24\xfd\xf7\xff\xe3\xbf\xfd\x53\x01\xae\xc5\x5c\x24\xfb\xbf\x8e\xd3\x7f\xbc\xfa\xe7\x9f\x6d\xcd\x5b\xfc\x6b\x36\x8d\x7b\xd2\x8c\xff\xef\x7f\x94\xf0\xfc\x2b\xd0\xbf\x57\x05\x0f\xf5\x7f\x54\xf0\xbc\x79\xef\x7e\x82\x5f\x2a\x32\xcb\xbc\x36\x1e\x20\x74\x63\x02\xf4\xfd\xaf\x3f\x31\x4b\x4f\x41\xaf\xb5\x78\xd5\xa5\x71\xdc\x1a\xd0\x94\x7a\x22\x82\xcc\x96\x1c\x3b\x18\x9c\xb8\xf1\xee\xe2\x33\x2e\x77\x53\x4d\xe3\x48\x90\xc5\x29\xb2\xc2\x39\x3e\xcf\xd3\xc1\x04\x22\x2e\xe7\xd8\x6f\xf9\x40\x19\xae\x46\x40\xa7\xee\x25\x94\x13\x1f\x14\x45\xdf\x53\x23\xf5\x44\xfa\x4e\x6b\x82\x6e\x67\xfa\x90\xc6\x98\x7a\x1c\x7d\x57\x6c\x2f\x29\x4d\xd0\x86\x56\xa1\x3e\x5f\x12\x08\xbf\xf8\xc6\xed\x99\x19\x41\xeb\x2e\x3a\x24\x06\x88\x07\x68\x9f\x61\xa8\xbe\x9f\xcc\x7b\x35\x02\x7f\x9d\x7a\x93\x20\x92\x9d\x45\x26\xda\x11\x0e\xa3\xa7\x1b\x91\xe2\xd7\x1d\x53\xf6\xc6\x7d\x1c\x11\xda\xda\x70\xca\xab\xe2\x40\x11\xc2\x60\x42\x21\x6b\x90\xa0\xe0\x46\x14\xfc\xb4\x75\x2f\xbc\xc2\xa2\x81\x7a\x2e\x80\xae\x8a\x4c\xd4\x05\x41\x6e\xcf\xd2\xaa\xa5\x72\x28\x25\xfe\x57\x05\xf2\xdc\x1e\x47\xd5\xe0\x79\x2b\xf1\xbe\x84\x98\xe0\xdc\x67\xbf\x02\xb5\xc0\x7b\xe4\x0b\xb4\x30\x0c\x10\x32\x2e\x6e\x86\xfc\xae\x76\x98\x91\x21\x02\x83\xc9\x97\xea\x1a\x5f\xfe\x46\x00\xe9\x99\xe7\xcb\xf6\xf5\x4f\x18\x85\x5b\x91\x2d\x31\xf5\x9d\x69\x84\x28\x3a\x6a\xbc\x48\x00\x11\xe2\x1b\x56\x41\x44\x07\x84\xc9\xbd\xb8\x36\x44\x32\x5d\x44\xcf\xde\x2c\xdb\x9d\x92\xa2\x99\xc8\x82\xd5\x3a\xf6\x7b\x65\x50\x6d\xca\x61\x7f\x0b\x7d\x57\xe3\x9d\x65\x4f\xb8\x4f\xd5\x93\x79\x9d\x78\x37\x07\xed\x61\xdc\xf0\xf8\x18\x35\x4d\xb2\xcb\xf0\x89\x6e\x84\x0d\x7c\x9f\x5f\x67\xab\xc6\x71\xb5\x1d\xa7\x36\x6e\x3a\x30\x64\x2e\x8b\xb3\x94\x08\x94\xb3\xb1\xdd\xc4\x5b\x82\x6c\x5d\x62\xa0\x65\x62\x20\x47\xc6\xf0\xd3\x2e\xa3\x3d\xad\x69\x3f\x9e\x7d\x47\x47\x51\x53\x39\xa1\x63\x9e\x1b\xb3\x3c\x98\x40\xda\xab\xf3\xa8\x1c\xda\x6e\x39\x75\x00\xcb\xa9\xe3\xbd\x02\x88\x55\x7c\x5f\xcb\x78\x9d\x84\xaf\x6f\x6b\xa5\xb0\xa8\xb2\x34\x4b\x9c\x5a\xc6\xeb\xab\x91\xa1\xa8\x53\xe1\xe9\x29\x0c\x13\xb1\xa4\x56\x87\x89\x59\x0e\xac\x9e\x40\xae\x78\x49\x19\x4e\x8f\x57\x0c\x44\xf3\x74\xa9\x83\x12\xe4\x53\x1b\xec\xce\x7a\xfe\x21\x03\x32\x0e\x43\x94\xe2\x60\xf1\x2e\x0d\xb9\xfb\x50\x96\x45\xc3\x69\xac\x13\x3d\xb9\xe3\xa8\xc4\xd7\xa0\xac\x79\xb9\xaf\x6c\x53\xbd\x79\xe6\x62\x57\x63\x97\x80\x7b\x54\x55\x55\x34\x33\x62\xa7\x08\xee\x26\x4a\xa8\x6e\x68\x53\x4b\x23\x8d\x2b\x31\x4c\x31\x9f\x21\x0a\x53\x12\x57\x5a\x7b\xf2\x6d\x3c\x66\x98\xdc\x86\x1e\x72\x6e\x6d\x20\xbb\xca\x73\x85\xaf\x0f\x79\x5a\x52\x20\x29\x81\x5f\x09\xe4\xf0\xeb\xea\x1a\xe6\x75\x89\x51\x36\x1b\x06\x25\xde\xef\x77\xa8\x74\x7c\x3f\x6b\x78\x98\xd1\xc7\x1f\xdf\x76\x27\x01\xe6\xa5\xa8\x74\x50\x5b\x66\x2d\xe9\xc6\xae\xfe\x52\x85\x48\x92\xec\xef\xa5\x0f\xe3\x7f\xea\x03\xe8\x75\x5f\x93\x03\xb9\xa8\xdc\xd7\xc3\xef\x4f\xca\xeb\x6a\x9f\x33\x27\x3a\xdb\xb8\x49\xfd\x49\x71\x04\x7e\x35\x53\x6c\x99\x0d\xce\xce\xa9\xca\x5c\x00\x59\x64\x59\x47\xf5\x53\xac\xa7\xa9\xce\x8c\xd1\xbc\x90\x88\x13\xa4\x8e\xa9\x3f\x93\x2a\xd0\xca\x00\xc7\xf8\x18\xc3\xf9\x50\x40\x67\x6b\xd4\x6e\xd6\x36\xb2\x7c\xc6\xaf\x79\x1a\x1a\x01\x88\x02\xb4\xc0\x10\xca\xae\x70\xa7\xce\x78\xf4\x6d\xd2\x59\xba\xdb\x0d\x51\x6a\x8b\x8e\xcb\xd3\xc7\x36\x5c\x41\xfd\x14\x4c\xdb\x62\x2a\xd1\xb8\xcf\x84\x67\x33\xbf\x09\xfa\x1d\x9a\xd2\x60\x9f\xf4\x7c\x39\x62\x78\x99\x74\xa5\x26\x97\x95\xdc\x5a\x9b\x84\x5f\xab\xc4\x48\xe4\x34\xc7\x5a\x7e\xe2\x6f\x58\x1c\xcc\x22\xe3\x46\xfb\xd9\x14\xb8\xb5\x32\x9d\x1a\x2a\xe2\xee\x04\x0b\xff\x0e\x51\xd1\x66\x14\x8d\x90\xe7\x3c\x14\xc7\x2b\xff\x86\x03\xfc\xe4\x5e\x8b\x29\x12\x75\x96\x22\x63\x56\xb0\xfe\x39\xa8\x13\x39\x2b\x92\x36\xee\x8c\xf9\x59\xec\x7b\x98\x40\x55\x8c\xbf\x03\x96\x9a\xd3\xaa\x23\x2f\xbe\xa0\x7c\x0d\x1f\xf6\xf0\xa4\xc7\xcb\xdd\x7e\x39\xa0\xf4\x99\xb4\x32\x04\x72\xbb\x58\x07\xba\xe0\x6e\x71\x73\x9b\x40\x6f\xe0\x4f\xaf\xcd\xaf\x7d\xb1\x5d\x43\xbc\x37\x23\x51\xee\xf0\xa6\xc5\x59\x0e\x4c\x7f\x74\x8d\x89\xea\x7c\x2d\x57\x57\xd7\x16\xb6\x5b\xa8\x4e\x9d\x1b\x93\x2f\x2f\xe3\xcf\xa9\xba\x8a\xa1\x62\xec\xe3\xe5\x2a\x43\xee\x57\x6b\xc5\xc4\x9c\xbf\xcd\xc4\x77\x31\x2d\x46\xf0\x82\xd1\x70\x8f\xe4\x32\xc7\x71\xa6\xc1\x14\x2c\x92\x45\xbe\x88\x87\x5b\xf9\x45\xf9\x0f\x2f\x0d\x0c\xfe\x6b\x2b\x9d\x92\x69\xd9\xe9\xfc\xe9\x27\xd6\x56\x44\x46\x4b\xd5\xd8\x48\x1c\xbf\x76\x22\xd0\x5d\x7e\x29\x70\xca\xdf\x82\x6a\xc7\x5e\x49\xc3\xf6\x00\xdd\xc8\x4d\x32\xc2\xbe\x79\xb7\x48\x5a\x51\xa7\x48\x0c\xcb\x1f\xea\xad\x50\xd3\x2b\xc4\x57\xee\x2e\xb4\xbd\x41\x7c\xaa\xf6\x61\xda\x98\x39\xc7\x68\x8c\x98\xe4\x2d\x40\x25\x6c\x18\xeb\xfc\x52\xe4\x8c\x74\x8e\x76\xe6\x20\xde\x6c\x4f\xf8\xa7\x66\xd0\xd7\x1b\x2e\xc2\x98\x0b\xf2\xf2\xf8\x66\xfc\x0d\x78\x8c\xeb\xca\x0e\xd9\xee\x40\x23\xb5\x12\x4f\xd8\x48\x2c\x26\x62\x2c\x5f\x91\xee\x94\xde\x8f\x28\x8b\x5e\xbd\xfe\x6d\x68\x30\xb4\x9c\xa6\x44\xa2\xc1\xaa\xbb\xad\xd2\x25\xb9\x23\x9b\x44\xad\xcd\xc4\x27\x53\xc0\x1b\x3e\x87\xec\x30\xf9\xfb\xb0\xa9\x10\x5b\xb6\xd3\x9d\xed\xf4\x19\x11\x59\xbf\x8f\x54\xf0\x9c\x8d\x69\x3f\x31\x33\x1b\x6c\xd4\x76\x82\x46\xe9\x2c\xd4\xe1\xc5\xed\x6d\x65\xdd\x31\xf9\x07\x22\x9f\xea\xa0\x09\x22\xe8\x71\x3e\x00\x26\x6c\x77\x71\xa0\xca\xc2\x85\xaa\x84\x1f\x33\xd4\xf7\x57\x0d\xe8\x66\x2d\x44\x7f\xc4\xc9\xc8\xc4\x98\xda\x82\xc7\x36\x05\x0a\xbe\x2f\x83\x45\x58\x5c\x58\x84\xe3\x5e\x90\x6a\x41\x82\x1a\xc8\x33\x00\x0f\xb8\xba\xeb\x67\xb6\x53\xd2\x67\x77\xaf\x53\xdf\x3f\xbb\x93\xeb\x79\xb7\x73\x0e\x6b\x60\x98\xff\x25\xcd\x68\xfe\x5e\x92\xd1\xa1\xff\x40\x0a\xf0\x7b\x72\x3d\xe7\x99\xae\xf9\xc6\x0b\x4d\x59\x89\xc3\x5b\x8d\x1c\x79\x43\xaf\x44\xf5\xe4\xf2\xc8\x57\x85\xdd\xf4\x00\x2c\xe0\x43\x9d\x5b\x08\xd3\x33\x50\x99\xdb\x11\xa8\xeb\xf2\x2c\x16\xee\x7a\xb7\xb6\x53\x27\xff\xdd\x23\x61\x0a\x2f\xfd\xc2\xaf\x13\x7f\xaf\xa8\xda\x95\x10\x56\x2f\x18\x27\x51\x1c\x9d\xd1\x17\xc7\x0d\xf0\x3b\xd5\xc1\x07\x60\xfd\x9a\x78\x5b\x3c\x89\x94\xba\xec\x85\x5c\x20\xe3\x4d\xb8\x54\xfd\xc9\xcb\xb2\xf2\xcb\xf5\x4b\x46\xe2\xb9\x71\xc9\x27\x3e\x80\xf1\x1e\xf3\x29\x45\x9d\xdd\x52\x3e\xf9\xf3\xfe\x84\xe7\xe7\x01\x95\xd2\x0f\x5a\x8b\x4b\x4f\xc5\x6b\x9f\xfb\xd4\xb3\x64\x9c\x11\x60\x47\x73\x6a\x1a\x42\x91\x7e\x20\xcf\xa3\x0d\x03\xd2\xa8\x1e\xa0\x49\x20\x33\x53\xcc\xf4\xd4\x63\x12\x6f\x30\xd0\xc0\x02\x16\xce\xd9\x33\x6e\x16\xbe\x62\x4a\x0e\x8e\x3d\x5e\x22\x2f\x1a\x96\x1f\x98\xa2\x86\x56\x89\x70\x64\x19\xa9\x8a\x92\x01\x08\x1c\x7d\x3f\xaf\x8f\x0d\x8b\x45\xd5\x29\xdc\xd2\x04\xb4\x23\xf8\x88\x0d\x9f\x16\xbe\x49\xb5\x93\xb9\x31\xef\xce\xe2\x0d\x53\xd0\x17\xa5\xd1\xad\x3f\xfc\x08\x08\x02\xad\xc2\x3f\x94\x4b\x03\x7d\xa6\x6e\xca\xec\x4e\xa3\xf8\xb2\x1f\x43\xe3\xb9\xc3\x7d\x89\xa6\xeb\x7e\xa9\xe0\xda\xc9\x48\x8e\x69\x98\xc8\x66\x3a\x9f\x2c\x2a\x96\x73\xf5\x64\x04\xfa\x29\x27\x93\xc6\xc2\xbc\x23\xc1\x39\x7a\xb0\xb0\x82\x33\x80\x4d\x82\x8b\x5a\x2b\x90\x64\x13\x01\xed\x99\xcd\xe3\x75\x70\xce\xba\x98\x9a\xda\x9c\x21\x06\x24\x39\xe8\x25\xa1\xd4\xc3\xa9\x8a\x17\xf7\xe9\xaf\xbd\xb3\x8e\x17\x18\xd6\xe9\x25\xb4\xca\x7b\xaf\x4c\x6b\x32\xc4\x5e\x83\x59\xe5\xdf\x9f\xd4\x81\xc1\xe4\x54\x72\xd9\xb2\xe7\xea\x2a\xf7\x7e\x51\xd2\xc3\x75\x94\x61\xa1\xb5\x07\x00\x71\x5e\xa1\xc7\x74\xb1\xbd\xa7\x6b\xe3\x15\xab\x01\xb8\x39\x87\x77\x50\x4c\x54\x5f\x96\x7d\xf1\xa7\x7c\x1f\x33\xd3\x72\x91\xe7\x65\x99\xd1\x4d\x89\x32\xe4\x46\x1f\x8f\xd1\xd7\xed\x2a\x2a\x0b\xa9\xfa\xae\x8d\x4b\xdd\xd8\x4d\x9c\x74\xf8\x40\x0c\x54\x76\xd9\xd2\x67\x44\x4c\x22\xc7\xb2\x95\x72\xb8\x92\x2a\xc7\xa3\x5c\x5e\xee\x80\xda\x2f\x4e\x61\x37\xb4\xcb\x01\xc3\xcb\x82\xa9\x97\x06\xf8\xb5\x57\x2a\xc2\x82\x70\x09\x6a\x93\xd3\x9f\xe2\x95\xaf\x2e\x16\x59\xfc\x66\xa6\xb8\x47\xeb\xc7\x84\xa7\xd6\x37\x69\x01\x16\x64\x1b\x2d\xec\x65\x8a\xb7\x1e\x05\x5a\x89\x72\xd4\x34\x3b\x9e\x91\xde\xfa\x6a\x89\xa3\xa2\xbb\x07\x7c\xbb\x85\xea\x34\xa6\x86\xf6\x48\xfa\xe0\x9f\xae\xc6\xc1\xac\x35\xd3\x15\x49\xb9\x18\xfd\xb6\xf1\x4a\xb8\xf2\x48\x93\xa6\x1c\xce\x2f\xec\x23\xbf\xce\x32\x0d\xcb\x97\x47\xc8\xc2\x40\xe1\xaf\x6d\xfd\xb2\xfc\x7b\x35\xff\xf4\x9f\xbc\xd0\x79\xe4\xbe\x70\x5f\xce\x0b\xe3\x21\x9e\x39\x38\x71\x69\x35\x95\x79\xd3\xdd\x79\xcf\xde\x1d\x66\xaf\x57\xdc\x63\x1e\x45\xe1\x65\x80\xa4\x46\x78\xaa\x30\xaa\x02\xa6\xb0\x9c\xbc\x16\x9f\x2a\x32\xd9\x18\x3a\x52\xdc\x5f\x84\x36\xc5\x24\xd8\xe7\x05\xd7\x3d\x28\x89\x15\xf4\x45\xaf\x98\xbc\x6f\x6d\xd6\xae\x98\x3d\x8a\xeb\x25\xe7\x75\x85\xa1\xb8\x31\x00\x9c\xd1\x13\xf6\x86\x0d\x75\xc7\x4a\xa3\xa5\x17\xa1\x02\x12\xc5\x66\x24\x69\x9c\x89\x8f\xf2\x96\x8a\x88\x97\x47\x3e\xfa\x3c\x4a\x8c\xdc\x17\x2b\xfa\x0f\xf0\x78\xd0\x40\xf3\x4e\x10\x2b\x73\x20\x2b\x7e\x06\x1a\x95\x47\x4f\x20\xa4\x20\x3a\x3b\x78\xd8\x84\xa3\xda\xfd\x09\xf2\x83\x94\xbc\x90\x2f\x1a\x83\xfa\x0d\xe7\xf7\x16\x8b\x28\x39\x2a\x9f\xcd\xdf\x39\x9d\xc9\xef\x22\xf4\xd4\x8f\x3f\x6f\x22\x67\x7e\x91\x25\x98\xef\xba\x1d\xb7\xbb\x05\x6c\xfb\xea\x08\xb4\x16\xe4\x1f\x80\x7f\x0d\xac\x1c\x8b\x80\x3a\xa6\x77\x67\x23\xc7\x51\x0b\xd2\x70\x31\xe7\x08\x69\x28\xcb\x6e\x1c\x8e\xc9\xe3\xe8\x2e\xf6\x2a\xfb\xed\x5b\x81\x77\x2c\x45\x00\x95\x03\xc2\xf8\xec\x40\xe8\x97\xe9\x13\xad\x0e\x6b\x73\x84\xf9\xa3\x06\x1a\x03\x08\x1a\xb3\x8e\xe4\x96\x2e\x9b\xe6\xb5\x1d\x6c\x24\xaa\xf9\x9d\xa7\xca\x3c\x1b\xd1\x96\x82\xcc\x79\x4d\x07\x05\x73\x6c\xb0\x90\x63\x95\xe4\xc4\xee\xfe\x62\x5d\xd3\xcc\x50\x4d\x84\x61\xbc\x03\x4c\x03\x9c\xe2\x28\xe3\x3b\x90\xd6\x7b\x38\x25\x43\x41\x23\xd3\x4c\xdc\xc6\x50\x2e\x1f\x76\xc7\xca\x31\x7f\xf1\x94\xfd\xfb\x0f\xd1\x6d\x02\x97\x7a\x79\x47\x50\xc4\x4d\xcc\x6e\x33\x2d\x59\x0c\xb0\x3b\xe7\x1b\xc6\xcf\xf2\x56\x08\x0c\x7d\x3b\xa0\x50\x45\x02\xfd\x2e\x17\x06\xcd\x04\x52\x8d\xd1\x48\x49\x10\xe3\xa4\x4e\x6a\xd6\xdc\xcc\x53\x09\x07\xbd\x06\x9b\x78\x54\x1f\x04\x17\x3b\x39\x28\x5b\x17\xc2\x92\x06\xb3\xcf\x01\x20\x33\x2c\x4c\x44\x2e\x1d\xf7\x48\x3a\x9d\x15\x29\x74\x50\xac\xad\x5b\x44\xea\x04\x94\xef\xbf\x12\xaf\x4c\x03\x63\x35\xc3\x07\xec\x0f\x07\x79\x94\xa8\xdf\xc4\xde\x7e\xe2\x09\xe7\x36\x87\x63\x20\x58\x41\x4f\x6a\x0b\x6b\x40\x24\xa1\x37\xee\x0e\xfb\xbc\x0b\xda\x41\x47\xee\x86\x9e\x19\x52\x96\xfa\x36\x27\x1f\x75\x23\x07\x20\x98\x3d\xa7\xe6\xa7\xd7\xc8\x2d\xb6\x14\xc5\xb1\xde\xe7\xc7\x54\x5b\x61\xfb\x90\x35\x51\xaf\x14\x45\x44\x3b\x14\x28\xab\x93\xa5\x04\xe6\xd6\xe4\x49\x2d\xa5\x0b\x4f\x6e\x92\xbe\x86\x9b\xf1\x45\xce\x00\xd5\xd0\xb3\x1e\xad\x79\x7a\x01\x3b\xf8\xb8\xf2\x62\xef\xb9\x47\x7a\x6b\x29\x33\x9e\x36\x3b\xb2\x54\xa5\xc1\xbb\x45\x05\xfa\xa5\x33\xbf\x11\x5f\x55\x2c\x16\xc6\x5e\xea\xf8\x1b\x85\xdf\x9d\xbf\xf4\x32\xfe\x8d\x17\x69\xc0\x7a\xd0\x51\x99\xb8\xd8\x18\x88\x75\xc0\x08\xc0\xd9\xf4\x83\x28\x1f\x88\xf0\xd3\x02\x8c\x88\x89\x8b\xb3\x5f\x87\x13\xce\x40\x61\xfb\x45\x1f\xec\x80\x81\x12\x51\x34\x8a\x3e\x9d\xa0\x64\xc5\x21\x55\xa0\x3f\xa3\x07\x2d\xdb\x1e\xca\xad\x9d\xfc\xcb\x41\xff\xfd\x7f\x2d\x7b\x9c\x7f\x33\x90\x18\xbe\xff\x00\x09\x62\x3f\x40\x90\xf2\xcc\x30\xce\x74\x70\x7c\xdd\x79\x81\x14\x0b\x9e\xa9\xab\x93\xa8\x31\x10\x17\x66\x2a\x8a\x9b\x4e\x3f\x94\xf5\xa1\xe8\x98\xa0\xa0\xed\x43\x49\x5d\x3e\x40\xd8\x2f\xc4\xca\x12\x1f\x28\x74\x27\x56\x5f\x65\xb6\xc5\x06\x9d\x06\x59\xe8\x52\x19\x4e\x05\xb1\xfd\x20\xda\x97\xb3\x56\xf7\x74\x1e\xc1\x34\x8f\xa2\x28\xb2\xcf\xe7\xcb\x55\x16\x7d\x8b\xf3\x07\x20\x87\xf4\x39\xd6\xc6\x8e\xf3\xbb\x95\x93\x46\xa1\x59\x38\xf8\x1c\xdf\xcf\x4c\x26\x04\x8a\x0d\xc9\xfd\xa9\x58\x83\x28\x52\xf7\x13\xb8\x10\xc8\xbd\x0d\xa9\xc7\x0d\x90\xad\x4b\x92\x9f\x23\xa4\x59\x34\x25\x94\x33\x63\xcc\x8b\xbe\x1e\x10\x09\xe3\xe7\x6d\x4a\x73\x84\x19\x63\xfb\x6b\x7a\xd8\xbd\xb2\x8e\xd4\xe8\xc6\xf1\xc0\x31\x2b\xc5\x66\x0d\xf9\x21\x0e\x61\x2d\x27\xaf\x85\xf1\xae\x86\xf3\x75\xe0\x4d\xac\x52\xdc\xc1\xb3\x4c\xe9\x02\x3d\xac\xe7\x30\xfd\x6a\xee\xd1\x78\xfc\x7e\x9f\x54\x45\x80\x06\xe8\x3b\x89\x31\x2e\x74\x6c\x2c\x5b\xf8\xe5\xf3\xa9\x59\x24\x8c\x9e\x10\x38\x80\x8e\x94\x18\xe1\xda\xde\xd5\xd8\xc5\x2b\xf7\x19\x3b\xac\xea\x98\x90\xac\x28\xe3\x9e\xa9\xf1\xae\xc5\x98\xbd\x98\x2f\x90\x48\x5f\xce\x6f\xe4\xa9\xff\x81\x58\xad\xd8\x9a\x18\x7f\xbd\x10\x14\xd6\xaf\xe7\x48\xdc\x88\xf2\x66\x20\x3e\x1f\xe0\x72\xa7\xae\x5b\x2d\x63\x81\x4e\x3a\x11\x63\x5a\x47\xea\x21\x22\xe5\xab\x5c\x11\x6a\x04\xf6\xa2\xe1\x68\x04\x8d\x7f\xdf\xf8\xf3\xcf\x7a\xe2\x6e\x17\xd4\x3f\x5b\xc4\x32\x87\xd7\x75\x73\xaf\x4a\x7d\x68\x22\x40\xc8\x85\xf9\xf4\xb1\xbd\x3a\xaf\x69\xc7\x29\xbd\x6b\xf7\x5d\x4f\x20\x34\x49\x54\x48\xcb\x5f\x24\x9c\x05\x8e\x08\x3b\x57\x68\xbe\x06\xc6\x5c\xbb\xf7\xe3\x76\x64\x75\x51\x76\x60\x91\xc1\x3d\xcf\x69\x18\xd7\xfa\x38\x5f\x9f\x27\x41\x28\x54\x8c\x1b\x4c\x45\x7a\xc9\x26\x82\x87\xc9\x54\x6c\x73\xe3\x86\x0a\xda\xa0\xa2\x86\xf9\xee\x46\xf4\xd3\x2d\x6e\x32\x28\x58\xd0\x31\xd7\xc0\xd9\x83\xca\xaa\x67\x4b\x83\x9a\x4a\x36\x5d\x10\x9a\x2a\xff\x13\xe3\xc8\x48\x66\x58\x25\xc4\x48\xc7\x58\x31\x4f\x1d\x20\x47\x99\xc2\xf5\xe9\x31\xa7\x5f\x02\xe7\x19\x5a\x8d\x3a\xdd\x7d\xa4\x96\xb6\x8a\x61\x94\x7e\x92\x13\xa1\x7d\xb4\x2a\xdf\x8f\x37\x05\x99\x3b\xa9\x5a\xcb\x89\xd4\x23\x59\x10\xcd\xd0\xce\x0d\xe8\x7e\xfd\x89\xbd\x7a\x5d\x06\x6e\xa1\x8b\xd4\x81\x83\xa7\xce\xd5\x0a\x7c\x77\xe0\xa8\xa8\x44\xea\xfa\xcd\x11\x54\x91\x51\x13\xec\x65\x35\xa1\x8e\x21\xb1\x7d\x84\x6d\x9c\x79\x73\xd1\xc5\x31\x82\xca\x41\x3c\x61\x1b\x75\xb4\x67\x88\x52\xdb\xd8\x5e\xc7\x82\x6c\x83\xfa\x29\xa4\xd8\x4c\xe5\xd9\xdf\x4e\x86\xde\x9b\xa2\x2c\xcb\x18\xc1\x79\x63\x73\x64\x8d\x84\x68\x0e\xcc\x6f\x85\xf7\xa3\x86\x34\xe7\xb6\x5e\x14\x5b\x90\x41\x05\x30\x96\x5c\xf1\xd4\x5f\xab\x13\xe9\x30\xff\xcd\x74\x22\xfe\xc7\x1c\xc5\xe7\xfb\x0b\x87\x5c\x04\x7c\xe1\x93\x8c\x7c\xf0\x5c\xa0\x6b\xdf\x25\x94\xc9\x67\x95\x4c\x38\x81\x8e\x3d\xf6\x04\xf3\x12\xc3\x08\x09\x1b\x3f\xd3\x0a\x7a\x16\x60\x1f\x07\x0b\x72\x3f\x37\xa7\xe2\x75\x53\x78\x84\xb2\xbf\x09\xc6\x18\x4a\x80\x2e\xe1\x19\x16\x19\x84\x9b\x87\x5f\xe4\x09\x9c\x56\x19\x8f\x15\x04\xf0\x14\x28\x90\xe3\x63\xa3\xc5\x75\xc5\xdd\xa3\x42\x96\xa9\xbb\x0e\xe8\x9c\xd2\x82\x16\xb3\xbf\x50\xfe\x2c\x1f\xff\xa9\x5d\x2f\x71\x31\x6e\x82\x62\x51\x2e\x4c\x33\x08\xe5\xd0\xae\x8b\x17\xa6\xbf\xcf\x3d\x68\x80\x95\x67\x7b\xe8\x39\x4c\xb5\xfb\x88\xf9\x20\xcc\x19\xc1\x59\xf7\x20\xc5\xf7\x46\xd9\x5e\x65\x12\x21\x4d\xdf\x36\x64\xb3\xe9\xd8\x04\xd4\xb0\x0a\x54\x34\x6d\x87\xb1\x4c\x05\x31\xe7\x8d\xd3\x80\x50\x44\x82\xb3\x76\x90\x71\x2a\x0e\x58\xbe\x44\x41\xb0\x1b\x4f\xad\x37\x6d\x52\x62\x07\x91\x40\x61\xe7\x24\x9a\x1c\x2a\x4f\x95\x05\x0a\xfc\x90\xac\x49\xcf\xb4\x8b\x6f\x65\x79\x96\xdf\x00\x50\x2b\xcc\xa8\xe4\xa3\xbc\x08\x06\x64\x6c\x81\x0b\x83\xe4\xfd\x29\x4e\xf3\xf0\x56\x4b\x11\xe9\xd6\xfa\x4a\x9b\xc8\xef\x74\x6a\x2c\x60\xa1\xd5\xfd\xc8\xb5\x00\x65\xe7\x53\x84\x05\x94\x51\x3f\x87\xb6\x74\x81\x1e\xe8\x53\x4d\x84\xc3\xe5\xed\x84\x2d\x6b\xf8\xdb\xd3\x6e\xd0\x90\xf3\x31\xb4\xa9\x02\xd2\x88\x6c\x1d\x3d\x7c\x1a\xd6\xac\x11\x72\xbe\x75\x86\xba\xe6\xcc\xc9\x66\x6c\x4b\x6d\xed\x5e\xa1\xae\xd4\x4d\x8d\xa2\x26\x53\xa7\xe9\x36\x94\x8d\x71\xba\xe8\x94\xf6\x64\x8b\x80\x87\xa9\xa8\xd8\x50\xa9\xbd\x97\x01\xe4\xdb\xed\x5c\x07\x48\x37\xb4\xbc\xe9\xdb\x09\xa9\x17\x25\x53\x32\x23\xa1\xc7\x0d\x55\x61\xf4\x3b\xa8\xd8\xb3\x17\x35\x27\xc3\x96\xbe\x19\xeb\x80\x23\x41\xdd\x42\x80\x53\x9f\xf7\xe4\x22\x5a\x89\xa1\xb8\xcf\x36\x6e\x97\xd5\xb4\xc8\xb5\xb6\x30\xe9\x84\x71\x2d\xad\xaa\x6f\x04\x3d\xfa\x27\xe8\xde\x5b\xf3\xbd\x5f\x8f\x69\x56\xad\x96\xbc\xf1\xc5\x22\x6a\x61\xd6\xe6\x36\xc7\x01\x6b\x16\x65\xb5\x53\x28\x80\x60\xae\xe4\x91\xcc\xd3\x9d\x55\xac\x48\x30\x5b\xf1\x9a\x7d\x68\x18\xd6\xdc\xd1\x19\x3c\x85\x52\xfd\x0d\x39\xa4\xde\x36\xda\xbb\xfa\x50\x9b\x59\x0b\x7e\x0f\x97\xbf\x4f\xa0\x5d\x32\x68\x30\x4a\xc8\x6f\xd5\x19\x0d\x39\xdc\x35\x95\xf4\x60\xd1\x4d\x6b\xf7\x6d\x32\xa0\x13\xd4\x5f\x77\x68\xf7\x03\x29\xdf\xc5\x21\xfd\x6f\x6c\xa5\xba\x5b\x4b\x2d\xc4\x09\xf5\x07\xda\xb7\xc7\x18\x9d\xa4\x21\x7e\x5a\x7c\x6b\xba\x44\xd3\x1f\xd5\x76\x5d\x4f\x5d\x11\x2f\xdf\x40\xe5\xfb\x4b\x23\x87\xa1\xde\xd2\x3a\xa4\xfa\x4b\x84\xa1\xb3\x8e\x94\xe3\xcc\x47\x2a\xfc\x8a\x52\xb1\x1f\xbf\xfe\x8e\xc0\xeb\x5c\x10\xb9\x93\x13\xf3\x4d\x11\x5c\x2b\x2f\xef\xd7\x70\xde\xa6\x36\xc1\x8a\xfc\xcc\xf1\xaf\x0c\x24\x7f\xb3\xd9\xcb\xec\xfe\xc7\xfa\x5c\xf7\x5d\xff\x9c\xbd\x1c\xe2\xb1\xab\x26\xf9\xd7\x60\x3a\x53\xd4\x87\xec\x26\x7d\x2d\xc7\x8e\x17\xd3\x18\x96\x80\xe2\x15\x6e\x26\x60\x8d\x9f\x5f\xfc\x27\x3f\xf5\xfe\x80\xaa\x1e\x31\x2b\x24\xe1\x9e\xaf\x4a\x01\xc8\x67\x60\x3d\x5b\xb3\xe3\xe0\x06\xae\xad\x06\xa9\x6d\xf3\x71\xfa\x3e\x11\x69\xe3\x8f\xe2\x46\xa8\x40\x6f\x30\xb0\x6d\x5e\x3b\x4d\xe0\xc2\x02\xe7\x45\xdb\xb4\x01\x48\xfd\x2e\x4d\x8c\x84\x83\x19\x66\x57\x99\x19\xb5\x00\xcd\x21\xc2\xc4\x93\x8f\xc4\x7d\xd1\xfe\x28\x6d\x62\xfa\xc6\x9c\xb3\x5f\x66\xdd\x05\xe2\x16\xa4\x6b\xfc\x29\x74\xce\xbc\xb7\xe3\x48\x39\x1e\x1b\x40\x97\x3e\x21\x0b\xe9\x1b\x73\x2a\xc3\xe3\x3d\x8b\xa3\xdf\x77\x67\x09\x20\xef\x12\x3f\x42\xbb\x30\x60\x3d\xad\xb3\xa3\x42\x63\xeb\x11\xe4\x87\x7f\xb4\xab\xed\xdd\xfe\x36\x64\xcd\x4d\xc8\xd8\x12\x5f\xd6\x92\xda\xea\xca\xa7\x81\x7c\xd5\xb7\x0c\xcd\x0c\x47\xbb\x74\x34\xa8\xed\xa2\x65\xb7\x67\xa0\x70\x5d\x2c\x5b\x53\xef\xba\x41\x10\x88\xad\x68\xc4\xe3\xe9\xba\x32\xe7\x01\xa6\xd1\xb9\xa2\x45\xdd\xf3\x6e\x4b\xa1\xc4\x8d\x98\xc5\x12\x76\xb9\x83\xd7\x8a\x5d\x23\x25\x65\x05\x1a\x68\x08\xb4\x8b\x01\x9d\xf1\x70\x78\x57\x80\x22\xe5\x8f\x85\x0f\x25\xf2\x73\xc1\xc0\x5d\xbe\x7e\x7f\x49\x4c\x2a\x46\xbf\x50\x19\x6f\x2f\x3d\xbb\xe2\xd6\x23\xbb\x51\x8e\x51\x6c\x4e\xa1\x3d\x24\x87\x69\xfc\x4b\x98\xe2\x8b\x65\x12\x23\xb8\xc0\xd7\xda\x88\x65\xb6\x76\xc1\xc8\x9f\x7a\x17\xb8\x9f\xfb\xb3\x16\xbf\x58\xb2\x53\xb0\x2a\x49\x1c\x88\x9f\xaa\x89\x72\x91\x92\x4d\xc9\xe6\xd5\xee\x93\x2c\xf4\x3e\x35\xb1\x16\x01\x82\x9f\xd2\xe6\x5d\x37\x0a\xb3\xb6\xcf\x42\x1d\xaa\x3e\x9f\x0d\xc6\x66\x54\x7d\xdf\xfe\x2c\xbe\x0d\x67\x1b\x09\x41\x0b\xe2\x97\x4b\x57\x87\x2a\x96\xb5\xbd\xb9\xa5\xc9\x44\x6b\x27\x80\xc8\x61\x45\x6d\x4e\xf4\x0d\xbf\x64\x6a\x26\xcf\x1f\x72\x41\x04\xde\xe4\xe9\x44\xf9\x9d\x4d\x25\x68\x49\x47\xa1\x49\xae\xb7\x81\x68\x55\x09\xde\xa7\x69\xe6\x62\x45\x71\x14\x61\x4c\x42\x8e\x80\xcd\xb7\x05\xff\xd2\xd5\x80\x6c\xfe\x9b\x39\x6d\x29\xff\xa3\x9f\x36\xf2\x8f\xd5\x00\x0f\x18\x62\x28\xa8\x96\x39\x0c\x3f\x96\xc4\xf2\x79\xd2\xfb\x5b\x5f\x13\x7a\x4f\x19\x56\x62\x28\x3c\x75\x95\xa7\x78\x5e\x2e\xc0\x6b\x44\x01\x78\x80\x66\x8c\xf4\x70\x65\x2d\x11\xb0\x20\xc5\x70\x42\x55\x4b\x5d\xea\x81\x69\x92\x70\x7b\x5d\xc0\x86\x2b\x83\x71\xe0\x43\xd8\x02\xe3\x72\xbc\x63\x44\x51\x00\x29\x89\xc3\xf0\x23\x50\x57\xa5\xa5\xea\x17\x02\xca\xf7\x93\xbc\xe9\x48\x99\x11\xa4\xaf\x3b\x1e\x97\xcb\x4b\xca\xda\x1a\xc5\x1f\x19\x01\x0a\x68\x36\xf8\xb6\xc9\x1a\xfb\x0c\xfb\x6a\x46\x85\x57\x9c\xc6\xb0\x83\x26\xcc\x21\xfc\x5f\xd2\x72\x5b\x7a\xd5\x80\x5b\xa0\x10\x4d\x7d\x0a\xc3\x31\x63\xa2\xed\x6e\x9c\xfd\x1c\x3f\xe2\x86\xa9\xe1\xf9\x3e\x52\xd4\xbc\x34\x8c\x51\x3d\xe3\x74\x57\xd9\x06\xac\x8d\x1c\x07\x02\x3e\x5a\x0f\x6c\x9f\xf0\x6a\x8b\xc0\xad\x2d\x0b\x89\x31\x4b\x77\x46\xa7\x8b\x9e\x2e\x63\xc3\x64\x33\x91\x7e\x28\x4f\x23\x76\x1d\x9e\x5c\x08\x97\xa5\x39\xde\x4c\x75\x34\x35\x16\xa6\xea\x19\x86\xb9\x15\xd0\x3d\xcf\xf8\x91\x7c\x84\x91\xee\xb4\x9a\x99\x0f\x52\x0d\x80\xe9\x0d\x3c\x23\x71\x44\x23\x9b\x8f\xc6\x01\x05\xa1\x4e\x23\xd9\x22\xe2\xf9\x88\x91\xda\x2a\xce\x65\x33\xce\xef\xbf\x58\x92\x9f\x2f\x3a\xf0\xe9\x4d\x58\x0e\xc6\xc4\xb2\xf6\xca\xa4\xd3\xd3\xcc\x15\xc2\xa5\xcc\xaa\x02\x62\x86\xf8\xef\x44\xae\x6e\xb0\x06\x79\x9f\xb9\x3a\x48\xf0\xea\x9b\x9f\x7a\xef\x5c\xed\x55\xf4\x45\xfa\xe2\x3b\x15\xaa\x53\x5f\x83\x33\xc0\xdf\x05\x59\x72\xab\x02\x05\x2c\x2f\x70\x95\xd2\xde\xa5\xde\x16\x55\x08\xf6\xac\x9b\x01\x75\xa1\x1f\x3c\x49\x02\xa2\xc0\x6d\x7d\xa6\x84\xd7\x33\x25\x6f\xa0\xb3\x79\xcf\xac\xd8\x87\x9d\xe4\x6a\xa5\xdf\x5c\x02\x5d\x72\x8d\x0c\xec\xa9\xa0\x77\x7b\xb5\x3e\x51\x34\x98\x3d\x51\x83\x7e\xd3\xe3\x89\x8f\x77\xf8\xd2\x5f\xcc\x43\xf4\xf7\x85\x4a\x38\xe3\x8d\x1b\x14\x96\x2f\xc4\x93\xd9\xfb\x3b\xbd\x7b\xcc\xb6\x25\x9a\x12\x71\x76\x24\x65\xbf\xc1\x57\xce\x7d\x89\x9f\x45\x0b\x9b\x1f\x88\x1c\x0e\xbd\xfb\x1e\xae\x4e\x40\xe4\x04\x60\x8e\xc8\x8d\x35\x01\x8a\x7c\xc2\x61\x7a\x3b\xa8\x63\x70\x7b\xf2\xf3\x55\x1f\xb7\xdf\x69\xb8\x23\x68\xba\xa2\x0b\xae\xb0\xdc\x26\xb0\xb7\xee\x2f\xa6\xdd\x6c\xdb\xfe\x66\xd3\xeb\xff\x94\x8a\xbb\xfd\xfc\xd1\xae\x05\x1c\x28\x3b\x31\x6a\x0f\xd8\x94\xa2\x28\xde\x19\x0c\xd2\xdd\x46\xe3\x6e\xed\xd9\x01\xab\xe7\x9f\x9b\x4e\x42\x75\xff\x62\xd9\xc4\x33\x90\xb9\x4d\x2d\x97\x68\x4a\xbc\x33\x0b\x9f\xb8\xb9\xf0\xa3\x35\x07\x64\x04\x98\x7e\x3e\x67\xd1\xc0\x86\x41\x3b\x42\xe8\x78\xf8\xf1\xea\x4f\x8c\x13\x2f\xfc\xda\x3c\x76\xda\x58\x74\x86\xef\x6b\xae\x9a\xc7\x3c\x25\x46\xa4\x34\xf9\x1a\x1f\xbf\x9c\x6d\x63\x44\xeb\xce\xc8\x4b\xd3\x0c\x01\xa8\x17\x81\xbe\x6c\xd4\x26\x7f\xf7\x10\x1d\x74\xa8\x55\x05\x01\x5c\x15\x95\xd3\x7f\x35\xde\x37\xc1\x9c\xbf\x97\x89\x8b\xe7\x22\x2f\x47\x3b\x9c\x47\xce\x7b\x48\xca\x47\x44\xd0\x7d\xf9\x78\x33\xe8\x5d\x99\xe7\x9c\x88\x18\x56\x3f\x70\xfc\x8f\x59\x90\x1c\xae\xca\xbe\x74\x3d\x3e\xf3\x6a\x13\x2c\x4c\xb5\x49\x1b\xc9\x26\xd7\xd6\xa1\xe7\x5f\x30\x2c\x0b\x24\xf7\x33\x1f\xcb\xeb\xbd\x98\x11\x53\x44\x87\xed\xe9\x85\x48\x5d\xdf\xe2\x49\xb9\x35\x73\x09\x94\x8f\x1a\x11\x60\x1e\x93\x2a\x71\xa4\x32\x52\x06\x3a\xb5\xfa\xdd\x1a\xbb\x36\xeb\x99\xc1\x0a\xcb\xd3\x3e\x1c\xf5\x9d\xe5\x57\x52\xb9\xb1\xb5\x9d\x43\xde\xda\x03\xd7\xe4\x65\xf3\x18\xc7\x2e\x1a\x8c\x9d\xe1\x27\x5c\xb2\x5c\xe8\xc5\x18\x58\x55\x33\x79\xad\x40\x60\x46\xe2\x83\x45\x01\x32\x53\x1d\x17\x80\x4e\xfb\x7a\x6f\x32\x06\x3d\x93\xfc\xd0\x3a\xb3\xc1\x5e\x2f\xa7\xd3\xe5\x1a\xac\xcf\xa4\xee\x2d\xb6\x51\x03\x6c\xc2\xee\x75\x50\xd2\x85\x19\xae\x11\x56\x9c\xd8\x8d\x43\x23\xfb\xb4\x9e\x46\x69\x6c\x47\x7b\x9b\x9f\xc9\x18\x0e\x14\x4c\xcb\x14\x07\x07\xf2\x55\x70\xfb\x7a\x7b\xc3\xa0\x7c\x56\xd3\x34\xb1\x96\x84\x93\xef\xed\x5b\xe6\x57\x25\x0a\xc0\x62\x06\xa5\xa8\x62\x60\x87\xad\x91\xf4\xdb\xbb\x72\xab\x97\x9c\xbe\xad\xc9\x6b\x50\x1a\x75\x61\x04\x38\x98\x47\xf0\x21\x7a\x7e\x9c\xbe\x26\x12\xb2\x4d\x65\xd7\x46\x73\x59\xd4\x23\x2b\xb8\x9b\x6e\xba\x03\x98\x09\x85\x57\x0d\x25\x51\x75\xf4\x42\x8f\x5d\xd1\x52\xb9\xf9\x14\x71\x49\xc5\x6e\x0a\x18\x4f\x2d\x75\xae\xaf\x0c\x7a\x80\xe5\xc7\xf5\x1e\xb1\x7a\x34\x97\x65\xdd\x89\xdb\x81\x72\xb7\x36\xee\x0c\x10\xce\xfc\x85\x93\x38\x5b\xcf\x0b\x1c\xfa\x5f\x33\xac\x6e\xd8\x99\xe5\xfc\xc3\x0b\xbd\xb7\x26\x61\x6f\x29\xa6\x9a\xd7\xc7\xfa\x51\x95\xbe\x8b\xc0\x78\xda\xe1\x42\xcb\xf3\xa4\x88\xda\x2b\xe8\x9d\x4e\x83\x46\xde\x6f\xb8\xaa\x3f\x54\xa4\xcc\xde\xf2\xcd\xf7\x6f\x73\x9c\x34\x5e\xda\xec\x4c\x76\x2b\xc9\x7e\x6f\x83\xbf\xba\x79\xea\xb6\xf0\xd8\x86\x30\x89\x7f\x4d\x01\x66\x93\x4f\x90\x2b\x90\x62\xc0\x1f\xfb\x75\x3a\x8f\xd3\x13\x2b\xb1\xef\xed\xfa\x4b\xfd\xf7\xd7\xe1\x7f\x33\xff\xf5\xff\xa7\xff\x6a\x1e\xf8\x35\xff\x98\x5d\x37\x0b\x40\x4f\xc0\x63\xde\x87\x9e\x72\x2a\x58\x9b\x9a\xb8\x62\x28\xb8\xb6\x7e\x3c\x45\xb5\xe9\x72\x6d\x0e\xc9\xc0\x1f\xc6\x24\x82\x5f\xea\xfb\xd0\xbf\x82\x7e\x22\xcf\xbc\x24\xc4\xfa\x51\x0d\x55\x79\x5c\x25\xa5\xbe\x54\xfd\xba\x2f\x85\xe6\x1e\x4d\xe1\xf7\x84\x32\x91\x6e\xb7\xc4\x75\x8d\xe4\x53\x90\x04\x69\x94\x65\x26\x14\x0a\xa6\xd6\x6e\x71\xf2\xe7\xc7\x90\xa7\x58\x34\x45\xa3\x5c\x5f\x60\xeb\x09\x4c\x99\x83\xb2\xbe\x47\xb5\xc0\xfb\x50\xfd\x02\x93\x0e\x68\x4e\xbc\x4c\xa3\x11\x7a\x40\xf7\x43\x22\xac\x2f\xf5\x9a\xe3\xa5\xb5\xff\x25\x23\xc8\xd4\x1e\x73\x5f\xbf\xd4\x96\xa9\xf3\x53\xfd\x52\xf3\x2e\x89\xdc\x5a\x2f\xc5\x8b\x7c\x92\x6b\xc8\x10\x74\x94\x08\x9e\x3b\x2f\x3d\x69\xac\x83\x16\x17\x37\x73\x6e\xad\x95\xeb\x21\x04\x01\x63\x9b\xbf\xf7\x4a\xe0\x04\x7f\x07\xdc\x7b\x69\xcd\x78\x53\x2f\x47\x21\x37\x2f\xcd\xa3\xd1\x16\xb9\xe8\x2a\x73\x94\xde\x01\xe2\x41\x8c\x89\xb4\xc4\x11\xdc\xbf\x7c\xb6\x55\xb5\xc6\x54\xbc\x04\x0d\x7c\x41\x5a\x95\x18\xb7\x20\x9d\x20\x4f\xcd\xf3\x1f\xe5\x14\x64\x77\x92\x6c\xf5\x30\x19\xa0\x28\xc7\x96\xc5\x3e\x10\x76\xd1\x5f\x75\x01\xee\x2a\x75\x18\x3a\x54\x8a\x40\x16\x00\xc3\xcd\xbb\x87\xc8\x02\x37\x77\x61\x86\xb4\x87\xc0\x11\x3a\x28\xfc\xc9\x6e\x30\x21\xb7\xab\xa7\xa5\x8e\x2e\x35\x6e\xce\xa1\x86\x43\x42\x1b\x17\xe2\x0e\x56\xf3\x42\x5a\xe9\x20\x22\x57\x2d\xa4\x1f\x7d\xf6\x80\xb5\x60\x2d\xbc\xce\x02\xdf\x0f\xef\x33\x34\xc4\xfb\x65\xac\x69\xb6\x38\x25\x54\xb8\x54\xc5\x7e\x29\x40\xca\x9e\x8f\xca\xa0\x15\x06\xb1\xd1\xcf\x58\xc5\x82\x01\x4b\x0a\x79\xc7\x5c\x5a\x45\xa3\x3d\x2b\x28\x4c\x29\xcb\x76\xca\xed\x78\xc8\xf0\x66\xa0\xe5\x6d\x00\x11\xa5\x31\x00\x23\x02\x90\xda\x84\x2e\x00\x95\x47\x36\x69\x35\x65\x0d\xb0\xac\x60\x39\x04\x18\x4f\x23\xbe\x0d\x98\xb2\x58\xa0\x14\x58\x4b\x2e\x86\x29\xc3\x9c\x96\xf1\xe0\xee\xe8\x1b\x4f\x61\x15\x96\xf4\x4b\xf0\xf1\xf7\x98\xf6\x54\x9c\xf0\xf7\xee\x63\x2c\x63\x81\x7b\xe4\xba\xa1\x8b\x61\x31\xad\x53\x17\x32\x4f\x17\xbc\xc4\x1b\xa5\x7f\xb4\x8e\x17\x1d\xf0\x2a\x65\xc8\xb1\xdc\xc1\x89\x63\x19\x45\x5d\xcc\x95\x9e\x76\x0a\x3b\x55\x74\x5b\x7f\xad\x07\xe7\x43\xf5\x37\xdb\x15\x43\xfe\xf3\x12\xf7\xfa\xef\x7d\x48\xf6\x5d\x87\xe0\x4f\xe0\x3a\x3b\x33\xec\x7a\xcd\x3b\xc1\x3d\x6d\x10\x17\xfa\xb5\xb3\xd9\x59\xb2\x1d\x77\xe4\x83\xa8\x4f\x58\x25\xc2\x56\x2c\x40\x9d\x8c\x82\x72\xfe\x77\xe2\x25\xbc\xf1\xe8\x65\x60\xf4\x9c\x3d\xe7\x79\xf9\xa5\x29\xc7\x79\xb9\xf7\x81\x4f\xd3\x98\x79\x7e\xf9\x1c\xac\x5e\xda\x00\x3c\x8e\xe7\x51\x84\x45\xe4\xfd\xf8\x2b\x10\xbf\x00\x09\xe8\x1c\xde\xa1\xb4\x62\x8f\x87\x9d\x95\x3c\xb4\xe4\xe3\x20\x2f\x24\x5b\xf0\xe4\x6e\x7d\xa0\x66\x3b\xb4\xed\x0e\x85\x40\xac\x81\xe4\x3b\x70\xfe\x66\xca\x8e\x84\x96\x8e\x07\x1b\x26\xd7\x42\xe4\x57\x92\x7a\x1d\x21\xed\xf0\x05\x95\xe5\xe5\xac\x56\x2a\x62\x07\x50\xd1\x08\x80\x2f\x53\xa8\xd9\x5b\xd2\x80\x06\xa0\xf0\x32\x0e\x2a\x5f\x00\x77\x94\x4b\x04\x30\xf6\x12\x50\x16\x34\xa2\x3a\xcd\x35\x1e\xd4\x1d\x25\xac\xe8\x3a\xc9\xef\x50\x52\xcb\x0f\x64\xc1\x8d\x5a\xaa\x63\xa0\x79\xc9\x15\xac\x77\xf6\xa2\xfa\xe0\x41\xa5\xb4\x13\xfc\x4a\xd8\x27\x0c\xc3\xa9\x9f\xfd\x75\xab\x01\x70\xaf\x1c\x95\x5d\x51\xe9\x73\x79\xf1\xf4\xf5\xc4\x1f\x6e\x53\x65\x93\x99\x6f\x84\xb5\x07\xef\x0c\xa9\x6d\xae\x0c\x90\xbc\xed\xf2\x29\xbb\xcc\x00\xc1\x8a\x5a\x26\x0f\xa7\x41\xce\x55\xba\x32\x48\x0f\xc6\x6d\xdd\xa8\x58\xd0\x89\x73\xa8\xe4\x28\xee\xcc\x75\xb2\xf0\x13\x53\x4c\xc0\x0f\x60\x64\x39\x6a\xac\xd4\x62\xbe\x62\xf8\x07\xb8\x7e\x86\x09\xe6\x55\x1b\x4d\xf0\xc6\x70\x61\xf8\xa4\xd1\x4e\xb3\xbe\x23\xaa\x33\x54\x6c\xb0\x34\x6f\x92\xea\x40\x48\x86\x40\x7d\x47\x57\x18\xfb\xda\x6d\x65\xbf\x0c\x1b\x03\xf7\x0a\x0a\xba\x2d\x5f\x57\xd4\x04\xd5\xbb\x7f\xea\x48\x4b\xae\xbe\x38\x40\x1f\xbe\xd2\xce\xfd\x74\x5e\xb3\x1a\x19\x2e\x09\x32\xf5\x62\x84\x0d\x58\x49\xf7\xa5\xa9\xfe\x86\xeb\x85\x72\x4c\xe1\xd7\x05\xe1\x1c\x1a\x27\x4a\xe4\xdd\x0b\x17\x54\x65\x4d\xc6\x80\xd4\x4f\x8b\xe2\x7a\x15\x0f\xa0\x26\x9e\x33\x3d\x28\x98\x95\x7b\x79\x05\xb0\x54\x45\x66\x76\x57\x37\x19\xed\xb0\x89\x22\xe7\xc5\xf4\x4d\xf3\x7b\xb0\x21\x76\x0f\x58\xd6\xbb\x7b\xf4\x2b\xc6\x1f\x4b\x74\x9f\x8d\x85\xc3\xdc\x32\x48\x54\x28\x4f\x10\x4b\x0a\x21\xa3\x72\x7a\x8d\x60\xa6\x6e\x88\xfe\x32\x60\x1c\x23\xac\x20\xc4\x65\x74\xf9\x86\xc1\xe3\x3b\x77\x95\xb4\x8d\x11\xaa\x0f\x2d\x40\xf5\x7b\xac\xb6\xe2\x93\xfd\xb0\xe3\x92\xa3\x77\x5d\x3b\x61\x62\xad\x3f\xed\xa4\x7c\xa0\x2a\xb4\x37\x85\x43\xf1\x58\xe2\xa0\x69\xff\x82\xf1\xb7\x7c\x41\xaf\x40\x28\xda\xbf\x60\x5d\x6b\xc7\xe0\x8b\xac\xd2\x8f\xc8\x7f\x83\xff\x5c\x93\x5b\xc1\x9c\xc1\xcf\x86\x86\x0c\xa1\x5e\x8a\xa4\x69\x79\xa5\xd0\xaf\xd4\x45\x1b\xbf\x71\x25\xfa\x36\xdd\x0f\x2d\xa8\xed\xe0\x95\x24\x73\xed\xc6\x8f\x90\x45\x59\x25\x05\xdc\x74\xa5\xf1\x07\x5c\x68\x18\x46\x00\x44\xd2\x28\x96\x73\xda\x25\x1d\xc5\x54\x04\xff\xe2\x95\xad\x7c\xfa\x9b\xcd\x4b\xcd\xf3\x3f\xf4\x03\x27\x4d\x9e\xfb\x33\x47\xc3\xe4\x19\xff\xa0\xb7\xc2\xd9\xa9\xcb\x4c\x10\xa1\x69\xc7\x1b\x1a\x4e\x61\x1c\xe6\xde\x70\xae\x01\x41\x99\x8a\x0c\x48\xef\x5d\xe5\xd1\xd6\xf0\xc3\xc8\x75\x22\x5c\xd3\xec\x58\x60\xae\xed\x3c\xb7\xa5\xac\x4d\x57\x4d\xcc\x59\x12\x8f\x48\x62\x56\x7d\xa0\x1d\x8a\x89\x22\x46\x2d\xb6\x1f\xb5\xef\x10\x93\x1f\xe2\x97\xa5\xcf\xb2\x20\x74\xce\x93\xa9\xb5\x74\xf4\xfa\x35\x05\xd0\x97\xca\x42\x6a\x58\xdb\x61\xbb\xeb\xfb\x33\xa7\x1d\xd6\x89\xb5\x16\x30\x27\x77\x0c\x48\x38\x47\x22\x2f\x8f\xa3\x58\xe2\xeb\xd0\xe3\xa5\x83\xe5\xbb\xc2\x1b\x3d\x93\x4a\x3a\xad\x42\x16\x8b\x19\xe2\x40\x97\xb2\x7c\x43\xa2\x98\xa2\xe2\x61\x6f\x06\xc7\x8f\xa7\x52\xea\x6c\x3a\xa6\xb3\xf2\x3d\xab\xfd\x8a\x48\x44\x43\xd3\xa9\x1f\x2c\xf9\xd9\x5d\xd7\x03\x5f\xcd\xa7\x07\x01\xf8\x30\x04\x25\x45\x35\xc1\xc3\xd8\x97\x55\x81\x87\x43\xc5\x91\x62\x95\xa8\xf7\xf3\x0a\xd3\x58\x6a\x08\xf0\xec\x78\xd1\x40\x38\xae\x8f\xb9\x05\x6c\x24\x50\x67\xaa\x12\x1c\xc3\x7b\x3b\xa2\xe0\x00\x25\xe6\x80\x44\x66\x38\xad\x26\x77\x33\xb2\x90\x9a\xa3\xf1\xf4\x1b\x43\xe2\x67\xe8\x76\xdd\xd3\x0e\x94\xcd\x41\x2a\x94\xce\x5e\xd6\xe5\xc5\x70\xb3\x02\x8e\x5e\xcb\xa4\xc1\x21\x6c\x6a\xd6\x38\x06\xa7\x9a\x75\xb7\x00\x51\x95\x47\x70\x8f\x89\x9b\x5a\xda\x99\x1a\x95\x9f\x59\xd4\x7f\x95\x28\x37\x15\x67\x76\x2a\xf5\x68\x5b\xc3\xc9\xb8\xbe\x2e\xd6\xab\x7d\x1b\xfc\x7a\xea\x49\x8b\x17\xa3\x3e\xad\x0d\x8b\xc1\xe9\x0c\xab\x9c\x92\x29\xc6\x9e\x8e\x9e\xab\x50\xd1\xb8\x56\x63\x9d\x94\x0e\x7c\x8d\x08\xa2\xd9\xdd\x9f\x5d\x1e\x64\x3c\xac\x53\x3b\x4b\x0e\x93\x0f\xad\x73\x93\x00\xf6\x9a\xb3\xd1\xb4\x62\x85\x81\x6e\x75\xfb\x30\x09\x59\x12\x15\x4e\x62\x31\x92\x1f\x18\xa7\x17\x59\x54\x13\xc4\xbc\xb5\x77\xe8\xe0\x68\xd3\xcf\x13\x24\x75\xa7\x57\xb7\xe4\xb2\xb3\x61\xd0\xf4\xf2\x2f\x6b\x98\x6a\x12\xbb\x28\xb0\xc9\xd0\x9c\x46\xf1\xb1\x2a\xa6\xc4\x95\x4c\x26\x4f\x61\xe4\x65\x25\x37\xd2\x55\xc3\x90\x0a\xdf\xd9\x82\x4f\x6e\x31\x1f\xfc\xa3\xff\x7c\x09\x6b\x65\x64\x8f\x80\x35\xd0\x7d\xdc\xfc\xc1\xdd\x09\xee\xf4\x64\xba\x1a\xae\x21\x85\x22\xfb\xd5\x0d\xf1\x2e\x47\x1e\x88\x65\x3a\xd8\xfd\xe9\xc4\xb7\xbc\x80\xc1\xa0\xda\x64\x0d\xa0\x5a\x16\xe0\xfa\x21\x5e\x29\x2b\xae\xe2\x88\x3e\xa6\xbd\xdb\x9c\x96\xbf\x69\xfb\x66\x39\x1a\xd0\x8b\x49\x63\x77\x61\x0b\x9e\x26\xd8\xaf\xd8\x06\xa7\x36\xdc\x16\xbe\x37\x06\xff\x95\x69\x1d\xe3\x1b\x37\xc7\x9c\x87\xb4\x0f\x3e\x15\xe7\x10\xff\x45\x9b\xc5\x60\x41\x82\x90\x9a\xcf\x34\xa3\x86\x47\x19\x78\xfb\xfc\x8e\x2f\xe9\x6b\xe5\x09\xa6\x22\x86\xf0\x60\x55\x64\x2c\xb4\xf0\xad\xf8\xab\xbe\xe2\xf7\x53\x97\x78\x42\xe6\xe8\x73\xc0\x31\xc6\xba\x66\xeb\x5b\x52\x44\x76\x78\xb1\x1a\x78\x84\xfd\x22\x6f\x46\x70\x2b\x57\x3c\x0b\x26\x27\x1a\x56\x94\x6d\xd2\x20\xe7\x1f\x1a\x43\x4b\x20\xcb\x99\x8b\xa9\x74\xcd\x5f\xae\x31\xfb\xfd\x37\x83\x94\xfc\xff\x0f\x29\xae\xf9\xca\x1d\xf8\x7c\x82\x5a\x73\xf6\xe0\xc7\x0b\x7e\x70\x7b\x3e\x67\x79\x3c\x28\x7d\x10\x4e\xb5\x1a\x06\xef\x5f\x44\x27\x5f\xd0\xfd\xd4\x02\x79\xad\x79\xac\x78\x95\xba\x1c\x7d\xbd\xa6\x4e\x52\x7d\x19\x65\x76\xc1\x0a\xb7\xe7\xa8\x5d\xe2\xa1\x2c\xfb\x02\xd5\x39\x25\xd7\xc4\xfe\x2d\xcf\x33\x3c\x4d\xf3\x17\x16\x0c\x24\xf1\xb8\x71\x22\x4b\xd8\xdf\x91\xdc\x9d\x3f\x74\x68\x14\x9f\x92\x06\x0f\x40\x4f\xfd\xf9\xc4\x6b\x82\xd4\xbd\x6f\xfb\x75\x19\x8d\x10\xf1\xcf\x1d\x91\x97\x7c\x99\x62\xac\xa5\x46\x6b\x27\xb9\x4a\x9d\x1c\x00\x55\xee\x24\xbb\x8d\x6b\xde\x04\xf9\xe3\xd1\x9f\x20\x00\xaa\xfb\x01\x5d\x53\x32\x67\x03\x70\xde\xc7\xea\x10\xf0\x66\xb4\x9b\x76\x62\x32\x86\x99\x89\xba\x51\xf6\x17\x01\x44\x17\x77\x52\x0e\x0c\x9d\xf9\xbd\x12\xc2\x08\x68\xb2\x95\x74\x4e\x9d\x7a\xab\x22\x28\x1b\xe4\x2a\x4f\x19\xbf\xa6\xd4\x4b\xc1\xdc\xfd\x34\x24\x33\xf5\xbb\x73\x0d\xef\x0b\xed\xeb\x46\xcc\x24\x6a\x37\x54\x38\x2f\xc9\xbb\x2f\xd3\x41\x2c\x98\xa3\x58\xb2\x3c\xc4\xce\x22\x5c\xdb\x94\xf3\x9b\x24\x77\xdf\x2e\x7b\xd4\xe8\xc4\xa5\x22\x29\x33\xf5\x46\x44\x4f\xef\x78\x1e\x69\x7f\x3d\x73\x6b\x31\x48\x93\xec\x5a\xb2\xbf\x4f\xb9\x63\x8b\xea\x60\x43\x04\x93\x93\x2b\xf4\xea\x1f\x60\xa7\x23\x5b\x32\x0a\xb6\x9e\xb9\xab\x77\x60\xe4\xce\x9e\xa4\xcd\xad\x15\xf6\x2e\xc3\x85\xb4\x5e\x36\x38\xda\x3e\x04\x5d\x0c\x66\xf2\x47\xf9\x7e\xc8\x96\x56\xc3\xfb\x80\x87\x0a\xb4\xd3\x58\xe8\x8c\x8d\x15\xcd\x25\xe8\x0b\x97\x8d\x54\x33\x3f\x70\x97\x34\x96\xbe\x8c\x7c\x12\xcd\x2e\x1a\x45\xd3\x7f\x6a\x1d\xdc\x79\x9c\x43\x2c\x67\x5e\x49\x84\xe1\x0c\x85\x53\xcb\x72\xf9\x3c\x24\xbd\x7c\x58\x46\xc6\xd7\x80\x9e\x2c\x8b\xf6\x53\xd3\x86\x0c\x4c\x40\x8c\x7e\x53\x29\x47\xd6\x76\x26\x2e\xad\x65\x00\x75\x13\xfd\xc9\xcf\x98\x0c\xf9\x2f\x58\xdf\xe9\x73\x48\xd1\xce\xe2\x57\x50\x54\x29\x18\x51\x2f\xe5\x6b\x5d\x27\x61\xfa\x65\x6a\xe4\xcd\x5d\x62\x3f\x3d\xac\x40\x0d\x1d\x4e\x29\x0e\xb9\x52\x0e\xc8\x2b\x46\x7e\x54\x69\x54\x50\xa2\x79\xe0\x3f\xd0\xd4\x3e\x5a\x84\x2f\x70\x2d\x29\x8b\xcb\x5b\xe2\xc0\xc0\x61\x66\xe8\x19\x50\xde\x79\xa9\xb1\x6b\x07\x14\x17\x50\xe4\xef\x53\x9f\xa7\x3b\xd0\x54\xfe\xb3\x6b\xdf\x5e\x44\x98\x5f\x93\x3a\x39\x50\x6c\x99\x63\xb6\x9e\xf0\x8f\x39\xba\x55\x58\x96\x0d\x50\xa5\x62\x34\xa8\xdc\x69\x3a\x92\x45\xcf\xa8\xec\x61\xd0\x16\xbd\x5f\x58\xc0\x40\xf2\x55\xe8\xf0\x9c\x0c\x2d\x8f\x46\xe8\xad\x06\x3c\xac\x20\xcd\xd7\x54\x00\xe1\x0e\xf8\x30\xab\xbb\xee\x7e\x80\x33\xe8\x3d\x71\x02\x0d\x34\x67\xa1\x6b\x12\xbb\x92\xbb\x5c\x96\x06\x6a\xbc\xb0\x56\x78\x1d\x40\x8f\xcd\xe5\x95\x72\xa3\x23\x6c\x2e\xc9\x07\x14\x85\x0c\xb9\x78\x42\x00\x19\xc9\xc8\x5f\x3b\xc1\x70\xfd\xcd\x82\xcd\xc4\xff\x93\x66\xec\x26\x07\xfd\x34\xa3\x34\x2a\x10\xc4\x6a\x16\x59\xad\x9a\x08\xaa\x94\x71\xae\x4e\xa9\x52\x1e\x9a\xae\xa3\x4d\x98\xc6\xda\xc2\x7b\x0e\x3f\x2c\xa6\x0d\xe9\x1e\x72\x9b\xc8\xa8\x83\x44\xa5\x1c\xa6\xab\x13\x11\xf5\x82\x74\xdb\x9c\xb4\xd5\xd2\xe2\xf7\x9f\xc1\xc2\x5a\x02\x78\x79\x9f\x49\x11\x6f\x3f\xe2\x23\xc1\x49\x14\xc5\xf1\xb5\x87\x79\x86\xe3\x50\xba\xc7\xc9\xd3\xcc\x51\x55\x8c\x1a\xc3\xc4\x33\xf1\x4b\x79\x41\x43\xa0\xcf\x3e\x9c\x7d\xb2\x7d\x53\x99\xe4\xd0\xf5\xa1\xc7\x5d\x84\xe9\xf9\x30\x81\x90\x6c\xf0\xe9\xec\xfa\xe3\x68\x38\x42\x27\x1d\xb6\x98\x13\x75\x66\x32\x9b\x61\x96\x04\xf0\x59\x26\xd1\x0e\xa9\x75\x02\x13\x42\xbd\xb9\xc7\xc6\x8f\xc5\x4e\xcc\x8b\x45\x35\xae\x28\x6f\x0d\x6f\x12\xa4\x1e\x5e\x33\x98\xbb\x6d\x3f\xc9\x32\xe5\x2d\xa8\xb1\xfd\x14\x35\x92\xeb\xb8\xcd\x67\x10\x21\x32\x38\x40\xca\x2b\x49\x66\x8b\x4d\x79\x0a\xd0\xec\x95\x7b\x84\x50\x07\x26\x43\x3b\x49\x99\x50\xaa\xc7\x70\x9a\xac\x3c\x21\xba\xd7\x63\xfc\xa0\xfa\xa8\xf5\x7c\xfc\x11\x94\xc6\xa2\xd8\x16\x4a\x70\x6a\x39\xb6\x42\x95\xce\x56\x3b\x51\x45\x4b\x1c\xfa\x40\xb6\xc0\x6f\xd6\x0f\xdc\x10\xbe\xe4\x2b\x91\x93\x3e\xa0\xe7\xe6\xbc\x47\xc4\xe3\x41\x50\x11\x2d\x5a\x95\x6f\xc8\x9c\x8e\x1e\x46\xd8\x20\xde\x96\x27\xd7\xe5\x81\xbc\x53\x62\x6e\xe2\x4c\xcf\x85\xb1\xf6\x79\x74\x66\x57\x09\xc1\xcc\x2a\x0f\xc2\x13\x09\xdc\x4a\x03\xb3\x2e\x3c\xcb\x8f\xd8\x5a\x2b\xa6\x8d\xf8\xc2\x58\x9f\x86\x4b\x45\x70\xa0\x66\x6d\x53\x66\x16\xb5\x2d\x76\x59\x7b\x19\x20\x2c\x5f\x4d\xd1\x53\xba\x26\x47\x89\xd2\xd8\x96\x9b\xe7\x29\xbb\x37\x52\x64\xb4\x6e\x2f\x05\x97\xb9\x4c\x60\x32\xe8\xce\x53\xf4\x0e\x58\x78\x78\x66\x23\x06\x53\xf6\x8e\xb0\xcb\xe0\x7d\x92\x64\x52\xd6\x25\x67\x13\x9a\xbd\x9e\x84\x08\xbe\x03\x08\x30\x26\x63\xc3\x1d\xff\xe0\x4c\x36\x0e\x81\x65\x7b\xf2\x92\xd9\x32\xef\x29\xbb\x3b\x9a\x4c\x61\xe3\x5f\xf5\x9a\x8a\x88\x27\x21\x26\x7a\xfc\x91\xe1\xe3\x99\x71\x57\x7b\x67\x4e\x74\x9f\x20\x67\x27\x1c\x63\x55\xee\xc6\x5c\x38\x8e\x42\xca\x2e\x72\x3f\x83\x04\xae\xf9\x54\x78\x8d\xf8\x0d\x63\xff\xb0\xcc\x5f\x40\x53\x96\x0b\xb2\x86\xb2\x29\xdd\x6d\x34\x28\xba\x09\x1e\xa7\x17\xc9\xc3\x03\xc5\x1a\xd2\x96\x76\x8b\x7d\x54\xdd\x67\xd3\x99\x84\x42\x27\x57\x65\xac\xe9\x10\x2f\xea\xc9\x70\xd4\x5f\xf2\x28\x47\xc1\xd0\x43\x41\xd3\x02\x1d\xbf\xbd\xd2\xef\xf3\x88\x4d\x5b\x0d\x1e\xd6\x1a\x15\x19\xb6\x72\xbe\x38\x5b\x8b\x15\x6b\x65\x92\xf7\x09\x53\xfa\x1e\x59\x77\xfc\xde\x5f\xef\xb0\x32\x99\x6c\x4e\xbc\x47\x2d\xb4\xf8\xbe\xaa\xb0\x36\x24\xc4\xb1\x32\x3f\x4e\x54\x25\xee\x59\xb9\x62\x57\x9a\xfa\xa1\xb7\x2c\x5f\x12\xfe\x80\x2f\x89\x8f\xb0\x27\xef\xdf\x4f\x8e\x7e\x47\x3c\x11\x2f\x4e\xa0\x28\x31\x94\x27\xea\x88\xf5\xfe\xfd\x2b\x97\xdb\xf3\xfb\xef\xb6\xfd\xf7\x9f\xf7\xfe\xef\x7f\x30\x47\x64\x96\xba\x36\xec\x3f\x83\x82\x3a\x56\x1f\xe4\x34\xf1\xf9\x2c\xa1\x7d\x7e\xa6\x36\x9b\xb3\x66\xfe\x8a\xc0\xf4\x80\xc5\xd6\x1a\xdd\xf6\x3b\xf8\xf9\x87\xd2\xb3\x83\x81\x17\xab\xb9\x87\xf5\xc1\x38\xb0\x0b\x78\x5f\xb2\x3e\xa9\xf2\x40\x7b\xca\xcb\x03\x5c\x0c\x4f\xe8\x1e\xed\x0f\x96\x3f\xc8\x2f\xb0\x6c\x45\x5e\xaa\xe3\x08\x9b\x46\xfe\x8b\x1d\x0e\x86\xaf\xd7\xf7\x64\x70\xb2\xe8\xf0\x32\xe6\xde\xf9\x80\xa9\xc8\x5b\x1c\x72\x57\x2c\x21\x36\xd5\x39\x81\xfd\xd0\x04\x0f\x9e\x19\x64\x80\xd8\x49\x43\xfd\xfd\x31\xe0\x90\xd3\x6c\x43\xd3\x4e\x7a\xd2\x33\x17\x27\x6d\x73\x52\x55\xe1\x74\x7b\x38\x3d\x28\xc8\xf4\xdf\xb9\xd9\x3c\x8b\x6b\xea\x37\x10\x1c\x53\x16\x25\x6c\xa3\x10\xc5\x0e\x34\x2a\xf0\x59\xf3\x56\xa3\xe2\x86\x1b\x66\x28\xe7\x43\xaa\xbf\xd6\xc6\x3b\x8f\x76\x5b\x06\x22\x9c\xa1\x28\x5e\x86\x8c\x85\x81\xf3\x48\x59\x47\x9c\x98\x68\x6d\x63\x4b\xdd\x7c\x86\x9f\x6e\x0b\xf9\x17\x5d\x21\x89\x80\x13\x7e\xfe\x6b\x7b\x9e\xe9\x3e\x79\xea\x67\xb7\x96\x5f\xc5\x49\x56\xae\xc3\x31\x08\x8a\x6e\x12\x6d\x1c\x90\xa2\x6e\x93\xcc\xbf\x4b\xb2\x99\xe4\xd9\x32\x05\x4b\xf7\xd1\xf2\x8e\x88\x1f\x86\x57\xb2\x0a\x4a\xac\xe3\xcc\x9a\xa3\x56\xa9\xb2\x2e\x80\x2b\x33\xad\x11\x48\x46\x96\xbc\xc7\xb7\xfc\x0c\x5d\x79\x87\x6d\x43\xd9\xdb\x7f\x6c\x1c\xd3\x12\x9c\xab\x4a\x59\x86\xa9\x0c\x32\x94\xbe\xd9\xa1\x99\x1b\x68\xd7\x2a\xc7\x2a\x9f\xc7\x63\xfe\x63\x92\xc5\xdb\x6d\xa1\x69\xdc\x02\x2f\xc3\xc6\x5e\x06\xe5\xa3\x1c\xec\x88\xb9\xf3\x19\x4d\xd5\x40\xdb\x72\xb6\x1a\x97\x24\xab\x52\x70\x0f\xda\x27\x37\xb6\x47\x55\xd6\xc7\xa2\x12\x79\x6e\xe5\x2f\x40\xdd\xab\x60\xc0\xce\x92\xc7\x3b\xde\x29\xb1\xbc\xbe\x72\x40\x57\xe8\xdc\x88\x9c\x7e\x44\xc4\xe9\x63\x05\xe8\x19\x4f\xf1\x75\x9f\x72\x3f\x19\xb4\xff\x54\x31\x4d\x38\xa2\xec\x5e\xb3\x73\x23\x0b\x22\x17\xae\xa7\x24\x77\x1d\x4e\x36\x8a\xd7\xb8\x3d\xfe\xe4\x92\x9f\x7a\x19\xbe\x4f\xb3\x4e\x52\x4c\xac\x64\x01\x98\x65\xbc\xdd\x74\x13\xd9\xbc\xf0\xd3\xc6\x0d\x53\x71\x5b\x90\x03\x6c\x2b\xa2\xd2\xea\x44\x33\xed\x51\x2b\xf8\x88\xf3\xeb\x4c\xa1\x62\xfc\x1c\x20\xc1\x0c\xba\x1e\xf3\x1a\x7c\xb3\xf0\xbb\x48\x91\x06\xd9\x52\x7a\x60\x0d\xa8\x34\x22\x8b\xd2\x80\xe0\x6a\xa2\x00\xc2\x9d\x2b\xcc\x9c\x8a\x8b\x04\x78\x89\xbc\x11\x5c\xf5\xc2\xc6\x1f\x67\xee\x68\x63\x67\xd8\x48\x66\xeb\x98\x43\x2a\x3b\xfb\x49\x68\xf4\x8c\xd4\x92\x32\x5a\x1a\xce\xd7\xec\x9b\xfc\x8f\x19\x2a\x30\x51\x64\x21\x8d\x5e\xe5\x3b\x23\x97\x54\x54\x6a\x9f\x71\xe6\x90\xef\x1f\xb6\x0d\xd3\x37\xdb\x6e\xbe\x67\x9e\xd1\xbe\x2e\x9f\xba\x02\xac\x6e\x97\xa2\x02\xe3\x1d\x85\xbe\x4e\xc4\x04\x8c\x7c\xa6\xbf\x91\x19\x21\xd2\x63\x6f\x5c\xac\xd6\x0d\x96\xb8\x66\x17\xc3\xee\x82\x5b\xe3\x84\xca\x9e\x84\xd8\x3f\x16\x3c\x29\xf9\x97\x6e\x7e\x0a\x0e\x72\xfa\x5f\x3c\xd9\x5a\xcc\x7f\xb3\x05\xcf\xa1\xff\xc7\xf6\xbe\xff\x79\x3d\x51\xa9\xfd\x70\xc1\xa7\x7e\x16\xe1\x2c\x1c\x05\x2a\x3e\x84\x60\x9e\x9c\x7b\xb2\x06\xa9\x13\x24\x2b\x00\xc9\xb7\xe1\x0f\xf8\x76\x79\x71\xf5\x17\x61\xc9\x6b\x5a\xd7\xcd\xe2\xee\xf4\xa7\x3b\xbc\x0b\xba\xd1\x0c\x32\x31\x31\x4b\x54\xf3\x68\xa5\x76\xd2\xe3\x39\x40\xfc\x94\x90\xc7\x1f\xd7\x15\xe1\x19\x49\x90\x38\x79\xe3\x78\xad\x6b\xe0\x65\x97\xf8\x51\x9a\xdb\x82\xc7\x90\x63\x45\x3f\x0f\x92\xa9\x8e\xbd\xad\xa4\xf7\xe2\x04\x0e\x24\xa2\x7e\xe0\x6a\x88\xd7\x2f\x9a\x1e\x9f\x97\xe8\x24\x95\xee\x7e\x9e\xc7\xc8\x0d\x96\x1e\xa5\xa2\xb5\x0d\xa8\xd7\x57\xc3\x58\x36\xd6\x34\xfb\x6f\x10\xdd\xd3\x42\xd5\xc7\x50\x55\xae\x09\xb2\xe8\xe8\x06\x78\x40\xe2\x7a\x48\x97\x2f\x1d\x7e\x61\xfe\x99\xee\x43\xc4\x6f\xaa\x4f\xcf\x79\x51\x6c\xe5\x5b\xba\xa0\x19\xa4\xf6\xc5\x0d\x2c\xf7\x34\xcf\xd7\xf2\x2b\xc0\xff\x0a\x06\x08\xed\xc6\x77\x57\x54\x2e\x82\x4c\xe9\x81\x15\x8e\xea\x07\xd9\x66\xd5\x30\x05\xdd\x22\x56\x3f\x23\x59\x21\xbf\x5c\xa6\xca\xa8\x28\xd1\x47\x34\x3a\x6f\xf8\x4b\x00\x7d\xb6\x59\x6a\x07\xb0\xa4\x38\xd5\xdf\x60\x95\xbc\xb6\x37\xb3\x79\x8b\x04\xeb\x75\x60\x35\x98\x12\xba\x3e\x95\x22\x0c\x34\x8b\x61\xa9\x2f\x4b\xce\x3b\x61\x26\x21\x67\x7b\xed\x65\xb4\x5c\x6c\xf1\x75\x6c\xcd\xa4\x0a\x3d\x9c\xdd\x2c\x91\xcd\x74\xe6\x0e\xbc\x28\x31\x55\xae\xb0\x88\x96\xca\x7d\x69\xb5\xf2\x06\x06\x0b\x05\xf6\x07\x47\xcd\x4c\x4b\xbc\xd2\xa5\x75\x32\x2a\xa2\x10\xa9\x34\xbb\x58\x48\xcc\xb1\xa0\x41\xa5\xa5\xda\x4f\x84\x21\xdd\x97\x70\xd6\x7e\xf5\xb8\x62\x4f\xc1\x77\x37\x0d\xad\x08\x54\x45\x45\x57\xac\x67\x6b\x27\x56\x26\x6c\x4a\xc7\xb3\xfe\x01\x76\xb1\xf3\xa8\xb9\xcb\x5d\x0a\x30\xaf\x98\x32\xef\x71\xbf\x72\xef\x69\x23\x0a\xf8\xc4\xda\x22\x8b\xad\x5a\x69\x3f\xab\x06\xb0\xd7\xde\x21\xa1\xd2\x12\x89\x83\xbe\x89\x2f\xb5\xa3\x33\x80\xac\x60\x96\x43\xfd\x3e\xf9\xaf\xbf\x28\x0e\x62\xdb\xcf\x8c\x1f\xab\xc2\x98\x67\x17\x77\x07\x59\xc8\x92\x6a\x38\x9a\x37\x76\xa7\x5f\x4b\x13\x2e\x44\x12\x0d\xdf\x8e\x12\xe5\xf5\x44\x7d\x8b\x35\x14\x7c\x25\x9c\x4f\xfe\x8e\xaf\x9c\x9e\xc6\x91\xc5\x08\xd3\xc2\x23\xee\xd2\x10\x03\xb9\x2f\xcd\xbc\x33\xc2\x8e\x38\x02\xaf\x10\x5e\xca\xde\x4f\x77\x96\xc3\x48\x81\xd1\x2b\xc9\xd2\x83\xe3\xc0\x00\x1a\xcc\xb2\xb9\x5d\xc7\xb8\xcf\xa6\x98\xad\x2c\xc0\x67\xa3\x16\x0d\x06\x0b\x03\xdc\x31\x06\xe6\x05\x4b\xa6\x15\x6d\x7b\x17\x99\x66\x0e\xf7\x36\xf1\xca\x1e\x4d\x33\xe6\xe9\xcd\x1a\xa9\x64\xb0\x67\xa8\x21\x2e\xef\x3b\x4a\xa1\xb8\x7c\xc7\x04\x37\x4a\x4d\xb6\x8d\x4c\x21\x7e\xb2\x86\x6b\x6d\x80\x8f\xe5\x1b\xe7\x6b\x8e\xb7\xaf\xfb\xc7\x35\x47\x4c\x0d\xeb\xc2\x56\xc3\x42\x96\xff\x95\xcc\x51\xdc\x7f\xb3\x5b\x18\x4c\xff\xb9\x21\x02\xec\x88\xfd\xcf\x45\xd1\x7b\xfd\xc4\xef\x69\xcb\x06\x06\xf5\x1e\x65\x25\x8a\x64\x81\xbc\x2f\xf3\x2d\x4d\xe7\x93\xd4\xad\x2c\x2b\x1e\xa1\xdc\x20\xfa\x28\xee\xe1\x47\x64\xda\x00\x01\x09\x0b\xb0\x06\xd1\xb5\x13\xc6\xb6\xa7\x5a\x92\x9b\xb2\x6a\x1a\x5a\x62\x5c\xeb\x80\xc8\x41\x2c\xc7\x0c\xd7\xc4\xec\xa3\xf5\x1c\xa3\xe4\x77\xfc\xa2\x24\xfa\x96\x9f\x0c\x87\xf1\x5f\x2d\xa4\x93\x11\xbd\xb7\xac\x32\xd8\xaf\xd5\x8b\x16\x2f\x00\x08\x11\x3b\x03\xc7\xc9\x0d\x17\xb0\x41\x6b\x33\xee\x2a\x95\x18\xfa\x7e\x80\xfc\x53\x0c\x1f\x3b\x4d\xaa\x84\x2f\x4e\x88\x58\xb8\x39\xbe\x5e\xa5\x82\xe3\xac\x5b\x18\x53\xfc\x8e\xa5\x43\xd4\x4e\x26\xe5\x4f\x4d\x17\xd8\x4f\xbf\x04\xd8\x2c\x1f\xd7\x2d\xd4\xf5\xe2\xeb\x33\x55\xf2\x87\x04\xee\x06\xb5\xaa\x3e\x54\xc1\x47\x90\x37\x85\xcd\xb2\x38\xc2\x55\x56\xfa\x39\x8a\x38\x3b\x65\xf1\x8b\xf8\xf5\xc7\xd6\x89\xf1\x17\x1f\x9e\xde\xe7\x6c\x42\x8b\x30\x53\x6c\xc1\xc3\x99\x3d\x05\xac\xef\xb7\x74\x87\x60\xf3\xf6\x71\xe2\x2e\x03\x5e\x8b\x15\x43\x5a\xee\xb9\x9b\x01\x03\xc4\x07\xc3\xd9\x30\x11\xb9\xfb\x94\x43\xb8\xbd\x70\x26\x24\xc2\xb6\x34\x6c\x2f\x67\x7b\x63\xaa\x6b\x0f\x29\x29\xbf\x95\x54\xc6\x4c\x07\xd2\xff\x3e\xd2\xa2\x40\x7c\x15\xed\x9f\x78\xd8\xe2\xec\x44\x7a\x91\xd0\x53\xf4\x98\x43\x16\xff\xd4\xbe\xd6\x4a\xb6\x95\x10\x4b\xc1\x56\x84\xe7\x46\xa6\x87\x66\x6e\x76\x3b\x1d\x32\x3a\x38\x48\x86\x2e\x8b\xc2\x71\x3d\xb3\x9f\x9f\x1b\x53\x66\xfb\x71\xe9\x97\x84\xcb\xb8\x79\xd1\x49\xf4\x11\xca\xfc\x96\x42\xde\x3f\x62\x2c\x75\x52\xdc\xad\x12\x2e\x3c\xf5\xa8\x90\x4c\x1c\x3e\x1f\xaa\xa9\xb0\xf9\xfb\x11\x2d\x2a\x02\xc1\x1f\xca\xf0\x04\xe5\xef\x82\x50\xaa\x81\xf0\xfb\x5e\x03\xd1\xd4\x18\xcd\x54\x30\xa0\x9a\x7b\x72\x91\xd7\x1f\x4c\x91\x62\x9d\x46\x9b\xe0\xfa\x15\x29\xe0\xae\x0a\x36\xe0\xdb\x54\xaf\x08\x11\xa6\x7e\x94\x32\x86\x60\x37\x71\x99\x99\xbb\x07\xf0\xf4\x95\x2d\xc4\x95\xd1\xea\x27\x4c\xbc\x93\x13\x32\x04\x1d\xcb\xac\xad\x18\xfd\x25\x70\x3e\xe8\xcb\xbe\xa0\x81\x0f\xd2\x4e\x94\x69\x7c\xb7\x39\x4c\x6f\x6c\xd3\xc5\x82\xfd\x1e\xb6\x14\x09\x1e\x73\xfb\x8e\xdd\xb3\xfb\xb7\x64\x88\xb5\x3c\x33\x76\xdc\x06\xf9\x87\x49\x89\xfa\xeb\x82\x0d\xa3\x7c\xf5\x97\x26\x65\x3c\xc8\x6b\xae\xf3\x1e\x97\x10\xe4\x99\x2e\x7b\x87\xce\x7c\x85\x75\xcf\x99\xa5\x2f\x48\x78\xad\xc5\x72\xbf\x46\x95\xc4\x8b\x67\xd4\x3b\x6f\x79\x3f\x24\x1a\x7d\xd7\x0f\xf4\xb9\x7a\xd0\x6f\xa4\xfa\xca\x5c\xe3\xdb\xa2\x44\x1d\x85\x50\x5f\x27\xe3\x4a\x17\xc3\xce\x01\xed\x18\xf8\x33\x95\x35\x16\xfe\x8a\x94\xb7\xd0\x74\xee\x4e\x0e\xb1\x02\x58\x43\xa9\x79\x7a\xa6\xb5\xee\x49\x8a\x96\x94\x91\xaf\xf4\xdc\x35\x6a\x48\xef\x64\x01\x0b\x9c\x92\x2c\x6c\x5a\xde\x07\xfe\x52\x59\x79\x58\x1b\xef\x2d\x12\xa3\x8b\xce\x33\x0b\xff\xc8\x30\x92\xaf\x8b\x58\xf6\x87\x27\x74\x7f\xa5\x9e\x94\xfd\xf9\x37\xe3\x8d\xfd\x1f\x19\xa6\xff\xf3\x96\x07\x9e\x99\x6e\x9b\x1a\x79\x4c\x98\xcf\x09\x43\x56\xc1\xe4\xb8\x15\xf5\xf0\x4d\xd5\xd8\x42\xf4\x51\xda\xf3\x38\xdc\x15\x40\x28\x04\xd1\x4c\xa0\xba\x81\x4a\x8b\xca\x4b\xda\x92\x11\xb4\x5b\xa9\x15\x1a\x59\x8a\x2b\x47\x12\x78\x69\x0a\x1d\x16\xdc\xe1\x18\xce\xc7\x1c\x07\xdf\x59\x72\x93\xf7\x88\x07\xf9\x35\xff\x5c\xdc\x1d\x12\x8d\xb3\x2c\xe6\x86\xdb\x1b\x23\x1d\x1f\x4e\x60\x25\xd0\xee\x62\x9f\x9d\x4a\xb9\x4d\x78\x80\x10\x88\x9d\x10\xd8\xb1\x1e\xa8\x03\x22\xfb\xc9\xcf\x7c\xc3\xf0\xf1\xe7\x00\x70\xf7\xe1\x6d\x67\xc4\x7b\x20\xcd\x2b\x74\xcf\x37\xea\xd0\xe0\xba\x00\xd0\xab\x60\x19\xe2\x54\xf3\xc5\x54\x2b\x49\x9a\xd3\x04\x1a\x9b\xe3\x46\xa1\xa1\x02\x00\x76\xe9\xb7\xc8\xa9\xe0\xe6\xdd\x0d\x7c\xc8\x5e\x1d\x66\x65\xf8\x43\x3f\x8c\x5a\x31\xb4\x88\xd4\x06\xcf\xf1\x78\xc6\xce\xef\x2f\xde\x3f\xc6\x9c\x14\xaa\x10\x3a\x19\x64\xaa\xb0\x98\x8e\x01\x1c\x26\xb6\xc5\x98\x5c\x15\x48\x90\xb6\xd9\x3e\xe5\x2f\xeb\x95\x86\x64\x2a\xde\x69\x6d\x28\x73\x23\x03\xe9\x3b\xb3\xc6\x0a\x75\x18\xe1\x43\xf4\x6e\xeb\xaf\xb6\x2f\x2c\xb7\x98\x45\x1b\x01\xc8\xeb\x08\x6d\x8a\x22\xb4\x06\x0e\x16\xd1\x21\xf7\xc6\x4e\x92\x96\x1c\xe1\x3d\x2e\x91\xc1\xe2\xe6\x70\x39\x99\x09\x7f\x73\xd0\x71\x80\x82\x3a\xea\x6f\xbf\x4e\x3d\x3f\x4b\xb4\xa5\x5b\x17\x34\x2f\x72\xbe\x6c\x54\x2f\x65\x05\xfd\xfd\xe3\x72\x80\xae\xe3\x00\x3e\xde\xba\x90\xb5\x7c\x3b\xbc\x30\x29\x12\x1b\x0e\x1c\x69\x2e\x4b\xcc\xd0\x71\x4e\x79\x14\xae\xa0\xb3\x42\x8a\x43\x92\x8c\xaa\xd3\xdb\xd4\xe4\xd9\xc8\xc5\xab\x98\xb4\x3a\x23\x53\x88\x27\xde\x4b\x69\xde\xf4\xa7\x37\xa6\xea\x8b\xb8\xa5\x56\x1f\x59\xa1\xa3\x77\x9a\xd6\xfb\x6d\x5f\x78\xb2\x5f\x6f\x72\xa7\x84\xbb\x48\xe5\x31\x88\xd0\x0a\x50\x8a\x43\xdb\x95\x77\x31\x2a\xa5\x22\xdf\xd7\x6f\x26\x06\x95\x04\x76\xa6\x0f\xeb\xb8\xd4\xc4\x36\x45\x8b\x3e\x97\xfb\x38\x69\xbf\x7d\x0d\x8e\x9d\xd2\x9a\xef\xb5\x2c\xbc\x57\xf1\xfa\x50\xa9\xa4\x73\xad\x1e\x56\x29\xfe\x60\xf4\x98\xab\x75\x47\x64\x76\x27\x8a\x29\xfb\x83\x88\x8a\xab\x1b\x99\xbf\xb7\xbc\x5e\xd9\x25\x49\x06\x3a\x61\x15\xa5\x58\x05\xc1\x51\x2e\x53\x22\x3b\x9b\xe4\x62\x26\xd1\x29\xf4\x48\x39\x9c\x42\x37\x42\xe2\xeb\x6d\xbd\xad\xae\x92\xeb\x78\x4a\x00\x10\xbb\x19\xf4\xb6\x5f\xe8\xfc\xc0\xb4\xd1\xf2\x7d\xba\x64\x42\xbf\x1c\x3f\xb0\x18\x73\x97\x3c\xa3\x0f\x34\x53\x72\x5e\xc4\x36\x56\x4a\x30\x8a\x32\x95\xc5\xe8\x10\x85\x18\xdf\x0b\xaf\x3a\x0b\xcf\x72\xd5\x33\x28\x25\xaf\x7d\xec\xe0\x9d\x13\xee\x72\x60\x24\x06\x17\x23\x0a\x86\xc8\x95\x40\x1d\x23\x84\xdf\xca\x22\xb6\x6a\x7c\xf4\xfd\x1d\x04\x28\x2b\xa2\xb6\x12\xad\x3e\xce\xf9\x6b\x7b\xeb\x2f\x9d\x27\xad\x9a\xbf\xd9\x3c\xc7\xe0\xff\x53\x26\xf9\x77\xe6\x00\xf6\x75\xee\xa9\xa5\x57\xc1\x3e\x69\x2e\x04\xbc\x29\x87\x7e\xb8\x5f\xe7\xf3\x82\x3f\x3a\x67\xfb\x6d\x3f\x55\x70\xeb\xa1\xbc\xe0\xfe\x9a\xfe\xbc\x1c\xaf\xbf\x60\xe6\xe8\xb5\x66\x8b\x53\xcb\x1d\x14\xe3\xd3\xca\x20\x62\x8e\x6c\x3d\x72\x11\xda\xf7\xfb\x9e\x64\xfe\xc4\x66\xc3\xec\x3a\xda\x6b\x05\x41\xa0\xf0\x3b\x96\x24\x0a\xdf\x59\x86\xd4\xef\xce\xbe\xf1\xf7\xc3\x60\xa5\xa5\x89\x54\x99\x23\x80\x46\xf3\xc2\x67\xc6\x6a\x60\x44\x0c\xaa\xa0\x26\x41\x77\xa7\x06\x28\x8c\xe1\x43\xa1\xa0\xaa\xd8\x89\x5a\x63\xc9\x30\xed\x0f\x74\xfa\x73\x9c\x4f\xbb\x1e\x66\x99\xc6\x29\xbf\xc4\x13\xdf\x09\x94\x6e\xac\x93\xaf\xd4\x52\x98\x27\x30\xa8\x1a\xba\x3b\xb9\x76\x45\x74\xb4\x3c\xe7\x45\xbe\x79\xed\x8d\x80\x8a\x92\x26\x62\x08\xbb\x40\x2c\x7d\xcc\x4c\x22\x84\x22\x00\x9b\x33\xb9\x45\x33\xf7\x12\x1f\x9f\xa3\x5a\xc6\x60\x0a\xc8\xed\xd2\xd1\xa2\x9e\x21\x7d\xda\x2b\x69\xc2\x52\xc1\x0a\x84\x3d\x71\x88\xcf\x2f\xf9\xf8\x64\x02\x1e\xa8\xf3\xf9\x56\x25\x45\x73\xcd\x6f\xc8\x72\xaf\x1a\x48\x42\x64\x2f\xcf\xea\x58\x97\x90\xc3\xc9\x34\x97\x0f\x3d\x69\x3f\x23\x8b\x06\xde\x25\xdb\xf5\x19\xbf\x9f\xba\xa3\x76\xbc\x2e\xe8\x9b\xc8\xeb\xd4\xb9\x28\x66\x70\x29\xef\x72\xab\xbc\x8f\x01\xc6\x57\xe4\x44\x1d\xa5\xaf\xc0\x12\x6e\xd3\xc5\x8d\xe9\xd8\x84\x70\x37\x29\x91\xd5\x22\x89\x0e\xb1\x7b\x57\x3f\x36\xba\x44\xfc\x53\xf1\xda\x76\x2d\xd9\xd4\x49\x76\xda\x6a\x06\x24\xb2\xcc\x18\x7f\xfd\x93\x5a\xe7\x96\x1b\x46\x25\xce\x88\x4f\x16\xcb\x72\x24\x6c\xea\xa6\x35\x31\x41\x55\x17\xb7\x24\x11\xa6\xa5\x98\x6d\xd9\x30\x2c\xf3\xb5\xc0\x31\xef\x4f\x12\x4b\x8a\x0f\xf5\x83\x3c\x3f\xb6\xaf\xdb\x71\x20\x69\x07\x2b\xeb\xc7\x0f\x64\x12\xf6\xc9\x3c\x5e\xa8\x19\xa3\xda\x0f\x6a\xe7\x7d\x96\x22\x60\x01\xa5\x08\x77\xe5\xd9\xeb\xc3\xa3\x96\x46\xdd\x94\x22\xcb\xf7\x0b\xdd\x3f\x25\xb5\x4d\x59\x48\x87\xcf\xca\x3c\xed\xb4\xc7\xc5\x2e\x22\xac\xf0\x26\x8e\x53\xb0\x14\x5c\x23\x71\x8f\xd8\xa0\x6c\x70\x71\xe0\xce\xd2\xcb\x23\x23\x30\x90\x24\x9a\xea\xf9\x12\x5f\x34\x36\x80\x15\x5f\xee\x30\xd5\x61\xe7\xfb\x51\xa2\xe0\xe2\xb7\x5f\x9f\x4b\x77\x28\xed\x5c\xf6\x23\x10\xca\xd6\xb8\x5d\xc9\xfd\x2a\x4e\x3f\xe5\x45\x81\x02\xc4\x40\x75\xa8\x81\xb1\x1b\xeb\x76\xe6\x94\x60\xfa\x9d\x87\xb0\xc1\x7f\x01\xb1\x95\x3e\x6a\x99\x8c\x03\x5a\xda\xd6\xa6\x10\xb4\x74\x2b\xd9\xaa\xe0\x0d\x70\x48\x95\xf9\x71\x7f\x27\xde\xdb\xdc\x22\xea\x68\x79\x03\xe7\xe3\x21\x87\x48\x73\x98\x67\x20\x15\x1b\x2c\x1d\x20\xf3\x89\x7a\x68\x77\x16\x16\x7c\x87\xb9\x87\x4c\x82\x3e\x28\x1a\xdd\xb7\x19\x71\x99\xa0\xa8\x76\xa8\x3a\xb3\x6c\xbd\x4f\xb3\xfd\xa5\xda\x50\xff\xbd\x94\x21\xb5\xff\xb1\x45\xb3\xfb\xaa\x17\x48\x59\x63\x09\x5e\x93\x02\xee\xbb\xbf\xcc\x60\x7d\x2d\x3e\xd3\x7f\x3c\x9a\xb3\x5d\x12\xe1\xf1\x1b\x27\x98\x12\xf1\xde\x2f\x4e\xb2\xee\x17\xe1\x89\x20\x8d\xc4\xdd\xee\xe8\xc8\x56\xf5\x85\xdd\xf3\xc4\x8c\x55\x23\x3d\xd4\xed\xab\x0b\x7d\x98\x05\xac\x1c\x0b\x69\xf1\x7c\x6e\xdc\xf2\x38\xab\x92\x16\x17\xc4\x4d\x15\x0d\x94\x5a\x54\x51\x11\xc1\xe4\x0f\x04\x2d\x10\x74\x6e\xc1\x9c\x06\x57\x3e\xda\xc8\x24\x13\xa0\xaa\x37\x2f\x94\xbd\x82\xd7\x72\x7a\x7d\xb6\x48\xb6\x4b\x1c\x12\xac\x2d\x11\x0e\xa6\xd7\x2b\x2a\x39\x32\x51\x66\xf0\x2f\x47\xb6\x5f\x43\x71\x5c\x26\x6f\xf3\x75\x6f\xd4\x7c\xc5\x95\x5f\x38\x7f\xb9\xf3\x0a\xb0\x6e\x65\x48\x88\xc3\x3a\x44\xc9\xc9\x54\xde\x51\x5c\xf6\xd1\x93\x57\x20\xe5\x57\x2f\xb6\xff\xb1\x29\xad\x7b\xef\xaa\x8f\xa4\xaf\xfd\xeb\x00\x28\x0f\xbe\xeb\x1c\xd8\xad\x35\xa1\xbe\xd0\x64\x91\x34\xad\x81\x3f\x4d\xfc\x69\xf9\x0c\x43\xb5\x02\x61\x0c\xd7\x57\xab\x04\x82\xd2\x7e\xc1\x84\xf6\x58\x67\x64\xca\x0e\x90\x04\x46\xbc\x4a\x98\x65\x58\x00\xf7\x6a\x43\xa5\x57\xbe\x63\x8f\x62\x06\x6a\xd6\x73\x1c\xe6\xfc\x36\xd1\x84\xac\x52\xb9\x08\xd7\xe0\x39\xba\xdc\x96\x51\x4a\x67\x96\x52\xc7\x17\x98\xd0\x1a\x85\xd0\x08\xa1\x5e\x74\x4d\xef\x40\x66\xe8\x04\x28\x0e\x1a\xa9\x5b\x58\x19\x56\x40\xd0\x77\x07\xe2\x5e\x83\x1e\xb0\x6f\x62\x2d\xaf\x28\x7c\x40\xd3\x51\xa6\x1c\xcd\x44\xd4\xaf\x21\xbb\xa1\xea\x48\xc9\x08\xb6\xf7\xbf\x9e\xe9\x3c\x07\xaf\x09\x4f\xe5\xf7\x5a\xf5\x05\x4c\x8c\x66\x30\x76\x09\x2d\x85\x68\xf5\x98\x0d\x7f\x85\xf2\x2d\xa1\xaf\x0f\x23\x97\x16\x46\x7c\xd2\x3c\xfe\x05\xae\xf2\xf3\xaa\xd5\x06\xfb\x5a\x77\x1b\xad\xb0\x37\x37\xe0\x83\x61\xc0\xd4\x31\x98\x49\x50\x03\xe4\xef\x19\xe6\xa5\x9b\x83\x56\x49\x7e\x69\xcd\x5c\xeb\x47\x7f\x2b\xa5\x34\x86\x3b\x09\x9e\x79\x49\xd8\x50\xfc\xf3\x16\x84\x3b\xa3\x12\xa1\x51\xf2\x08\xfe\xd7\xde\x60\xac\xfe\xbb\x5d\x52\x54\xd4\xff\x7c\x89\xde\xf9\xc7\x45\xfe\xa2\x02\x84\x4a\xf9\x7d\xe2\x9c\x73\x7c\x9b\x0e\x5b\xdb\xb1\xf9\x45\xb3\x7c\x9a\x42\xa5\x0c\x95\x60\x76\xbc\x38\xa0\x9a\x47\x75\x7c\x04\x58\xad\xc2\xaa\xbf\x11\x8f\x65\xd5\xd8\x01\xed\xa0\x63\xd5\xc6\xbe\x9d\x63\xeb\xc8\x03\xfb\xf1\x6a\x31\xa4\x83\x5a\x05\x71\x1a\xe2\x1f\x28\x1d\xe3\x9e\xfc\xb1\x2f\x58\x4d\x13\x9c\xfe\x7f\xec\xbd\x67\x8f\xeb\xc6\xb6\x28\xf8\x57\x1a\x0f\xb8\xf0\x3c\xe8\x75\x33\xa7\x19\x9c\x0f\xcc\xa2\x18\xc4\x28\x91\xfa\xf2\xc0\x24\x92\x62\x92\xc4\xcc\x5f\x3f\x45\xa9\xb7\xed\x73\x6c\xcf\x3d\x17\xd7\x0f\xf0\x00\xde\xd8\x5d\x4d\xb1\xaa\x56\xad\x9c\x24\xb1\xb1\xeb\xf5\x08\x35\x71\x32\x2e\xd7\xdb\x3e\xbd\x92\x0f\x54\xa8\xc6\x8e\xb6\xd0\xb2\x5f\x9f\x08\xb9\x73\x34\x12\xbe\xe5\xf9\x85\x64\x22\x90\xee\xd1\x7c\xa5\xf1\xd4\xc4\xf8\x89\x0a\xaa\x3f\xfa\x3a\xe8\xf0\x13\x39\x3f\x0f\x32\x9e\xf9\xb7\xec\xe1\x21\x02\xac\xcb\x31\x5a\x72\xee\xd2\x57\x3b\x2a\x64\xfc\xfa\x1c\x57\x88\xc2\x41\xf9\x45\x15\xf3\x38\x7e\x10\xe1\xe1\x00\xa5\x58\xe4\x0e\xd4\x39\x43\xf8\x53\x4b\x3d\x0d\xad\xb2\x73\x2f\xc7\x5b\xbd\xf1\x5b\xa1\x5d\xf2\x76\x2f\x2f\xe7\xda\x4b\x77\x96\x17\x89\x9a\x12\x52\x67\x9a\xbf\xb1\x20\x6b\x5c\x93\x35\x8d\x4b\x7e\x92\x0c\x98\x64\x5d\xb6\xa1\xad\x7a\xfb\x5a\x80\x5c\xe9\xc3\x74\x1e\xe2\x7b\xcb\xef\x8d\x14\xbf\x71\x25\xab\xa7\xcd\x2d\x18\x6d\xd3\x5d\x0a\x02\x3b\x8c\xc3\xe4\xd3\xec\xbd\xaa\x52\x36\xe5\x62\x5b\x7a\xee\xc9\xa7\xaf\xcc\x5a\x51\x33\x0a\x74\x2f\x30\x8b\x45\xec\x5c\x0d\x83\x39\xb9\x95\x06\xad\x4c\x61\xc5\xeb\xca\x74\xee\x98\xea\xa6\x97\x39\x3c\xad\xe5\x8e\xd1\xa9\x09\xf8\x27\x1e\xd3\x2e\x86\x3f\x8f\x29\x9a\xef\x62\x6c\x3f\x30\xc8\x3e\x55\x6b\x01\x22\xd7\xfd\x51\x6b\x26\x1a\x2b\x2a\x0e\x01\xfa\xce\xa7\x0f\x0f\xb3\x68\x44\xe3\xa1\x23\x8e\x44\x20\xf3\x9f\x51\x03\x3d\x9c\x4c\xf4\x59\xd8\x74\xea\x7a\x73\x5d\xc0\xee\xca\x4d\x4f\xf5\xa2\x77\x0f\xbd\x5d\x69\xbd\x13\x72\x48\x38\x0f\xe7\xdc\xe3\x54\xcf\xd6\xd4\xd9\x52\xd2\xa3\x7e\x6e\xef\x7d\x60\x36\x0e\x12\xef\x14\xe3\xc2\x95\x93\xe2\xb4\xf5\xd1\xe9\x6a\xee\xe2\x50\xdc\x0e\x3b\x1f\xd7\xcb\xf8\x54\xe5\xb5\xd4\xd9\x42\x7e\x18\x0b\x95\xed\xb4\xda\x2e\xed\x6b\x5a\x72\x0d\x6b\x2c\x28\x42\x62\xe6\xc5\x7f\x3a\xd1\xd3\x22\xc7\x38\x47\x18\x7f\xa5\xaf\xa6\xb0\x5f\x34\x8c\xb1\xd6\x72\xcf\xa9\x69\xe2\xdf\xae\xc3\x6e\xc1\x80\xf2\x7a\x18\xa5\x18\xa0\x04\x6e\xff\xd4\x07\x74\x6d\x0f\xb8\xfd\x8b\xa5\xaf\xbf\x7a\x6e\x6d\xf9\x9d\xbe\xd6\x01\x09\x13\xe1\x0e\xa8\xbb\xcb\x19\xaa\x2d\xb0\x77\xe3\x79\x56\x6c\x16\x6f\x44\xce\xbb\x24\x35\x9f\x81\x18\x82\xeb\x2a\x71\xdd\x49\x46\x32\x60\x91\x34\x22\x07\x44\xf4\x8f\x02\x6f\x23\x87\x83\xa3\xbb\xd3\xfd\xe1\x99\xa4\x51\xf0\xf7\x14\x81\x2f\xa0\x60\xca\x6f\x84\x5e\x63\x85\xb3\x44\x15\x46\xdd\x9a\xeb\x2e\x26\xb0\x5b\x2d\x44\xc6\xbd\x60\xd2\x68\x47\x4a\x7d\x5c\x74\x69\xc8\xc0\x4c\x74\x36\x56\x23\x22\x93\x69\x75\x7c\xb5\xcc\x95\x1e\x7a\x22\xf8\x8e\x40\x88\x07\xf7\xa8\x41\xf2\xb4\xbf\x3f\xd4\x30\xcb\x09\x50\x73\xb6\xf7\xa8\xba\x2b\x51\xba\x2b\x1b\xe1\x2e\xb1\xb9\x19\x46\xa8\x30\xc1\xae\x3a\xda\x2a\x55\xd5\xc6\x51\x58\x53\x93\xcb\x2e\x95\xca\xb4\xde\xd9\xb9\xd7\x27\x23\xb9\x2b\x1e\xed\xee\x6e\xa1\xde\x2d\x7a\x28\xe0\xba\x10\x5f\x4e\xe1\xce\xc8\x0f\xba\x01\xc3\x13\xdc\x90\x27\xb4\x31\xb0\xc5\xca\x92\xfd\xf9\x12\x02\x1d\x5a\x03\xd3\x6d\x6b\x85\xed\x5d\xd8\xdd\x9f\x7a\x53\x06\x59\x51\x04\x71\xd1\x6d\xd2\xa4\x72\x6c\x50\xa5\xef\x03\x39\xb8\x57\xea\x22\xac\xdd\x3e\xa7\xb2\xa4\x5e\xd8\xf3\x35\x27\xd6\xd3\x03\x96\x87\x3c\xc3\x6d\x76\x2f\x79\x79\x7d\x1d\x1f\xd8\x0d\xc1\xf0\xd0\x6f\xe9\x28\xd9\x29\x6e\xa3\x4b\xc7\x63\x3b\x7a\xc4\x2d\x92\xed\xf6\x32\x19\xb3\xd4\x20\xf1\x3c\xb0\x4a\x2c\x9c\xa1\xee\x22\xdc\x58\x28\xc6\x87\x1d\x67\xcc\xc7\xc3\x32\x3f\xc2\xb4\xb9\x2b\xd8\x4a\xd3\x99\x7e\x35\x1b\x66\x3f\x82\x4c\xd9\xba\x38\xa4\x1d\xae\x20\x20\xdf\xc4\x79\x94\x0a\xda\x95\x13\xc1\x59\xa0\x33\x2c\xd6\x57\x5c\x03\x93\x74\x37\x22\x08\x57\x5f\x57\x67\x2a\xcd\xb8\xa6\xf0\x43\xcb\x88\x05\x2c\x5f\xb2\xba\x9c\x68\x8b\x3f\x86\x2d\x7d\xf4\xe3\x70\x85\x88\xd1\xb9\x34\x66\xd2\xdc\x31\xf6\xfe\xe0\x38\xad\x3c\x1f\x44\x98\x2f\x28\xd6\x59\x23\x25\xe7\x35\xcd\x42\x44\xb8\x38\x96\x9c\x90\x33\xc6\xdd\xcb\x0a\x2f\xbd\xb5\x74\x6b\x0c\x87\x69\x69\xe0\xc0\x64\xb9\xbe\x21\x10\x2f\x90\xa4\x62\x94\xb1\x85\x93\x79\xe3\x52\x0e\x42\x1f\x43\xfa\x79\x77\xb1\x14\xec\xf8\x0c\xee\x5c\xc8\xda\xbc\x3a\x5c\x4b\x8c\x23\x7c\xa5\x38\x2f\x79\xa1\x11\x47\xe1\x4e\xef\xa4\xab\xb4\xdf\xef\xd4\x42\x75\x1e\x67\xd5\xd3\xe8\x2b\x0f\x19\x6b\x23\x57\x26\x57\xaf\x3b\x14\x4f\x2e\x37\xb5\x11\x60\xfe\x20\xc4\x7a\xbb\x9c\x61\xe7\xe1\x0f\x7a\xbf\xe7\xa4\x89\x7a\xe6\x0a\x2b\x20\xe2\xd3\xf0\x8c\xf0\x3a\xab\x9c\x91\x8e\xe1\x7d\x1a\x48\xc2\xb8\x8b\x16\x2e\x3d\x7c\x85\xdd\xd9\x7a\x42\x9e\x26\xce\xc4\x62\xd3\x11\xed\x88\xcc\x34\x45\x52\x1e\x24\xb2\xf3\x1e\xf2\x39\x94\x92\xf8\x79\xea\xa1\xaa\x66\x87\xf3\x8d\x2d\xd0\xb0\xd0\xa5\xa9\x3e\x06\x83\x72\x80\x0c\x52\x47\xe9\x71\x52\x74\x56\x6f\x0d\x61\xc9\xf0\x67\xec\x31\x7f\xa6\x53\x28\xe2\xbf\xd8\xdb\x6c\xb9\xfd\x2b\x9f\x20\xfd\xfc\xdd\xde\xe4\x19\x52\x91\x06\x2c\x16\x65\x67\x4b\x52\xc3\xaa\x3c\x3c\xdb\x5c\xe1\x8b\xa0\xf4\xcd\x30\xf3\x9f\x20\x81\x15\xae\xb8\x8a\xd3\xb2\xc0\xad\xb5\x95\x9a\x9a\x9e\x7b\x47\xc1\x98\x2d\x22\x2e\xc4\xf2\x70\x02\x49\xec\x41\xae\xf5\xf3\x93\x67\x5d\x3b\x4a\xd0\x34\x6e\x1d\x62\xc0\xc2\x76\xac\xd3\xb5\x1b\x07\xe6\xf5\x91\x64\xb3\x50\xab\x5c\xc1\x7d\x8d\x8a\xd1\x27\x11\x68\x20\x7c\x28\xa2\x26\x66\xb9\xc6\x62\x03\x34\x24\x73\xbd\x6f\xcc\xfa\x2a\x9f\xa1\xf3\x30\xee\xb4\xfb\x04\x52\xdb\xd4\x0e\xb8\x99\x32\xd3\xb3\x0a\x4f\xc7\x20\xc8\x63\x7f\x5e\xe2\x52\x3e\x21\xd7\x24\x6a\xa2\x41\xef\xfc\xb8\xac\x1e\xa6\x7d\xb0\x66\x49\x57\x57\xdc\x9e\x05\xbe\x3f\x1d\xf1\x0e\x9b\x2b\xfb\x76\x6e\x39\x58\x0a\x6e\x95\x65\x8a\x22\x57\xa4\x7c\x3e\xa9\xbc\x2d\xeb\x33\x31\x68\x7d\xa8\x76\x88\x49\xa4\x05\xad\xa8\x95\x1c\x42\x80\x56\x90\xe3\xf5\x95\x2b\x0a\x55\xd7\x4c\x50\xad\xfb\x39\x77\x88\x1f\xb3\xa3\x92\x63\x15\xdf\x43\xdc\x14\xef\x7c\xdb\x7a\x8b\xf2\xe8\x08\x9d\xcc\x65\xa4\x45\x79\x6f\x7a\xb0\x30\x83\xc4\x52\x1c\x68\x57\xa8\xbb\xad\xb1\x4f\x11\x62\x61\x29\xed\x4d\x61\x28\x10\x4f\xdd\xc0\xc6\x6f\x93\xcb\x5d\x77\x4f\x4a\x66\xa7\x1c\x66\x67\xbe\xd0\xc8\x83\xee\x42\x30\x3e\x5a\x14\x33\xd0\xa1\x82\x1e\x21\xea\xb0\x66\x24\xd3\x92\x72\x4b\xa7\x13\xa8\x2c\xc3\xbc\xe7\xee\xac\x90\xef\x55\xad\x51\xa1\xf4\x14\x95\x7a\xd2\xdb\xc7\xb3\x32\xd2\xb0\x61\xa1\x9d\x99\x37\x31\xcb\xec\xfb\xa9\xd9\x31\xf9\x45\x86\x9f\x01\x29\xac\xd2\x74\x00\x59\x49\x92\x77\xf0\xa5\xeb\xbd\x85\x7e\x26\xc3\x64\x3a\xd0\x94\x40\x69\x5e\x17\x58\x39\x3d\xf0\xd5\xdd\x17\x67\x2f\xf3\xef\x3b\x71\xd0\x68\x69\x34\x46\xa9\x22\xec\x06\x9f\x59\x87\x26\x8b\x45\x41\xcb\x00\x6b\x60\x7d\xc4\x69\x6e\x79\x1e\xad\x3a\x04\xb9\x13\x60\x11\xac\xc6\x37\xcc\x23\x8a\x81\x45\x99\x99\x4d\x2b\x95\xf7\xf6\x4f\x51\x09\x97\xb5\xea\xc3\x4c\x46\x45\x5c\x99\x4a\x29\xb4\x32\x87\x27\x41\x71\x00\x33\x85\x01\x27\xb4\xd2\x13\x83\x71\x22\x1a\x99\xe8\xb2\x43\xa2\x06\x3c\x2c\xd3\xe7\xd6\x3d\x5e\x46\x4e\x71\x24\x43\xe4\xe6\xa6\x1b\x56\x01\x93\x7d\x7c\xbc\x0a\x5d\xb7\xae\x0f\x76\x80\x6f\xe5\xc1\x12\xd2\x84\x97\x23\x8e\x64\xcf\x19\x81\xdf\x83\xc8\x6c\x77\x86\x7c\x32\x13\x77\xfb\x2e\xfe\xc3\x7a\x50\xb0\xb9\xf4\x8a\xf6\xe7\x7e\x17\xbf\xe8\xda\xbf\x96\xbd\xde\x7e\x79\x5b\xbc\xec\xfa\xa7\x07\x6f\x6f\x7b\x41\x25\xf1\x88\x0e\x6b\x62\x06\x77\xb9\xbc\x77\xde\xa1\x65\xd1\x07\xd2\x6a\x37\x37\x29\xe4\x42\xe1\x89\x78\xf0\xf7\xd5\x3c\x32\x9c\x78\x7c\x3a\xbd\x3b\x72\x8d\x35\xda\x4f\x87\x55\xba\xf4\xc6\x3f\xab\x07\xd8\x63\x3f\xd0\x9c\x3f\x18\xd6\xce\x35\xb4\x9a\x18\x62\x68\xc6\xf4\x7a\x3d\xe4\x18\x5e\x0b\x46\x33\x8e\x8d\xb1\x1e\x45\x5c\x96\x8f\xd3\x4c\xef\x7a\xc6\xb7\xca\x6b\x03\x9f\x44\x38\x20\x20\xea\xb2\x2b\xf7\xf5\x2e\x19\x8f\x79\x9f\x60\x26\x03\xf6\x4e\xc6\x25\xa7\x9a\x73\xa8\x07\xbd\xe1\xd5\x3e\x24\xc5\x3d\x34\x56\x06\x05\xd1\x07\x22\xb4\xed\x6c\x59\x6b\x7a\x5e\xef\xb6\x70\x93\x45\x34\x3c\xed\xf1\x93\xfb\x48\x9a\xd6\x0b\x32\xc8\x2f\xc5\xbc\x5e\x76\xfc\x8c\x09\x39\x4b\xf4\x20\x03\x96\xda\x6a\xcc\x08\x7d\xc6\xa4\x99\x71\xea\x3d\x7c\x50\x32\x8a\xb9\xb4\xd8\x0d\x97\x59\x41\x57\xda\xf2\xe0\xaa\xfd\x2d\xba\xb2\xaa\xef\xe1\x01\x84\x1a\xa3\x73\x5d\xf6\x14\xe1\x4a\xc7\x39\x64\xf8\xfa\x09\xfb\x4d\x65\x6e\x4a\x9d\x1e\x2d\x72\x32\x74\xa1\x6b\x25\x9f\x04\x3e\xfd\x61\x55\xc6\x09\x51\xbb\xe3\x60\x4c\x0e\xc4\xca\xd2\x4d\xf4\x14\x21\xaf\x1b\x62\x07\x81\x34\x1b\x21\x21\xa6\xc8\xb8\x76\x62\x57\x49\x55\xba\xc9\x3c\x3c\x49\xfd\x56\xcd\x92\x89\x75\x27\x18\xcf\x42\x93\xb2\x57\xdd\x4c\xc9\x79\x3c\xeb\x48\x66\x78\x9c\x8e\x7a\x6a\x50\x71\xe1\x80\x59\x59\x2e\xdf\xf3\x0c\x65\x0e\x58\x26\x54\x9a\x56\x54\x67\x23\x11\xb0\xac\x1c\x15\x45\xb0\x73\x81\x59\xe4\x14\xad\xf9\x13\x7c\xc2\xf7\x92\x3f\xf0\xb3\x4b\x80\x32\x84\x16\x56\xac\x53\x4a\xb6\x83\x31\x29\xac\x84\x99\xd3\x2d\xa7\x67\x38\x9b\xd3\x73\xa3\x89\x87\x23\x95\x2c\x1e\x4a\xc7\x4c\xdf\x5c\x69\x1e\xb2\xe0\x4b\x6f\xe3\xb2\x52\xa7\x96\x73\xa3\x59\x53\xb3\xf7\x14\x85\x72\xe7\x38\x2b\xa4\xa3\x70\xbd\xf2\x23\xd4\xd3\x7b\xa6\x3f\xc2\x23\x66\xdf\x4e\xd4\xe9\x51\xa6\xa9\xaf\x55\xab\x70\x1d\x83\xdb\x8d\xa6\x07\x3f\xaf\xf0\xc3\xa4\x74\xcf\x60\xae\xb5\x00\x02\x44\x04\x71\xa5\x8c\xb2\xc8\xdc\x2e\x57\xc3\x7c\x3a\xbc\x80\x41\x56\xa6\x2d\xe9\xd9\x65\x85\x13\x39\x9d\x19\xf6\xb9\x28\x31\x5f\x72\xf0\x80\xb0\x0f\xa8\xe5\xcd\xa1\xae\x86\x6b\x2b\x51\xe8\xa8\xe4\x69\xa8\x09\x4f\x7c\xe2\x35\xe5\xf8\x78\x06\x8d\xa7\xb1\x08\x6e\x3a\xed\xe3\xee\x1c\x44\xd6\x2b\xaa\xcb\x53\xa0\x28\x67\xc4\x34\x75\x17\x2b\xf0\x8e\x4b\xe9\x98\x8d\xa2\x9a\x3d\x3e\xdb\x08\x0e\xb3\x5e\xc0\x19\x44\x0b\xae\x65\xfb\x18\x9b\xe5\xa2\x75\x67\xbb\x64\xa1\xac\x9c\x58\x4e\xc3\x1d\x4c\xa3\x68\x3f\x58\xfe\xcc\x78\x7c\x0b\xc7\xf0\x2f\x66\xe0\x3f\x1e\x5e\x73\xa7\xb7\xcf\xbd\x6c\x95\x71\x63\x14\x47\x71\x49\xef\xd6\x92\x5b\x6d\x64\x73\x4e\x75\x52\x5a\x9b\xe7\x44\xd3\x65\x6f\x56\xe7\x88\x4d\x2a\xee\x40\x69\x28\xe9\x9b\xa1\x6b\x46\x6b\x25\x83\xc2\x8a\xd8\xa3\xbd\xab\xc6\x81\x55\x94\xfb\x9d\x45\x6f\xcf\xa3\xcd\x60\x61\x48\x42\x06\xbd\x5c\x06\x6f\x5e\xe6\xf2\xe9\x3f\x41\x31\x5c\xaf\xf1\x1a\xb9\x96\xcd\x65\x7b\x9e\xc6\x9a\x1b\x16\x87\x28\x0d\x54\x95\x6c\x66\xf2\x3a\x36\xeb\xd3\x30\x9e\xc9\x1d\x6f\x8b\x68\xf1\xea\x74\x61\xae\x26\x97\xae\x62\x76\x3e\x49\x43\xa0\x25\xcb\x58\x81\x3c\x59\x0f\xf0\x6b\x85\x84\xb6\xb3\x63\xee\x0f\x93\x10\x2b\xb6\xac\xe3\xa6\x6c\xec\x69\x38\xee\x8c\x05\x38\x7d\x48\x74\x83\xeb\x72\x63\xce\xcf\xfb\xa5\xa9\xef\x73\x83\x42\xc7\x12\x71\xa6\x06\x92\xc7\xbe\xd9\x13\x25\xee\x6a\x43\x8a\x97\xc6\x75\x6e\xa5\xf6\xfe\xb4\xad\x11\x71\xd7\x63\x04\x14\x44\x19\xb0\xdd\x45\xa0\x5a\x01\xda\xd7\x94\x3f\xcc\xeb\xc3\xf6\x0c\xa1\x4f\xca\x41\x2b\xdc\xfa\x4c\xf4\xd2\x1c\xa7\x9c\x5f\x35\x7e\x66\xb9\xec\xd1\x76\xf4\x1e\x6d\xe5\x74\xf5\x0e\x87\xc3\x53\x65\x12\xe9\x9c\x88\x7b\x4a\x29\xf1\x84\x43\x19\xad\x10\x9d\xd4\x13\x0f\x7e\x42\x41\x09\xeb\x62\xda\x0e\x29\xc6\x8a\xdf\x3f\x69\x43\x5c\x87\xde\x77\xb9\x31\x3a\x98\xa6\x93\x1c\x21\x76\x4f\x4c\x4b\x07\x32\x04\x1d\x42\xf6\x2b\x2f\x83\x94\x71\xdd\x3e\xb1\x3a\xa0\x29\x3f\x24\xe4\x05\x3d\x94\x29\xaa\x4f\x6e\x7e\xf7\x7c\x8d\x34\x1a\xf4\xc2\x9e\x24\xb7\x32\x26\x0a\x8e\x54\xb8\xb0\x1b\x99\x7d\x30\x8f\x69\xdf\x1f\x6b\xd7\xbe\x77\x33\x62\x74\xfb\x45\xb5\x4f\x8d\x61\x36\xad\xde\xaa\x4c\x19\xa3\xdc\x2d\x12\xf0\xb1\x24\x0e\xf2\x89\x30\x4a\xae\x15\x4d\x1d\x97\xa4\x86\xef\xd3\x83\x74\x3e\x3a\x78\xd3\xef\xc5\xeb\x3d\xb7\x16\x35\x94\xdc\xb3\xa4\x01\xd8\x38\x7b\x40\xc9\x8b\x34\x43\x17\x51\x2c\xb0\x60\x09\xe0\x23\x22\xd4\x4f\x5c\x54\x5d\x6f\xcf\xd2\xb9\xd7\x38\x64\x57\x6c\x5f\x79\xd9\x6b\x41\x9b\xed\x22\x94\xb6\x79\x7b\x30\x7c\x1b\x2a\x2c\xef\x6e\x7a\xa2\xb5\x33\x4e\x79\x4f\x6a\x6d\xd2\xdc\xe6\x9d\xec\x07\x99\xe0\x48\x76\xf3\x60\x28\x31\x41\xef\xbe\x53\xf9\xb9\xe7\xa4\x72\x3e\x07\x31\xd1\xa9\x95\xe1\xeb\x84\x20\x6b\x36\xea\xea\xd3\xf5\x72\x32\xd9\xed\x3b\x3b\xbd\x6c\x9c\x17\xe4\x2a\x5e\x3c\x35\x6d\xf1\x47\x8c\x2f\x9d\xb2\x93\xe8\x53\xd9\x69\xca\xe1\x1a\xc4\x02\x63\x05\x19\x07\x09\x86\xa9\xdf\xfb\x33\x07\x12\x28\x10\xe0\x69\x72\xae\x05\xdd\xe5\x5a\xc8\x3c\x27\xb0\xc6\x91\xa7\xcb\x35\x11\xa3\x83\xfb\x7e\x5e\x2e\x4b\xec\x95\xb5\x1a\x99\xa4\xff\x53\x3f\xbb\x72\xbb\xa7\xd9\xff\xfa\x71\x99\xfd\x55\x3f\xe6\xd6\x79\x74\x35\xbc\x9e\x62\xd7\xe1\x49\xad\xdf\xcb\xf3\x6c\xb7\x77\xf9\x60\x18\x51\x31\xe5\x25\xd2\x2b\x7d\x24\xf7\xfe\xfe\x09\xe7\xf1\x54\x8f\xd9\x31\x39\x57\x09\xc9\x0d\x78\xdc\xfa\x43\xdd\xdb\xa2\x88\x5d\xd4\x81\x96\xb2\x63\xc5\x23\xa5\xd2\xfa\x3d\x8f\xdd\xcc\x6b\x12\x43\xb4\xe9\x29\xf7\xb5\xe4\x74\x6a\xba\xee\xae\x50\xde\xf5\x1d\x8a\x07\xf1\x3a\xa4\x4f\xd9\x8b\xe1\xba\xe5\xaa\xc0\x05\x84\xec\x0a\xba\x8b\x12\xbf\x67\xd7\x9b\xe2\xda\xfd\x03\x63\xca\x27\x85\x75\x90\xfc\x64\x04\xd7\x0b\xcc\x63\x1a\xd6\xd3\x18\x34\xfb\xe4\x99\xd8\xb0\xd3\x08\x69\x34\xf0\x51\x37\x1d\xe4\xc7\xdd\xdf\x79\x57\x06\xdd\x3f\x6a\x2a\x5f\x25\x56\x86\x70\x37\x33\x9e\xc8\xc0\x4f\xe2\x01\xee\x9f\x31\xc7\x03\x6f\xa6\x46\x08\x64\x5a\xe5\xbe\x67\x0e\xb3\xa3\x35\x1a\xe9\x72\xbb\x00\x5d\x59\x45\x3f\x1e\x38\x59\xf3\xeb\xbe\x8b\x44\x36\xe0\xba\x99\xde\x37\x0f\x5e\x56\x4f\x4e\xcc\xfa\xf4\xb0\xbf\x12\xe2\xfd\x51\xcb\xfe\x88\xd0\xa7\x23\x79\x12\xc4\x14\x36\xfd\x4e\x65\x39\x83\xde\xb1\x6c\x56\x84\x51\x1d\x48\x86\x71\x70\x05\x0b\xb5\x3d\x29\xa6\x77\x17\x46\x86\x8f\xa6\x8d\x9b\x6a\x30\x8d\x6a\xf8\x2c\x60\x1d\xac\x0b\xa0\xa3\x25\xf3\x6c\x78\xcb\x75\xc3\xc6\x1b\xe1\xa2\x87\x4a\xf3\x54\x39\xb9\xa2\x60\xc7\xd4\x9d\x38\x2e\x11\xc3\x92\x31\x1f\xe2\xd9\xb3\x26\x62\xc0\x41\x9c\x4f\x44\x6e\xdb\x27\xe5\x30\x1f\xcf\x46\xd9\x3d\x4d\x84\xe9\xa4\x4c\x7f\x3c\xd8\x34\xf2\xe4\x60\xaf\x2b\x64\x9f\x3e\x60\x96\x69\xdd\x13\x9b\x3f\x06\xb4\xbb\x46\x41\x70\xeb\x34\xaa\xe2\x2f\x18\x49\x63\x19\x4b\xc1\x2e\xe6\xaa\x14\xc4\xe1\x9c\xd1\x1e\x61\x14\x96\x27\x8e\x71\xef\xd3\x95\x5d\x69\x2d\xd3\xfb\x73\x03\x19\xe5\x89\x16\xf7\x59\xcb\xd1\x48\x4c\x35\xc1\xc4\x2f\xdc\xa5\x12\x4f\xe5\x3e\x7e\xe8\xae\x2a\x35\x65\x9d\xe6\x07\xf7\x68\x8e\xe2\x95\x6f\xd4\x02\xbe\xb2\x0b\x2b\x1c\xd4\xab\xcf\x43\x39\x8d\xb9\xe1\xc5\x3d\xdc\xc2\x90\xa8\x58\x43\xbb\x1f\xa5\x07\xeb\xf7\x95\xc8\xb6\x4f\x76\x3a\x32\x03\xd1\x2d\x2e\xe1\xcd\xda\xd5\x83\x53\xac\x45\x24\x7d\x40\x66\x7c\xa0\x8e\x42\xf0\x80\x51\x4e\x65\x75\xf6\xe4\x3c\x1d\x43\x7b\xaa\x88\x9e\x6b\xe6\x8d\xae\x0d\x09\x1e\xa5\x7a\xc7\x23\x97\x71\x52\xb9\xc9\xf7\x45\x9c\x95\x1b\xe4\x0c\xd2\xea\x70\x15\x9d\xc9\x3b\x63\xdc\xea\x39\x7a\x7a\x40\x5b\x90\x03\xe0\x9d\x39\x3c\x47\x2b\x70\x6c\x6d\xaa\x00\xb3\xc8\xda\x09\x83\xa5\x80\xcf\xd5\x9d\x3d\x3c\x25\x7b\xc6\xda\x21\x27\xa0\x4a\xef\x09\x90\xe0\x70\xda\xe4\xdf\x61\x1d\xaf\x9c\x7b\xa0\x21\x54\x8a\x0e\x2a\x31\x99\x64\x75\x83\x62\xf4\x62\x36\xa8\xad\xec\x02\x97\xbe\x0a\xe3\xa3\x5d\xd2\xec\xa4\x2d\x57\x99\x28\x39\x35\xb4\xac\xe3\x75\x94\xd0\xbb\x16\xef\x8a\x2b\xb0\xbf\xc2\xda\x63\xa6\x18\x23\x05\xf4\xa7\xba\x8a\xbf\x58\x79\xbe\x1c\xee\x27\x29\x87\xcf\x22\xa2\x1f\x6b\x63\x8c\x1c\x22\x7f\xaf\x27\xf0\xc8\x67\xdf\xff\x84\x09\x4a\xf7\x5c\x1e\xa0\x7d\x95\xf0\x5c\x71\x39\x27\xf7\xe8\x06\x17\x14\x35\x40\x4a\xc1\xdd\x2f\x02\x5c\x9c\xd6\x93\xa1\x8b\xc8\x64\xa1\xa7\x36\xf4\x72\x32\xae\x4f\x6e\x5a\x12\x5e\x80\xdd\x9f\xc1\x5a\x95\xca\x8d\xde\x29\xc2\x8c\x1f\x9b\xbc\x8f\x65\xa4\x4a\x64\x31\x4b\x65\xa4\x8b\x1a\x9d\x4c\xc1\x7e\x80\xd3\x18\xd4\x1e\xb9\xbd\x8e\xce\x27\x38\x70\xe8\x42\xd9\x67\x24\x58\x33\x25\x72\xc7\x28\xa5\x54\x46\xe8\xa1\x52\xa4\xdc\xf0\x78\x4e\x88\x30\x70\x2d\x78\x83\xbe\x20\x37\x5d\x10\x67\x45\x08\x50\xed\x26\xc2\x86\x1b\xa0\xba\x33\x65\xfa\x8d\x9d\xf5\x82\x9e\xb6\x1f\xa3\x80\x67\x43\x68\x11\xe3\xd6\x2e\xc6\xc2\x66\x0a\xff\xfd\x73\xc3\x33\x73\x7f\x28\x2f\xb7\xbb\x63\x8b\xc1\xcf\xf8\xc4\xb5\x5d\x9b\xce\xa1\x4d\xf6\xf6\x74\x2c\xe8\x31\xc1\x12\x4c\x6b\xe2\x55\xab\x99\xe5\xb2\xd0\xf3\xd1\x2d\x09\x6d\x65\x17\x6d\x55\x16\xcd\x07\xfb\x0b\x64\x4d\xcf\x04\x1c\xf8\x59\x0f\xf6\xdf\x7e\x05\x57\xbc\xf8\xc6\x2d\xae\x2b\x40\x43\x35\x46\x05\xb7\x5c\xe4\x80\x0c\xce\x87\x31\xf1\x2d\x46\x29\x94\x5f\x78\x00\xe8\xfc\xf5\x99\xe0\xde\xf0\xcd\x93\x21\x40\x99\x5e\xc3\xf2\x3c\xe6\xe9\x59\xbb\xb1\x23\xe0\x39\x1e\x9d\xe7\x21\x5e\xef\x40\x46\x9c\xe1\xba\x70\x11\xee\x6d\x38\x16\xda\x51\x43\x09\x80\xe7\x9b\x57\xda\x4b\x9e\x0c\x1e\xf8\xec\xa8\x3b\xf8\xa4\xa1\x48\xaf\x2d\xbf\x9c\x19\x63\xb6\x73\x39\x07\x8c\x52\xe7\x70\xb2\x67\x49\x6d\x61\x86\x78\xf9\x59\xfe\xb7\x08\x85\xc7\x54\x96\x26\x6d\x15\x07\x9d\x67\xd6\xd3\xbe\x9a\x2e\x0e\xe3\x00\x9a\x00\xfe\x87\xdb\xa6\x4b\x97\x42\x07\x72\x02\x32\x15\xee\x02\x90\x79\x9e\xc8\xcc\x72\x92\x99\x31\x02\x32\xb5\xde\xf8\x67\x9e\x9c\x8f\xe0\xfe\x1a\xca\xcc\xa4\x88\x86\x6b\x14\x6c\x7b\x42\xab\x01\x9c\x83\xc5\x4b\xf9\xde\x2f\x22\xc6\xb1\xac\x06\x80\x53\x1e\xd5\x46\xe5\x78\x80\x3f\x9b\xae\xf0\xc4\x3d\x3c\x5b\xa4\xed\x59\x04\x70\x28\x84\x05\x1b\xb2\xee\x8a\xb8\xee\x4a\x92\xee\x56\x82\x7d\xab\x04\x03\x3e\x71\x96\x1b\x60\x96\x5b\xc2\x60\xee\x57\xf0\xc0\xf9\xd8\xa9\xbf\x00\xd9\xfc\x0a\x5e\xf9\xcf\xf0\xec\xff\x14\x9e\x59\xb0\xf4\x26\x1f\xd7\x83\x49\x5b\x3e\x2d\xa1\x7f\xa9\x2e\xe2\x65\x01\xfc\xc9\xbe\x79\x48\x86\x67\x62\x4d\x64\x90\xaf\xa2\xa7\x83\x0d\x68\xdf\xd6\x6b\x75\x05\x6c\xe3\x2e\xd9\x42\x89\x1b\x00\xae\xe5\x05\xb3\xee\x66\xb3\xee\x79\xf3\xd1\x33\xe4\xa3\xa7\x63\x20\x98\x70\xc6\x2d\xe1\x8e\xae\x35\xeb\x8e\xf2\x33\x3c\xc0\x9b\x5b\x72\x46\xaa\xa8\xb1\x7f\x05\xcf\xfe\x67\x78\xe5\x7f\x0a\x6f\xdc\x70\xd7\xb0\xdf\xd1\xc5\x4d\x47\x79\xe6\xa5\x8f\x5e\x69\xcb\xef\x75\x6f\x7b\x7b\xd9\x9f\x0b\xe6\x05\x06\x8f\x41\xc5\x1a\xa2\x27\x58\x91\x4f\xc3\x66\xe7\x71\xa1\x40\x66\xeb\xd2\xab\x45\x03\xc7\x70\x48\x1c\xcf\xe6\x4e\xfb\x5b\x98\x48\x46\xe4\xce\x86\x2d\x9a\x04\xda\x53\x0e\x1c\x95\x7d\xa4\x4a\xec\xe8\x7a\xa7\x70\x22\xe1\xe0\x51\x8a\xb3\xe7\x0a\x64\x2b\x50\xf8\x83\x87\x16\x01\x9a\xee\x50\x96\xd3\x34\xe1\x8b\xa2\x02\xdb\xec\x12\x58\x5e\xa1\xdf\x15\x4e\xd6\xcb\xc0\x26\x5b\x14\x0b\xa3\x62\x47\x61\xc0\x7d\xc7\x30\x3e\x22\x9a\x7d\xd4\xfb\xe6\x62\xba\xe6\xf5\x3a\x9a\xc6\x5e\x97\x17\x4d\xe0\xa8\x2e\x3a\xa0\x71\xe1\x39\x55\x81\x79\x18\xd1\xd5\xa5\xca\xf4\xd4\xcc\x8c\x54\x83\x26\x02\x94\xd1\xaa\x57\x7a\xd3\xf9\x91\x9a\xd8\xa8\xae\x28\x66\xcc\x07\x79\xcd\xa6\xe3\x0c\xdb\x3c\x88\x22\x99\xd2\xee\xce\xea\x30\x9a\x04\xa9\xa7\xde\xba\xa0\xcd\x41\x2c\x69\xf3\xdc\xc9\x87\xd3\xc9\x1d\xf2\x73\x74\xcf\xea\xfd\x84\x53\x12\x47\x25\x0c\x7d\xce\xf0\xfa\x74\x56\x09\x10\x94\xba\xf9\x98\x61\xa7\x10\x33\x6b\xe9\xbe\xb3\x50\x33\xf1\x1e\x43\x28\x79\x3a\x03\x8d\xd3\xee\x48\xc6\x26\x16\xcd\x53\x8d\x93\xfe\x94\x23\xac\x66\x63\xd0\xa8\x21\xe9\x2e\x97\x6f\x71\xcc\x94\xad\x13\x7b\x9d\xeb\x3c\xb0\xb8\x3d\x09\x56\xe7\x1f\xb0\x69\x6d\x89\xbd\xd8\x61\x71\x95\x5d\x0c\xdd\x3d\x25\x33\xe2\xc2\xe2\x9d\x8a\x1b\x12\x82\x2c\xdb\x9a\xf4\x7c\x65\xc9\xe1\xb2\xd7\x04\x22\x56\x24\x51\x72\x54\xc5\xdb\xc9\x34\xa8\x72\xfb\x08\x56\x3b\xf9\xa2\xc6\xee\xa1\x15\x17\x13\xa6\xd9\xe8\x1e\x6b\x0b\xd9\x45\xa6\x9a\x58\xcf\x22\x4b\x58\x39\x14\xa6\xf3\xdc\x09\x7b\x64\x90\xa3\xaa\xe0\x03\xa0\x67\x3a\x7d\x96\xcc\x8c\xd6\x1f\x84\x79\x5b\x93\x65\xc0\x6f\x41\xc4\x8b\x38\x2e\xa6\x77\x5f\xdf\x0f\x93\x9f\xee\x58\x7a\xc6\x54\x35\x98\x83\xee\x36\x46\xde\x5a\x83\x32\xec\xce\xd5\x41\x92\x29\x48\x1b\xdc\x1c\x3d\xd2\x70\xb6\x45\x02\x5f\x2f\x63\x1d\x15\x12\x74\x6e\x22\x36\xac\x4d\x8b\x7d\x64\x88\x7c\xb4\xb5\x4b\xb2\xc4\x75\xe0\x26\x87\x87\xa4\x96\xa8\x60\xda\xd8\xb5\x9e\x14\x7c\xe5\x84\x67\xc6\xd8\xac\xf0\x98\x4d\x36\x32\xb9\xe2\x8a\x9e\x5d\x87\x23\x68\x1c\xc2\x20\x57\x4e\x33\xf1\x58\xf4\x3b\xc1\x8e\x47\x0b\x3f\x5e\x0e\x05\xa2\x65\x93\x62\xa9\x27\x3e\x43\x0b\xb2\x89\x10\x85\xda\x63\xdc\x13\xb3\xce\x0f\x97\xb9\x73\xac\xc9\x0c\xc0\x8e\xe7\x5b\xe2\x00\xed\x7f\xcc\x69\xeb\x95\xd7\x53\x23\x1e\xcf\xb4\x57\x6a\x4b\x29\xda\xae\xe1\xdf\x4a\x9d\xca\x31\x65\x22\x0d\x63\xdc\x4f\xe4\xe1\x36\xe4\x6c\x7e\x7a\x46\x21\xbc\xb3\x52\x56\x6b\xd7\x58\x61\x1a\xcb\x86\x4f\x23\x50\x90\x18\xba\xd4\x33\x6f\xc8\xa3\xc2\x59\xf1\x5d\x90\xc9\x21\x61\x51\xb4\x5c\xce\x28\xb3\xe7\x29\x74\x1a\xe8\x45\x26\xb6\xf6\x50\xe1\x04\x83\x0d\x55\x0b\xba\x5b\x18\x9e\xc5\x8d\x38\x3d\x6b\x75\x4b\x21\x94\x8b\x14\x84\xa7\x3d\x0e\x46\x1f\xde\x35\x9a\x17\x58\xbe\x2b\x67\x53\xba\xd8\xb3\x36\xfd\xc9\xdf\xaa\x29\xd3\xe5\xaf\x95\x4f\x54\x97\x5f\xca\x0d\xe4\xc7\xa7\x5c\x75\x90\x29\xa0\x6c\x7f\xf0\xce\x1e\xf9\x28\x00\xdf\x82\x96\x10\xeb\xda\x14\x78\x81\x61\xf7\x38\x09\xf2\xf1\x58\x6c\x6e\x21\xb4\xe3\x39\x86\x66\xd6\x5d\xb0\xd3\x55\x33\xd3\x0b\xa1\x0b\x2c\x96\x8f\xa3\xf0\xcc\x71\x6d\x16\x08\xcc\xf6\xe4\x91\x21\xa1\x2e\x95\x75\xe9\xa2\x55\xbd\x60\x51\x85\xad\x37\xac\x01\xc2\xcd\x94\xfe\x16\xa4\xc2\x11\xd3\xab\x73\x9d\xc2\xd1\xcd\xeb\x73\x3a\xa5\xa1\xeb\x11\xed\xf7\xeb\x8c\xf9\x0f\x4f\x2c\x09\x78\xb8\xf6\x3b\x23\x69\xf2\xa4\x8e\x31\xa7\xba\x1a\x66\x79\xba\xb8\x34\xbf\x90\x30\x2c\xb4\x8a\xe2\x8c\xa7\xab\x1a\xf4\xd5\x78\xf5\x91\x3b\x49\xfa\x75\xab\x04\x9d\x7e\x49\x6e\x07\xeb\x3c\x8a\x52\x14\x75\x3c\xe4\x10\x19\x6a\xe5\x4e\xa9\x7a\x2a\xf5\x9c\xc9\xe3\x3a\xae\x0f\xed\xde\xb5\x23\x9b\x87\xec\xcc\x62\x82\xc7\x59\x0d\xea\x7a\x52\x57\x51\xea\xe4\x5e\x6b\xfc\xc2\x08\xed\x91\xd0\xce\xd4\x60\xfa\xc5\xf6\xc0\xf9\x6b\x43\x5d\x54\x09\x66\xc6\xc5\x9c\x7d\xb4\x38\xc7\x86\x5d\xaa\xf2\x65\x85\x6b\xeb\xac\xb6\x46\x47\xb3\x27\x8e\x9c\x91\xc5\x0e\xd0\x7b\xd0\xc4\xa8\xa6\xb5\xd7\xc1\x77\x67\x3d\x63\xab\xba\xf2\x95\x6b\x93\xf1\xb3\x31\x8b\xab\xbe\x13\xcc\x91\x34\xac\x10\xe5\x96\x4c\xcb\x2e\x8b\x4f\x1e\x5c\x1a\x6b\xb2\xbd\x6b\x10\x32\xab\xc1\x67\xa7\xf5\xac\xd2\xb1\x1a\xbf\x5c\xb8\x1c\x2a\x61\x60\x61\x2e\x1b\x56\x89\x8e\xc2\x9d\xab\x8e\x69\xbf\x5b\x96\x5c\xb9\xb0\x77\x91\x0f\x6b\x0d\xbe\x81\xc2\x1c\x6b\x25\x3e\x9f\x31\xb7\xbd\xeb\xb8\x9b\x8a\x96\x89\x66\x31\xc9\x65\xb2\x32\x45\xb8\x2a\x83\x3a\x3f\xe7\x4b\x5d\x9e\xb3\x0c\x2d\x0f\xe9\x12\xd9\xc5\x45\xc7\x09\x81\x3e\x93\xc8\x43\x64\xcb\xc6\xa9\xef\x7b\xa7\xe7\xf2\x65\x1a\xe0\x46\x3a\x0d\x77\x87\x77\xd9\x58\xc7\x45\x7e\x7a\x84\xf7\x71\x67\x58\xa4\xe6\x30\x07\x80\x5d\xab\x2b\x4a\x6b\x06\xc2\xed\x52\x88\x1a\x32\xef\xfb\x68\x39\x62\x0c\x6b\x79\x42\xc8\x52\x36\xc2\xee\x51\x4c\x70\x52\x87\xc5\xf3\x91\xb0\x65\x08\x14\x40\x73\x5b\x9b\x81\x49\x77\x13\xc3\xe2\x6e\xc8\x33\xc5\xbe\xbc\x79\xe7\xfa\xd9\xcb\x63\xb4\xf0\x08\xc8\xfe\x53\x4c\x36\xe1\x1a\x3b\x9f\x71\x85\xc8\x45\x4e\x6c\x4c\xc5\xe9\xfd\x75\x19\x24\x65\x8e\x70\xb1\xd4\xc5\x03\xc5\x58\x21\x34\xef\x23\x75\xe6\x13\x9d\x9a\x53\x7e\x5a\xbd\xcb\xf1\x96\x89\x53\x33\xac\x3d\xf5\xd0\x56\xd2\x05\xa9\x89\x53\x5b\x26\x8e\x0d\xeb\x2d\x55\x0a\xf7\xa0\x93\x45\x4b\x9e\x11\x86\x83\x0c\x8d\x57\xf5\x93\xcc\xaf\x25\xdf\x6a\x36\x04\x57\xcf\xeb\x51\x41\xaf\xc6\x02\xe5\x83\xca\x23\x92\xdb\xa9\xd0\x33\x92\x8d\x54\xb7\x79\x3a\xd9\xdf\xf7\xdc\x72\x1d\x76\x23\x0b\x2a\x21\x0d\x82\x6f\x23\x7b\xba\x58\xd5\x9f\xfb\x36\x42\x95\x76\x7f\x17\x16\x7f\x17\x16\x7f\x17\x16\xff\xe5\xc2\xe2\x76\x11\xb7\x1f\xc3\x3d\xfc\x52\x08\x78\xca\x74\x14\x4f\xe0\xbe\x45\xe8\xee\x45\xd4\xcb\x13\x67\x8b\xde\xbf\x57\x58\xfc\x0c\xcf\xf8\x4f\xe1\xfd\x37\x0b\x0b\xd9\x00\x89\xbb\x51\x5a\x88\x2e\xfe\x52\x08\x58\x37\x16\xb7\x3d\x70\x5f\x28\x67\x30\xb7\x80\x82\x40\xb4\xff\xbd\xc2\xe2\x17\x78\xde\x7f\x0a\xef\xff\x4c\x61\x51\x21\x13\x43\x29\x5b\x61\x91\xff\x28\x2c\xf2\x83\xa1\x45\xf3\xc1\x96\xf4\x2b\x34\x99\xac\xa2\x2b\xb3\x5e\x7a\x0a\xd5\x3b\xf0\x29\x78\x54\xb2\xa0\xc9\xf9\x2d\x51\xcb\xc7\x72\x41\xf1\xf8\x20\x19\x0c\x4a\x24\xe9\x6e\x7b\x12\xcc\x1e\xe8\xee\x0d\x2f\x29\xd7\x10\x48\x47\x6e\x91\xd3\x79\xaa\xef\x68\xe9\x68\x79\xf5\xd0\x85\xc8\xa2\xa9\x6c\x35\x83\x38\xa0\x4a\x1c\x78\xc1\x03\x19\xd5\xc9\x44\x30\xf4\x3a\x36\xa3\x0e\x31\xc4\xdd\x3b\x05\xa7\x3a\xdc\x75\x63\xdc\x8e\x33\xbd\xe8\x8d\x74\xd9\xb3\xfa\xc1\x3c\x70\x87\xab\xb0\x3e\x88\xf1\xee\x93\x3b\x68\x14\x88\x47\x0b\xad\x94\x70\xd4\xb9\xa4\x85\x12\x94\xec\xc9\x9b\xa4\x1f\xb0\x22\x20\x90\x1b\xb7\xe2\xfa\x4e\x33\xc9\x69\x16\x27\x1c\x96\x17\x14\x7b\x04\x2b\x87\xed\x8f\xd4\xf5\x5e\xdf\x2f\xfc\xb1\xbe\x8a\xd7\x90\xf2\x23\xdc\x98\x8f\x4f\x1e\x07\x0c\x34\xdd\x36\x45\x17\x55\xc4\x54\xc4\x4c\xcc\xc9\x38\xf6\xfb\x64\xa6\x18\xd1\xb9\x3e\xf0\x87\x5a\x16\x37\x8e\x80\xfc\x2c\x1d\x95\x65\x3f\x8b\x3c\x8a\x7b\x4e\x9d\x4e\xc2\x43\x40\x59\x6e\x1e\xa2\x50\xb3\xcb\xeb\x99\x5c\xf5\xc7\xed\x2c\x57\x39\x05\x71\xcb\xc2\xf6\x73\xd0\x1c\xcd\x46\x83\x2d\x74\x97\xc9\x72\xad\xda\xe1\x44\xaf\x1e\x77\x08\xdd\xc2\x21\xac\xe3\x11\x91\x0e\xcd\xa0\xb0\x3a\x9d\xc7\x45\xa8\x9c\xf7\x59\x41\x1b\xb1\x63\xf4\xbb\x0a\x54\x28\x01\x7d\xe0\xfc\x1c\x6d\x6f\x07\xd6\x46\xf6\x91\xeb\x18\x40\xa3\xef\x27\x5a\x98\xe3\x5b\x06\x37\x4e\xc0\x3d\x7b\x6e\x4e\xb4\xe8\xa1\x20\x45\x9e\xf2\x5e\xce\xf8\xbe\x21\x0f\x4f\x76\xe2\x13\xb1\x8c\x18\x6b\xe1\x1c\x29\xac\x19\x57\xb8\xb6\xe6\x05\xd1\xbc\x0c\xe1\xf6\x28\xad\x14\x93\xc8\xda\xee\x89\xb5\x8e\x6b\x2c\x01\x9c\xf6\x97\xbb\xd6\x86\x4c\x38\x06\xa4\x86\xc8\xd7\x0e\x8e\x31\xb4\xc7\x4d\x8f\x3c\xa3\xd5\xb9\x3b\xd1\x1a\xdb\x3e\x27\x98\xef\x90\xc6\xbf\xf6\xc1\x59\xc4\x1e\x17\xf9\x31\x9d\xf7\x6b\x70\x47\xd7\xb5\xca\xd2\x7e\x68\xab\x93\x34\xf6\x85\xa0\x45\xb6\xad\x4a\x2e\x7b\xe6\xe7\x19\x95\x21\xb5\xd2\x7b\x5f\xca\xcf\xe6\xe3\xb8\x76\xe7\xfd\x61\xe2\x66\x90\xb9\xa9\x23\x6a\x53\xf5\x24\xad\x58\x35\x31\x10\x21\x68\x3c\xa4\xe3\x5e\x87\xda\xd3\x09\xce\x30\x8f\xa0\xfd\x43\x23\x51\xc9\xf8\xec\xb8\x63\xeb\xc3\x6c\x14\xa5\x37\x48\x00\x98\xea\xaa\x82\xc1\x57\x17\xa4\x00\x18\x7a\x66\xd1\x9a\xd8\x33\xb1\x65\x8d\x79\x50\xc0\xa3\x08\x51\xe7\x24\x8b\xd7\x86\x64\x6d\x96\xb1\x4c\xee\xc9\x75\x6b\x7c\x87\xfc\xf1\xbe\x80\xf2\x24\x5a\x2d\xd8\xad\xba\x07\x59\x55\xb3\x96\xa7\x4c\x24\xe0\xb1\xa4\x3f\x19\x3f\xb9\x69\xfb\xbb\x36\xee\x1c\x4d\xe6\x82\x3d\x1b\x14\xa7\x01\x29\x8d\xe3\x9d\x0d\x6d\xf7\x1c\xf1\xe9\x2d\x2b\x26\x78\x95\x73\x49\x76\x54\x24\x9c\x76\xab\x1f\x8d\x57\x15\xda\xbe\xb3\x6f\x4e\xac\xc0\x76\x84\x7c\x97\xff\xdc\x8f\xc0\x57\x6d\xf6\x47\x1f\x4e\xf8\x1f\xbf\xca\x2a\xba\x31\xdb\xcd\x75\xf5\xbf\xfe\x03\xe3\xc1\xe5\x07\xb8\x6c\xba\x7f\xfc\x94\xf7\xfd\xfd\xff\x86\xa0\x69\x9a\xbe\x26\xec\xab\x7d\x66\x10\x0a\xc3\xf0\xb6\xf8\xa7\x8f\xb1\x48\x27\xae\x9d\xff\xf1\x13\xfc\x01\x7f\x10\x04\xf6\x81\x62\xc4\x17\xf6\xd3\x7f\x60\x22\x80\x91\xa4\xd7\xee\x7d\x05\xfd\x72\x79\x0f\xfb\xfc\xe3\x5a\x54\xd5\x3f\x7e\xfa\x0f\x14\xbb\xbe\xfe\xfd\xf4\x91\xfc\xe3\x27\x1d\xc5\x98\x0f\x12\xcb\x11\xea\x8b\x1e\x11\x98\x00\x19\x23\x73\x22\xb1\xb5\x06\x10\xc9\x0f\x38\xc7\xc8\x2f\x2c\xa6\xbe\x18\x70\x10\x82\x7f\x11\xe0\x02\x61\xc0\x04\xfa\x45\x76\xcc\x17\xfa\x81\x7f\x21\x1f\x08\x0a\x06\xea\x0b\x09\x51\xfc\x0b\x27\x3e\xde\xe3\x86\x19\xf2\x41\x82\x25\x08\xbc\xad\x83\xbf\x28\xe2\x7b\x7c\x4f\x21\x5f\xf4\x7b\x27\x0e\x0e\x62\xbe\xc7\xd7\xd4\xe7\xf7\x14\xf3\x81\x92\x5f\x04\xfd\x3d\xbe\xa7\xbe\x01\xd2\x1f\x18\xfc\x45\x30\xdf\xe3\xf7\xae\x37\x1a\x58\xfc\x49\x7c\xbd\xc0\x7f\x22\xc8\x17\x40\xe7\x8b\xfa\x04\x28\x63\xdb\x45\xbe\x5d\x21\x27\x84\xa4\xc1\x15\xf5\x45\xbc\x09\xdd\x60\x12\x48\x88\xd1\x5f\x18\xf5\xf1\x1e\xe1\x37\x4c\xe4\x6b\x43\x06\xfb\x40\xc8\x1f\xc3\x7b\x82\x04\xc0\xb1\x2f\xea\x03\xc1\xbe\x30\xfc\x7b\x7c\xcf\x60\x5f\x04\x38\x1e\xe0\xcc\x6c\x94\xbe\xc7\x6f\x60\x9f\xd4\xc6\x37\x40\x26\xfd\x3d\xbe\xee\x7f\x6e\xf7\x01\x24\x74\xfb\xff\xbe\x83\x7d\x91\x00\x06\xf1\x01\x30\x44\x7e\x8c\xef\x19\xf2\x8b\x01\xb3\xf8\x07\x8e\x6c\x1c\x79\x8f\xdf\x50\x60\x30\x85\x7c\xa1\x80\x2e\x1a\xd0\x85\x20\x78\xbe\x5d\xac\x35\x02\x28\x66\x3e\x09\x64\x44\x80\x86\xe4\x9f\x38\x10\xda\x09\x81\xe9\x1c\x07\xd4\x6c\xf7\x70\x70\x0f\x5c\xfe\xcc\x13\x1a\xf0\x24\x27\xa9\xb5\x46\x37\xb6\x50\x40\x0a\x31\x20\x07\x1c\x0c\x48\xc3\x36\x8e\x30\x80\xc7\x80\x66\xa2\x03\x47\x02\x4c\x36\xdc\x69\x40\x18\xb8\x08\x31\xa0\x15\xc8\xc7\x7b\xfc\x26\x1a\xfe\xe6\x20\x0a\x04\x85\x7e\x8f\x3f\x78\x48\x02\x5a\x88\x5f\x53\xfe\xcd\x3d\x74\x53\x31\x98\xfe\x1e\xe1\x9f\x25\x8f\x6f\x22\x40\x7e\x8c\x6f\xba\x81\x70\xf1\x4d\x27\xd1\x2f\x9a\xfa\x1e\xdf\x13\x00\x09\x30\x43\x6c\x8b\x81\x86\xbc\xc7\xf7\x0c\xd0\x8e\x8d\xc5\x1f\x18\xb1\xfd\x7f\xdf\xa3\xbf\x50\xb0\x03\xaf\x3e\x81\x2c\x71\x70\x45\x84\x34\x10\x1d\xf9\xf1\x1e\xbf\xf5\x0b\x7f\x6d\x04\x2a\x02\xd0\x44\xbe\xc7\xef\x29\xc0\x8a\xb7\xd8\xd1\x2f\xf4\xc7\xf8\x03\x6d\x0c\x40\x07\xfa\xba\x59\xca\x6b\x78\xdf\x47\xbf\x5e\x1a\x4b\x01\xed\xde\xfe\x7f\xdb\x04\x60\xd5\x27\xd8\x8d\x7f\x6c\x66\x47\x7c\x8f\xdf\x73\xe8\x4b\x2d\xc8\x0f\x12\x1c\xc4\x7c\x8f\xdf\x53\xc4\xa6\xec\x5f\x34\xb0\xd3\x8d\x93\x9b\x3e\x6e\x16\x04\x88\xfa\xd8\x48\x01\xa7\xe2\xe4\xc7\x7b\xfc\xf9\x1c\x00\x61\x33\x0e\xb0\x06\xf9\x1e\x7f\x58\x2c\xf3\xb1\x59\x0b\xfe\x32\x4d\xf4\x07\xbe\x40\x34\x40\x2c\x08\x60\x10\xb9\x91\xf9\x45\xc6\xe0\xc0\x8d\xe6\x4d\x21\xa9\x8d\x01\x28\xd0\x63\x64\xe3\x1f\xd2\x01\x82\x37\xb1\x60\xdb\x62\x64\xbb\x08\x71\x0c\x80\x7d\x0d\x3f\xab\x2b\x10\xcd\x66\xa6\xcc\x17\x4e\x7d\x8f\x3f\x74\x1c\x30\xeb\xeb\x85\x23\x89\x7d\x8f\x3f\xec\x82\x02\x6a\x46\x6e\x7e\x68\xd3\x0e\xfc\x67\xed\xf8\xdc\x20\x01\x55\x8e\xe1\xf7\x3c\x40\x75\x83\x41\x6d\xea\x87\x75\x1b\x45\x2f\x23\x05\x56\xfe\x85\x55\x9b\x17\x03\x04\xd0\xf1\x66\xdd\x1f\xe8\x1b\x87\x6d\x8a\x7c\x79\xae\x6d\x0f\xd1\x51\x3f\x9c\xd3\x76\x81\x02\x4a\x43\x0c\x79\x79\x07\xe4\x87\x77\xd8\x24\x88\x6f\x6b\xb0\xcd\x9a\x51\xea\x7b\xfc\x61\xe9\x38\x60\x22\xbd\xc9\x8f\xf8\x31\xfe\xec\xa4\xf0\x4d\xf5\x3f\x88\xd7\xff\xef\x9b\xd4\xcb\x3d\xe1\xf1\x4b\xfd\x36\xe5\xdd\x74\x10\xb8\x18\x40\x3c\xe0\x25\xd1\x6d\x9e\x8c\xd9\x14\x10\xff\x7c\xa9\xc1\x66\x9b\xc0\xbe\xd7\x9f\xa0\xdf\xf3\xee\x38\xc9\xa4\x21\xfa\xf6\xee\xc0\x41\x6e\x9e\x0b\xaf\x68\x60\xab\x00\xd4\x07\x8d\x7f\x6e\xbf\x4f\x24\x55\x7d\x7e\x5f\x7f\x7e\xcf\x8d\x0c\xf5\x07\x10\xc9\x30\x0e\x13\xe4\x0d\x11\xc4\x88\xcd\x06\x80\x61\x57\x9f\x9b\xb5\x03\xb2\x43\xa0\x6a\x08\xf3\xf1\x1e\xdf\xea\xb2\xd9\x32\x56\x91\xf0\xe6\x37\x5e\x2b\x00\xd7\x89\x8f\xf7\xf8\xad\x83\x1b\xd1\xf0\x6b\xc9\xe7\x1f\x01\x01\x22\xc7\x34\x04\xc5\x5e\xc7\xfd\x16\xc6\x9b\x71\xf0\x5a\xbf\x09\x40\x7f\x20\x44\x6e\x4b\x29\xec\xe3\x3d\xbe\x97\x6e\x3e\x0a\x21\xaa\x4d\xa4\x70\x08\x0c\x86\xa0\x3e\xde\xe3\xfb\x28\x74\x13\xf8\x17\xf9\x6b\x74\xfe\x15\xc4\x07\x00\xf1\x89\x10\x27\x0a\x38\xd4\x10\x85\x81\x33\xfb\x59\x11\x36\xeb\x23\xd7\x1a\xcc\x82\xd0\x04\x94\xe5\x37\xf0\x3f\xbf\xe1\x8f\x14\xbc\x4d\x03\x93\x78\x29\x30\x46\xff\x02\x7a\xc3\xee\x57\xfc\xfa\x3d\x0c\xb7\x53\xc6\xcf\xdf\x07\xf1\xf9\xc6\x4e\x03\xf1\x0b\x78\x68\x60\x80\xff\x86\x72\x20\xdb\x7e\x10\xa4\x2a\x6a\x8b\x98\xc0\x3c\xf1\x91\x26\xbf\x80\x62\xfc\xfc\xfa\x75\xf5\xb9\x5d\x9d\x40\x28\x00\xb2\xf8\xde\x51\xbf\x4c\x18\xab\x7e\xa5\x3a\xff\xaa\x61\xe3\x27\xf3\x8b\x8a\xfd\x9b\xc8\x00\xf1\x63\x5b\x08\xfb\x42\x59\x40\x3a\xb9\xf1\x64\x1b\xdf\x0c\x60\x00\xbd\x5b\x0c\x1e\xb7\xf9\xf0\xb7\xf3\xe0\x1a\x38\x22\x30\xe4\x7f\x3c\xff\x1a\xc6\xdf\x9d\xff\xfc\x79\x7e\xad\x41\x74\x02\x5a\x96\xff\x7c\x10\xf1\xf1\x1e\xbf\xbd\xcd\xe6\x5a\xe8\xea\xc5\x8a\xcd\xc7\x6e\x17\x5b\x04\xf9\x83\xa5\x9f\x34\x50\xd0\x2d\xb2\x6d\x8c\xc8\x3f\x37\xed\xfc\x97\xb3\xe1\xcf\xdf\xe0\x86\x60\x1f\xef\xf1\xad\x64\x20\x12\x03\xe5\xfc\x3e\x08\x04\x6b\x9c\xde\xb4\xed\xf5\x92\xd9\x96\x6f\x01\xf9\xeb\x47\x4c\x7c\x2d\xfe\x5d\x22\xe1\x5f\x98\x90\x7f\x91\xc0\x07\x7c\xa1\x1a\x42\x6f\x41\x89\x60\x00\x40\x12\xf8\xac\x4f\x90\xa3\x01\x39\xc3\xf0\x96\x76\xbd\x60\x53\x2f\x54\xa8\x1f\xa8\xbc\xa9\xff\x42\xf2\xdf\x65\xce\xc7\x9b\xe2\xea\xf3\x07\xae\xab\x8e\x6f\x3e\x99\x00\x6a\xf9\xbe\x09\xf6\x82\xc4\x03\xc8\x31\xff\x3d\x4e\xfc\x22\xc5\x6f\x31\x93\xaf\x28\x40\xfe\x12\x05\xb6\x38\x43\xad\x9b\x63\x03\xda\x82\xd3\x1b\x43\x37\x70\xe8\x0f\x79\x60\xff\xca\x8f\x8f\x6d\x07\x39\xfe\xae\x56\xc1\x1f\x3f\xe0\xfc\x81\x86\xc2\x34\x16\x11\xf8\x4f\xaf\x1b\x9f\xed\x3d\x8c\x8b\x7e\x01\x69\x37\x48\xc9\xfe\xc9\x84\xb6\xe8\xb2\x29\xfc\xe6\x14\x7f\xe8\xff\xf6\xea\xbf\x08\x15\xfe\x01\x95\xa1\x5f\xd9\x0e\x08\xe3\x3f\xcc\xe8\xe3\xb7\x30\xb7\x5a\x60\xbb\x82\xd9\xff\xf1\xdf\xa9\x52\xea\x22\xf9\xa7\x22\xe5\xbf\xba\xbd\x1c\xff\xfd\x1a\xe7\xff\x89\xf3\xf0\xd9\xa5\xfd\x3f\x86\xfe\x4a\xff\x28\x78\x0a\x40\xb2\x16\x2e\xe9\xf3\x7f\x03\x57\xf0\x5f\xad\x7e\xa8\xad\x1e\x80\xbf\x4b\x9f\xae\x5f\xaa\xf4\x9b\x3b\x55\xd1\xa4\xe1\x53\x7e\x86\x49\x91\x36\xfd\xeb\x10\xe7\x24\x2b\xc2\xff\x46\xfe\xf7\x4f\x1f\xd9\xf7\x6d\xaf\x29\x7a\x70\xda\xd0\xa5\x4f\x07\x88\x21\x3d\x36\x5e\x97\x02\x24\x90\x7f\xfc\xb4\x95\x05\x3f\x7d\x2c\xe0\x0a\x81\x41\xe8\x9b\xd1\x9f\x6f\x6d\x57\x5f\xf0\x26\xab\x1f\x60\xdc\x67\xd8\x74\xd7\xf6\x59\xff\xe3\xa7\x3a\xec\x9f\xc5\xfc\x7f\xbd\xd3\xa6\x4f\xe4\x95\x03\xa3\xff\xf3\x67\xfc\xda\xfb\x47\x7b\xbd\x6e\x1c\xf8\x09\xfe\xe9\x63\x7b\xfd\x19\xb7\x55\xfb\x7c\xa9\x45\x8a\xc6\x49\x8a\xff\x10\xef\x3f\x2d\xfe\x42\xa8\xdf\x2e\x87\xe3\x30\x45\x7f\x7f\x39\x86\x60\xbf\x59\x9f\x44\x31\x9c\x24\xbf\xbf\x1e\xc7\x7f\x0b\x3f\x41\x23\x24\xf9\x03\x74\x08\x8a\xf8\xcd\xfa\x98\x62\x92\x98\xfa\xfd\xf5\x24\x43\xff\x66\x7d\x14\xd2\x78\xc4\xfc\xfe\x7a\x1a\x61\x7e\xb3\x3e\x8c\x48\x3a\xfc\x83\xf5\x0c\x86\xff\x66\x3d\x13\xe3\x04\x38\xf6\xf7\xd6\x23\xbf\x5d\x8c\xa1\x21\x9d\xfe\x6c\x5a\xff\xac\x3d\xbf\xb2\xe1\xcd\x3c\xf1\x57\x8d\x52\x6d\x49\x1f\x08\x97\x27\x86\xd9\x6f\x45\x55\x8e\x83\x30\xf7\x6d\xe3\x9b\xfa\x03\xa0\x3f\x14\xee\x7f\xfe\x93\x1b\xf8\x77\x40\xfc\xca\x2b\x6c\xa8\x3e\xdb\x32\x7d\xa1\x49\xd3\xe0\x07\xfb\x71\xeb\x73\x2a\x92\x3e\xff\xc7\x4f\xe8\x6f\xe0\x83\xe2\xf6\x83\x01\x09\xee\x89\xda\x8a\x42\x90\x86\x57\x5b\xc9\x83\x6c\x05\xd7\x56\x5e\x6e\x17\xdb\xdd\x13\x83\xe4\x20\xc9\xa2\x4f\x20\xce\x83\xea\xe7\xe5\x36\xc9\xed\x0e\x70\xd6\xf8\xe6\x86\x49\xb0\x62\x4f\x6d\x85\x24\xba\xed\x85\xdf\xf0\xf0\x13\x0d\x57\x5b\xcd\x04\x2a\x9b\x1c\xec\xd4\xf0\x2d\x97\xa6\x98\xea\x9d\x35\xe7\x5b\x11\x57\x6d\x35\xec\x27\x28\x25\x5e\x15\x2b\x48\xe4\x46\x00\x18\x07\x70\x36\xca\x61\x0d\x07\x59\x0a\x48\xa6\x73\x90\xd3\x6a\xc4\x2b\x6d\x20\x2b\xfc\x9d\xe4\x52\xe0\x26\x48\x2d\xa8\x77\x21\x08\x3c\x3c\x78\xf9\x33\x67\xdf\xdd\x8b\x9f\xfe\x33\x23\x47\xff\x3d\x23\xdf\x6a\xa4\xb7\x91\x83\xcc\x0d\x46\xb1\xb7\x9d\x7f\xdf\x45\x7f\xb9\xfb\xdf\x32\xf4\xb7\xaf\xf8\x57\x7d\x23\x31\x9c\x41\xfe\x4d\xe5\xa4\x60\x04\x23\xa3\xff\x4f\xe5\xfc\x43\x4e\x60\xff\x26\x27\x10\x50\xce\x22\xdf\xbc\x40\xbe\x28\x84\x7c\xf3\x02\xd4\x99\xe8\xeb\x3e\xfa\xcb\xfd\xbf\x3e\x37\x7e\xb6\x03\x72\x0b\xcf\x04\xd0\xc4\xad\x5e\xa5\x3e\x81\xca\x6e\x59\x2d\x50\xca\x2d\xaf\x05\xbf\x40\x01\xf1\xf9\x2e\x5b\xb1\x2d\x3f\xdf\xba\x27\xaf\xd2\xd1\x41\xb7\x2e\x17\xb9\x19\x0d\xba\xcd\x91\xa0\x38\x03\x3b\x5f\x55\x2e\xd8\xf1\x2a\xcb\xb6\x0a\xf0\xf3\x95\x95\x63\x9f\xaf\x86\x10\xf1\x89\x7c\x6e\xe9\xcb\xab\x50\xa7\x3e\x5f\xf0\xb6\x53\xb7\x75\x5b\xa9\x02\x16\x6d\x4d\xad\xef\x96\xc8\x86\x08\x98\xdd\x02\xfd\x17\xf2\x2a\xd5\xb7\x06\xd1\x56\xb9\x83\x20\xff\x6e\x2e\x91\x9f\x60\x16\xdd\xea\x5b\xe2\x63\x6b\x24\x20\xef\x6e\xdb\x56\x1d\xbe\x6c\x63\xbb\x20\x5f\x80\xc0\xeb\xad\x39\xf4\xee\x8e\x81\x54\xa9\x06\x96\xc7\x6c\xd6\x8c\xc5\x00\xad\xed\xf6\x8b\xde\x0f\xe4\xb5\xe8\xc5\x06\xea\x4d\xfe\x06\x16\x1c\xbf\x75\xd6\xc0\xb8\x15\x9a\x9b\x15\x02\xbf\xb4\xbd\x06\x85\x33\xc0\x00\x14\xc1\xdb\x56\xf0\xea\x85\x01\xf5\x22\x89\x78\x77\x24\x00\xd6\x35\xfa\xea\xbd\x81\x84\x10\x89\x37\x54\x36\x2e\x21\x6f\x68\xaf\xa6\x14\xb1\x31\xe4\xd5\xd3\x7b\x37\xd3\xbe\x40\xae\x89\xbd\xf0\x00\x5b\x88\x13\xb6\x35\x9e\x00\x9e\xf0\xc7\xc6\x3a\xfc\x9b\x8a\x2d\x0d\x07\x09\xdc\x0b\xfb\x6d\x2f\xfc\xe6\xd2\x4b\x78\xbf\x86\xff\xda\x3b\xbe\x96\xbf\xfa\x7d\x5b\x5e\xc9\xc4\xbf\x59\xf6\xea\x56\xbc\x18\xfe\xea\x0f\xbc\x39\xb9\x21\xf2\xb9\x51\x18\x03\x66\x6c\xf5\xd5\x0b\xbb\x8d\x5d\x5b\xb6\x48\xc5\x6f\xdc\xdf\x52\x22\x5f\x42\x78\x6f\x79\x79\x50\x40\x2c\xc0\x18\x48\x68\x03\x84\xbf\x51\x06\xc7\xbf\xb7\xbc\xd9\x8d\xbe\xfe\x93\x9b\xa6\xbc\xc1\x30\x1b\x2d\x9b\xce\x6c\xb3\x40\x7c\x1b\xac\x0d\x67\xfa\x85\x30\xf2\x22\x71\xc3\x0f\xdb\x3a\x5b\xc4\x36\x20\x9b\x66\xbd\xf1\xfd\xdc\x90\xdc\xa8\xfd\xa1\x08\x20\x15\xac\x51\x7a\x53\x3e\xa0\x9c\xdb\xed\xb7\x98\x5f\xac\x02\xda\xfb\xb5\x89\xe7\x45\xd1\x1f\xa2\x82\xbd\x85\xb5\x01\x7e\x91\xf5\x6e\x8c\xbc\x59\xfe\x2d\x86\x1f\x4b\xe8\x6d\xdb\xeb\xe6\xc6\x0e\x90\x75\x33\xef\x33\xdf\x68\x63\x9f\x2f\x05\x46\x5e\x6d\x43\xe6\xd5\x8b\x7a\x75\xb8\x5e\x1d\xb3\x6d\xf2\x15\x0c\x36\x38\x5b\xfc\xd9\x12\x73\x6a\x83\x48\x6c\xfd\x9d\x6d\x23\xfe\x6a\x18\x90\xaf\x36\x0e\xa0\x48\xc7\xbe\xbb\x9c\x24\x40\xe6\xeb\xd5\xa3\x79\x69\xe3\x8b\xc5\xdf\x8c\x7a\xa3\xb7\x31\xfb\x25\x0d\x66\x43\x0e\x7d\x0b\x6b\x63\xd2\x26\xd9\xaf\x77\x1d\x04\xd4\x81\x79\x2d\x46\xdf\x7b\x5e\x44\xfc\xc2\xcf\x97\x57\x78\x21\x4b\x7c\xf3\x17\x79\xdb\x25\x00\x01\xd4\x69\xc3\xe1\x15\xfa\x36\xff\xb0\x99\xe3\x66\x9c\x9b\x4a\xbe\xc5\x4c\xfc\x0c\xec\xf3\x25\x17\xf4\x67\x68\x2f\xb9\x7d\x31\xaf\xbb\x6f\x06\x6f\x41\x19\xdd\x23\x54\xfc\x9a\x78\xcb\xe1\x97\xf5\xf8\xeb\x5c\xf4\x0d\x06\xdb\xa4\xf7\x82\xfc\xf1\xc3\x47\xbc\xac\x06\x5c\xc4\x5b\x6d\xbe\x09\xe8\xd5\xa7\x43\xbe\xf0\xef\x92\xec\x63\x5b\xbb\x21\xbf\x75\x15\xb7\x9e\xd6\xd6\xff\x04\x44\x6d\xd1\xfc\x0b\x79\xf5\xe4\x36\xd6\xa0\x6f\x5b\x7f\x7b\xbb\x8d\xb5\x2f\x3b\xd9\xbc\x05\xe0\xd9\xb6\x81\xfe\xd9\x90\x89\xb7\xb4\xd1\x57\x3b\x15\xd9\x2c\x8d\x88\xdf\xd4\xff\xc2\x8b\x17\x93\x5e\x2e\x22\x7e\x35\x1f\x5f\x98\xe3\x6f\x63\x7b\x9d\xf8\x82\xb2\x89\xf6\xf3\x05\xe8\xad\x4d\xf8\xc6\xd2\xcd\x0e\x36\xe6\xbf\x7f\x11\x2f\x35\xfa\x56\x87\xb7\xe5\x6d\xc7\x10\xaf\x63\xf0\x57\x0a\x41\xbf\xc1\x6d\x18\x6c\x32\x66\x3e\x5e\x6e\xf2\xeb\xd5\x45\x7d\xed\xdf\xde\x8f\xf8\x56\xda\x57\x5f\xf8\x65\x36\xf4\xbb\x71\xf2\xae\xc5\x37\xaf\x86\x7d\xbe\xdc\x36\xf1\x36\x42\x90\x61\xbc\xde\xcf\x20\x5e\x8e\x9f\xdc\x50\xfc\x15\x9e\x9f\x3f\xd3\x4b\x7d\xab\xf8\xe6\x37\xdf\x34\x81\x03\xea\x57\x71\x8b\xbd\x3c\x19\xe0\x19\xf6\xc3\xeb\x20\x3f\xcc\xe4\x17\xe7\xb4\xd1\x84\x8f\x9b\xa3\xdb\xa8\x79\x83\xdb\x5c\x0a\x3c\xbe\xf8\xfa\xf9\x3a\xfa\xcd\xec\x8d\x6a\xa0\x2f\xd4\xcb\x17\xe0\x39\xf2\xee\x79\x10\x6f\x37\xf9\x85\xbc\x95\xf1\xa5\x90\xc4\xcb\xeb\x7d\x22\x1b\xdc\x5f\xa1\x80\xff\x50\xeb\xd7\xaf\xdf\xb8\x9c\x4d\x76\x1b\x9c\x6f\xf7\xf0\x56\x2f\x6a\x23\xfa\x9b\x32\xe6\x87\xc3\x20\x5e\xa0\xe8\xcf\x1f\x10\x5f\xac\x25\xdf\x7a\xfd\x76\x19\x60\xeb\xf8\xd2\x0b\xea\xed\x6f\xf0\x6f\x6f\xf0\xc7\xdb\xe9\xf7\x76\x7c\xd5\x11\xe2\x83\x00\xc6\xf0\x2b\xe1\x53\x2f\x63\xd8\xc4\xf7\xc2\xf9\xa5\xa4\x2f\xb6\x63\x2f\xcf\xf2\x0a\x20\xf1\x5b\x26\xf8\x17\xfd\xe6\xe0\xfb\xbd\xa9\x97\x7b\xfb\x78\xbf\x61\x43\x7d\x7e\x6b\x2f\xfd\xde\xf1\x46\x75\x0b\xb0\x31\xf2\x63\x2d\xfd\xbd\x96\xf9\xd9\x95\xe1\xbf\x72\x65\xbf\xf8\xf9\x97\xcc\x00\x92\xe4\x3f\xcd\x12\x2f\xf3\x26\x5f\xfa\xfe\x52\xae\x97\x0f\xda\x5a\x14\x2f\xb5\xff\xc1\x6b\x1d\x01\x39\x04\xbc\x39\xc3\x6f\x6b\x00\xd4\x7d\xbe\x29\x65\x36\xb5\x7d\x47\xfd\x8f\x9f\xa3\x22\xf2\x4e\x11\xe2\x97\x84\xbf\x90\x6f\xb7\x85\x6d\x74\xfc\xe2\xbe\x36\xea\xbe\xe3\xd3\xcb\x8b\x6f\xe7\xbf\x49\xf9\xa2\x7f\x78\xbd\x2d\x34\xd1\xff\xe4\x3c\x5e\x11\xe5\xc7\xbd\xcd\x16\x5f\x9a\xf2\x1d\x5a\x5e\x3a\xc7\x7c\x63\x88\x7c\xbc\xa0\x6d\x2a\xa8\x23\xcc\x07\x89\xbe\xd5\x6f\x3b\xee\x15\x92\xc8\xb7\x38\xdf\x42\x46\xbe\x23\xce\xdb\x47\x7f\x93\xb1\x19\xd3\x66\x11\xd8\x0f\x47\x87\x7d\x7e\x07\xa1\x1f\x01\xfb\x25\xc3\x6f\xa7\xfc\xcd\xee\xdf\x2d\x9d\xb0\x5f\x4a\xa7\x3f\x4c\x70\xf1\x7f\x2f\xc1\xdd\x2a\x2e\x92\x79\x27\xb8\x14\x30\x6e\xf8\x3b\xc1\x05\xc5\x07\xf5\xba\x0f\xae\x69\xe0\x58\x60\xf2\x4f\xaf\xeb\xaf\x4c\x7a\xbd\x92\x7f\x50\xa8\x53\xbf\x2d\x8c\xaf\x74\x9a\x5c\x89\x3f\x28\xbc\x91\xdf\x66\xcf\x57\x2c\x25\xaf\xc8\x1f\x14\xde\x08\xfa\xdb\xc6\x41\x9c\x44\x69\xf4\x47\x85\xfa\x6f\x97\x63\x71\xfc\x47\x7d\x06\x8a\xf8\x9d\xbe\x01\x15\xd1\x09\xf5\x47\x75\xfd\x6f\xd7\xc7\x61\x88\xc4\x7f\xd4\x07\xa0\x7e\x9b\xff\x47\x31\x4d\x47\x7f\x80\x3e\x83\xfe\x76\x7d\x98\x92\x71\xf8\x47\xeb\xc9\xdf\x29\x46\xae\x78\xc2\x44\xff\xc7\xfb\x06\xf9\x27\x4a\x9d\x90\xdf\xd7\x7b\xfc\x3f\x6d\x19\xfc\xd3\xee\xff\x6a\xb7\xe0\xc7\x8d\x0d\xdb\x5b\x5b\x34\xff\xf8\x29\x4a\xc7\xb4\xfa\x97\xc6\xe2\x7f\xaf\xab\xd8\xfe\xdd\x16\xfc\xbb\x2d\xf8\x77\x5b\xf0\xaf\xd5\x16\xdc\x2a\xac\x3f\xad\x2d\x48\xbe\xea\xbd\x2d\xeb\x03\x99\x47\xbc\xbd\xef\xf4\x6a\xf6\x51\xdb\xe7\x4d\x5e\x75\x30\xf2\x23\xc7\x7a\x15\x5d\x5b\xbe\x43\xbf\x13\xaf\x2d\x7b\xdf\xd2\xfa\xef\xb6\xc0\xbb\x2e\xdc\x3e\xa8\x03\x5e\xe1\xaf\x1c\xf1\x63\xcb\xa5\xb6\xea\x6b\xfb\xb8\x09\xf2\x9d\x25\xd1\xaf\x1f\xf4\xf5\x31\x80\x57\x3a\xb7\xbd\xd1\xf5\xaa\x1c\xb7\x34\xe3\x83\x78\xe5\xf7\xe4\xc7\xbb\x46\x78\x2d\xfe\xc4\xdf\x9b\x3f\xbf\x81\x74\x5b\xb1\xb2\xa5\x24\xd4\xbb\x4e\x8a\x5f\xe9\xe9\xf6\x83\x7e\x7f\x64\xe1\xbd\x18\xa4\xc9\xf8\x2b\x63\x89\xe1\x57\x23\x84\xd8\x3e\x41\xb0\xbd\x8d\xb5\xa5\x39\x1f\x3f\x6a\xd6\x2d\x69\x7e\x8d\xdd\x2b\x65\x26\x5f\xfd\x11\x90\x63\xbe\xd2\x78\xe4\xd5\x1c\x61\x5e\xbf\x89\xef\x1a\x0a\xdb\xb2\xd2\x77\x71\xb3\x55\x1f\xc8\x2b\x2d\x42\xdf\x99\xef\xeb\x3e\x40\x10\xdb\x9a\x0a\x2f\xa8\xf1\x77\x99\xff\x4a\xe3\x3f\x5e\x8b\x3e\x5e\x85\x01\xfa\x12\x1a\xfc\x6a\x91\x7e\xbe\x5a\xa4\xdf\x5d\x54\xea\xef\x2e\xea\xdf\x5d\xd4\xbf\xbb\xa8\x7f\x77\x51\xff\xee\xa2\xfe\xdd\x45\xfd\xbb\x8b\xfa\x77\x17\xf5\xef\x2e\xea\xdf\x5d\xd4\xbf\xbb\xa8\x7f\x77\x51\xff\xee\xa2\xfe\xdd\x45\xfd\xbb\x8b\xfa\xff\xc3\x2e\xea\x1d\xfb\x6b\x7d\x2b\xbd\x99\x7f\xf9\x03\x4b\x34\xf3\x7e\x1a\xf5\x33\xa4\xfc\xf3\x7c\x77\x3a\x29\x50\xa2\x93\x75\x65\xc0\x0a\x6e\x5f\x06\x9c\xad\x98\x87\x1c\x9f\x9e\x06\x26\x74\x17\x5c\x37\x65\x93\x8e\xa0\xc9\xe3\x95\xbd\x38\x11\x62\x00\x4f\x30\xde\x32\x8a\x16\xdb\xa2\x5c\x4f\xa7\x2c\xf4\xa3\xc8\x4f\x9a\x04\x8d\x7c\x63\x36\xc3\x5c\x5e\xe2\x35\x8d\xfa\xf5\xb9\x62\x63\xca\x30\x14\x0e\x9f\x6d\x7b\x5f\x9e\x1b\x22\x4e\xa3\x01\x8f\xf7\xa4\xa3\x07\x54\x0a\xdf\x6c\x76\xff\x64\x62\xec\x3c\x77\xa7\x31\x4e\xeb\x3a\x5a\x3a\x66\x71\xc9\xdd\xb0\x3f\xd0\x6d\x68\xa6\xd7\xe5\x9e\x98\x82\x40\x04\x75\x4e\x4c\x28\x39\xe9\xa5\x98\xb7\xf4\xa1\x17\xfa\x41\x3c\x5e\x24\xfe\x39\x1a\x10\x3e\x1c\x2e\x86\xbc\x87\x5d\x82\x11\x08\xda\x87\x78\x94\x0a\x25\x83\xd5\x30\xbf\xcf\xeb\x6e\x9a\x08\x99\x5f\x0c\x7c\x37\xac\xc3\xee\xa8\xf5\x56\xe7\x99\xb3\x69\xcd\xe4\x45\x67\x5b\xeb\xc6\xb1\x13\x75\xdc\x45\xe2\x89\xd7\x75\x2b\x7b\x2c\x59\xb7\xd3\xa4\x0e\xc9\x77\x89\x9c\xd0\x51\x13\xc2\x56\xed\x1f\x99\xa3\x46\x1e\x82\x6c\x62\xc7\xa3\x74\x51\xd5\x76\xc1\x4e\x38\xce\x67\xc6\x75\xa4\x82\x99\x6e\xb5\xfd\xae\xf2\xc4\x6c\x5c\x25\x3a\x48\xf2\x75\xd7\xe0\x48\xd7\xb0\xb2\x2d\x13\x99\x27\x4e\x7c\xb5\xe6\xfb\x64\xec\x7b\x69\xbe\x1a\x4b\xa0\xc2\x62\x73\x68\x57\x55\x25\x52\x39\xbe\x22\x8f\x7d\x8b\xc8\xc7\xb9\xbf\x4d\xca\xee\x7e\x1d\x6b\x3c\x83\xce\xc8\xa3\x3f\x64\xe9\x33\x10\x51\xc7\x3f\x38\x8a\xc6\xec\xce\xf0\xad\x84\x6b\xde\xc9\xd9\x59\xf0\x33\x4b\xcd\x22\xc3\x9f\x2f\x0f\x8c\x18\x2d\x95\x2f\x54\xab\x74\x3c\x3f\x89\x91\x5d\x16\xc7\x39\x22\xed\x65\xb3\x56\x07\x25\xe3\xf8\x24\x26\xc9\xc7\xc1\xda\xeb\x32\xb3\x43\x0c\xe5\xee\x2d\xd5\x3c\xef\xfb\xe1\x4c\x66\x62\xe1\x2a\xc3\xce\xc0\x97\x5b\x72\xce\xfa\x05\x5e\xdc\x3a\x31\x6e\xd2\x1a\x4f\xea\x2d\x80\x75\x8f\xd2\xb5\x7e\xa7\xdc\x5a\x27\x4c\x10\x52\x6a\x95\x19\x8b\x20\x16\x16\x2e\x94\x14\xf8\x4d\x97\xdc\xd9\x9b\xa7\x0b\xc7\x9b\x42\xac\x66\x76\x6d\xb9\xce\x6e\x93\x3c\x97\xf3\xcb\xfe\xca\x71\x1e\xd4\xc8\x80\xfe\x7e\x3e\x2b\xc7\x8c\xa8\x5c\xd4\xe8\x9f\x0a\x91\x68\xb0\xc3\x11\xea\x61\xd5\xda\xa4\x6a\x1f\x7e\x78\x14\xc3\xde\x94\xd7\x5e\x7d\x10\x99\x8c\x39\x84\x60\xdc\x06\x56\x25\x8c\x9b\x7a\xb8\x9a\x81\xaa\xc0\x6d\xc9\x95\x4e\x92\xd6\x7c\xe1\x64\x0e\xf4\x2c\x2d\x43\x73\x52\xb3\x9f\xb9\x1b\xae\x39\xfc\x74\x95\x7a\x11\x2e\x9f\x8f\x87\x4f\x5d\x4e\x7a\x33\x30\x7e\xa5\xfb\x0b\x5a\x51\xba\x08\x27\x2b\x8b\x15\xac\x61\x2d\x01\x39\x9e\x67\x9e\x44\xbc\x00\x47\x56\x77\x31\xe2\x20\x27\x21\x8b\x37\x7c\x6b\x5c\x0b\x22\xb1\x31\xa6\xd9\x9f\x1e\x08\xe7\x2d\x83\x2e\xb3\xac\xdd\x49\x64\x1f\x45\x29\x5e\xa8\x02\xf2\xa4\x8e\x35\x51\x48\x5c\x52\x9f\x11\xa7\x13\xae\x95\x56\x0c\x1c\xd9\xf9\x0c\xbc\x03\x16\x29\xec\xd9\x33\x95\x9c\xe1\xa0\x59\xb3\x3f\xf7\xd1\x36\xf5\x1d\xff\x8b\xf9\x0e\xee\xe7\x47\xdb\x94\x0c\xf3\x84\x11\xd6\x9b\x29\xe6\xde\x3d\xdd\xe7\x70\x3b\xf5\x51\x9c\x86\x51\x73\xe9\x70\x21\xb0\xf7\x4e\xab\xe7\xa4\x90\x3d\x28\xea\xd9\xec\xd8\x6b\xcb\x42\x3b\xe5\x8a\x73\x6d\xb9\xe3\x61\xfe\x3a\x9f\x6c\x81\xcb\x77\x0a\x6a\x3c\x2e\xeb\xf3\xee\x20\xc2\x69\x8c\x7a\x0f\x73\x0e\x66\x5c\xd3\x8e\x53\x75\x87\x2a\x8d\xbb\x86\x49\x5c\x0a\x6b\x8e\x34\x71\x82\x38\x66\x0f\xef\x29\xfa\xa8\x25\x3d\xa4\x09\x95\x85\x0c\x7a\xb0\x44\x0a\x9b\x29\x4c\xc3\x30\xc7\xf3\xdc\xa6\x34\xd6\x94\xc5\xca\xe4\x82\x10\xa6\x18\x06\x1f\xc6\x59\xc3\xe9\x23\x81\xf8\x73\x0d\xa5\x8d\xd1\xab\x66\x74\x9a\xd8\x1c\x16\x63\xb2\x0c\xee\x7e\xd8\x33\x66\x53\xa1\x32\xc7\xc3\xe8\xfe\x79\xd3\x89\x80\xca\x71\x93\xde\x51\x52\xaf\x48\xa7\x03\xce\x1c\x9f\xb3\xd7\x54\xd4\x10\x2b\xc9\x35\x1f\x77\x9c\x22\x8c\xbb\x2b\x17\xdf\xa8\x3b\xd4\xfb\xba\xf9\xec\x50\xdb\x3f\xb9\x13\x7e\xf4\x51\xaf\x91\xdc\xa7\xcf\x47\x51\x40\xf5\x77\xbd\x63\x11\x4c\x12\x1c\x81\x3d\x5a\x78\x76\x10\x65\xef\xa0\x75\xb0\x69\xe6\x0c\x5f\x8a\x4e\x67\x3e\xeb\x79\x16\x57\xb4\x7a\x86\xd8\xb3\xb9\xb3\x3d\x26\x93\x61\x92\x25\x56\xce\xdd\xeb\x54\x2b\xa5\x36\x31\xea\xee\x76\x3f\x9a\xad\xcf\x3d\x2d\x57\x88\x6b\x49\x32\x1c\xaa\xc0\x74\x5b\x58\x57\x93\x9f\xa0\x71\x0c\xe9\xa2\xf4\x76\xfc\xd1\x9a\x6b\x04\xe7\xb8\x7c\x3c\xc4\x7b\x4d\xbe\xcb\xa6\x7b\x64\x0f\xf3\x9c\x6a\xd1\xd3\xce\xd8\x32\x18\x6e\x5c\xb2\xc7\x65\xa2\x3d\x1f\xce\xbe\x77\x46\x98\x40\xc3\xfd\xc7\xd0\xb0\xba\x94\x37\x40\xe1\x1f\xe7\x20\x0b\x03\x44\x67\xf7\xac\x63\x1d\x0b\x8f\x21\x57\x75\x2d\x3d\x8a\xac\xa7\x34\x0f\x8f\xe3\x4c\xe6\xcc\xa0\x8f\x94\x2a\x19\x98\xdd\xde\x35\xbc\x75\x59\x05\xdf\xdb\x20\x2c\x38\x15\xc6\x30\x73\x15\xee\x60\x38\x88\x6c\x6c\x79\x2a\xab\xce\x38\xab\xf3\xc8\x0e\x87\xa6\x55\xe3\xb2\x92\x9f\x7b\x98\xe6\xe3\xc3\xad\xe1\x7a\x4d\xe3\x3b\x47\x94\xe5\xbb\x73\x3e\x8c\xfb\x5e\x50\x8d\x56\x2a\xee\xf7\xee\x00\x0f\x95\xd2\x09\x82\xc7\xf3\xac\xab\x57\x9c\x55\x1a\x43\x98\x8b\x4b\x71\x71\x63\xad\xab\xa5\xed\xc1\x94\x73\x78\x2c\x09\xcc\xad\xf6\xa9\x5a\xea\x14\xcc\xde\xd8\xb6\xe5\xb3\x9c\x3b\x96\x4c\xa7\xf6\xca\xf8\xe8\xcd\x87\x83\x4b\x5c\x68\x37\x07\xc7\xf6\xe9\xfe\x86\x0a\xc7\x1c\xab\x24\x8e\xb0\x26\xf4\x62\x48\x2a\xa1\xd9\x2d\x39\xe1\x60\x35\x9a\x65\xec\xba\x80\x1c\x80\xd5\x0d\x88\x19\x14\xd3\xb9\x64\x15\xa7\x09\x47\x42\x92\xb0\xda\x16\xb0\x69\x2f\x32\x8f\x93\x3d\xb6\xfb\xa7\x77\xbf\x36\x3b\x4f\xb4\x54\x18\xa2\x9c\xbc\x9f\xa2\x76\x7f\x20\x6f\xc9\x9e\xb6\x2f\xb3\xc3\x13\x54\xee\x9c\x44\x37\xa2\x52\x02\x42\x07\x58\x9a\x1f\xe6\xfe\xd0\x4e\x3b\xba\xb8\xe0\x55\x10\xa0\x96\x3c\x60\x45\x92\x2b\xf3\x91\x0a\xa8\x3b\xcd\xb2\x13\xab\xf1\xf2\x45\x15\x4d\x4e\xe6\xff\x74\x5f\x91\xfd\x55\xf3\x8c\xee\xe7\x3f\xe4\x38\x44\x76\x52\x3d\xea\xc6\xe3\xa2\x36\x84\xbd\xd4\xb3\x84\xc2\x93\x79\x1e\xd9\x1d\xea\x67\x3e\xb7\x7e\x0f\x65\xdc\xe8\x1f\x0a\x0a\x07\xbe\x82\x3d\xc1\x01\xd6\x19\xaa\x04\x07\x5e\xa8\xf0\x8f\x56\xb9\xde\xb2\x93\x93\x8b\x85\x2c\xe1\xbd\x70\x4c\x8e\xd0\xf5\x98\x1e\x43\x47\xed\x8b\x94\x90\x2f\x03\x75\x5f\x57\x2c\x85\x62\x0a\xc4\x12\x59\x09\x15\x7c\x6c\x98\xfb\x0e\x32\xb1\x1c\x4f\xf1\x90\x34\x47\x52\x6c\x2d\x4e\x28\x16\xca\x24\x9e\x31\x62\x22\x7e\x4f\xfa\x26\xad\x13\xe9\xcd\x6f\x49\xef\xb0\x4b\xce\xd1\x90\xd6\xa9\x2b\x27\xf7\xd1\x48\x0a\x23\x65\x82\xd1\xd2\x52\x9c\x2b\x70\xb3\x76\xef\x76\xbd\x3c\xe8\x9a\x42\x9e\x77\x0a\xe7\x66\x55\x42\xfb\x49\xd9\x8f\x6c\xbb\xbb\x42\x15\xe2\xa2\xc1\x1d\x5e\xe3\x74\x5f\xac\xd7\xe3\x25\x84\x66\xbb\xb9\x72\x76\x2e\xe3\xbc\xf5\x60\x83\xa2\xe5\xce\xa9\x2f\x2c\x2c\x2d\x14\x64\x5a\x20\x71\xea\xf3\x88\xcf\x4d\x4f\xd7\xdf\x9b\x7b\x74\x3c\x93\x71\xcc\x71\x9c\xae\x90\x5c\xe7\x00\x85\x5b\xc3\x52\xd6\xc8\x99\x83\xfb\xf1\xb6\x70\xb3\x6d\xcc\x09\xdf\xc7\xa8\x7f\xa5\x6d\xc6\x5d\xc2\xb3\x2b\x1c\x33\xd4\xbd\x5d\x4f\xc9\x44\xe6\x0f\xa5\x15\x19\x0e\x95\xe7\x52\x14\xce\x95\xb6\xa7\x3a\x3c\xed\xfb\x14\x2d\x9d\x3b\xdc\x0a\x76\xba\x3e\xad\x89\x57\x14\x16\x6b\xe5\xfd\x13\x86\x6a\x55\xaf\x45\x02\x7b\xb4\x28\x76\xc6\x77\x13\xe1\xec\x2c\xa2\xcd\xdb\x88\xf1\xb1\x3e\xdb\x67\x47\xda\xd3\x2c\x96\xe7\xa9\x4c\x9f\x99\x5a\xb5\x4c\x0c\x16\xcf\x64\x2d\xee\xef\x41\x41\xcf\xf1\x41\x4c\x56\x7d\x88\x6f\xd7\x52\x8d\x9f\xee\xb5\x7e\xd4\x85\x14\x70\x33\x2f\x41\x4a\xb2\xcb\xc2\x45\x3d\x4c\x20\x9f\xda\x27\x4f\x96\x18\xf1\xbd\x4a\xf1\x0f\x1c\x57\x6f\x17\xe3\x7c\x0f\xb3\xf0\x71\xa7\x00\xe8\xb2\x83\xf7\xcc\xda\x9c\x31\x92\x28\x42\x14\x17\xd6\x56\x14\xda\xf5\x78\x88\xf6\x74\x7a\xea\x65\x27\xf7\xf7\xee\x64\x3c\xc3\xb8\xe9\x64\xea\x41\x6a\x6b\x27\x0e\x21\x5b\x19\x66\xcd\xe4\x94\xe0\x38\xa7\x9b\xb6\xf0\x7a\x79\xbf\x0b\xca\x4e\x76\x50\xa7\xb7\xce\x2c\x62\x57\x6a\x0b\xfb\x04\xd9\xa0\xfe\x8e\xb4\xf6\x65\x5d\x0a\x61\x39\x4b\xcc\x59\x3f\x3d\xce\x8b\xba\xeb\x20\xee\xd9\xe7\xc1\x75\x3c\xaf\x88\x97\xed\x56\x4f\x88\x6f\xba\xa9\xd7\x70\x8e\xdd\x67\xf1\x26\xd5\x21\xc6\x47\xca\xc0\x0f\x09\xd5\xea\x31\xac\x69\xc8\x25\xab\xc5\xea\x2e\x1f\xfa\x33\xc6\xed\x10\x62\xaf\xc8\x86\xe2\x3e\xf5\x40\xa9\x8e\x7b\xaa\x80\x3d\x1c\x31\x9e\x4a\x63\x8b\x4b\x15\x80\x64\xd6\x26\x1e\x56\x64\x71\x21\x9b\x33\xc4\xf5\xa4\x5e\xf8\xe6\x6e\xf1\x4d\xc4\x08\xcf\xf3\x65\x79\xda\xa2\xed\x90\x86\x90\x7a\xd3\x6e\xd9\x87\x7e\x4e\x3d\x7b\x9c\xc7\x39\x7a\xd9\x31\x63\x39\x35\x54\x39\xa9\x86\x7d\x17\xb6\x67\x7f\x14\x92\xca\x05\x8d\xb4\xb3\x41\x56\xfd\xb0\xd0\x14\xa9\x31\x9b\xde\x1e\x72\xb1\x30\xe5\xca\x2c\x1d\x2f\x92\x7f\xf2\x23\xf4\xda\xe4\x2f\xf6\x47\x60\x0b\xe2\x97\x1a\xa5\x7c\xff\x05\x0e\xa8\x8e\x06\x4c\xba\x8a\x88\x6a\xdb\xde\xfd\x1c\xe4\x29\xab\x19\x9c\x67\x7b\xa7\x85\x8a\x17\xc6\x2b\x8f\xa4\x7a\x86\x76\x5a\xd4\xc3\x8a\xd9\x49\x43\x81\x67\x1a\x17\x79\x0f\x29\xf4\x06\x5f\x74\x3d\x95\x0f\x42\xf8\xe6\x10\xcb\x05\xbd\x1c\x09\x82\x8a\x08\xbd\x76\xfd\x7b\x56\x0b\x66\x73\x3d\xc6\xd4\x6d\x6d\x74\x59\x31\x02\xb3\x80\x93\x94\xf0\xc6\xfa\x7e\xb4\xe7\xfb\x4d\x84\xca\xc8\x78\x9c\x61\x98\x10\x4e\x03\xed\x9e\x27\x64\x4f\x11\xf4\xe5\x5a\x4a\x99\x91\x1d\xea\x7e\x99\x1d\xd3\xbe\x1d\x94\x1b\x6c\xb9\x67\xc8\x16\x8a\x40\xf6\xe5\x88\x12\x2a\x0c\x85\x52\xff\x79\xea\x12\x38\x73\x78\x60\x47\xc5\x83\x87\x41\xcc\x4a\xee\x24\x93\x11\x16\x94\x93\x47\xcd\x80\xfb\x85\x58\x1a\xa5\x9c\x56\x7d\xf2\xa6\x1c\x95\x26\x3d\x89\x23\xa1\xe0\x2f\x96\x5f\x95\x0f\x89\xbf\x8b\xad\xe9\x42\x53\x91\x23\xc1\x4d\x53\x1a\xd6\x88\x85\x1d\xe4\x85\x0c\x60\xc5\x74\xa8\xe4\xf1\xc9\x5d\xf8\x3a\xed\xd1\x9d\x43\xea\x22\x91\xe1\xad\x5c\x5d\x18\x16\x52\x28\xd8\xe7\x85\x3c\x17\xd2\xd9\x34\x5c\x09\x14\x3f\x07\x6e\x8a\x9b\xc1\x2f\x25\x9a\x3c\x39\xc6\x65\x3e\x0d\xf0\x50\x6a\x8e\xdc\x70\xf1\xa3\x6a\x0c\x7c\xdf\xf2\x16\x70\x98\x83\x32\x52\x9a\xd7\xb6\xfa\x31\xb3\xa8\x07\x45\x68\x2c\x1b\x11\x0c\xc7\x6c\x7f\xb4\xda\x51\xb3\x47\x5a\xdb\x4f\x06\xaf\x75\xb5\x16\x50\x9c\xbf\xea\x74\x13\x51\xee\x3e\x90\x41\x42\x7e\x67\x4b\xaf\x8c\x23\x92\x3c\x2c\x32\x27\x49\xe2\xd9\x3e\x69\x04\x95\x49\xe2\x9e\x78\xdc\x68\x6d\x9e\xe2\x10\x7d\x38\x98\x78\x09\x87\xac\x5e\xd9\x09\xc5\x13\x1b\xf2\xad\x6c\xea\x3b\xde\xe1\x6e\xa6\x02\x7c\xaf\xd7\x8a\x28\xc8\x94\xb8\xe6\x44\xb3\x31\x14\x17\xe7\x7d\xa7\x32\x50\x56\x9d\xb5\x46\x20\x55\x74\xcc\xcc\x6e\xed\x80\xdb\xb7\x92\x6b\x6a\x21\x31\xe4\xe6\x6a\xeb\x66\x42\xc3\xb0\x98\xea\x89\x02\x44\xa0\x09\x4e\xcc\x71\x7e\xe9\xc6\xf6\x29\x90\xdc\x15\xba\x90\xf0\xb3\x38\x61\x16\x73\x81\xe0\x28\x92\x3d\x64\x68\xef\x81\x5c\x5e\x81\x35\x5a\xd8\x29\xea\xf4\xa9\x82\x1f\x15\x88\x2c\xac\x5d\x3b\xad\x19\xf8\xe7\x05\x4e\xda\x27\xc5\x68\x57\x4e\x17\xc5\x84\xbe\xc9\x1d\x34\x58\x58\x50\x51\xe6\x41\x32\xa4\x68\x10\xfd\xe2\x84\x3e\x0b\xfe\x4a\xd3\xd7\x76\xf4\x73\x10\xc9\xa0\xf1\x61\x62\x3d\x8c\x42\x97\xa0\x3e\x48\x0f\xb7\x5c\x03\x84\x84\xe5\x96\x19\x44\x3d\x92\x54\x3a\xb7\x21\xe3\x99\xf8\xbd\x34\x78\xa7\xba\xa9\x17\xe7\x24\x67\x47\x7c\xc4\x35\x9d\x65\x6f\x76\x56\x1f\x77\xce\xe1\x50\xfc\xe9\x36\xfd\x17\x7b\x1a\x5e\xda\xff\xf2\xe7\x5b\x6f\x88\xb2\x17\x81\x4d\x17\xd4\x40\x5d\x50\x2c\xa5\x7b\x39\x6b\x52\xd9\x32\x27\x2c\xbf\x08\x65\x99\x5b\x85\x33\x4c\xba\x65\x8b\x51\x88\x0f\x5e\x76\x3f\x55\xa0\xc4\x17\xad\x7c\x78\x96\x7c\x7b\x7e\xc4\x96\x05\x83\x34\x34\x0c\x54\xe3\x71\x58\x32\x59\x9c\xb2\x3d\xd0\x22\x52\x6d\x1a\x2a\xf5\xe3\xc4\x87\xe4\x3a\xd9\x9f\xcc\x73\x20\x99\x58\x36\x51\x34\x42\x61\xd0\x58\x8f\x41\x69\x59\x1d\x3b\x19\x63\x5c\x3e\x18\xd2\x6b\x16\x45\xcc\x29\x28\xc1\x7b\x6a\xf0\x12\x08\x38\x63\x9a\x30\x77\x91\xef\xf8\x78\xf6\x2c\x73\x85\x6c\x0e\x81\x79\xa6\xb4\xa7\x7f\xe7\x54\x34\x98\x0b\xa7\x75\xf2\x7c\xc0\xb9\xe1\x81\x18\x66\x7b\x15\xd2\x47\xf2\xbc\x60\x17\x3c\xae\x28\x01\x3f\x60\x5e\xcf\x12\xa7\xe5\xc4\x56\xf5\xa1\x7d\x0e\x0c\x7b\x45\x2f\xec\x1d\xa9\x62\xde\xc2\x9a\x47\x56\x0c\x6a\x1d\x05\x58\x05\x71\xfb\x9d\xde\xec\x75\x46\x3e\x69\x26\x7c\x9e\x30\xd5\x60\x31\x8c\xb7\x5a\xcb\x6a\x34\x1a\xc3\xa5\x1b\x85\x9b\x9e\x5c\xb2\x86\xa8\x94\xbc\xe3\x74\x21\x9d\x59\x8f\x2c\x70\x95\x98\x83\xcd\x53\xe7\x73\x18\x42\xcb\x20\xf0\x14\xfc\xe9\xcc\x97\x77\x5b\x62\xbb\xdd\x8d\xc8\xd4\x8a\xc5\x7b\x94\x91\xb3\x1c\x4f\xe0\x1e\xc6\x25\x15\x94\x37\xd6\x43\xc2\x3d\x29\x1b\x6a\x47\x64\x69\x16\xe8\x8d\x58\x15\xd5\x39\x0a\xec\xd3\x02\x55\x5d\xd3\x41\x06\x62\x71\xe5\xdc\x8b\x86\x84\xdf\x66\x73\x8e\xb3\x98\x43\xf8\xcb\xe9\xf8\x1c\xf5\x3e\x55\x09\xc5\x12\xfa\xc3\x41\x91\xf3\xdd\xce\x8c\x7d\xa6\x08\xa6\xf3\xcd\x57\x31\x6a\x37\x02\xd4\x93\xa0\x84\xf7\x3d\xc3\xdc\x7a\x9f\x3e\x2c\xd3\x59\x2a\x2d\x3e\x9f\xf0\x3e\xde\xc5\x97\x78\xac\x24\xea\xa9\xaf\xe9\x6e\x88\xa2\xd9\xc0\xe1\xab\x1e\x56\xb6\x2e\x98\x10\xd9\x4f\x61\xe1\xa5\x2a\x35\x55\x17\xd8\x15\xe0\x90\xc8\x35\x17\x54\x6f\x9b\xf1\xaa\xea\xfd\xf9\xd8\xab\x87\xae\x72\xbb\x05\x85\x99\x0e\x9d\x1a\x5d\xe2\xdc\xc0\xb8\xdc\x62\xa8\xd5\x7a\x26\x33\x47\xed\x38\xef\xf2\xf9\x74\x50\x54\x07\x0d\xce\xc4\x9d\x8f\x9c\xe5\xe0\xca\xba\x4a\x51\xc4\x91\x3e\x2d\xdc\x24\x1c\x12\x54\x73\x85\x0a\xf2\x89\x82\x1e\x31\x87\x32\x4e\x13\x33\x1e\x22\x02\xe9\x15\x57\x10\xbd\x49\x94\x59\x83\x9f\x51\xe8\xe9\x8b\xa2\x24\xfd\x99\x4f\xb7\x6f\x93\xfe\x2f\x66\x77\xf7\x9f\xed\xae\x5c\x11\x45\x41\x44\x6b\x86\x76\x14\x1f\x92\x87\xf2\xb1\x04\xc5\x81\x8e\x55\xf1\xce\x5a\x11\x67\x49\x42\x0d\x77\xa7\x63\x27\x71\x0e\x33\xf5\x84\x7a\x74\x33\x51\x26\xfa\x69\x3e\x55\x97\x9d\x2f\x36\xaa\x51\x28\x59\x1e\xa8\xf3\x4c\x34\x93\x78\x57\x14\x3f\x2c\x1c\x36\x53\xe5\x5a\x07\x51\x13\xc5\x06\x7a\x87\xb9\xd4\x31\xbc\x1c\x44\x5d\x93\x99\xba\x36\x1b\x03\x9a\x90\x91\xf3\x40\xdd\x68\x1a\x46\x6e\x9f\xfd\xfd\x71\x11\x32\xb8\x99\x69\xd4\x2d\x0e\xfd\xc5\xac\xcc\x53\x6b\xdc\xaf\x20\xb7\x8d\xdd\xb6\x8f\xf7\xfd\x3c\xc3\x64\x99\xf8\x77\xc7\x82\xb8\x71\x55\x45\xe7\xb2\x2a\x42\xcd\xae\xe4\xb2\x33\xda\xdb\xf1\x76\x17\xf4\x4b\xf1\xb0\x4d\x25\x03\xf5\xe3\x72\xd6\x69\xe1\xa9\x7a\x27\x2a\x9c\x8f\xbb\x2c\xbe\xe3\x19\xc5\xae\xf5\x63\x60\x93\x93\xf1\x38\x66\x1a\xba\xa2\x0a\x5e\x24\x08\x07\x4f\x59\xba\x64\xc7\xa5\x87\x9e\x9a\x46\x44\x83\x36\xb1\x8a\x90\xb1\x4c\x12\xec\xb4\x9d\x50\x0e\x82\x75\xe4\x9f\xa5\x9e\x71\x9d\x9d\x55\xb1\x7e\x7c\xb0\xf2\xa4\x74\xbd\x9e\xd1\x6c\x29\x77\xc3\x45\x37\x3c\xdd\xd6\x4b\xed\x21\xee\x2c\xb9\x6a\xb3\x6a\xac\x58\x6a\x50\x8e\xac\x3a\x5e\xc2\x9b\x0d\x3f\x0e\xa5\x77\x83\xfc\x7d\x0a\x73\xc0\x50\xab\x93\xaa\x56\xcf\xab\xda\xdd\x98\x07\x4b\x67\x2c\x39\x3d\x9c\xc3\x6a\x86\x7e\xf5\xa4\xca\x05\x82\xfa\x44\x13\xbd\x6b\x38\x75\xdc\x4d\x9c\x3d\x49\x3e\x9d\x23\x97\x62\x67\x27\xc4\xd1\x3d\xaa\xe9\xd7\xa3\x50\x8f\x09\x36\xa9\x11\x65\xb3\xba\x16\x4c\x20\xd2\xf6\x1d\x3d\x1f\xa0\xbb\x98\x52\x10\x66\xd6\x26\x9a\xca\x23\x26\xec\x98\xa1\x8b\x30\xe2\x0c\x47\x8a\xd1\xb2\x94\x5d\xdf\x7c\x67\xdf\xed\xe1\x2b\x71\x81\x6a\xcf\xb9\xc0\xca\x13\x3d\xd4\x1d\xd7\x0b\x34\xdc\xd1\xd0\x53\x5e\xd6\x73\xa7\x49\xae\x40\x2c\x9e\xc5\x47\x57\x8f\xdb\x57\x4c\x82\xd1\xda\x58\x26\x82\xeb\x2b\xc3\xfe\xc4\xb5\x70\xb9\x8b\x40\x1a\x45\x3e\x8e\xbb\x85\x35\x65\x7f\x02\x25\x15\x8b\x4f\x47\xc6\x34\x70\xfc\xa6\x0a\x32\x05\x82\xdb\x90\x29\x6b\x81\xf6\x16\x0c\xd7\x1d\xc0\xa8\xba\x4f\x0d\xc8\x51\x6f\x17\x8c\x52\xcb\x04\x01\x6e\xb2\x27\xfc\x95\x53\xa4\x0a\x1a\xae\x52\xdf\x14\x2e\x54\x49\xdb\x5f\x91\xf3\x20\x28\x23\xcb\x31\x91\x39\xfd\x4f\x7d\x52\x5b\xdb\xff\xc5\xfe\xfc\x72\x7c\xf8\xc5\xee\xba\x6e\x64\x91\xed\xaf\xc8\xed\x2e\xb7\x32\x89\x9e\x57\x49\x3d\x09\x08\x2a\x3e\x03\xc7\x16\xb5\x00\xbd\x96\xfb\x1d\xa7\x77\x5a\x6c\x85\xce\xd4\x3d\x9d\xf5\x52\xe0\x94\x78\x3b\x88\x56\x29\xe6\x41\x29\x55\x79\xe0\x9c\x7a\xe9\x9e\x3c\x8e\x8f\x7b\x49\x21\x54\x85\xdd\xd4\x4b\x5d\xf0\x3b\xa2\xbe\x54\xeb\x08\x75\x14\x43\x4c\x2b\x3f\xc4\xb1\xd5\xc4\x2b\x16\xa4\x36\x77\xc3\xd5\xfd\x0a\x43\xf6\xf3\x78\x49\x51\xa3\xbb\x98\x26\x0a\xb7\x28\x41\xac\x16\x26\xb2\xf3\x2a\xb2\xfc\x8e\x1c\x56\x77\x42\x30\x19\x77\x25\x8b\x0d\x4b\x16\xe2\xcf\xbd\x12\x44\x01\xac\x72\xc7\xe7\x4c\x29\xe3\x24\x58\x8f\x6a\x7f\x42\x5a\x2c\x98\x55\xf7\x38\x2e\xac\x37\x5e\x2d\xfe\xfa\xa8\x59\x2c\xc5\x8b\x72\x71\xa0\xd5\x06\x16\x70\x6c\x7b\x2a\x6d\x9d\xbd\x98\x5d\x94\xfd\x93\xbf\x5a\xec\x95\x7b\x70\xa1\x6a\x59\x82\xea\xc4\x16\x2b\xce\x09\x9d\xd6\x1c\x6b\x64\x3b\xd4\x43\xf9\xd1\xd0\xcb\x32\x73\x1e\x5c\xfd\xe4\x67\x89\xa3\xeb\xe1\x96\xb6\x07\xee\x3c\x89\x0f\xd6\x8e\xbb\x4e\x75\xd9\x83\x28\x3f\xeb\x50\x7e\xde\x1e\x2a\xb2\x8e\x46\xd9\xec\x71\x0e\x24\xdb\x6a\x85\x9e\xbc\xbb\x3d\xd7\x37\xe2\xbc\x9b\x29\xec\xc1\xb0\x62\x78\x79\x82\x04\x4b\x40\xc4\x43\x35\x28\x77\x07\x2b\x98\x83\x55\xb0\xbb\x70\x97\x3b\x0f\x7a\x3f\x1d\x6e\x6e\xa7\x3d\xed\xf0\x5c\xf4\xa6\xcb\x1f\xab\x5c\xbc\x34\x84\x41\x11\x9d\x8e\x3c\x8a\xac\xae\x8c\x5d\xc2\x61\xc3\x6e\x67\x85\x2d\x71\xc6\xdd\x3e\x12\x7d\x5f\xe9\x8a\x6e\x95\x35\xdc\x64\x61\xb8\xe8\xdd\x6e\x20\xfd\xc6\x3a\xb7\xb0\x7b\xd3\x01\x6a\x8f\xab\x2e\xcc\xd0\x73\xe1\x0e\x71\x78\x5a\x1d\xc3\xd9\xe3\xd7\x33\x6c\xed\x9e\xa5\x72\x25\x9e\xdd\xcd\x2e\x8a\x84\x5a\x16\x83\x1a\x5a\xdf\xc1\x2e\x0d\x9c\x66\x22\x6c\x14\x47\x9b\x35\x98\xc8\x82\x11\x4a\x40\xb4\x67\x41\xf1\x5a\x65\xa2\xa2\xf0\xc4\xec\x2b\xc5\x43\x45\xbf\x9e\xd0\xe8\xea\xb3\x50\x91\x69\x42\x68\x17\xf3\xb5\xc9\xa4\xdc\xe1\xdb\x76\x8f\xce\x27\x93\x3f\x73\xd5\xa4\xc6\x2c\x3b\x71\x3e\xd2\x0d\x2c\xd9\xa7\xbb\x97\x62\xfe\x99\x39\x63\xff\x17\xcb\x19\xaf\x97\x5f\x72\xc6\x12\x79\x6e\x3d\x24\xd3\xa1\xd7\x3a\x8c\x0e\x89\xa0\x72\x68\x56\x89\x53\xc0\x88\xf3\x21\x28\x38\x4f\xb4\xee\x27\x94\x78\x64\x3d\xe7\x5f\xb3\xe8\x2e\xf6\xfe\x30\xdc\xd4\xeb\x8e\xbd\x8a\xaa\x9d\x24\xa0\x6e\x90\xf7\x7c\x15\x05\x27\x7e\x5c\xd4\x73\x58\xdc\x5c\xb4\xce\x6f\xf7\xbc\xa4\x9c\x84\xd2\x53\x8a\xbc\x1c\x41\xbd\x29\x76\x05\x17\x9c\xa3\xe3\x78\x4d\x69\x7a\x47\x32\xf9\xfe\x78\x64\x45\xe6\x8a\xad\xee\xa9\x11\x54\x63\x3f\xe6\x55\x77\x7d\x98\x13\xf4\xd4\xe1\x76\x5c\xce\x9d\x2d\x25\xfe\x41\xbc\x0b\xca\x62\x37\x4e\x75\x5a\x61\xd5\x29\xeb\x31\x8c\xc2\x43\xea\x3b\x07\x4e\xcc\x33\xf6\xb0\xba\x82\xb1\x44\x75\xef\x35\x0e\x61\xd6\x19\x54\x3d\x0d\x98\xda\x13\x86\x0e\x5d\x11\x4c\x6a\xf5\xec\xee\x0b\xce\x90\x17\x4a\xa1\x62\x56\x41\x6a\xde\x90\xf9\x94\xc4\x46\xa1\x69\x76\x40\xdf\x94\xfd\x5e\xe5\x9d\xfe\x61\x77\xfe\x49\x32\xad\xe1\x92\x09\x0c\xc7\xcd\xb7\xa2\x74\x0a\xfb\x81\x23\x2c\x5b\x66\xbc\xc5\xad\xa7\x2b\xbc\xe3\xce\x22\xe7\x53\x32\x57\xc3\x73\xb6\xdf\x7b\x8f\x12\xf1\x22\x6e\x10\x63\x65\x49\xd6\x85\xbf\x54\x47\x85\x09\x44\x79\x10\xe6\x29\x19\x78\xbe\xe8\x1d\xcc\xcf\xb1\x11\xd4\x9c\xf8\xed\x19\x3f\x70\xf3\x40\x0a\xda\x3e\x21\xa9\xfe\xd6\x0f\x2c\xeb\x17\x11\x81\x27\xbc\x79\x12\xaf\x16\xe1\x2b\x71\x51\x42\x30\x84\x16\x42\x7a\x0c\x56\x2c\x4e\xf9\xf9\x59\x74\x7a\xc1\xc0\xf8\x02\xfb\x85\x6e\x49\xd7\x92\xa3\xaa\x8c\x9c\xa5\xfd\x24\xcd\xe2\xc3\xd3\x4f\x70\xd8\x78\x06\xa7\x9d\xd5\x9a\x9e\x13\xac\xc0\x57\xee\x7c\x5c\x6b\xd6\x99\x61\xaf\x2b\xce\x34\x86\xd4\x2c\xc1\xf8\xb7\x7a\x97\x53\x7b\xfe\xb8\x64\xc9\xe0\xef\x1f\x87\xb0\x5f\x59\xca\x6c\xd3\x85\xb1\x1a\xff\xe6\x0e\xed\x49\x80\x6e\x50\x67\xc7\xb5\x70\x3c\x6b\xd9\xa3\xd1\x78\x82\x45\xa7\xc9\x71\x77\x66\x43\xbb\x24\xca\x54\xe1\x66\x2c\x28\x83\x44\xd3\xc0\xf8\x2c\x35\xc6\x0b\x56\xa3\xbb\xcc\x0d\x3d\x1b\xc1\xfb\xfc\x59\x32\x9c\x1f\x76\x51\x52\x1c\x6f\x4e\x8a\x35\xb5\x79\x9f\x96\x0e\x9e\x9d\x02\xda\xdf\x23\x21\xc1\x22\xc5\xe2\xc9\x64\x86\x1e\xd8\x25\xb2\x23\xe8\x46\x73\x62\x81\x99\x2d\x11\xbb\x87\xfb\x5e\x61\x78\xce\x10\xc4\x70\xb7\x93\x17\x81\x33\x98\xd7\x53\x47\xa7\xab\x50\xf1\x27\xcf\x0a\x0d\xf6\x4f\x8e\x65\x7f\xb1\x1c\x32\x91\x7e\xb1\x43\x9a\x79\xc2\x28\x1b\x5c\xef\x07\xb8\x38\xd5\x94\xf8\xc8\x4f\xc7\x79\xa2\x12\x81\x13\x93\x7b\xc1\x8b\x88\xdd\x8a\x17\x60\x6f\xa3\x97\x45\x4a\x3a\x43\x76\x47\xa5\x69\x7e\xbf\x2f\x12\xae\x1d\x57\x6b\xc0\xf5\x79\x0a\xee\xf0\x3e\x73\x72\x65\x98\x26\xef\xe4\xf0\xa7\xbe\x82\x3b\x04\x0b\xaf\xd5\xce\x59\xd7\xf8\xbe\x80\x42\x7a\x91\x28\x3c\x35\x08\x1a\x83\xba\x9d\x79\xdc\x99\x8e\xa8\x28\xe9\xe1\x92\x3c\x7b\xeb\xa4\x20\x0c\x9f\xd5\x25\x64\xe6\x18\x13\xdd\xab\xca\x7f\xd0\x68\x46\x75\x99\x36\x9f\xe2\x45\x35\x2e\x77\x65\xa6\xbd\x1b\x31\x10\xab\x73\x8b\x19\xb9\x1d\xe9\x4b\x91\xf3\x36\x4f\x0b\x3b\xea\xd8\xe0\x87\x78\x4d\x47\x39\x75\x65\x00\x93\xc7\xe3\x5e\x3d\xf4\x1c\x22\xb8\xa6\x5f\x20\x05\xcf\x8b\xa5\x52\x23\xa2\x54\xf1\xa8\x58\xc3\xc5\xd4\x9f\xaf\x45\xe3\x97\xca\x7a\x2b\x8e\xf0\xad\xba\xf5\x02\x4f\xb8\xfd\xb5\x92\x15\xd9\xb3\x74\x6e\x57\x5f\x6f\x21\xd4\x9a\x23\x6a\xcf\x8c\xae\xdf\xee\x7a\x72\x98\x8f\xfe\xe2\xbb\x53\x19\x4f\x4a\x99\x4e\xd7\x87\x85\x58\xbd\x65\x70\x18\x4d\xac\xb1\x82\xe0\x12\x20\x21\x9d\x3b\x27\x22\x1d\x7b\xe9\x2e\xb8\x14\x5d\x77\x12\x99\xee\x27\xd6\x8d\x99\xec\x20\x1b\x6c\x4d\x14\xbc\xab\x11\x0d\xa4\xf1\x27\x5b\x66\x05\xa3\x4d\x43\xb9\xdd\x3f\x96\xd0\x07\x75\xde\xc3\xaa\xdc\xa3\xd0\x8e\xf1\x64\x94\x04\x7b\x23\x27\xff\x71\xa2\x53\xbe\xa5\x44\x21\xd7\xdb\x46\xdc\xdf\xcf\xbb\xe4\xc2\x53\x99\x27\x33\xb4\x80\x65\x69\x4b\x8d\x65\x04\x23\x39\x13\xb3\xa3\x99\xf4\x14\x79\x94\x17\x64\x6e\x7a\x0f\xe8\xfc\xcd\x9b\xd9\x12\xdb\x55\x68\xb7\x3f\x40\x52\xd6\x3f\x5d\x58\xf7\x0e\xc6\x55\x70\x6a\x38\xba\x59\x10\x85\xee\xd7\x79\x6e\x77\x86\xba\xcb\x31\x8e\xf2\x6d\xe3\x88\x7b\xcf\x69\xa9\xad\x5e\x57\xca\xc4\xe5\x3c\xb8\xde\x75\xdc\x31\x5c\xb4\xae\xa1\xd5\x90\xa2\x6b\x4b\xb8\xcd\x50\x4a\x53\x7b\xdf\x2c\x23\x64\xe7\x75\x57\x13\xd4\x8b\x6c\x7d\x41\xae\x26\xd4\x0d\x65\xc3\x45\x64\x93\x5e\x4f\x96\x7f\xab\x60\xd2\x9d\xf5\xea\x70\x3d\xab\xc6\xf9\xb9\x53\x47\xda\x05\x9a\x5a\x7a\x43\x9a\xce\x07\x09\xa7\xfe\xe4\x9e\xc8\xfd\xaf\xd6\xe7\xac\xf3\x5f\xfa\x9c\xf0\xab\xcf\x19\x98\xae\x89\x11\x8f\x60\x0e\xcf\xa4\x5b\x09\xe1\xdd\x50\xcf\x72\x94\xb5\xa8\x54\x4a\xf4\x93\xb5\x39\xf2\x19\xb5\xfe\xd0\x5d\x61\x09\xca\x97\x07\xea\xe1\xe5\x53\x7a\x0c\xd9\xc3\xe1\xd2\x81\x5d\x34\xdc\x11\x6d\xae\xeb\xf7\x21\x5c\xd8\xcc\x41\xbd\x1c\xa8\x7a\x1c\x76\x3a\xdc\x3f\x2e\x72\xb9\xc7\x66\x9a\xa1\xa9\xdb\x2a\x34\x4d\x8d\x4a\x8e\xf3\xc8\xf1\xa6\x83\x4f\x1e\xb2\x44\xd2\x7a\x23\x29\xfc\x1c\x62\xc3\xb9\x38\x47\xd7\x4e\xb2\xa2\xdd\xed\x29\xdf\x68\x7f\x8c\x6a\x78\x5f\x8d\xc1\xc9\x9e\xf3\xc3\xfd\x79\xaf\xdd\x4a\xae\x87\x7a\x77\xd4\x6b\x62\x30\xcc\xcb\xff\xcb\xde\x7b\xed\x3c\x8e\x2d\xe9\x82\xaf\x92\x38\x37\x7d\xc1\xc9\xa4\x77\x33\xe8\x0b\x7a\xef\x9d\xc8\x9b\x01\xbd\x95\x48\x49\x14\xdd\xd3\x1f\x4a\x7f\x96\xe9\x53\xbb\x66\xef\x46\x57\x03\x35\x40\x03\xf9\x23\x29\x6a\xad\x58\xb1\xc2\x7c\x11\x41\x91\x0c\x33\x9b\xbb\x1e\x1d\x28\xa4\xdc\x29\x00\xa0\xc6\x08\x53\x17\x50\x06\xd7\x82\xc1\x70\xa2\x46\xfa\x45\xa6\x69\x71\x97\xcd\xf6\xc5\x1c\x48\xb1\x56\x43\x66\x52\x71\x44\x1d\x86\x86\x4b\x23\xc8\x19\x95\xd3\x33\xcd\x24\x3d\x77\xa7\x76\xb9\x79\x98\x04\xac\x53\x02\x7d\xe7\xe8\x4a\x3e\x5e\xa4\x61\xdb\xc2\x00\xe2\x6d\x46\x44\x96\x00\xa9\xaa\xe9\xb6\xb2\x5c\x13\x1d\x45\x5c\x8d\x6e\x05\x91\x5a\xc2\xb2\x3e\x8b\x31\x3b\xc2\xe2\x0e\xe9\x08\xec\xd9\x33\xb8\xe8\xcb\x45\x2a\x18\x76\xcb\xa8\xcf\x63\x86\x9f\x1a\x54\x60\xcf\x97\x7a\x8d\xb1\x1e\x02\x14\x9e\x97\xdc\x33\x85\x6d\xa0\x17\x5e\x97\x9d\x93\x03\x95\x44\x95\x31\xa7\xa4\x8d\xeb\x70\x42\x55\x3b\x37\xe5\xd5\x08\x7d\x3d\x5b\xa6\x00\x14\xd2\x16\x6f\x02\xdf\xc1\x7c\xd3\x0a\x7b\x9e\x00\x70\x7c\x29\x82\x49\xe6\xee\xbe\xa1\x2a\xf1\x2e\x08\x1e\x9e\x5e\x2e\xce\xb3\x45\xfa\x78\x90\x84\x36\xc5\xa5\x59\x0e\xb5\x1b\x90\x5e\x6d\xb6\xe4\x5e\xb3\x5d\x57\x3a\xa3\xdb\xaf\xf0\x05\x6c\x7c\x68\xaf\x88\xe0\xec\xa2\xd0\xc9\x7c\xdd\x64\x53\x6c\x5e\xe1\x5d\x0b\xc3\x5b\xdc\xf9\x56\x79\x5a\xf1\x0d\x13\x88\x8b\x73\xd5\x25\xcf\xee\xed\xde\xba\x44\x8c\x6f\x78\x7c\xde\x39\x42\xcf\xa4\x77\xeb\x45\x00\x2d\xf8\xc8\x73\x46\x9f\x45\x1d\x47\x75\x05\x7d\x40\xe6\x1d\x4e\x78\x0c\x34\x2e\x89\x9a\x46\xb1\x58\x3e\x80\x13\xca\xd4\x9c\x78\xa2\x8a\x50\xc7\x28\xf0\xb2\xe3\x44\xf6\x99\x9c\x91\x0d\x80\xf7\x05\xaa\xbe\x19\x30\xe7\xed\x29\xf7\x1a\x16\x40\x7a\xb8\x3b\xf5\x3a\xf7\x31\xe4\x2f\xec\x52\x35\x7a\x38\xf5\x93\x39\xdf\x40\x5e\x62\xf0\xe0\x05\xa5\x99\x28\xa3\x44\x74\x3b\x0e\x2e\xdd\x23\xa6\xbf\xc6\xea\x12\x95\x5c\x5f\x65\xb4\xb7\xc6\x80\xc9\x67\xde\x68\x6d\x58\x16\xdf\xd5\xd3\x40\x93\x7b\xe8\xba\x97\x10\x94\x9b\x23\x9a\x03\xef\x1a\xad\xd7\x68\x80\xdc\xab\x89\x2d\x27\xec\x04\x77\x89\xf1\x1e\x57\xc3\xea\x6f\xb3\x27\x8b\x94\x61\x3d\xe9\x33\xbb\xf0\x21\x2a\x42\x30\x6a\x3d\xe2\xa7\xca\x5b\xaf\xce\x65\xcf\x9d\xde\xdb\xde\x9f\xdc\x11\xbc\xdf\x0b\x08\x2c\x16\x9a\xcc\x51\x3d\x9d\xe9\x8a\x71\x62\x06\x3b\xa8\xec\x42\xdf\xfc\x67\x46\xfd\xa5\x71\x77\x6a\xfe\x66\x35\x64\x63\xfe\x56\x43\x0e\xf3\xe3\x7d\xaf\xd6\x06\x82\x49\xc6\xa7\x17\xf3\xb1\x24\x47\xa1\x9c\xa6\x6f\x5d\xa4\x7a\x8b\x9d\x81\xd3\x10\x45\x89\x09\xe1\x55\x6d\x52\xc1\x52\xcc\x82\x61\x4c\xe1\x52\x8c\x8d\x61\xaa\x65\xb3\x2e\x20\xd4\x23\x64\x73\x43\xa0\xf9\xfe\xc5\xf1\xae\xbd\x1f\xcf\x56\x7f\x7d\x65\x9e\xfa\x70\xe0\x12\x95\x91\x5d\xf5\x04\xd5\x54\xf8\xe7\xfb\x02\x03\x50\x51\xf4\x19\x89\xf3\xe2\x8a\xf0\x79\x11\x2d\x37\x52\x3f\xfa\x33\xaf\xbb\x72\xca\x23\x25\x6f\x57\xfa\x35\xd4\xd4\xae\x27\x10\xbd\x5f\x2c\x13\xc7\xf9\xba\x9b\x61\xdb\x40\x5a\xfb\x09\x01\x69\x04\x56\x8f\xa2\xa1\xb2\xb3\xa8\x01\xbd\xc5\x61\x6f\x9c\xde\x0a\xf7\x5c\xdc\x74\xcd\xf3\xc6\x62\x78\x04\xf4\x50\xb6\x92\xdd\x36\x51\x69\xb3\x7d\x5d\x0a\x89\x45\x78\x0d\x87\x14\x1c\x93\x98\xaf\x8a\x60\xf8\x0c\x18\x0a\x07\xb7\x03\x7b\xc3\x58\x4e\xcc\xeb\x4b\x55\xac\x67\x7e\xda\xc0\xfc\x5c\xe0\xdc\x59\x21\x9b\x2b\xe9\x39\xad\x51\x70\x97\x7e\x20\xf6\x50\xd9\x41\x87\x8d\xea\x3c\x00\xf6\x0d\xbc\x3c\x75\x0d\xc9\x0b\xf9\x56\xdc\x07\x0d\x1c\x92\x4d\x06\xad\x49\xb5\xc8\xea\xf6\x8c\x62\xf4\x00\x63\xe6\x0e\xd4\x90\x7f\x95\xa7\x31\x90\x91\x85\xc5\x4f\x97\xa1\x5c\x9e\x16\x47\x18\x4a\x99\x2b\xaf\xfa\x75\xb4\x2b\x2f\xeb\x35\x5d\x35\x94\x7a\x3c\xed\xdc\x5a\xee\xaa\x85\xa2\x97\x48\x58\x56\x5d\x7d\x5e\xb5\x4d\x76\x43\x61\xad\x40\x43\x8b\x06\x70\x0f\x8c\x8a\xba\x2a\xc3\x18\x99\xd4\x63\x05\x9e\x36\xc4\x13\xb6\xec\x5c\xe3\xd7\x21\x99\x35\xe7\xb6\x46\x12\x32\x30\xa9\x38\x21\x66\x29\xa9\xaf\x50\xd9\x4b\x06\x19\xd5\x10\x0b\x08\xf1\xfb\x09\xc2\x6d\x0b\xeb\x6e\xd1\x92\xe3\x67\x18\x67\x14\x73\x26\x5f\xf0\x85\xb2\x33\x5a\xab\x15\x40\xdf\xb2\x05\xcd\x45\x97\xda\xba\x8d\x60\x1c\x20\x03\xfc\xed\x09\xde\x75\x5a\x2b\xed\xa1\x6d\x10\xce\x60\xb9\x3b\x8d\x34\x35\x72\x3f\xd1\x8e\x19\xc3\xb3\xb4\x88\xb7\xa2\x29\xee\x8d\x7c\xb8\x59\x9d\x4f\x62\x79\x3f\x41\x6b\x98\x16\x27\xc2\xc4\xe6\xca\xb4\x5b\x02\x3e\xd7\x27\xc6\x8e\x11\x67\x80\xf7\xeb\x53\xc0\x2f\x77\x01\x2d\x78\x87\x7b\x9c\x4e\x1a\x47\x45\xd9\xdd\x93\x5a\x23\xa4\x80\xa7\x00\x25\xa4\xc3\xba\x61\x45\x13\x76\x13\x75\x41\x9f\x3e\x5e\xb4\xec\xca\xc1\x78\x7d\xcb\xeb\x9a\xb3\x55\x4d\x84\x22\xce\x36\xa9\xe2\x75\xc3\xf1\xce\x29\xc2\xe9\x40\xe3\xdb\x70\xaa\xf8\xf4\x02\x46\x6d\x5a\x29\x9d\x79\x9a\xb1\xfe\xe2\x7b\x1e\x4e\x57\xfc\x9b\xc5\xf3\xf9\xb7\x7b\x1e\xe8\xd9\x7a\xd7\xab\x97\x8b\x3d\x41\x1c\x13\x44\x57\x35\xe3\x20\x6c\x63\x19\xd3\xf4\x45\xa0\xd7\x1a\x89\xaa\x14\x9e\x48\xef\x3a\x58\xdb\x42\x47\x0b\xd0\xa0\x54\x04\xdf\xa1\x90\xac\x43\xe8\xba\x39\x73\xa8\x70\x90\xa3\x3d\xa2\xcd\x94\x60\xbe\xa7\x13\xc4\xea\xe0\x63\xbb\xc1\x57\x68\x54\x12\xff\xa6\xec\x09\x05\x96\x25\x80\xc0\xf0\x81\xcb\xcf\x33\x5f\x8b\x6b\x84\xae\x74\x69\xda\x22\x45\x8d\xd8\x58\xf2\x70\x85\xa1\x6e\xb9\xd6\xf8\x74\xb4\x54\x58\x7c\x9f\x1e\xf3\x0b\x58\xd1\x3b\x85\x5f\xc8\x8a\x76\xb6\x67\xc9\x83\xd3\x35\x31\x92\xe7\x66\xd2\xd5\x2d\xc7\x55\x73\x85\x18\x4e\x92\x16\x62\xb6\xbd\x65\x5f\x04\x5e\x13\xc6\x28\xc1\x63\xca\x92\x65\x2b\xd3\xa6\x57\xbe\x62\x2c\x34\xa3\x69\x7d\x1b\x30\x4e\xb5\x9f\x48\xdc\x3b\xe1\x0b\x7b\x12\xb0\xe5\xa6\xec\xdd\x50\x73\x8a\xe3\xd9\x56\x71\x9b\x26\x34\xe8\xec\x12\x17\xe1\x3d\x66\x72\x99\x32\x50\xb3\xf6\xa0\xd9\x71\x9e\x62\x5e\x15\x9a\x2c\x64\xcb\xf0\x20\xa2\x3d\x75\xc1\x90\x78\xd9\x9a\xbd\x93\x2c\xc6\xfb\xe4\x10\xdc\x31\x23\x68\xa7\x33\x2d\x44\x0c\x9b\x0f\xb4\x39\xac\xb4\x04\xb9\xdd\x40\x9c\x1d\xcd\xac\xb7\xcc\xed\x52\x66\x94\x1e\x5f\x4a\x90\xa1\x19\x55\x37\x23\x6e\xab\x8b\xe6\x0c\x7e\x35\xaf\xd0\x7c\x52\xd3\x77\xa5\x17\xf6\xd9\xf5\xfa\xe2\xee\x7b\xa2\x33\x4b\x8a\xee\x9d\x5e\x40\xe9\x61\x6b\x00\x73\x0e\xed\x76\xf5\xea\x0f\x65\xb7\x54\x14\xa7\x7b\x3a\x76\xa1\xd2\x29\x58\x44\xbb\xb2\xd9\xb4\x5e\x46\x03\xe5\xc7\x02\xf6\x7a\x1e\x78\xe6\x93\x90\x4e\x9c\x82\xf3\xc5\xf4\x54\x1b\x45\xcd\xbb\xb4\x73\x92\xb4\xce\x3b\x05\xc0\x47\xbd\xc4\xc2\xbe\x74\x6e\x7b\x9b\xd4\x85\x22\x23\x17\x82\x55\xc9\xc2\xbb\x3b\x7a\xf5\xa8\xeb\xee\x14\xa5\xb3\xda\x00\x28\x95\x35\xa7\xc8\xd3\x64\x8e\x22\x53\x0b\x86\x75\x7a\x91\x36\x92\xec\x99\xee\xb4\xe8\x6e\x42\x78\x7e\x3b\x73\x25\x88\x75\x0c\xa6\x16\x51\x8e\x98\x6e\xd9\xcb\xac\x69\xc4\xa0\x19\xd1\x87\x8b\xd6\xe3\x1a\xa2\xcf\x00\x51\x5a\x46\xc4\xd8\x6e\x2c\x4b\x8f\x79\xb2\x4d\x98\x71\x7b\xc2\x79\x51\x22\x30\xca\x52\x70\x49\x6e\x2b\x93\x0f\x58\xd7\xa7\x29\xa4\x04\x5e\x17\x14\xba\xba\xf6\x3a\x24\x74\x44\xe8\x2d\xcc\x83\x9b\xc9\x81\x82\xdb\xfe\x45\x48\x0f\xed\x16\x0b\x67\x1d\x77\x5a\xaf\xa7\xba\x57\x17\xe2\xd3\x0d\xa8\x14\xfa\xf9\x8a\x37\x6f\xc4\xe4\x21\x52\x56\xc2\x40\x45\x76\xb8\xf1\x74\xdc\xb4\x97\x34\xdb\xad\xa7\x55\x2a\x77\x89\xf4\x0f\xbe\x54\xe2\x91\x10\x78\x69\x02\x12\x3b\x43\x7a\x4d\xbb\xcb\x54\x03\x5c\xfb\xad\x55\x70\x90\x87\x41\x98\x56\xd1\x6c\x8d\xed\xc5\x8e\x2e\x77\xe4\xd9\xf7\xad\x1a\x6e\xe5\xf3\x3e\x43\x97\xa3\x6e\x26\xcd\xa0\x6e\x6e\x18\x22\xfa\x82\xdc\xe9\x52\x57\x16\xaf\x7a\xb7\x05\x4b\xd3\xfc\x36\x0c\xb9\xc8\x5e\xe3\xbf\x36\xa6\x4f\x7f\xb3\x5a\xba\x6f\x7e\xdf\x1e\xf0\x13\xd3\x6d\xdf\x20\x77\x2f\x99\xae\x87\x4f\xf3\x66\x7a\x8d\x98\x59\xac\xbc\x96\x6b\xeb\x07\xc0\x6c\x22\x7d\x68\xf3\x02\x36\x4b\x05\x32\x7d\x0b\x88\x42\x4b\x8c\x0b\xa6\xcc\x91\x6c\x4b\xed\xda\xb2\x81\x36\x06\xf7\xbb\x1f\xa8\xca\xf5\xea\xa2\x06\x69\x1c\xe8\x0c\xf5\x66\x09\x5f\x31\x5d\x59\x73\x80\xc0\x71\x1a\x3f\x86\x6d\xe8\x04\xf5\x28\x5c\xfc\x55\xe1\x37\xfd\xfa\x5c\x92\x8d\xa8\xbd\x65\x2e\xe8\x88\xce\xba\x46\x24\xbb\x1a\x2f\x64\xfa\xe6\xe6\xe0\x7a\xa7\xe2\x4b\xea\x7b\xea\x34\x67\xd0\xd6\x0f\x1b\xa9\x24\xa3\x16\x08\x07\x7c\xa8\x08\x1f\x04\x04\x0d\xa2\xe4\x22\xc6\x0f\x7e\x2b\x6b\x95\x0e\xa0\x81\x03\x98\x51\x51\x42\xa9\xa6\x39\x1d\x58\x14\x2b\xb3\x31\x4a\xe3\x9d\x1b\xb9\x41\xcf\xa8\x0d\x6f\xea\xe3\x26\xf0\x35\xa9\x49\xfd\x99\xbe\x32\x39\xd6\x06\x06\xe8\x92\xec\xf3\xfa\xf0\xbc\x6d\xbe\x18\x55\x8a\x5e\xc8\x72\xa0\x00\xad\xab\x50\xad\x0a\x54\xa0\x75\x55\x67\xc5\x56\xc8\xcf\x29\xd6\xdd\x23\xc9\x65\x92\x18\x7d\xb0\x27\x58\x9c\x8b\x5d\x04\x1e\x49\x77\xbe\x49\xf6\xcb\x00\xa1\x40\x63\x32\xa8\xbf\x99\xce\xb3\x19\x24\x9d\xb9\x09\x18\x76\x70\x8a\x0e\xd7\xe3\x25\x59\x5b\xc3\x5c\x58\xb8\x25\x8e\x13\x7d\xb5\x17\x73\x02\x9d\xe2\x47\x6b\x6d\x1a\xb1\x5d\xea\xa1\xda\x8a\x82\x45\x33\x8f\x4e\x66\xda\xe5\xc1\x74\xe6\xc1\x3e\xb0\x0a\x5b\x56\xa5\x2e\x24\x1e\x8b\x03\x86\x7b\x26\x9c\x40\x31\x73\x2c\x45\x3e\xde\x71\x4f\xc9\x59\xf1\x55\x88\xd5\xc3\x06\xa7\x0e\x77\xd7\xd8\x67\xe0\x0c\x22\x4b\xe7\x5a\x25\x94\x0d\x3d\xeb\x22\x35\x10\x71\x88\xd7\xa8\x37\x6a\x63\x1a\x08\xd3\x61\xce\xb4\x41\xd2\x0c\x68\x7d\x3a\x51\x35\xf4\x0d\xfc\xba\x4b\xe1\x35\x8c\xb2\xe1\xd9\xf2\xbd\xb3\xdf\xb7\xb5\x4a\x6b\x14\xf7\xee\x79\x3f\xa7\x42\xe4\x07\xbe\x1c\x9b\x1b\xd6\x4a\x01\xc8\x21\x34\xc0\x2e\x17\x4c\x9f\x5e\x20\x47\x1e\x71\x2d\x76\x65\xed\xc5\x04\x1a\xed\xde\x53\xde\x2f\xeb\x14\xcb\x01\x04\xb5\xe5\x0b\xca\x18\xeb\xa5\x66\xac\xcf\x3c\xd8\xbe\xbd\x68\xed\x99\xc6\xf8\x47\xbe\xb8\x6a\x10\xd2\x02\xf5\xb0\xea\x3b\xbb\xde\x87\x98\x62\xbc\x27\xc8\xa4\x45\xe6\xea\x19\xc5\x1d\xa5\xdf\x85\xdc\x70\xaf\x66\x42\x2c\x58\x98\x9c\x75\x72\x2f\xb9\x4c\x74\xd9\x78\xd4\x1f\xc4\xe2\x82\x76\xeb\x09\x03\xce\x9e\xf0\xca\xf2\x10\xe8\x22\xb7\xde\x4a\xee\x95\x83\x54\x4f\x6d\x06\x20\x4f\x70\x00\x49\xf7\x14\x21\x58\xd4\x2b\x40\x74\x59\xda\x20\xc0\xe4\x21\xe9\x78\x03\xb0\x1a\x4c\xab\x02\xf3\x62\x35\x7c\x31\xe4\xb2\x5c\x0a\x0e\x54\x64\x67\xf6\x46\xff\xa6\x01\xfb\xe9\xf6\x8a\x90\x2a\x05\x7c\x05\x96\x57\xf1\x57\xfe\x06\x3b\x3d\x8b\xbf\x97\xcf\xdf\x7f\x7f\x2f\x24\x6c\x9c\x3e\x1f\x57\xd9\x5c\x68\x67\x6d\x3f\x8f\xca\xec\x46\xb5\x2a\x33\x13\xc7\x0a\x88\x02\xc5\xaf\xb1\xf5\xd2\xb4\xe4\xd5\xd2\x52\x1b\x0c\x8e\x4d\xd4\xaf\xfc\x76\xcb\xdd\xd5\xa8\x84\x9e\x3a\xbf\x90\x98\x33\x97\x87\x62\xdf\x77\x5d\xa3\x86\x10\x4d\x13\xc7\x91\x63\x33\x7f\xe8\x55\xdd\x23\x2b\xd2\x06\x5e\xa8\x46\x5f\x46\xaa\x8d\x7c\x34\x39\x53\xb2\xe7\xfb\xfe\x87\x97\x64\x40\x90\x1f\x0e\x6b\x52\x1d\xea\x71\xe6\x63\x48\xab\x59\xf8\xf8\x5a\x1c\xc9\xb1\xe5\xdc\xe1\x4d\x94\x82\x96\x81\xb7\x1f\x04\x5e\x15\x7a\x87\x59\x29\x06\xb7\xf4\x53\x9c\x81\x7d\xae\x4d\x72\x24\xd7\x51\xb2\x1e\xf7\x7a\x59\x26\x9d\x4d\x45\x6a\x89\x3a\xd8\x0c\x98\x55\xf2\x43\xd3\x35\xd0\x6b\x85\x2f\x01\x78\xa4\x01\x70\xd9\x3d\xe7\xe5\xdf\x15\xf1\x72\xd0\xcd\x76\x95\xf4\x7e\xf4\xc8\x07\x10\x6f\x4b\x78\x7b\x1c\xd2\x31\xd4\x17\x9b\xb7\x8e\x20\xb1\x7d\xec\x8a\xf2\x33\xa8\x0d\xca\x4b\xa6\xc7\xa0\xbb\xc4\x82\x9c\x24\xe0\x6a\xd5\x92\x73\x66\xf8\x7e\x92\x4b\x0a\xde\xe8\x67\xf4\xb3\x00\xc0\xf7\x2c\x4f\xcb\xf5\x39\x37\x18\xa8\x50\x20\xc1\x7e\x4c\x18\x20\xcf\xb7\x4a\xc2\x9f\x7d\x1b\x94\x39\x2f\xad\x8e\x80\x18\x1d\xb3\xb6\x52\x34\x88\xa1\xc9\x1f\xc0\xac\xec\xdc\xd8\xab\x8d\xad\xc5\xde\x85\x94\x8a\xe3\x14\x31\xdc\x58\x6a\x71\x8b\xe9\x87\x0a\x92\xc1\x6a\x4b\xbe\xd0\xde\xbb\x39\xbb\xaa\xf3\x8a\x19\xf1\x46\x52\x1e\xf8\xbe\x12\xd2\xe0\xc1\x68\xc5\xab\x5d\x28\xd6\x84\x5c\x5a\x70\x86\xa9\xc7\x20\xd6\x39\xbf\x12\x23\xb0\x17\x6d\x14\xba\xab\x75\x56\x36\x93\x0e\x8d\x16\x63\x5c\x4d\xa1\xd1\x13\xa5\xf6\xca\x71\x5b\x25\x2f\xca\x05\x77\xbc\x81\x06\xcd\x8f\x6c\x7f\xf3\x95\xc2\x74\x4c\xc6\xb8\x59\x98\x7d\xe2\xe4\x74\xca\x26\x5f\x6f\xc5\xc0\x20\xd6\x2c\xe5\x98\xc1\x6c\xd7\x01\x21\x27\x8c\x51\x5e\x43\xe4\x29\x29\xed\x84\x69\xd1\xc6\xde\xa8\x6e\xcd\x9d\x55\xdb\x45\xab\x1b\x3c\x2d\xd0\x9e\xae\xd3\x95\x50\x8b\x81\x3f\x4b\x64\xf2\x8e\xaf\x8a\x19\x63\x22\x82\xc8\xa9\x2e\x7b\x7c\xe2\xf4\x6c\x44\x22\x16\x9d\xb3\xb1\x28\x2c\x2d\xab\x04\xae\x23\x93\x29\x69\x98\xd0\x8b\x6f\x9f\x41\x04\x23\x49\x92\xa7\xd2\x70\x2d\xee\x18\xbf\x72\xf7\xd2\x1f\xe1\xc3\x9c\x93\x57\x44\x36\x67\x79\xae\xac\xe7\xd0\xbb\x17\x78\x88\x72\x35\x9f\x9a\x30\x79\x85\xb0\xce\xf8\x20\x35\x95\x53\x30\xbd\xc3\x0a\xe3\x2b\x43\x4d\xf3\xc1\xab\x15\xa3\x94\xec\xcb\x7d\xe4\x8f\x18\xdf\x24\x07\x71\x45\x95\xe9\x06\x08\x52\x28\x9b\xcb\x7b\x35\xe0\x58\xfa\x0e\x07\x2e\x7b\xeb\x51\xea\x22\xa8\xc2\xab\xc7\xe0\xf9\x2a\xad\x09\x53\x11\xc4\x13\x0e\xad\x06\xe9\xcb\xfb\x3a\x06\xb7\x78\x12\x37\x59\xc6\x5a\x4d\x69\xb7\xde\x44\xe5\x23\x74\x34\x1b\x6d\x1d\x17\xc4\x25\x5e\x33\x5e\xf1\x55\x40\x1b\xa0\xda\x50\x43\xbe\x37\xf0\xd3\x48\xab\x63\x96\xf5\x57\xcb\xd5\x90\xc8\xf4\xd0\x4b\x2c\xf4\x7b\x3e\x49\x31\xee\x08\x97\xcc\xe7\xc0\x8e\xbc\x2a\x69\xd8\xe5\x30\xc5\x6f\xaf\x2a\x98\x59\x15\x5c\xce\x3a\xe5\x09\xa0\x6d\x17\x1e\x20\xe4\xc5\x0c\xfb\x54\x65\x3b\x98\x5f\xe0\xa9\xdf\xbf\xb8\x26\xf9\xbb\x75\x23\x0e\x7f\x83\x9e\x7e\x5e\x84\xeb\x3b\xdd\xb0\x0f\x3f\x10\xaf\xb5\xaa\x21\xc1\xfd\xe2\x88\x12\x3a\x31\x84\xc5\xea\x0f\x67\x4b\xaf\x6a\x9e\xc6\xeb\xeb\x85\xf2\x13\x34\xd0\xed\x45\x5f\xc2\xd9\x7d\xf1\x53\x3d\xa3\xaa\x12\xa7\x7e\xe8\xa5\xdb\xec\xca\x4f\xf5\x8e\x6c\x82\xca\xca\xf5\xe5\xda\x56\x65\x66\x34\x02\x77\x39\xb4\xe4\x5a\x02\x67\x49\x72\xa6\x1c\x30\x71\x06\x02\x84\xbb\x21\x79\x86\x84\xeb\x2e\x0f\x30\x02\x82\x8b\x8d\x80\x87\xe5\xa4\x22\xf6\xe2\x49\x29\x32\x09\xba\x04\xf5\xb1\xae\xcc\x2b\xd9\x07\x4f\x9b\x15\xd3\x4b\xbc\xca\x46\xa9\x41\xd6\x30\x39\x82\xca\x4c\xb2\xea\xbf\xf6\xfe\xd0\x68\xaa\x10\x68\xca\x82\x32\xdd\x00\x03\xb3\x7e\x60\x0b\x8a\xf0\x1d\x5c\xad\x9e\xe3\xce\x74\xe1\x3d\xab\x6b\x92\x1b\x29\xf4\x50\x16\xda\x3a\x7d\x23\x7e\xd8\xe1\xf6\xb2\x6f\x3a\xcc\xbd\xe0\x54\xc8\xd4\x17\x59\x2d\x4a\x12\x28\x23\x96\xdd\xcb\x30\x76\x8f\x7c\x5f\x40\x2a\x47\x3d\x3b\x9e\x56\x81\x9b\xc1\xe2\xfa\xc2\x2c\x13\x15\x0a\xcc\xdf\xaf\x0a\xd0\x38\x39\x9c\xdc\x47\x01\x10\x81\x97\xa3\xf3\xcf\x9b\x3c\xac\xbd\x66\x3c\x73\xd5\xaf\x07\xac\x54\xad\x96\xae\xb6\x44\x2f\x6d\xb3\x91\x36\x22\x71\x5c\x57\xbc\xcb\x58\xd8\x25\xa9\xe4\x2d\x50\x4c\xe4\x4e\xae\xea\xd3\x23\x85\x92\xfa\xa5\x44\x65\x7d\xe7\xdb\x95\x1c\xe2\x53\x5b\x21\xb1\xb5\x52\x4f\xf3\x0e\xaf\x1f\x1a\x9e\x09\xbb\x88\xc9\x2d\x1d\xea\xc6\x73\x8c\x15\x52\x25\x84\x27\x6e\x77\xa5\xdc\xdd\xac\x01\x7f\x65\x77\x39\x4d\x55\x89\x15\xfa\x23\xdb\xa6\xc1\x61\x34\xa5\xe6\xaf\xac\x5c\xf6\xbc\x5f\x2b\xc0\xfd\xcc\xbe\x35\x7d\x31\x99\xa9\xbe\x0a\xf5\x7e\x7f\xe9\x4f\x98\x1b\xc5\x1b\x12\x63\xb9\x52\xea\xea\xd4\x77\x23\xa7\xf7\xd1\x2d\xf0\x14\x11\x55\x37\xc5\x53\x82\x27\xd7\x31\xe3\xd6\x44\xa6\xaa\xb3\x8a\xfa\x44\xe3\xf2\xd2\x81\x10\x35\xc6\x49\x6f\x69\x31\x81\xcb\xc3\x20\x80\x59\x6f\x5c\x63\x1c\x3a\x33\xa0\x02\x83\xe4\xe0\x8e\xa8\x9e\xb7\x47\x44\x1b\xda\x57\xbd\xbd\x08\x60\x62\xc4\x0e\x17\x00\xcd\x45\x43\x1b\x78\x1e\xef\x85\x69\x42\x4f\xc1\xca\x76\x6d\xbe\xcf\xbc\x43\xf4\x17\x7e\xcd\xd3\x82\xf7\x10\x9f\x7d\xec\x13\x76\xbb\x20\xf2\x76\xe9\x9e\xaa\x46\x64\xfc\x2d\x51\x98\xa4\xbc\x12\xfe\x84\xc7\x86\x71\x6d\xf3\x1b\x6e\x71\xd8\x70\xcc\x66\x34\x2f\x2c\x9d\xd7\xa7\xf4\x19\x76\x8e\x3a\x4d\x47\x3a\x19\xf4\x51\xa9\x66\xf3\x98\xbf\x08\x02\x71\xcd\x79\x83\xf0\x86\x54\x65\x24\x23\xea\xdb\x45\x67\x39\x1c\x4b\xea\x5b\x9b\xa4\xbc\xec\x29\xd8\xc3\xf4\xee\x37\xf2\x84\xea\xa5\xee\x25\xd5\x5e\x93\x4b\x55\x79\x25\xd7\xf5\x48\x1b\x44\x07\x0d\xdc\x3c\xdf\x31\xa9\x92\x28\x2b\x4a\x90\x18\x6e\x08\x5a\xf0\x3e\xb6\x56\x8e\xfe\xc5\x30\x70\xff\x9b\x55\x1d\xb7\xdf\xc1\x00\x35\xbf\x20\x88\x09\xec\x00\x7f\x4e\x18\x6c\xf6\x87\xbb\x4d\x95\x97\xdc\x5b\x28\x54\x14\xe7\x2e\x08\x6a\x90\xc4\x17\x81\x5b\xb2\x95\x8b\xb6\xf8\xf9\x62\x79\x80\x61\x03\xf1\xc1\x3f\xb8\x5b\xc4\x9e\x7e\x59\x28\x51\x5b\xb3\xde\x83\x98\xee\xae\x0a\x97\x59\xb3\x3d\x04\xda\x20\xd3\xe4\xea\x3d\x1c\x6c\xc4\x21\xbc\x92\x8d\xdb\xc5\xb6\xed\xa8\x90\xda\x54\x51\xe2\xc7\x46\xd1\x92\x36\x99\x7c\xb1\x5d\x2f\x7d\x80\xa6\xc2\xc8\x3d\x7d\xe0\xa0\x22\xd8\xbb\x22\x30\xd0\x71\x93\x9a\xcf\x8e\xbf\xa4\xe5\xa3\x03\xfc\x0b\x19\x65\x37\x15\x80\xf2\xa9\x7a\x5d\xa8\xab\x33\xdf\x28\xa9\x56\x31\x26\x99\x15\x31\x46\xe4\x03\xad\x46\x3e\x74\xf3\x7a\x9f\x81\xdc\x95\x73\x0f\x52\x18\xb7\x01\x78\x90\x92\x20\x7b\xba\x86\x2d\xf9\xbc\x16\x50\xa1\x3f\x01\x46\xe9\x56\x3f\x14\x5a\xc6\x14\xe2\xd8\x59\x39\x79\x10\x92\x5d\xad\x15\x47\xab\xc6\x0b\x85\xd5\xf4\xcb\x4e\xa0\x5c\x4e\xb0\x38\xba\x0a\xf4\xed\x75\xc6\x0b\x68\x65\x58\xce\xf3\x94\xab\xf1\x8c\x84\xfa\xee\xbd\xc6\x3b\x03\xa2\x34\xf8\x5c\x13\xd1\x8f\xf4\x9d\x5c\x9c\x91\x2c\xf8\x5b\x65\x2f\x51\xe4\xa4\x94\xf4\xb2\x42\x54\x89\x75\xf5\x72\xdd\x0f\xfe\x90\x10\x90\x6b\x12\x74\x5a\x33\xb9\x70\x10\x82\x64\x35\x75\x8c\x5f\x1d\xe9\x89\x88\xe1\x34\xad\x48\xe9\x2f\x5e\x03\xa5\x0a\xe2\x35\x9f\xd5\x14\xf3\x80\x95\xcc\xcf\x04\xe3\x08\x60\xc6\xe4\x23\x52\x67\x2a\xe1\x64\x4f\xa9\xd9\x27\x2b\x0e\xcc\x46\xe8\xb1\x75\x10\x06\x93\x2f\x23\xcf\xcd\x4c\x28\x49\xb6\x36\x30\xad\xf2\x02\x45\x1c\x17\x51\x54\xe4\x19\x22\xa2\x51\xc1\x62\x2c\x23\x35\xac\x81\xcc\x5a\xcd\x5e\xdd\x99\xa7\xc4\xb1\x9c\x3b\xfc\x19\xdb\x95\xcf\xcf\x5b\x37\xc0\x15\x1a\x64\x23\xde\xf5\x4e\x5b\x3a\x18\xc3\x79\x01\x8f\xb4\x02\x76\xe1\x27\xa6\xc8\x82\x36\xa2\x79\x32\x78\xb6\x26\x1d\x99\x7d\x52\x6f\x69\xb7\x66\x05\xa4\x4b\xc7\x8c\xf6\x25\xa3\xda\x41\x0e\x9b\xb8\x17\x96\x82\xe9\x43\xcc\x88\xb0\x5a\x48\x38\xc7\x3a\x24\xa5\xcb\x7a\x1a\x13\x37\x25\x9a\xc6\x72\x9e\x44\x37\x72\x80\x85\xaa\xdb\xed\x7d\xca\x35\x5a\x77\xa4\x94\xba\x73\x4b\x44\x77\x34\x60\x2c\x69\xfa\x74\x2c\x39\x75\x1b\x29\x2a\x9a\xa7\xa9\xbb\x54\xb3\x8e\x45\x1c\x55\x04\x8b\xcd\x71\xc7\x77\x9e\x35\x2e\xee\xfd\x8e\x22\x0f\x2f\xbe\x83\xc3\x7e\x66\x64\x0c\x49\x8e\x70\x88\x08\x6e\xcb\xcc\x47\x8c\xf7\x7d\xf0\x78\x3c\x65\x00\x75\x44\x44\x8b\x29\x92\x79\xf8\xb3\xf3\x72\x88\x1b\x53\x61\x1b\x21\x72\x21\xcc\x43\x10\x0c\x2c\x71\xf9\x78\xb2\x06\xb2\x30\xd8\x0e\xbb\x57\x84\x27\x41\x37\xcd\x41\x3f\x51\x56\xac\x00\x09\xe3\x91\x4b\x8c\x5d\xd1\xcd\x6c\x42\xd7\x56\x6d\x48\x7d\x7f\x8d\xfb\xf2\xa0\x1e\x4e\x69\xd3\x6d\xa8\xe6\x0f\x4f\xab\x55\xc0\x7f\x88\xf9\x1e\x97\xd9\xc5\xb7\x41\x57\xac\x19\xd1\x19\xb3\xab\x62\xb1\x5b\xf6\xfc\x2b\x2b\x96\x47\xfa\xf8\x9b\xe1\xc5\xf4\xfb\xb4\xe1\xe7\xd3\x5b\x04\x65\xd5\xfb\x90\x5c\x5a\xa8\x49\x18\xce\x09\x60\x5d\xd0\x34\xce\xc8\xd3\x99\xec\x7c\xf7\xe6\x3c\x2f\xdb\xd3\x99\x17\x4c\x00\x31\x83\xc4\x6c\xb8\x7d\xb4\x41\x6a\x72\x2b\x2c\xd6\xdb\xd4\x71\xaa\xa3\x69\x4c\x40\x40\xc0\x95\xba\xaa\xb7\xc7\xd5\x1b\x06\xec\xc2\x9d\x05\x3e\x40\xe3\xf4\xfb\xc9\x2d\x8a\x92\xac\x3c\xa7\xd2\x3b\x5d\x2e\x5c\x63\xc9\x03\x38\x2c\xc6\x99\x70\x2e\xd1\x05\x3c\x30\x80\x5d\xfd\x21\x5b\x56\x32\xbb\x14\x35\x95\x91\x0d\xa1\x0b\x70\x2d\x45\x80\x45\x51\x9c\x6b\xcc\xd8\xc5\x1f\x5c\x02\x7c\xc4\x5b\x5e\xe2\x97\x05\xed\x06\x59\x86\xe9\xca\xee\xd9\x17\x50\x47\x3b\x7e\x1d\x72\x31\x43\xa3\x1b\xf8\xba\x96\x9a\x25\x18\xaa\x88\x73\xe4\xc6\x89\xd9\xcc\xd0\x85\x7b\xe9\xc4\x31\xd6\xa4\xd6\x77\x99\xc1\x96\x82\x10\x4e\xb5\x80\xb6\xd0\x70\x35\x1d\x12\x63\x28\xde\x61\x94\x64\xbb\x33\x2f\xa6\x61\x6d\xc5\x10\xe3\xc9\x09\x99\x90\xc5\x38\xdb\x4b\x3a\xe1\x58\xe0\xb5\x94\xf1\x39\xe3\x1d\x13\x50\x1d\x84\xc9\x5e\x18\x45\x5d\x6f\x87\x92\x9d\xb2\x44\x47\x30\xd4\x10\x7b\xe5\xd5\x91\x7c\xe0\xe2\x4e\x26\xee\x6b\x69\x31\x5b\xf4\x08\xe0\xae\x5c\x8a\x30\xe6\x29\xb5\x26\xd8\x20\x7f\x59\x3c\xc5\x8e\x87\xa7\x0f\x6e\xb4\xb7\x79\x1d\x6a\xbd\x6f\x38\xd1\x1a\x0c\xa2\x08\x59\x63\x89\x8b\x8a\xdd\xe3\x24\x7b\x26\x4f\x90\xd7\xee\xeb\xde\xf1\x54\xc6\xf1\xbb\xfc\xb4\xfa\xb2\x2c\x5d\xd4\x0b\x36\xa1\x77\xad\x6b\x40\xb0\x95\x13\x8b\xaa\x21\x57\x4d\x74\x09\xa9\xb2\x3a\xbd\x26\x83\xd1\xd0\x36\xfc\xe7\x23\xf7\x1d\xef\xd8\x12\x10\xb8\x41\x52\xc8\x10\x82\x94\x79\x52\x51\x4b\xf4\x32\xc2\x78\x55\x23\x37\xb1\xce\x2c\xaa\x27\x21\xcf\x2d\x0a\xe0\x9a\x36\xbc\x4f\xc1\x4b\xb0\xf9\x89\x3b\xa8\xac\x6b\x3c\xbb\x3b\x45\x73\x90\x70\xe1\x16\xaf\x22\x7d\x85\x4d\x60\x94\x88\x93\x40\x15\x5a\x81\xd4\x6b\x15\x29\x4c\x08\x66\x93\x97\x00\x15\xbd\xd2\x6c\x02\xa9\xe5\x8e\xaf\xc1\x4e\xd0\x01\xf9\xca\x08\xfb\xc3\x4e\x80\xf7\xdd\x48\xee\xb8\xa7\x0d\x70\xd5\x77\xa4\x7d\x66\x11\xbb\x96\x82\xa7\x0a\xb7\xb3\x6c\x00\x60\xd7\xa0\x83\x8c\x96\x1c\x2b\xea\x19\xb6\xa6\xe4\xce\x7d\x20\x05\x1d\x37\x50\xe3\x0f\xec\xe0\x3e\xab\x1b\xc0\x63\x4b\x0d\xb1\x4b\xc0\xd1\x8f\xe4\x2e\x70\x81\xfa\xea\x49\xff\x78\xc0\x47\xda\x70\xf1\xc0\x12\xb2\xca\xb8\x82\xbd\x56\x59\x66\xb0\x87\xce\x1e\xcf\x2c\xd0\x42\x05\xba\xb3\xb5\xd3\xe7\x80\xb7\xd5\x51\x10\xa7\x0a\x73\xb7\x26\x6d\xd5\x2e\x2a\x91\xd5\xd2\x90\xa6\x7c\x08\xc6\x8b\x9c\x8a\xe6\x63\xe6\x9f\x9a\x5f\x85\xde\x43\x2d\xef\x4a\x0d\x66\xae\xb9\x76\xf9\x20\x2a\xcc\x99\xf3\xa1\xcf\x74\xcf\x9a\x17\xe5\x71\x58\xc8\xca\xeb\xad\x61\x2f\x17\x45\x1b\xf3\xd8\xbd\xf0\xb4\x73\xb0\x2d\x76\xa9\xf3\xe6\xda\x17\x34\xa0\x03\x9c\xcf\x30\xc6\x7d\xdd\x81\xe4\x18\x5e\x6c\xfd\x97\x5e\xdd\x7c\x64\x7f\xb3\x07\xb7\x7e\xb9\xd0\x71\x17\x3e\xd5\xc6\xf0\x7e\x70\x2b\xad\xf1\x0a\xd9\xd5\xe6\xa2\xaf\x9b\x32\x43\x7d\x7b\x7f\x86\xc2\xe5\xd5\xd9\x00\x43\x23\x78\xfe\x82\xed\xbc\x44\xa3\xf9\x12\xce\xf1\x4b\xe5\x44\x52\x9b\xfd\x18\x32\x1f\xa1\xa3\x4c\x6e\xa8\x95\x17\xbf\x37\x72\x8b\x16\x4f\x98\x48\xc2\x71\x54\x55\xbc\xcf\xaf\xd9\xb5\xb2\x4a\x12\x45\x2b\x0b\x8e\x33\x5e\x89\x3c\x0a\x00\x6b\xb8\xd8\xf3\xf0\x4c\x1d\xc0\x6d\xb7\xd0\xa5\x78\xbe\x42\xed\xbe\xdc\x04\x52\x4e\x10\x14\x22\x29\xf4\x31\xec\x23\x99\x85\x6c\x9b\x7b\xd2\x5e\x75\xcf\x70\x1d\x8f\xf1\x1a\x66\x8a\x5d\x82\x39\x20\x5e\x9f\xb6\x5c\x8d\xae\xa6\x16\xb0\xec\x93\xcd\x45\x66\x93\x2e\xcc\x18\x2c\x65\x60\xcc\xbc\xf1\x74\xc6\x21\xa1\x4b\xd5\xeb\x6b\xb3\x5b\xdc\xdb\x50\x9a\x9b\x00\x03\xba\x04\x36\x94\x36\x8d\x0d\x77\x7a\x13\x91\xb2\xc8\x63\xc7\x51\xa2\x19\x8d\x2a\xfa\x7c\x89\xf7\x3d\xd4\x06\x97\x02\xf1\x46\xcc\x6e\x8b\x45\xd7\xf3\xf0\x90\xbc\xce\x12\x01\x6b\xba\xf4\xf1\xd2\x46\xfb\x48\x5d\x39\x0f\x73\x89\xec\x6e\x61\x13\x45\x2c\x57\x74\xc1\x2a\x0e\xe3\x1f\x76\xb5\x62\xaf\x5b\x07\x5f\x4c\x42\x5d\xd8\xd4\x99\x3b\xae\x39\x82\x8b\x86\xa1\xcf\x30\xc0\x56\xf3\x2c\xb8\x31\xd4\x5f\x40\x96\x44\xcb\xc2\x59\xfd\x6b\xa5\x34\x4c\xc7\x85\x3a\x11\x14\x36\xd3\xc1\x4a\x28\x7a\x5a\xc3\x15\x4c\xce\x28\xf4\xe3\xd6\xb1\x2d\xd3\xcd\x6c\x2c\xbc\x50\x49\x28\xcf\x42\x5a\x53\xa1\xf8\xe2\x12\xd4\x33\x54\x5e\xdd\xa1\x34\xc7\x1a\x53\xbc\xc0\xca\x67\x26\xa8\xbb\x5a\xdf\xe6\x0e\x5f\x2d\x0c\xbb\xa5\xad\xe3\xe7\x58\x0c\x72\x04\x3e\x71\xd6\x65\xcd\xdf\xad\xee\x17\xb5\x96\xe7\x61\x6d\xb1\x72\x8a\xdb\xaa\x75\xa2\xfa\x3a\xea\xc9\x6a\x47\x84\xec\x48\xd0\x09\x03\xb9\x55\x6b\x16\x14\xd4\x03\xa3\x59\xfe\x0c\x0a\x00\x44\x57\x2b\x73\x66\xe1\x01\xe9\x30\x02\xbd\x05\x1a\xda\x65\xcb\x78\x7d\xb6\xcf\x29\x09\x65\x23\x14\x78\x2f\x29\xe4\x91\x7e\x70\x09\x88\x85\x8a\xa1\x31\x2f\xe8\x22\x75\x53\x40\x16\x88\x47\x35\x3d\x34\x71\x82\x2e\x0b\xc7\xa3\xd3\x6e\x9c\x63\xc5\xea\xc8\x03\x67\x81\x0f\xbb\x7b\xbb\x06\x57\x5c\x4f\x0d\x7e\xa4\x4d\x71\x46\xf2\x3b\xaf\x2f\x31\x36\x3a\xe2\x04\x3a\x53\xe5\x83\xc6\xe3\xaa\xe0\x62\x1f\x95\xd9\x0c\x98\x53\x34\x8f\x50\x87\x38\x57\xa7\xb7\xba\x84\x6e\xa6\xb5\xb9\x77\x97\xe4\x30\x40\x80\xd4\x03\x20\xcf\xc9\xc1\x7d\x74\x97\x86\x02\x87\xf0\x14\x03\x3e\x2e\x7a\x74\xa9\x07\xeb\xd9\x5a\x5b\x31\x53\xe0\xfb\x06\x84\x63\xa1\xee\xd9\xf5\x45\x2c\xb5\xf0\xd7\xba\xf3\xfc\x37\xbb\x41\xa9\xc4\x7f\xf3\x67\x08\xb6\x3f\x17\x2e\x6f\xc9\xb5\xc0\x45\x6f\xa9\xf5\x58\x7c\xad\xd1\x4c\x0b\xb5\xbc\x0b\xe9\xb8\x11\xef\x6b\x93\xe5\x22\x2f\xab\x0a\x36\xbe\x2d\x2f\xb0\x09\x3a\x75\x82\x98\x1c\x56\xa3\x7d\xc0\x38\x9a\xa8\x49\x4d\x77\x3a\xa5\xfb\x58\xb2\x9b\x3b\xdc\x9a\x19\x21\x10\x51\xae\x76\x60\xa7\x28\x92\xb4\x6d\x83\x3a\x48\x78\xe2\x38\xad\xc1\xc0\xb7\xb6\x1e\x6a\x75\x11\x0e\x84\x08\xbb\x0a\xb9\xde\x9e\x26\xfe\xb0\x35\x2d\xca\xc2\x69\x23\x81\x52\xc3\x2f\x2f\xa4\xdf\x8d\xd9\xf4\x0b\xbc\xa0\x8c\xa2\x69\x55\x9a\xb5\x2c\x32\x9d\x45\x85\x4e\xbb\x1d\x0e\x76\xe6\xee\xd9\x8a\x6b\xb8\x44\x72\x1d\xcd\xb2\x31\x65\xc3\x60\xc9\xea\x02\x06\xd1\x22\x68\xa2\x91\xc5\x9a\x40\xf1\x95\x73\x63\x9a\xd5\xbd\x5e\xd5\x3e\xdb\x88\xb3\xbe\xc4\x81\xe8\xaa\x21\xc2\xf2\x9a\x90\x9c\xde\x83\x1e\xb9\xdd\x34\x06\xba\x84\xb9\xd7\x4a\x17\xa6\x84\x7b\x3c\x2b\xcf\xf4\x06\xc0\x5c\x91\xbb\xdc\x6f\xf6\xbe\xb2\x20\xa3\x85\x87\x86\x62\x6e\x27\x9b\xf1\x70\x46\x6e\x4e\xc6\xa1\x95\x14\x58\x76\xac\x33\x3f\x05\x99\xd0\xc0\xbd\x9a\x2d\x6e\x50\x9d\x23\x8c\xc1\x77\xf6\x33\x13\xed\x03\xd9\xf6\x92\x4e\x9f\x6b\xe9\x11\x37\xc0\xf4\x33\x89\x62\x39\xae\x0a\x66\xbd\xab\x2a\x24\x1a\xc3\x5b\x38\x31\x32\x71\x10\x8d\x11\xcc\xaa\x80\x0a\x7b\x1f\x57\x93\x88\x2d\x41\xab\xa3\x8e\xe9\x91\xa2\x51\x7a\x8c\xe3\xb2\x72\x84\x3e\x60\xf9\x4e\xc3\x2c\x10\x8e\xac\xe3\x76\x2d\x06\x08\x9b\xf8\x5c\x0e\x42\x3e\xc8\xee\x4a\xc6\x2b\x6e\xc9\x34\xc2\xe4\xaa\x16\xd1\x12\xe6\x8d\x72\x73\x34\x3b\x38\x5c\x32\x96\xa6\x9c\xeb\x15\x19\xb9\x8b\x89\x47\xe9\xfa\x0c\x11\x9f\xe1\x84\x51\xbd\xdc\x57\x5f\xb3\x9e\x19\xca\xce\x2e\xf2\xca\xaf\xc9\xc6\x98\x93\xcd\x52\x6a\xd0\xec\xf6\x4d\x8e\xf8\x94\x9f\xaa\xb3\xfa\x4b\x44\x46\x3f\x54\x15\x33\x34\x0b\x37\x84\x33\xfd\xf3\xbd\x0b\x4b\x86\x60\xf6\xa0\x5a\x47\x37\x0e\x20\x1d\xae\x6e\x12\x9d\xbe\x3e\xe2\x77\xfa\xee\x0e\xdb\x5a\xae\x5c\x5d\x5e\xb2\x12\xbd\x3f\x42\x0b\x4f\xfa\x80\x07\xe9\x4e\x95\x5c\xdd\x4e\xad\x2b\xc1\x59\x2d\x8d\xf8\x01\xcc\x67\x5b\x5c\xd2\xaf\xab\xcb\x43\xfc\xe3\x31\xab\x16\x70\x59\x15\x83\x61\xa3\x89\xbe\x79\x80\x58\xa7\xe3\x5f\x99\x82\x3f\xd3\xe7\xdf\xec\xee\xf7\x5d\x9d\x42\xb1\x81\x22\x01\x36\xac\xab\xb9\x64\x1e\xde\x7c\x8d\xc7\xb1\xec\xf2\x75\xc7\x31\xc3\xaf\x60\x29\xb3\x4d\x8c\xcc\x43\xc1\xb1\x6d\x12\x15\x53\xd6\x41\x2d\x49\xbe\x40\xa5\x65\xa7\x84\x87\xda\xf0\x08\x4d\x43\x80\x57\x07\x09\xc7\x34\x68\x88\xfc\x1a\xfa\x65\x8f\x07\x31\x3a\x3d\xe2\x63\xe8\x95\x8e\x02\x14\x7e\xc3\xac\xd3\x1d\x73\x09\x1e\x0a\x49\xa8\x4b\x09\x7e\x66\x37\x83\x28\xcf\xf9\x27\x4f\x4b\x7c\x66\x94\xef\xcf\x59\x14\x42\xb1\x47\xb5\x8a\x5c\x13\xe7\x98\xb5\x90\x9e\xb4\xd2\x8b\x7d\x86\xa8\x83\x22\x36\x66\xc0\xb1\x7c\x86\x9e\xc7\x7c\xf0\x32\x76\xb8\x33\x78\x61\x53\xf8\x18\xd1\x3b\x01\x32\xfd\x18\x31\xbc\xb5\x36\x3a\x66\x33\x5a\x6a\x7d\xff\x99\x2d\xb4\x99\xfc\x08\x9b\xdd\xb8\x9b\x3b\x53\x2b\xdc\xcf\xbf\x0e\xab\x6d\x59\xed\x93\x6e\xf2\x5c\x21\xfe\x95\x9f\xfc\xea\x5e\x6d\x4f\x1d\x0b\xd9\x5d\xad\x96\x5a\x0a\xb4\x40\xf5\x5b\x7e\xe8\x57\x7a\x4f\x76\x6a\xb3\xfc\x1e\xd7\x0f\x66\xd7\x0f\x65\xd7\x2f\xe7\xfc\x16\x3e\xca\x08\x3f\xe3\x5c\x3d\x9f\xf3\xbb\xdf\xd1\x15\x92\x8b\xf9\x0e\x64\xe7\x1e\x86\x25\x6b\xd9\x3d\x91\x62\x22\x8e\xd4\xa5\xb8\x38\xb4\xd2\x2a\xbf\xc9\xe0\xdc\xe7\xef\xd7\x3c\xcf\xbd\x7e\xca\xe4\x15\x23\xf4\xac\xa3\x4d\x93\x73\xd4\xa6\x77\xcc\x72\xca\x1c\xcb\xa2\xed\x95\x1f\xd3\xa9\x23\xd6\xf4\x7d\xa8\x4d\x65\x17\xca\xf9\x33\x8c\x20\xf8\xc9\xe7\x97\xac\xf4\x8f\x3e\x69\x2c\xbe\x30\x8b\xe1\x61\xab\x8e\xc0\xb3\xbe\xff\xb6\x66\x8e\xba\x5e\x12\xc5\xb4\x72\x6d\xa0\x42\x66\x08\x7d\xa7\x5f\xf9\xfe\xab\xfe\xbb\x0c\x81\x96\x52\x12\x57\xfd\x10\x5e\x06\x47\x1f\xa1\x3c\xac\x89\x47\x7b\xe7\x9e\x4e\xfe\xd5\xee\x6d\x4b\x49\x6b\x9c\x7a\x3a\x75\xca\x4f\xfc\xa9\xf3\xa6\x90\xe8\x3d\x3c\x93\xfc\xec\xd4\xa9\xf3\xc5\x7f\x1d\x48\xcd\x72\x9e\x3f\x52\x89\x5e\x95\xb3\xb2\x37\x5b\x66\x0c\x91\xe1\x75\xae\x83\xe6\x7b\xff\x35\x5f\x80\x4d\xab\x1f\x5e\x27\x4f\x4d\x76\x35\x07\x2f\x38\xe5\xf3\xb6\x15\x0e\x9f\xd2\xc8\x21\x0c\xde\xe4\x0d\x9f\x65\x0d\x48\x95\x0c\x5f\xc0\x0d\x5f\x14\x0d\xbf\x46\x4d\xc8\x14\x2d\x5f\xd9\x0d\xdf\x41\x9c\xa3\x61\xcd\xde\xf9\x1d\xbd\x73\x7d\x34\x9c\x93\x53\x37\xbf\xa3\xd7\xff\x47\x7a\xe6\x3f\xa5\x67\xb7\x0c\xf5\xd6\x8f\x1f\x40\x84\x2b\x85\x7b\x7a\x49\x86\x44\x48\xf6\x53\x3e\xf5\x4f\x19\x12\x69\x84\x1f\x85\x24\x9e\xba\x0a\xd5\x13\x4a\xda\xf7\x78\xfd\x3a\x9c\xbe\x31\xae\x06\x64\x6c\x86\x20\x1c\x56\x10\x6f\x86\xdf\x6f\x46\x10\x6c\x16\x9f\xa3\x0e\x14\xe0\xa7\xb5\x9c\xb6\x99\xf0\x96\x20\x20\x2e\xa7\xfc\x4a\xef\x94\x4d\x57\x44\xf0\x70\x46\xb0\xdf\xd1\x73\xff\x03\x3d\xe7\x9f\xd3\x5b\xde\xbc\xeb\xe8\x3f\xb0\xc5\xb7\x8d\x72\xf4\xc7\x1e\x83\xde\x95\xbe\xc6\x7d\xf9\xdb\xc7\xff\xfc\xf3\x7b\x9e\xc6\x72\x49\xec\x52\x24\x84\x14\x29\x7c\xbd\xfd\x3c\x6f\x15\xd0\x1e\x49\xe4\x12\xe4\x27\x30\xa8\xb9\x17\xb8\x6c\x28\x77\x69\x23\xaa\x85\xbf\x99\xac\xc8\xc3\xd9\xde\xe7\xe0\x3c\x2f\xc5\x3c\xcf\x91\x54\x2b\x50\x16\x3f\x82\x9a\x4f\x46\x33\xbe\xbb\x2a\x04\x63\xbd\xe1\x5e\xce\xf8\xcd\x82\x8d\xe3\xcb\xe0\x2a\x36\x86\x2b\xa8\x95\xc8\x3a\x1b\xdb\x9d\xc7\x8a\x28\x38\x1e\xc7\x0c\xce\xa6\xc5\x52\x5d\xb7\x01\x5a\xa2\x72\x76\xab\x2e\x48\x26\xce\xb0\x10\xd0\x3d\x7d\xa5\xaf\xd9\x70\xe0\x13\x76\x0c\x87\x68\x0a\x5b\x7c\x9d\x5e\x25\xd8\x5d\x7d\x2f\x59\x71\x3b\x61\x06\xd8\x60\x56\xde\x87\x72\xd2\x22\x91\x75\xb1\x8b\x57\x51\x9a\x69\x6f\x9b\xb3\x95\x40\xf3\xd3\x20\xf3\xe5\x28\xac\x1c\xdf\x4d\x5c\xe7\x99\xa7\x2f\xba\x8c\xda\x3f\xc1\x9e\x43\xec\x4d\x74\xc3\x12\xa5\xbd\x96\xa8\xb0\x6c\x2f\x0f\x02\x11\x26\x46\x90\xc1\xda\x34\x61\x4a\xc4\x51\xb7\x05\xad\x7c\xab\x48\x6b\x2e\x74\x63\xd2\x7c\x0c\x79\x82\x89\x5d\xc3\xb9\xe2\xa9\x13\x68\x43\x73\x0d\xf9\x67\x29\xc8\x8d\x1a\xc5\x93\xa5\xdc\x60\x6e\xaf\xeb\x2a\x96\x73\x07\x8c\x30\x9d\x71\x59\xeb\xf2\xa0\xf6\xe6\x3e\x87\x23\x6a\x66\x77\x57\x1b\xf2\x2d\xf7\x74\x98\xa8\x93\x55\x45\xdc\x9d\xd2\xc2\x9c\xea\x13\x0b\x92\x0b\x5f\x1b\x02\xf7\x06\x50\x12\x61\xf3\x88\x97\x34\xba\xe1\x74\x34\x11\x31\xad\xc1\x34\x88\x7b\x11\x63\x4d\x21\xa9\x21\x57\xf3\x8d\x8e\x62\x96\xdb\x73\x85\xbb\x4d\x66\x36\x76\x41\x34\xf1\x78\x0c\x71\x58\xd2\x20\x3c\xb4\x12\x4d\x94\x93\xd0\xcb\x49\x38\x40\x89\x2a\x27\x79\x5c\x44\x48\x79\xca\x4b\x0c\x5c\x4b\xb6\x80\xa8\x26\x65\x9a\x31\x34\x0c\x47\x3c\xc3\xb6\x48\xda\x66\x62\x00\xfd\xd0\xb8\xfb\x58\x4b\x4d\x76\xdf\x2c\xe5\xc2\x18\xd6\x2c\xac\x12\x73\xe7\xef\x61\x5e\x67\x61\xdf\x0b\x00\x1e\x3c\x05\x26\x4a\x97\x0a\x4a\x0f\x64\x86\x92\xfb\xa2\x21\xf7\x34\x6b\x08\x25\x40\xbb\xb1\x3d\xf8\xd5\xaf\xd7\xb2\x83\xf5\x22\xac\xd4\x4b\x5f\x64\x1a\x75\x9d\x11\xa7\x4f\x77\x8b\xa6\x19\x08\x84\xe6\x87\x83\x11\x0a\x3c\x84\xf9\x05\x08\x5c\xa1\xb9\x3d\x6d\x14\xdb\xf5\x09\x66\x78\x78\x6b\x33\xd6\x78\xdd\xe6\xe9\x08\xa8\xab\xba\xb7\xa8\x9f\xc3\x16\x06\x0c\xf4\xe9\x83\x37\x72\xa3\x1c\x90\xb2\xe5\x03\x3e\x43\x36\xa3\xcf\xd4\x59\xef\x40\xdc\xa3\xcf\x54\x43\xf6\xc7\x89\xf6\x7a\xfc\xa9\xa7\x70\x4d\xe9\x00\xe3\xaa\xc5\xcd\x6b\x34\x6e\x88\xd1\xf9\x75\x33\x09\x7a\xc6\x5b\x49\x8f\xfd\x81\x9b\xd3\xbe\x13\x63\xb2\xde\x4c\x86\x2d\x4c\x94\x45\x49\x36\xbd\xdf\xc7\xd8\xa7\xa6\xfd\x02\xb5\x37\xcc\x72\x33\xb7\x57\x18\x8c\x88\x07\xde\xcd\x02\x3f\x63\x13\x44\xcd\x40\x7b\xc5\xee\xad\x26\xde\xd2\x29\x9f\xec\x91\x9e\x8a\xec\x51\x53\x7c\xc7\x58\xec\x53\xac\x05\x5d\xbd\x3e\xa5\x27\x59\x46\x7d\x9f\xe3\xd9\x0b\xdc\xce\x62\x5c\xee\x23\x8e\x08\x6c\x86\xf7\xad\xbf\x34\x99\xc8\xff\x27\x99\xf8\x9f\x64\xe2\x7f\x92\x89\xff\x74\x32\xe1\xf6\x06\xe6\x74\xf1\x6e\xf2\xe1\x3b\xf8\x63\x5f\xc1\x7f\x60\x5d\x21\xe1\x4c\xde\x14\x0c\x48\x78\x07\x56\xc8\x08\x94\x7f\x29\x99\xf8\x8d\x5e\xf2\x4f\xe9\xfd\xd7\x92\x89\x49\x3a\x93\x12\xce\xec\x14\xc8\x80\xde\xc1\xbf\xfe\x0a\xfe\x81\x28\x9c\x48\x75\x9e\x73\x0e\xc7\x17\x4e\x9b\x72\xb6\x33\x0d\xfa\x17\x92\x89\xdf\xe8\x99\xc2\x3f\xa5\xf7\xdf\x93\x4c\xf4\x15\xbc\x97\xef\xc7\xf4\xd5\xec\xb7\x64\xc2\xfc\x99\x4c\x40\xb3\x1e\xe8\x13\x4b\xc3\xaf\xb1\x08\x23\xfb\x3a\x3e\x02\x65\x1b\xa1\xd0\x6b\x4d\xef\x94\x69\x1c\xcb\x02\x8a\x0e\x50\x50\x80\xed\x0d\xe0\x00\xcb\xeb\xe2\xba\x73\x25\x9e\x75\x39\x4d\x55\x82\x5e\x11\xa2\x16\x8a\xb4\xa1\x4b\xa1\xd7\x80\x20\x00\x8e\xc0\xf4\xa0\x22\x44\xad\x97\x6d\x79\xbc\x2e\xcb\x8d\x7c\xdc\x6e\xb7\xc7\xf0\x08\xc4\x20\xbc\x3e\x51\x63\x29\x90\xac\x53\xce\xc0\xef\x86\x3a\xcb\x40\x87\xc4\x1c\x23\xd1\x35\xdd\x15\x9e\x4b\x80\x14\x02\x94\x7c\x88\x16\xb0\x92\xea\x60\x64\xb3\x04\x94\xb6\x8a\x5f\xad\x34\xb9\xe6\x2d\x78\xc6\xaf\x81\xb0\x4c\x76\x5e\x61\x2a\xe2\x9d\x9c\x9e\x93\x3b\xf4\x9c\xc8\xb8\xf3\xdb\xe7\x25\x04\x4d\x51\x73\x27\x77\x39\xe4\x49\x1e\x0b\xfe\xfe\x14\x21\xb4\xe8\x8e\xd7\xc2\xd7\xc4\x22\xab\x91\xc8\x4c\x0f\x11\x64\x1e\x5b\x09\x8e\xed\xed\x49\x00\xf1\x63\x1f\xef\x5c\x1b\xb0\x8b\x69\x59\x00\x29\x3e\x2e\x46\x77\x8b\x72\x28\xda\x15\xc6\x72\x6c\xc8\xc4\xcc\x1b\xa5\xdc\xef\xde\x61\x6f\xc8\x73\x1d\x86\x67\x7c\x4f\x06\x84\xaa\xcf\xd4\x83\xdf\xdd\x25\xdf\xb4\x8b\xb3\xb0\x44\xad\x78\xf1\xd4\xae\xb5\x0c\x74\x42\xd8\x92\x31\x53\x45\xd8\x4d\xb8\xf7\x90\xad\xae\xfc\x58\x99\x65\xc7\x5c\x48\x3b\x2d\x91\xa7\xdd\x34\xb2\x33\x8a\x04\x1a\xe0\x52\xda\x22\xec\x0d\x2e\xa7\xd1\x8e\xae\xd7\x54\x1b\x5f\x35\x1c\xb0\xa3\xf1\xa8\x57\x39\xcd\x94\x30\xe4\x36\xa4\x2b\x1e\x9a\xb8\x5e\x09\xd2\x4f\x90\x69\x18\x0a\xdb\x91\x6a\x24\xd7\x70\xb9\x29\x95\xc0\xc8\xd2\xe4\xae\x85\xa7\xa3\x10\x75\xcb\x73\x3b\x6a\x98\xef\xdf\x7b\x6b\x4d\x41\x4e\xc0\xdc\x46\x87\x11\x9e\x8e\x32\x4f\x26\x27\x47\xda\xad\x36\x46\x08\x4e\x1f\x7e\x0c\x76\xa2\x72\x64\xaf\xd9\x6b\xd4\xd7\xa5\x81\xc5\x2b\x2d\x2a\xd7\xb8\x35\xc8\xd9\x9f\x35\x6b\xce\x2c\x60\x1e\x4d\xda\xcc\x81\xca\xc1\x3d\x29\xb0\x94\x30\x1e\x48\xf9\xb0\x15\x19\x89\xdd\x04\xda\x11\x2d\x01\x9e\xe1\xc1\xca\x37\x64\x81\xaf\x93\x21\x6d\x10\xef\xa7\x2e\x7a\x3b\xaa\xb5\x92\x04\xb5\xd8\x33\xa9\x2f\x46\x70\x15\xda\x9a\xdf\x97\x4d\x3f\x6a\x6c\x6f\x05\x6f\x19\xbb\xa4\xa9\x0a\x8c\xb6\x98\x52\x57\x91\xa2\xac\x53\x4f\x57\x6c\xcb\x22\x96\xb5\x6e\xe3\x34\x75\x85\x87\xef\xba\x82\x50\xdc\x28\xa3\x3c\x6d\x35\x4c\x70\x83\xbc\xe9\xc5\x99\x7e\xde\x45\x3d\x75\x56\xa1\x12\x2b\x86\x73\x6e\x30\x20\xb3\x91\xe6\x69\x19\x6a\xf8\x98\x57\x3f\xe7\x57\x43\x2f\x21\x9f\x02\x99\x83\xf1\x96\x2b\x10\x23\xe1\x0e\xcf\xe9\x30\x7a\xda\xb7\xec\xea\x01\x54\xad\xae\x72\xba\x18\x3f\x50\x3f\xf1\x34\x33\x57\x48\x20\x55\xd7\xc8\xb9\xda\x06\xe0\xf7\x77\xb7\xc6\xe8\xf9\x45\xe0\x15\xa8\x9e\x39\xb7\xb1\x1a\xe9\x93\x88\x02\xad\xf8\x6b\x1f\x4b\x7a\xde\x87\xbf\xd9\x63\x49\xbf\xbe\x9e\x8d\xea\xf3\xf9\xf3\x58\xfe\xfb\xd5\x16\xfb\xc5\xe8\xc7\x14\x36\x59\xd7\x4a\xbd\x7e\xf2\x59\x01\x41\xed\x26\x41\xf0\xfc\x3e\x20\x3b\x5e\xbc\x16\xc6\x0e\x4e\xf0\xf4\x60\x71\x53\x5c\x97\x3d\xe1\x55\x74\x68\x29\x2c\x78\xc1\x54\x1a\x49\xdd\x7a\xfe\xb9\x22\xd7\x5b\x99\xdc\x0c\x0a\x7d\xbc\xcc\x64\x38\x53\x95\x91\x6f\xce\x52\xe2\x40\x0b\x90\x3e\x16\xd4\x1b\xd7\x8d\xe2\x1a\xf0\x3e\xc4\x1a\x72\x31\xd0\x5c\x50\x9f\x99\x0c\x01\xf7\x2e\x04\x1f\x05\x01\xbe\x88\xf4\x2c\x06\x32\xd2\x35\x44\x1f\xa0\xd3\xf1\x69\x83\xc2\x06\x40\xe9\x29\xa0\xd7\x4a\x76\x28\x61\x0f\x9a\x60\x30\xc7\xfb\xa9\x5f\x34\x95\xc4\xf4\x6a\xc8\x5d\x08\x15\xc0\x9a\x4b\xed\xdc\x1b\x8f\x29\x8a\x26\xa2\x09\x95\xbc\x15\x7a\xc4\x60\xac\x35\x0b\xa2\x6e\x7c\x6e\x50\xd0\x8a\x63\x74\x57\x27\xc9\xd8\x70\xde\x3c\x2c\xfe\x8e\xa7\x8a\x3d\xa6\x14\xc2\x44\x6c\x0d\x0e\xbb\xa6\x1b\x32\x6c\x1b\x13\x98\xd2\x97\x45\xbf\xe8\x87\x04\xe7\x2f\x71\x60\xd3\x68\x6a\xb5\xed\x1c\xeb\xe9\x31\xc1\xa5\x1c\x8d\xd6\xb5\xd3\xed\xad\x93\xb8\x7d\xe9\x78\x87\xee\x8e\x8b\x94\xda\x79\x1b\xe9\x33\x5e\xa5\x5e\x24\xb0\x63\x43\x68\xec\x62\x35\x6d\x6a\xd6\x29\x04\xc4\xf4\xb4\xa6\xe2\xb6\xbc\xc8\x47\x35\x9d\x95\xce\x14\xf1\x33\x70\x37\x8d\x09\x51\xda\x4e\x96\xd6\xa2\x88\xb8\xc5\x04\x50\x14\xe4\x46\xc6\xb2\xf1\x5b\x58\xf8\xb4\x57\x8c\x0e\xac\xf6\x67\x95\xe6\x10\xf1\xf2\x20\x43\x02\x02\xe8\x83\x4c\x7b\x06\xa9\x2b\x99\xce\x5d\x91\x6a\x2c\x5c\xc4\xc3\x12\x1b\x1c\x46\xa4\x5f\x2f\x0d\xb8\x8b\xcf\x7d\xe8\x8f\xa7\xb3\x3d\xf2\xd4\xb9\x42\x58\x53\x44\xbd\x4b\xa1\xc6\x51\x4d\xf4\xc3\x41\x2b\x46\x3c\x5e\xb4\xdd\x10\x40\x64\x54\xdd\x40\x80\x93\xc4\x16\x19\xd2\x65\xda\xf5\xcc\xfb\x07\xb7\x8b\x76\x7c\xe9\xf7\x26\x48\x58\xbb\xb6\x4a\xc9\x94\x4d\x9a\xc9\x78\x5d\x0a\x0e\x42\x2e\x91\x26\xec\x50\x7d\xa0\x39\x0a\x2c\x97\x67\x51\xa7\x86\x06\xc9\x21\x95\xe5\x57\xfe\x0c\xb9\x41\xc0\xc6\x97\xbc\x2c\x5c\xc0\xd4\xa5\x9b\x0c\x29\x68\xb7\xf0\x3d\xec\x3e\x77\x8c\x10\x6d\x8c\x03\x61\x76\x7e\x14\xb7\x99\x93\x64\xb3\xb5\x98\x23\x4a\x6a\x41\x73\x70\x67\xc6\x07\xe5\xae\x8f\x5d\x96\x5e\x3c\xc7\xcf\xdd\x1d\xb1\xd6\xd3\xb9\x93\x13\x44\x95\x98\xb1\xea\x3e\x8c\x02\x01\x18\xb6\x0b\x78\x64\x10\x3f\x89\xe6\xd4\xfb\xc7\x35\xb9\x56\xe2\x7c\x94\x6b\x23\xc7\x79\xb5\x06\x31\x63\x24\xd7\x30\x4d\x9c\x41\x17\xe5\xbf\xb2\x10\x98\xeb\xf4\xff\x17\xaf\x7f\x47\x70\x0d\x41\x14\xdd\x95\xda\x29\x43\x90\x13\x9b\x09\x35\xf7\x04\xc7\xcf\x02\x65\xbd\x8d\xc7\x36\xb1\xf9\x72\xee\x57\xb0\x21\xcd\x1e\xeb\xdc\x07\xd7\xc1\xf4\x61\xb2\x57\xb4\x4c\xb8\x72\x8d\x18\xc4\x9a\x14\xce\x3a\xe4\x22\xb5\x3e\x9b\xdc\x80\x26\x70\x82\x02\x87\xc7\x0c\xe3\xed\x5a\x1e\x56\x09\x5a\x00\x4d\xa2\xcb\x92\x08\xa7\x42\xd5\xf8\x76\x2f\x6c\xe3\x75\xa6\x67\x9e\x13\x3b\x08\xad\x0a\x3d\x0b\xd8\x49\xfe\x78\x0e\x8f\xd9\x6e\x9c\x01\x41\xc3\x24\xa7\xc0\xf9\x41\x2b\xf0\x6e\xc8\x35\x24\x30\x94\xfc\x60\x0d\x08\x1b\x42\xf1\xac\x89\x63\x94\xaa\x3c\x8f\x77\x42\x13\x9d\x2a\x2d\xa4\x24\x52\xcb\xb7\x44\x1e\x88\x05\xde\xb2\xe1\x1e\xa0\xa5\x9e\xa3\x13\x12\x8a\x49\xf7\x20\x80\xda\xa9\xd7\xe7\xad\x39\x24\xff\x75\x27\xcc\xe6\x49\xf4\x71\x72\xbf\x74\xab\x0e\x49\x62\x23\x0c\x68\x40\x40\x56\x75\x5b\xa3\x27\x2c\x49\xf3\x23\xd0\x4c\xf7\xa9\xf5\x53\xa9\x28\xec\xa4\x29\xee\xac\xc0\x2a\x99\x5f\xc8\xe6\xe2\x36\x39\x68\x58\xcd\x65\xc5\xf9\x9e\x4b\x24\x09\x39\x2e\x4c\xda\x1d\xa2\x32\xb2\xc6\x72\xab\xe1\x7d\x52\x34\xeb\x7e\xb5\x51\x48\x8a\x50\x4b\x11\xf2\xf6\x3a\x2e\xc7\xb0\xb9\x3b\xee\xd6\x8c\xb8\xf2\x02\xa3\x58\xe7\xd4\xfc\xaa\x18\x45\xeb\xe8\x4f\xb3\x88\x56\x23\x29\xd4\xa1\xa6\xa6\x43\x08\x66\x38\x65\x1b\xed\x8e\x5e\xda\xdb\xa6\xc5\x77\x91\xde\x9d\xec\xee\x2a\xf7\xa7\xb1\x03\x90\xb4\xef\x18\x99\x69\x75\x10\x5d\x3c\x7d\x3e\x31\x53\xc0\xd7\x62\x7e\xdd\x1d\xc6\xbf\xe2\x90\x08\x87\x37\x3b\x73\xa5\x52\x58\x29\xf9\x92\x64\x6d\x48\x55\x4f\x7e\x51\xe8\xa1\xe8\x5e\xf4\xc2\xf0\xcc\xde\x36\xba\x90\x3d\x22\x26\x69\xac\x31\x9c\xd0\x83\x68\xef\x6c\xd0\xb2\x30\x73\xa6\x1c\xd5\xca\x09\xcf\xac\xd7\x41\x03\xf3\x6b\x01\xbf\xe1\x1d\xbf\x01\xa3\x2f\x1c\x13\x5c\x50\x57\x7a\xe6\x28\x99\x30\x52\x86\x85\xda\xeb\xb1\x41\x66\x00\x46\x5a\x10\x9f\x6e\x35\x0a\x7b\xba\xf2\xec\x88\xbc\x5a\xc2\x97\x2a\x9f\x60\x4c\x66\x7b\x2c\x1b\x45\xd6\x31\x27\x71\xdb\x45\xd0\x42\x75\xe1\x22\xf1\x29\xa1\x65\x03\x2f\x26\xa8\x62\x16\x4f\x58\x05\x16\x70\xd4\x08\x49\xa8\x7f\x21\x7d\x49\x97\x57\x85\x6e\x05\xe7\xba\x88\xf4\x85\x10\x1b\x52\xc4\xc0\x36\xde\x01\x29\xd7\x2d\xc3\x1b\x2a\xa1\x96\x9e\x36\x74\x2f\x4c\xeb\x7a\x81\xd8\x56\xd2\xa6\xb0\x0f\xe1\x6c\x05\xe1\x5b\x84\x64\x60\xcd\x4d\x15\x6c\xfb\xb9\x02\xc4\x64\x59\x13\xd3\x13\x90\xb0\x4c\xc2\x6c\x97\xa6\xbd\x8e\x09\x47\x5a\x16\xb0\x3b\x50\x69\xa6\x00\x2d\x03\x9e\xae\x2f\x6f\x6c\xba\xb2\xed\x32\xb7\xac\xc2\x8e\x99\x83\x5a\x70\x5c\x8d\x8c\x9b\x96\xb6\xb4\x1b\x79\xd3\xa2\x64\xdb\x06\xcf\x8e\x06\xd4\x59\xeb\x5b\xaa\x3c\x22\xca\x31\x1a\x1e\xc7\x90\x25\xc8\x36\x30\xc2\xe8\x2b\xf9\xbc\x63\x0b\xf6\xfe\x39\xc2\xba\xb7\xa8\xd0\x91\x19\x23\xfc\xc5\xaf\xe3\x99\xeb\xe3\x6f\x86\x1d\xbf\x7f\x74\xe9\xf3\x38\x72\x6c\x67\xc6\xe1\xf9\x55\x31\x2b\x37\x71\xee\xfb\xa9\x4d\x2f\x61\x34\xbe\x10\xd1\x0d\x84\x1b\xf8\x1c\x09\xf5\x55\xeb\xe0\x28\x83\x4c\x05\xb0\x30\x4d\x51\x6d\x85\x41\x5d\x7e\x16\x31\x4e\xe0\x9a\xac\xe7\x14\xef\x37\x16\x3b\x26\x32\x3c\x5f\x25\x75\x86\xfe\x67\xb2\xe4\xa4\x38\x5a\x0f\xfe\x79\x18\x24\x7e\xdc\x2a\xeb\x1a\x5e\xaf\x73\x10\xef\xcb\x63\x07\x00\x94\xd8\x2b\xab\xf4\xe0\xfb\xfd\xe0\xe7\x45\x46\x6c\x92\x60\x26\x7b\x88\x50\xfe\x91\x21\x4f\xb9\xdc\xc0\x57\x9e\xcb\x11\x53\x64\xc9\x05\x85\x7a\x0b\x01\x58\xf4\xd9\x9a\xe8\xd1\xf8\x37\xf9\xe5\x10\x04\x40\xe3\x2d\x86\xa0\xa7\xd7\x1e\x56\x38\x74\x6c\xa1\x59\x89\x29\xa7\xc7\x12\xfa\x64\x89\xaf\x22\xe0\x25\x6c\x77\x0a\x41\x05\x50\x15\x86\xaf\x59\x84\x9c\x11\xd0\xc7\xf4\x55\x1c\xda\x73\x66\x75\xf7\x83\xe5\x96\x1f\x7e\x30\xd1\xee\xe9\x86\x8d\x56\xab\x1b\x74\xc7\x0a\x61\x8d\x4e\xcb\x77\x05\x4d\xe1\x64\xe1\x39\xc6\xf1\xc5\xb8\x2c\x43\x1e\x5d\xef\x2f\xbd\xbe\xc6\x24\xbc\x73\xbd\x04\xe6\x10\xd3\x33\x6e\xad\x1b\xc9\x74\x33\x78\x63\x92\xcc\x43\x88\x4b\xa8\x90\x26\x42\x5e\x97\xab\x3c\x65\x32\x07\x47\x15\x6d\xdc\x44\xae\x97\x1d\x6b\x8f\x05\xfb\xf0\xd4\xd5\x41\x58\x02\x3f\xfd\xdc\x4c\x24\x54\x7c\x36\xed\x95\x58\x33\xc3\x54\x6f\x58\x76\x56\x2c\xab\xa1\xcd\xca\x7d\x44\x38\xab\x76\x11\x25\x67\xa6\x79\xed\x96\xbc\x1e\x89\x96\x24\xdb\xba\x13\xae\x21\x7f\xcf\x62\x6a\x73\x54\x38\x5c\xdd\x40\x6f\x5a\x56\x21\x00\x89\x4e\x2a\x34\xcf\xfc\xa3\x7d\x1d\x0a\xdf\x5f\x65\xcf\xbc\x0b\x9c\x2f\xd6\x8e\x97\x0b\x79\xfc\xca\x11\x93\xa1\xfc\xbc\x88\xd7\x0b\x0f\x48\xbd\x06\xfa\x71\x68\x02\x2f\x12\x89\x1e\xca\xab\x68\x19\x9e\x1a\x89\x91\x71\x11\xd7\xe5\xae\x8e\xd1\xb5\x4f\x94\x53\x76\xb8\xf5\x26\x7b\xf7\xb8\x4b\x23\xfa\x97\x72\x67\xea\x06\x63\x5d\x40\x0d\x57\x6a\x4a\x5f\x7a\x0b\x71\x13\xb6\x73\x17\x9a\xa1\x21\xd1\x69\x6e\x1e\x1b\xcf\x37\x11\xec\x01\x07\x90\xe3\xf4\x4a\xb7\x73\xa5\x24\x2c\xea\x2c\x3e\x75\x74\xd4\xc5\x8b\x70\xa1\x31\xfd\x27\xdd\x2e\x99\xa7\xaf\x76\x70\xd8\xba\x69\xab\xac\x62\x08\xda\x2d\x75\xe2\x9a\x48\x59\x1a\x70\x25\xf7\x9d\xc6\x3c\xcd\xfa\x66\x6a\x17\x43\x81\xcd\x42\x95\x24\x3c\xaa\xe4\x43\x0c\x75\x95\xec\xa9\x9c\xda\x82\x5b\x3d\x85\x63\x31\xf0\x9e\x94\x89\x71\x47\xc5\xd8\x6e\x4c\x96\x04\xd1\x83\x5c\xa1\xbb\x22\xda\x07\x83\x56\xab\x9c\xf8\x8c\x13\xce\x01\xa6\x06\x7e\x29\xf2\x8f\xa2\xe6\x8b\x41\xa1\x56\xe6\xc0\x9d\xb3\x7c\xd9\x9e\xab\x5b\x2a\xa4\x63\xf1\x49\x6e\x3d\xa0\x44\x46\xef\x48\xa3\xf0\xac\x42\x1e\x77\x98\x98\x5f\x3d\x2a\x9a\xf6\xc6\x28\xd8\x6a\x02\x53\x3f\xe6\x79\x3c\x0a\x58\xac\x9e\x70\x50\x04\xb3\x81\x39\xcf\x19\x21\x16\x84\xb0\xdf\xbf\x62\x6a\x1d\x6a\x83\x48\x1c\x68\x75\xfc\x97\xe6\x1b\x6d\xf5\x77\x7b\xc7\x89\xfb\xfb\x77\x39\x7f\xdd\x49\x78\x8f\x0f\x39\x7a\xce\xc3\x23\x56\xcd\xfc\x4c\xcf\x86\x3d\x50\x9f\xa9\x29\x35\x34\x0b\xd5\xb0\x3e\x42\x0b\x2d\xec\x24\x75\x46\xab\x70\x63\x9b\xcb\x4b\xf6\x9a\x2b\x82\xc7\xd2\x89\xad\xce\xc4\xc4\x2c\x2f\x44\x53\x2a\xd8\x42\xd3\x3f\x8b\x70\xca\xf6\xee\x58\x4a\x0b\x33\xaa\x84\xe7\xfc\x28\x4b\x5f\x65\x95\x93\x67\xaa\xaf\x1c\x67\xa2\x48\x92\x96\xfd\x7e\xb5\x01\x97\xbf\x6f\x72\x43\x5e\xfd\xd3\x61\xb4\xb1\x12\xc7\x17\x48\x86\x8f\x99\xf1\xdc\x69\x09\xcd\xfd\xd6\xc1\x68\x45\x5c\xac\xa6\xab\x29\x99\x19\x0c\x5f\x71\x8a\x1d\xd4\x2d\x60\x41\x5f\x71\x60\xfb\x3d\x23\x73\x7e\x06\xe4\x1a\x00\x4d\xc5\x2e\x69\x04\xd0\x64\xcb\x0b\x03\xb7\x76\x97\xb3\x5b\x1e\x33\x6b\xe5\xc2\x74\x52\x67\x12\xc8\x8f\x16\xad\x3f\xbd\xba\xf4\x0b\x5a\xbd\x8c\x5b\xb5\x1b\x12\xf2\x58\x1c\xbe\x60\x87\x55\x49\xd2\x97\x83\xbf\xaa\x06\x46\xe6\xb5\x1d\x03\x4c\x00\x6e\x71\x00\x9b\x8a\xcb\xbd\xdf\xbc\x00\xb0\x33\x42\x5d\x2f\xc7\x46\x85\xe6\x2b\x21\x69\xa2\xa3\xef\x20\x5f\x33\xeb\x78\x5d\xef\xdc\xd0\x0e\xcc\x55\x84\x44\x01\x5b\x39\xe5\xc6\x0c\x7d\x67\x61\x3b\xf0\x80\xd4\xda\xbc\x20\xe3\x93\xb8\xd8\xb1\x87\xdc\xc1\xdc\xd9\x99\xdc\x49\x67\xb1\x63\x20\xd6\x96\x09\x33\x6e\xa7\x3c\x67\x56\x45\x0a\x76\x84\x0b\x55\x31\x51\x6e\xbd\x36\xec\x2a\x73\xdf\x50\x1f\x03\x15\x43\xba\xaa\x7d\x19\x29\x91\x77\xe0\x5a\xad\xe0\x53\xd1\x64\xe8\xce\x5f\x3c\x9c\xf7\xb8\xb8\xa1\x1e\xbe\xc2\x53\x4f\x68\x2d\xb7\x32\xe4\x21\x69\x36\x0a\x0a\xd7\xfa\xbe\xac\xc4\x2d\xa8\x04\x8c\x34\x60\x73\xec\x33\xb5\x67\x77\xc1\x19\x88\x59\x07\xc6\x17\xa3\x00\x46\xb0\x66\x62\x73\x8b\xb3\x54\xd5\x72\xd0\xbb\xd7\xd0\x86\xc9\x58\xc9\xbb\x12\xcf\xe0\x44\x15\x58\xa7\x8d\xd1\xa0\xf4\xe4\xa6\x54\x91\xd7\x07\x25\x5a\x8d\x43\x21\xa8\x85\xbb\x55\x44\x10\x73\x20\x31\x7c\x63\xee\xa8\x6b\xba\x7c\x97\x9b\x18\x2c\xeb\x5b\xe1\x50\x2a\xd1\xc5\xfe\xd3\x72\xf4\xca\x52\x59\x0d\xab\x80\x27\x02\xbe\xc6\x74\x6e\x46\x53\xd2\x7c\xd8\x98\xc5\x32\x94\x93\xad\x88\x7c\x21\xa9\x84\x3b\x78\x82\xe3\x80\x3e\x38\xd5\x52\x0e\x3f\x24\x18\xfe\x89\xb8\xf8\x6b\x88\x79\xde\xec\xe3\x6e\x82\xf8\xe6\x21\x02\x07\x17\xd6\xfd\x8a\xc2\xc0\x02\x07\x5b\xe7\x24\xbd\x19\x42\x1e\xc3\x17\xc8\x08\x1b\xca\x2b\xa7\x4f\xfc\x06\xc0\x5e\xdf\x32\x08\x0c\xc4\x51\x2e\xed\x82\x66\xe0\xfb\x51\x06\xa3\x36\xb5\xc3\x46\x6f\xb9\x1e\x1d\x75\x0d\xbc\xc0\xa3\x0c\xab\x97\x73\xef\x0d\x3a\xe3\x4c\x78\x7f\x3e\x22\xed\xb2\x15\xb3\x5d\x2f\x5e\xbe\x06\xcc\x15\xa7\x2e\x09\x8e\x44\x30\xe1\xec\xf6\xe9\xab\x6e\x70\xb4\x38\x45\x49\x99\xd0\xc2\xc7\x2c\xdb\xcf\x65\x65\x38\xec\xc5\xd1\xf7\xe6\x76\x46\xc2\xbf\xf8\xa1\x84\x79\xfb\xbb\xbd\x9b\x56\xfd\xf5\x42\x42\xf0\x98\x2d\x09\x16\x1c\xbb\x7a\xee\x97\x76\x3f\xd8\xfa\xa5\xa8\xde\xce\x35\x09\xcb\x0a\x22\xa7\x4d\x9a\xac\x8f\x44\x59\x4f\xc2\xc3\xbc\x51\x9c\x5c\xcb\x54\xdd\x27\x4a\xeb\x7b\xca\x45\x6f\x54\x26\xe2\xea\x7e\x34\x6a\xd7\xf0\xda\xf7\x0d\xe9\x08\x7d\x60\x3b\x0d\x28\x70\x01\xca\xdc\x43\x7c\x5f\xf0\xb1\x6f\xb7\xae\x3f\x86\xc9\xf1\xf6\x5b\x0c\x54\x36\x09\x55\x92\x88\xd4\xce\x8b\xdd\xb9\x83\x18\xaa\xa2\xa0\x83\xd7\xa3\x5a\x1e\x8f\xae\x7c\x12\x24\x81\x94\x19\x3a\x1f\x0e\x41\xbf\x44\x75\xb5\x9f\x77\xcb\xe6\x00\x83\x15\xae\xdb\x36\x35\x2d\x36\x0f\x01\x6e\x49\xd7\xa7\x75\xa4\x6c\xa4\xe7\x80\x45\xbf\x1f\x80\x6e\xbc\xbc\x2d\xfa\x5d\x4a\xa8\x45\x26\x90\x9b\x3c\x7b\xda\x90\x2a\x58\x7e\x8a\xd3\xef\x98\xca\x4b\x45\x96\xcd\xf3\x11\x12\xa2\xfb\xbe\x1c\xaf\xec\x3a\xed\xce\xa3\x03\xc5\x4b\x4f\xe2\xef\x97\x7f\x0a\x46\x69\x5c\x02\x6a\xdb\x7b\x9b\x01\xe5\xae\x00\x09\x50\x77\xf3\xbd\x13\xa6\x19\x69\x49\x99\x29\x31\x06\xf1\x4b\x3d\x39\xb3\x6f\x4b\x4d\x43\x7d\xeb\x10\xb2\xa3\x60\x54\x90\x25\x8d\xbb\xa4\x8c\x2a\x6c\x2f\x10\x63\xad\x33\x3c\x0b\x3c\x44\x3d\x5a\x87\x47\x1e\xad\xf5\x28\xb7\xdd\x1d\xe3\x1c\xe5\x96\x27\x93\xe9\x12\xc3\x74\x49\x9d\x92\x4b\x9d\x48\x08\x1a\x19\x5c\xc1\x2e\xe3\xa6\xa9\x39\x4a\xf8\x2e\x3b\x18\x6d\x9a\x22\x2a\xbb\x77\xe6\xfd\xce\x53\xf7\x3e\xce\xe1\xa9\xe3\x35\x32\xcd\xca\x1d\x00\xe3\xe6\x11\x0d\x95\xf6\x5c\x9b\xa9\xe5\x5c\x9a\x48\x85\x80\x1e\x71\xc0\x11\xd0\x1a\x19\x97\x30\xe2\xaf\x88\x97\x23\x48\xc6\x3a\x57\x6a\x79\x9c\xe8\xdc\x7b\xf4\x03\x28\x46\xfb\x01\x0b\x56\x94\xa4\x0e\xb4\x8b\xe3\x56\x23\x76\xee\x0a\xe3\xf3\x2c\x88\xb0\xd7\x92\x34\xea\x74\x88\x86\x0d\xe4\xde\xc5\xcd\xee\x00\x84\xa1\xae\x91\xde\xca\x03\x98\xcd\x48\x33\x14\x42\x32\xa1\x1a\x4a\x20\x4c\x16\x5d\xc0\x1c\x4d\x0e\x84\xf6\x8d\x84\xc9\xb2\x4a\x2c\x2f\x3b\x62\x94\xc9\x5d\xcc\x9a\x10\x46\x62\xf0\x09\x8c\x50\xf8\x41\x97\xd0\x62\xd6\xde\xa3\xa1\xad\x95\x7b\x4c\x25\xd7\xdf\x97\xe4\x75\xf7\xf7\xec\x36\xb9\xf7\x0d\x7c\x20\x5c\xb7\x9c\xfe\xc5\x33\x2a\x07\x92\xc3\xc1\x1c\xce\x75\xfd\x2b\x03\xf1\x9a\x2e\x7f\x2f\x5f\x9b\xc4\xdf\x27\xef\x9f\x5b\xf9\xec\xcb\x3c\x69\xd9\x05\x5a\xef\x81\xa3\x85\x8e\x21\x16\xca\xc5\x61\x4d\xce\x61\x9f\x10\xa1\xd9\x00\x4b\x59\xbc\xd4\x00\x46\x85\x65\x20\xa6\x3c\x25\x01\xc7\xfa\xad\xc9\x0d\xf6\xd1\x4f\x64\x6c\x39\x35\xeb\x2e\x9e\x26\x91\x68\xb5\x3c\x1f\x33\x65\xcd\x63\xfa\xec\xa1\xed\x5a\x00\x19\x79\x4c\xdb\xb1\x5c\x29\x01\x05\x73\xb7\xb3\xfb\x5b\xbb\xbf\x86\x08\x32\xc5\x1d\x51\x47\xbe\x17\x98\xfa\x65\x0d\xd3\x45\xa5\x27\x54\xa7\x0c\xf6\x1e\x0e\x98\x45\xc7\xf2\x96\x96\xd4\x74\x79\xb9\x43\x2e\x59\xa4\x66\xeb\x4d\xe5\xf3\x61\x70\x5f\x83\xba\xad\x55\x63\xc7\xa2\xe6\x4a\x0c\xc2\x45\xee\xb6\xb8\x42\x2f\x37\x76\x77\x4d\xc8\x61\x41\x44\x4b\x65\x32\x9b\x8d\x21\xd8\x46\x57\x65\xf2\xfd\xae\x35\x85\x95\x36\x33\x09\xf0\x2a\x46\xdf\x00\x24\x5f\xfc\xdb\xba\xdf\x2e\xf9\x33\x03\x7a\x7d\x55\xb1\x47\x5d\x5d\x0d\x9e\x97\xa2\xfb\x72\x2b\x2f\x5c\xe2\xd1\x26\x4f\x9e\xd5\x3a\xde\x9c\x07\x14\x6e\xd0\x3b\x45\xf0\xd3\x3c\xe2\x8f\xa0\x7f\xa9\x98\xbd\xa3\x31\xaf\x4e\x3b\xe1\x48\xaa\x9d\xee\x77\x16\x30\xbb\x0a\xc0\xd0\x8b\x8e\x20\xd0\x0c\xe5\xab\x95\xf4\xd5\xc3\xbc\x3c\xd9\xe0\x9e\xbc\x6a\x9e\x21\xb8\x4c\x2f\x98\x96\x59\xd3\xb2\x87\x93\x80\xd6\xaf\x36\xe5\x36\x84\x47\x52\xd2\x53\xda\x3a\x6b\xa2\xc5\xe2\xd0\x4e\x1f\x4e\x94\x86\xad\x25\xc6\x3b\x34\x04\x5e\xc1\x35\x7b\xdc\xb8\x9e\x2a\x5f\x17\x40\x30\x98\x2e\x4d\x93\x97\x2d\x17\x6b\x50\xc8\xa1\x18\x0f\xaf\x97\x64\x4b\xdc\x69\x27\x6b\xdc\xd5\xf8\xe3\x6e\xad\x79\xee\xf6\x00\x1a\x6c\x90\x22\x2c\xb7\xf9\xfa\x34\x25\x2c\xb9\x06\xe9\xae\x83\x2b\xb3\x6a\x72\x60\x56\x49\xe5\x6b\x26\x94\xb8\xe9\x4a\x73\x63\x45\xdd\x55\x70\x32\x98\x5d\xde\xcf\x00\xce\x18\x7e\xff\xf4\x04\x75\xbe\x19\xe5\xcd\x20\x05\x23\x34\xd2\x35\x2b\x3b\xe3\x50\x4f\x56\xc9\xec\x22\xc4\xad\x72\x11\x98\xd0\x7f\xff\xb2\x26\x30\xcd\x71\x5f\xab\xfb\x83\xcd\x2f\x1b\x16\x5a\x4d\x7c\xc5\xdc\x33\xeb\x96\xb3\xa7\x2a\x5a\x7b\xe2\x3f\x1d\xd8\x30\x81\x26\x1f\x9b\x31\x18\x7a\xb1\x35\x7c\xc0\x77\xc4\xc9\x58\x2f\x56\x27\x4d\x97\x5a\x00\x2e\xab\xd4\xc3\x26\x7e\x9a\xe2\xac\x99\x5c\x79\x7f\xd5\xb1\xf4\x9a\x38\x97\xcd\x38\xde\x9a\x20\x8d\x55\x73\x2c\x1c\x6d\xb4\xd8\x59\xcb\x8c\xa8\xd7\x5e\xb0\x35\x2b\x07\x7e\x73\x77\x34\xae\x47\xaf\x42\x02\xea\x63\x20\x01\x2e\xdb\x8e\xaa\x45\x86\xe9\x83\x59\x05\xe6\x36\x77\xb1\xdd\x06\x41\x44\xcb\x0d\x96\x21\x2e\xd9\xd3\x1d\x4d\x35\x26\x4a\x6e\xd1\x46\x55\x12\x33\xa3\xa5\xc2\x14\xa1\xa1\xdf\x80\x57\xb0\x00\x19\xa2\xb9\xa5\xc7\x25\x5d\xa2\xb6\x2c\x9d\x85\x74\xa7\x1c\xc8\x58\x3d\x60\x56\x5b\x96\x82\x94\xe8\xc2\xe5\x28\x68\x48\x4b\x48\x3e\xac\x19\xc8\xa3\xf0\xf6\xf0\x34\xfe\x61\xa8\x7b\xdf\x56\xab\xc9\x4e\x5c\x2d\x80\x01\x33\x9c\x96\xf8\xaa\xae\xfc\x8b\x4a\x86\xaa\x5a\x4e\x6b\xbf\x78\x0e\x0d\x7d\xde\x79\x04\x4d\x22\x99\x43\xd4\x88\xd9\x7f\xf1\x45\x81\xf5\xfa\x67\xb8\xf2\xbf\x7e\x07\x2c\xcf\xa5\x06\xb6\xeb\xf0\xff\xe4\x4d\xfa\x78\x37\xec\x7c\xcd\x15\xf5\x7f\xbd\x9b\x78\x2e\xf5\xa7\x63\xac\x9e\xee\xe5\xe3\xff\x45\xfe\xed\xdb\x39\xe8\xf6\xfc\xf7\x7f\x6b\xe6\x79\xfa\xbf\x41\x70\x5d\xd7\x1f\x2b\xfa\x63\x7c\xd4\x20\x02\x41\xd0\x9b\xcc\xbf\x7d\x5b\xda\x72\x65\xc7\xed\xdf\xff\xed\xdd\xf1\x95\x44\xbe\xc1\x10\xf4\x6b\xbb\xd7\x7d\x28\xff\x59\x5b\x5a\xf8\x5f\x6b\x4b\x8b\x12\x3f\x90\xaf\xa6\xb4\x30\x04\x7f\x75\xa4\xfd\x79\xea\x7d\xf4\x03\x82\xf0\xbf\xbc\x1d\x6d\x89\xe4\x45\x89\xfd\xe3\x7e\xa8\xff\xa0\x3d\x6b\x09\xe5\xe9\x9f\xb5\x7f\x45\x61\xf4\x8f\xed\x5f\xb3\x1c\x2a\x8a\x7f\x3c\x1e\xc3\xfe\x41\xbb\x58\x24\x83\x8b\x3f\x61\x07\x27\xff\xd8\x9e\x35\x27\xe9\x22\x27\xff\xac\x7b\xed\x1f\xbb\xe9\x66\x29\x85\x65\x7f\xd6\x5e\x16\xfe\x63\xbb\xdb\x34\x23\xa8\xf4\x4f\xc6\xd3\x28\xf6\xc7\x0e\xb0\x39\x86\x9f\xcb\xfe\x77\xb7\x8b\x0d\x69\x5a\xfe\x81\x84\x70\x83\xe1\xff\xb8\x67\x2c\xfc\x4f\x7b\xc6\xfe\x91\xc4\x7f\xb6\x71\xec\xff\x49\x9f\xfe\x01\x7f\xa3\xe1\x1f\xb0\x8e\xfd\x20\x4f\x1f\xf9\x81\x37\xe8\x0f\x7a\x40\xde\xdd\xb5\x91\x4f\x3f\x75\xec\xdd\x63\x9e\x6a\xb0\x1f\xf8\x80\xbe\xdb\x43\xbf\xbb\x70\xd3\xdf\x61\xf4\x1c\x47\x0d\xdf\xdf\xcd\xd3\x61\xea\x07\xd1\x7c\xc7\x74\xf8\x24\x40\xfe\x40\x86\xef\xef\x66\xf6\xf0\x49\x46\xa6\xdf\xdd\xe5\x91\x77\x67\x69\x28\xfc\xd0\xc6\x7f\x90\xc3\xa7\xe3\xf8\xbb\x69\xf6\x4f\xda\xe4\xfb\xec\xf2\x45\x04\xfd\x81\x87\x24\xf1\x03\xfb\x49\x03\x7b\x77\xc9\x3d\x8f\x3e\x1f\xbf\xbf\x3f\x2e\xbf\x9c\x23\x3e\xed\xc4\xc9\x6f\xd0\xf0\x9d\x78\x7f\xf7\x9e\x8e\xfd\x80\x07\xec\xcd\xf9\xc9\xda\x37\xec\xab\x61\xfc\xc9\xb9\x4e\x20\x9f\x2d\x9e\x3c\xfe\xf8\xad\x5b\xef\xbb\xeb\x72\x55\xfd\xd3\xde\xd4\xc8\xbf\x06\x02\xe7\xfa\x3f\x41\x00\x87\x7e\x40\x08\xfa\x85\x03\x3f\xcf\x22\xbf\x9d\xfd\x2f\x01\xc1\x17\x96\xfc\x9f\xf6\x48\xa0\x18\x0d\xff\x8b\xc6\x4b\x42\x30\x4a\x64\xff\x9f\xc6\xfb\xa7\x92\x40\xff\x45\x49\xc0\x3f\x70\x18\xfe\x29\x0b\xf8\x07\x09\xff\xec\xd2\x4d\x9c\x86\xfc\x39\x8f\xfc\x76\xfe\xef\x2f\x8d\x5f\xfd\x84\xc0\x4e\xe3\xc7\xf1\x1f\x78\xfe\xd5\x9e\x1e\xf9\xfe\xe9\x37\x8f\x7e\xc7\xdf\x7d\xd1\xd1\xef\xe4\xbb\x9d\x3a\xfc\xb6\x38\xf4\xd3\xf3\xfe\x54\xfd\xdb\x7b\x48\x0f\xa1\x4f\x17\x21\x4e\x83\xfd\x86\xbc\xbf\x23\xb0\xfc\x3d\xf3\x9c\xff\x99\x41\x7c\xa7\x7e\xe0\xdf\x91\x37\x29\xea\xab\x25\x3b\xf5\x76\x82\xef\xf0\x49\x97\x7c\x7f\xfd\xfe\xef\x43\xef\xbd\xea\x7b\x1c\x4c\xfe\xf8\xea\x31\xff\xee\xd8\xfe\x69\x59\xff\xf1\xa3\x9f\x5d\xe2\xe1\xaf\x86\xf4\xe7\x6a\x6f\x1f\x20\xcf\xcf\x1f\x57\x7c\x77\x7c\xff\x86\xbc\x7b\xc4\xe3\xdf\x88\xaf\x66\xee\x6f\x1f\xc4\xbe\x7f\x7a\xb7\xd3\x9f\x03\xe2\x43\xe8\xfc\x4c\xbc\x1b\xbe\xbf\xa3\xe5\x49\x1b\xba\x7e\xa7\xcf\xa5\x60\xfc\xdd\x0a\x1e\xff\x6a\x5f\xff\xde\xef\xb7\x4f\xb7\x79\xe4\x23\x06\xf2\x6b\xfb\x6f\xb2\xef\x16\xf7\xc4\xe7\x34\xfc\xee\x0e\x7f\xe2\xc4\x89\x5b\x9f\x06\xf1\xd8\xb7\x93\x03\xf2\x07\xf5\x9e\x7a\x7e\xfa\x70\x40\x7e\xb6\x84\xbf\x39\x21\xce\x03\xf2\x8a\xbc\xf1\x00\x6e\xce\xef\xf2\x37\x2b\xd8\xa7\xdf\x3c\xf2\xd5\x75\xfe\xcd\xfb\x5b\x20\xf0\xdb\xc1\xbf\xff\xda\xa9\x1e\xfd\xf0\x71\x4e\xc1\x43\x94\xfa\xea\x4c\x0f\x7d\x7b\x8b\x0e\xfb\xb9\x8b\x1f\xe4\x71\xc5\xbe\x41\x1f\xee\xdf\x73\xa1\x2f\x29\x7d\x94\xf7\x7b\xfa\x9f\xb9\xcb\x67\xf8\x9b\xf9\x73\xc3\xcd\x0f\x3a\xff\xc3\xb0\xf3\xef\xcd\xd9\x5b\x27\xdf\xe0\xcf\x29\xf8\x8b\x91\xef\xef\x1d\xe6\xa7\x30\x90\xb7\x76\xde\xdc\xbd\xc5\xb5\xbc\x35\x93\x7f\xf1\xfe\xa5\x25\xe2\xa3\x84\xaf\x29\xe8\xe7\x13\xfc\xe6\xf8\xd4\xd0\x9b\x10\xf6\xc5\xf2\xb9\xfc\xd7\x94\x2f\x71\x23\x9f\x7f\xc4\xdb\x52\xbe\xc8\xd0\xef\xbd\xbc\x6d\xe6\xfd\xed\xa9\xbe\x37\xad\x37\xcf\xd4\x87\x61\xf8\xb3\xc5\x37\x7f\xa7\x02\xbf\x9f\xab\x41\x6f\xd3\x7c\xb3\xf5\xe1\xf7\xfb\x9b\xc9\xf7\x6e\x7f\x31\x84\x0f\x4a\x53\x6f\xe3\x3b\x8d\xf3\x7d\xfa\x4b\xcd\x1f\x51\x9d\xd6\xfb\xe3\xad\x9e\xcf\x8e\xfe\x94\x15\xf4\x4b\x59\x6f\xc2\x9f\x6d\xe1\xa7\xd6\xa9\x9f\x22\xff\xa9\x86\x5f\x86\x50\xef\x69\x9f\x93\x6f\x71\x34\xe7\xe1\xd7\x9a\x5f\x6c\xa3\xdf\x3f\x06\x0c\x7f\xd0\xfd\x2d\x6c\x2a\xff\xfe\xb6\xf3\xf3\xdf\x67\x0c\x72\x3a\x04\xfe\x61\x01\x7f\x9b\xeb\x3b\xaf\x7b\x53\x3c\x05\xfa\x65\xf9\xef\x33\xa7\x54\x4f\x5a\xf8\xdb\xd6\x0c\xf4\x3d\x08\x3b\x85\x71\x32\xf3\x03\xf9\x2c\x81\xfc\x22\xe2\x9f\x82\xfa\x62\xef\x2d\xec\x8f\x36\xe8\x37\x73\xc8\x97\xb2\xde\x42\x7a\x6b\xf6\xad\xb6\xd3\x34\x4e\x73\xa0\x3f\x83\x91\xaf\x39\x9f\x4d\xfc\x26\xcf\x0f\x2a\x7c\x98\xc5\x7f\xca\x17\xfe\xf2\xcb\x93\xc4\x69\x4e\x6f\x1e\xde\x92\x79\x4b\x95\x78\xbb\xe3\xdb\x39\xdf\x26\xf9\xa5\x66\xfc\x57\x62\xdf\x3f\x7a\x41\x7e\xa5\xf6\xd1\xdb\xb9\x32\xfa\xa1\xf6\x16\x30\xf9\x16\xb0\x0c\x93\xf9\xe7\x8b\x2f\x3d\xfc\x36\x1e\xfb\xac\x8b\x7c\x91\x41\xdf\xda\xfb\x50\xfe\xf6\x0b\x46\x7c\xbc\xe6\x3c\xc8\xcf\x73\xd8\x5b\x41\xdf\xe8\x8f\x76\xb1\x37\x52\x90\xdf\xa8\x6f\xef\xb1\x6f\xe6\xdf\x41\xfa\x04\x07\xe4\x14\xc0\xc9\x1d\x7e\x6a\xe0\x0c\xe8\x27\x60\x91\x6f\x26\x61\xe4\xcb\xd7\xbf\xd0\xee\x2d\xda\x8f\x9f\xbc\xd1\xe2\x94\xd9\x7b\x02\xf5\xab\x23\xe3\x5f\xda\x46\x4e\x43\x7c\xfb\xf7\xdb\x03\xf2\xaf\xdd\xff\x26\x8b\x8f\x90\x3e\x10\x91\xbf\x07\x7e\xfb\x70\x8e\x7d\x39\xdb\x67\xc5\x0f\x95\xb7\x6a\xbf\x7f\x08\x7d\x59\x13\xf6\x16\xe9\xdb\x0f\xde\xc2\xff\xfa\x0f\xff\x98\xd1\x4f\x73\xf8\xf2\xbc\xf7\x32\xf8\x67\x99\x93\xe0\xb7\x0f\x7c\xbe\xc9\xbd\x39\x78\xeb\x98\xfe\xf6\x81\xc9\x1f\xef\xfd\x7e\xf0\xe8\x8d\x57\xbf\x18\x2d\xf9\x1d\xfb\x18\xe2\x7b\xe3\xd4\x17\x14\xa3\x1f\xdc\x42\xce\x6f\x3f\xb0\x8d\x7f\x39\x21\x7d\x2e\x4f\xbc\xe7\x7f\x80\x9f\x78\xb3\xf8\x3b\x3e\xbf\xff\xba\x5f\xf2\xa7\x89\xbf\x71\xf3\x6b\x4f\xe7\x02\x57\x0c\xfb\x00\x30\xf2\x25\x33\xf4\x17\xd4\x81\x7f\x71\x93\xdf\xc0\xe9\xbd\x27\x6c\x79\x03\xdd\x7b\x37\x5f\xe4\xde\x90\x02\x2d\x1f\xb9\x7e\xff\x2c\xfd\x25\xec\xf7\xae\x4f\x7b\x21\x3f\x58\x80\x35\x6f\x1a\xef\x73\x5f\x30\xf9\x03\xfe\x32\xc6\x8f\x41\xe2\x1f\xd4\xfb\x0e\xbf\xe9\xfe\x8e\x05\xec\x17\xb3\xfe\xfc\xf7\x07\xc8\x79\xeb\xee\x4d\xe7\x27\x3c\x7c\x99\x17\xf9\xde\xf4\xcf\x9d\xd1\xbf\x00\x06\xfe\x21\x45\x7d\xff\x85\xe2\x47\xb4\xc4\x97\x5d\x7f\x41\xc6\x39\x75\xf9\xd8\x05\xf9\x85\x37\xd8\x4f\x34\xf8\xf3\xe9\xd4\xd7\x74\xec\x30\x60\xfc\x1b\x7e\x3a\xc3\xef\x94\x4f\x7e\x9c\xe1\xad\xbe\x0f\xcf\x1f\x23\xfd\x88\x1d\xfd\x20\xcb\x27\x80\xe4\x5f\x3a\xc1\xde\x84\x3e\x06\x42\x7f\x44\xf1\x86\xb7\x0f\x2e\x7c\x56\xfa\xb2\x5e\xea\x6b\xc6\x17\xab\xef\x00\x9b\xc3\xbf\x8c\xa5\x7e\x8e\xa5\x7f\x85\x32\xec\x77\x50\xf6\x1b\xce\x7f\x74\x76\x32\x49\xfc\x87\x6f\xf1\x8f\x7b\x13\x1f\x7b\xff\x18\xd7\x07\x83\xd0\xf7\xe7\xb7\xd9\xff\x22\x6b\x03\x3e\x73\x08\xe8\x0d\x86\x3f\xbd\xe1\xdc\xdd\xf7\xaf\x9d\xd2\x6f\xb3\xfd\x8a\xfa\xdf\x7e\x8d\x8a\xf0\x57\x8a\x90\x7f\x34\xfc\x03\xfe\x09\x5b\xe8\x7b\x1f\xbf\xc1\xd7\x7b\x77\x3f\xe3\xd3\x07\xc5\xdf\xeb\x7f\x6d\xe5\x07\xf5\x0b\xea\xbd\x43\x13\xf5\x1f\xc0\xe3\x13\x51\x7e\x39\xf7\xf6\xc5\x8f\xa5\xfc\x0c\x2d\x1f\x9b\xa3\x7f\x72\x08\x7f\xfb\x50\x7b\x9b\xa0\x01\xd3\xdf\x08\xe4\xcb\xfc\xde\xcb\x7d\x42\x12\xf1\xa5\xce\x2f\x25\xc3\x3f\x23\xce\x17\x46\xff\xdc\xc6\xdb\x99\xde\x1e\x81\xfe\x02\x74\xe8\xf7\x9f\x41\xe8\x97\x80\xfd\xd1\xe1\x4f\x50\xfe\x29\xee\x7f\x58\x5a\xa1\xbf\x95\x56\x7f\x9a\xe0\x62\xff\x5a\x82\xfb\xae\xc8\x08\xfa\x2b\xc1\x25\x4f\xe7\x86\x7e\x26\xb8\xf8\x89\x3e\x9f\xf3\xe7\x31\x75\x02\x0b\x44\xfc\xe5\x75\x7f\x45\x97\x55\x45\xfc\x49\x21\x4f\xfe\xb1\x70\xae\xa8\xb2\xa8\xf0\x3f\x29\xcc\xe1\x3f\x66\xcf\x15\x5a\x12\x15\xfc\x27\x85\x39\x8c\xfc\xf1\xc2\x42\x5e\x64\x65\xf6\x67\x85\xfc\x1f\x87\xa3\x79\xfe\x67\xd7\x21\x48\xfc\x1f\x5c\x57\x20\x33\xaa\x20\xff\xac\xee\xff\xe3\xf8\x3c\x4d\xe1\xfc\xcf\xae\x13\x90\x7f\xcc\xff\xb3\x9c\xa2\xb2\x3f\x61\x9f\x46\xfe\x38\x3e\x2d\x89\x3c\xfd\xb3\xf1\xc4\x3f\x28\x46\x2a\xac\xa0\xb3\xff\xf6\xeb\x0a\xcd\x77\x84\x0c\xe1\x7f\x6c\xf7\xd8\x3f\xbd\xa4\xf0\x1f\x66\xff\x67\xaf\x26\xfc\x72\xe2\xcd\x6d\x37\xb6\xb7\x7f\xff\xb7\xac\x5c\xca\xe1\xd7\x5d\x3c\x97\xfa\x3c\xfa\x5f\xff\x95\xcb\x86\xdb\xf0\x37\x7b\x1e\xe9\xfa\x1f\xda\xc3\x3f\x44\x41\x70\xc0\x6b\x7a\x4d\xd0\x18\x01\xa0\x43\xe1\x10\xe3\xaa\xf8\x42\x1f\xc4\xb5\xe0\x8a\x8e\xf0\xd8\x70\x81\xd2\xfc\x16\xce\xc0\x86\xa4\x19\x10\xf3\x22\xf4\xc6\x99\x65\x5c\xd7\x6c\x9c\x84\x82\x11\x80\x3b\x6a\xa2\x09\x69\x90\x96\xcf\x83\x12\x31\xf4\xc8\xe3\xb5\x22\xd0\xe1\x16\x01\x78\x09\x69\x38\x0c\x25\xe8\xea\x39\x1a\x88\xde\xe0\x14\xec\xfa\x9d\x28\x88\xcb\x13\x95\xa8\xe8\xe2\x01\xf6\x8a\x9b\x84\x7e\xe9\x74\x5d\x7a\xdd\x52\x90\xa4\xc6\x44\x2b\x1f\xd2\x33\xd6\xe6\x07\x2f\xc0\xc4\x4b\x95\x74\x58\xa7\x6b\xc1\xb2\xf2\xb8\x40\x0a\x1d\xae\xcc\xf5\x91\xe9\x20\x98\x5e\x4c\x52\x85\xcb\xca\x36\xae\x7d\x70\x17\xde\x2f\xa4\x64\xa0\x30\x31\x33\x51\x48\x1d\x8e\x7d\xe6\x4a\x7f\xe9\x68\x12\x3f\xb9\xd3\xef\xf4\xb6\x97\x94\xcd\xf6\xcc\xad\x95\x2d\x71\x6a\x80\x0e\x29\x39\xec\xf1\x2c\x46\x16\xd3\x9f\xe2\x2b\x93\x06\x50\xbe\x53\xee\xbc\xc4\xd1\xb4\xf9\x4e\x8b\x23\x23\xdc\x2d\x51\x2b\xc5\xa9\x9b\xe8\x10\x98\xbe\xf8\xda\xea\x39\x30\xa1\xd8\x58\x47\xec\xfb\xd6\x16\x13\x2c\x26\x7d\x33\xf2\xdb\x69\x05\x17\x06\x0c\xba\x57\x07\x62\xf2\x68\x6d\x9a\xd6\xb5\x56\x5b\xf6\xa4\x1b\x78\x8d\x6b\x3a\x51\x6a\xc6\xa2\xf3\x32\xd2\xea\x11\x73\x5b\xbb\x62\xe3\x91\x68\xd5\x3d\x8b\x55\x48\x58\xdc\x62\x80\x79\x67\xdf\xfd\x27\x75\xe1\x4c\x9f\x21\x02\x41\x37\xd6\x85\xdf\xf5\x29\x89\x94\xc4\x34\x07\x53\x1f\x1c\x4b\x43\x74\x43\x75\x55\x00\x67\xfa\x57\x02\x7b\x24\x27\x8a\x87\x7a\xf4\x38\x21\xdd\x82\xce\x89\x86\xb8\xbf\x73\x23\x1b\x89\xd9\x78\x13\xc2\x38\xd7\x75\x06\x2b\xcc\x1b\x4b\x20\x98\xe4\x3c\x79\x36\x52\xea\x2a\x51\x85\x7a\xeb\x47\xae\x39\x3c\x31\x5a\x6e\x58\x38\x39\xe5\x95\xbf\x22\xe1\x7a\x71\x9e\x17\x75\x15\xe1\x86\xc8\x81\x7a\x1b\x1c\xbc\xab\xbd\x56\x08\x84\x19\x5a\x0b\xa5\x24\x67\x43\xf0\x80\x9b\x20\x21\x29\xa7\xb7\xaa\x61\x29\x4a\xa4\xbc\x7f\xe7\xe3\x05\x41\x67\x8c\xe0\xb1\xba\xaf\xbc\x09\x99\xdd\xd7\xda\x6b\xb0\x5a\x24\xae\x5e\x89\x50\x44\xd9\x85\x6c\xaf\x56\xda\x01\x5b\x41\x06\xa2\x16\x7a\x20\x6b\xab\xe2\x69\x47\x02\x36\xd7\xfe\x43\xc0\x68\x95\x80\x2c\xbc\xcf\xcb\xd1\xbc\x8f\x6b\xfb\x7a\xc5\x5a\x4a\xd5\xcd\xff\x6e\xee\x4d\x9b\x1d\x37\xb2\xc4\xd0\xef\xf3\x2b\xee\xab\x09\x7b\xa4\x07\x55\x61\xdf\xa4\x56\xdb\x20\x40\x02\x20\x89\x7d\x25\x1d\x0e\x07\xf6\x7d\x21\x76\xa0\xad\xff\xfe\xc0\x7b\xab\x4a\x25\xa9\x4a\xdd\xe3\xe9\xf0\x1b\xde\x08\x12\x40\x66\x9e\x3c\xfb\x02\x5c\x64\x0a\x2e\x83\x51\x16\x2d\x99\x08\xa3\x25\xab\x3e\x99\x66\x7a\x44\x21\x5f\xcb\x3c\x83\xf7\xb0\x75\xe7\x83\x7c\x6a\x00\xee\x01\x22\x81\x30\x60\xec\xad\x9c\xd7\x53\x8c\x18\xc4\x41\x98\xe7\x4e\x9a\x41\x6f\xe2\x7c\x1d\x67\xe1\x82\x4c\xe1\x9b\x86\x5d\x04\x94\xa9\x62\x80\x09\xee\xeb\xd1\xbf\xab\x13\xcd\x8a\xc3\xa6\x71\x70\x49\x1f\xfd\xe1\xa6\xe4\x13\x89\x4d\x60\x88\x2a\xb4\x2e\xa9\x26\xd7\xf5\xc7\x99\x17\x1d\x99\x7a\x20\x6b\x41\xba\xf2\x4d\xef\xe9\x73\xa7\x4f\xa8\x8d\xed\x16\xc8\x19\xbc\x4e\x44\x1a\x04\xca\xda\x3f\xf3\x91\xe5\xee\x23\x96\xff\x64\x4e\xa2\xfc\x72\xd5\xc2\xc7\xee\x24\x2c\xd5\x76\x43\xf5\x06\x8e\x91\x73\x17\x9b\xec\x76\xcf\x8c\xdb\xe5\x96\x9e\x03\xe2\xe8\x87\xa3\xa0\xa4\x49\x96\x37\xe8\x00\xce\x02\xbc\x11\x07\xe8\xcc\xa4\xca\x2a\x89\xd7\x74\x69\xb0\xe2\xcc\x17\x30\x63\x98\xcc\x32\x3b\x27\x48\x6a\x5b\x3c\x14\x02\x7a\x3a\x59\xdb\x6d\xf7\xc4\x5e\xf3\xa8\x1e\x00\x45\xe3\xf4\xb2\xf9\x1b\x3f\xc8\xc1\x42\xd0\xaa\x69\x20\xc4\xd1\xac\xb4\xc1\xa0\x3b\x4c\x58\xc1\x65\x22\x25\x87\xcc\x6d\x60\x48\xf1\x75\xb3\x03\xef\xa6\xba\x20\x00\x88\x03\x3d\x62\x32\x33\x01\xac\xad\x13\xc3\xc1\xb2\xcc\x87\x74\xee\xb9\x40\xdf\xf2\xbb\x72\x97\x28\x87\xbe\x5f\x2f\x0b\x00\xba\x9c\x8f\x45\x38\x58\x08\xb8\x58\x43\x65\x2b\xde\x1c\xde\xf2\x58\xe8\x66\xde\xeb\x03\xcb\x1c\x2f\x8d\xad\xa3\x57\x94\x68\xb8\x86\x94\xfd\x3e\x29\xef\xb3\xff\xe0\x88\x4c\xbb\x28\xee\x49\x0a\x2e\x96\x1d\x1f\x8a\x03\xd3\x68\x69\xc9\xe1\xcb\x22\x71\x2d\x28\x74\xc6\x84\x3e\xe0\x73\x72\x73\xd0\x0d\x5a\xa0\x8b\xbb\x15\xec\xed\xbc\x7b\x8b\x32\xef\xc6\x3d\xd8\x0a\x8e\xb9\x5d\x33\xaf\x62\xd6\x75\x98\x20\x85\xb6\x6e\x7b\x2e\xa3\x32\xdd\xa3\x6c\xdb\x43\x2c\x59\xf1\x88\x35\xcc\xf5\xd8\x3d\xee\x87\x4b\x60\x2d\xd2\x49\x67\x85\x63\x0b\x71\x89\x83\x5f\x1b\x4c\xa6\x65\x51\x86\xc3\x13\xe4\xba\xbd\x7d\x52\xac\x55\x3d\x9b\x5b\x03\x38\x07\x46\xe6\xb2\x30\x6f\xa9\x21\x11\x78\x1e\x0d\x20\xae\x3e\x63\xa3\xa8\xc7\x58\xa0\xb0\x07\xcf\xc1\x2f\xf9\x8d\x5a\x8a\xfb\x95\x44\x96\xf6\x4c\x6b\xc1\x69\xc3\x7d\xfb\x21\x58\x67\x5e\xb8\x5f\x91\xdb\x20\x57\x97\x0c\x22\x6e\xec\x9a\xd9\x27\x1f\xeb\xc1\xe9\x78\x91\x4c\x26\x86\x5b\x2c\x0a\x15\x9e\xa8\xa4\x0c\xde\x74\x62\x3e\xb6\xa1\x35\xbb\xc0\x74\xe5\x86\x82\xdd\x84\xe6\x42\xb6\x43\x20\x11\xde\x7a\xad\x78\x2f\x9f\x86\x68\xc1\xd4\x32\x5b\x1b\xb1\x38\xe9\x79\x65\x1d\x19\x23\x2a\x0f\x48\xe7\x6a\xba\x11\xe7\x16\x6b\xd2\x6a\xe9\xb4\x95\x83\xc0\x99\xc9\x6f\x2c\xb3\xee\x01\x25\x5b\x54\xfd\xe1\xa6\x11\x62\x63\xdb\x25\xd8\xfc\xb0\x5a\xfd\xa4\x68\xcf\x50\xc0\x2b\x23\x43\xdc\x10\xc9\x64\xfb\x4b\x93\xe1\x42\x2f\x5f\x48\x19\x95\x64\x60\x9c\x6f\x9e\xe3\x2f\xb7\xb8\xb9\x5e\x8b\x2d\x5c\x82\x68\x26\xca\xee\xe6\x78\xf7\xb4\xa2\x54\x41\xe0\xd1\x2d\x8e\x5b\xd8\x9a\xcf\x86\xb0\xd1\xd3\x54\x58\x94\xda\x84\x7e\xa0\xdd\x6e\x37\x03\x2f\x97\x5b\xb1\x98\x05\x1f\xda\x9c\x16\xda\xf0\xb9\x87\x1f\x6a\x72\xe3\x88\xb3\x38\x8a\x47\x32\xe9\x5d\x3b\x8a\xc5\x54\x1c\x25\x08\x2f\xc8\x70\x96\xfc\x48\xaa\x52\x8a\xf4\x60\x6a\x8c\x45\xc9\xb3\x6f\x6b\xdb\x75\xe7\x6b\x92\x47\x88\xdc\x01\xf0\xb0\xdd\x5c\x46\xae\xb9\xba\x43\x37\x3d\x15\x72\x94\xb4\xa7\x15\x99\x5b\x57\x18\x64\x26\xc4\xc1\x59\xbb\x31\x1c\xe1\x48\xa3\x89\xb0\x73\x63\xff\x53\x1d\x44\xf5\x9f\xec\x45\xa4\xac\xfc\x62\x8f\xdd\xb7\xe5\x89\x96\x14\x48\x50\x07\x19\x60\x1e\xea\x8f\x8f\x55\x34\xd6\xda\x3a\x9d\x7b\x43\x31\x52\x79\x66\xbd\x6a\x77\x19\x47\x0e\xbd\x26\x62\x11\x8c\x4b\x23\x83\x19\x7b\x8f\xd4\x7b\x76\xe3\x39\xc4\xe3\x39\x20\x83\x4e\xf8\x45\x62\xd3\xa5\xb4\x3d\xaf\x60\x2e\xbb\xfb\x0d\x98\x01\x70\xb6\xf0\x3a\x92\x5b\xb1\x34\xa8\x73\xbf\x62\xed\x48\x55\x6e\x5c\x45\xd1\x6d\xcf\xe9\xf0\x31\x21\xc8\xae\x51\x4f\x29\x5e\x4f\x01\x81\x54\x74\x9a\x37\x3a\x80\x5f\xa5\x96\x94\xba\xe2\x16\xa3\x24\xf4\xb0\x87\xa0\x01\xec\x81\xb5\x4e\xad\x9f\x03\x39\xaf\x03\x3b\x45\x35\x49\x28\xab\x46\x45\xea\xdc\xdf\x19\x53\xf3\x29\x1d\x4c\xbd\xfa\x9e\x88\x72\x92\xd3\xe2\x23\x1a\x0f\x27\x76\x86\x2f\x03\xad\x48\x87\x5a\x76\xd8\x1e\xc1\xdc\xb9\xe0\x41\xed\x72\xb3\x8e\x19\x9f\xdb\x8c\xaa\x35\x31\xd8\x3d\x9e\x8b\x9b\xb5\x46\x7a\x34\x51\xeb\xaa\x35\xd4\xb2\x41\x99\x81\x9f\x3c\x46\xae\x3a\x41\x13\x10\xae\x79\xdc\x00\xce\xb8\x1a\x1b\xa1\x6f\x51\xe0\x3c\xf7\xf5\x58\x2b\x0b\x23\xaf\xb7\x3c\xc4\x14\xfc\xe0\x3e\x38\x93\xc7\x4e\x12\x2a\xb7\x7b\xce\xd5\x86\x67\xbc\x9b\x21\xa6\x99\xaf\xba\xe4\xa7\x56\xdc\xe2\x54\x62\x2a\x1e\x07\x5a\x42\x25\x6b\xaa\x48\xdc\xaa\xe9\xe2\xe8\x2e\xbc\x1d\x8d\xdd\xa4\xc1\xe6\xd0\x23\x75\xa5\x7a\x80\x94\xcc\xd0\x5d\x94\xd0\xe1\xbe\xf2\xcd\x79\x5e\x93\x4d\x93\x0f\x48\x2e\xaf\x67\xf1\xee\x92\xd9\x61\xf7\x79\x6a\x23\x91\x90\xc5\x57\xaa\x4b\x6d\xac\x05\x74\x3c\x25\x73\xf6\x22\xcc\x61\xc6\xe2\xd5\xa5\x57\xad\x93\x25\x83\xc9\x34\x5c\xaf\x31\x4a\xf7\xa7\xcd\x7e\x78\x32\x7a\xeb\x58\xc2\xcc\x0f\xde\xf8\x48\xdd\x54\x66\x60\x53\x6b\x1e\x65\x63\x93\x9c\x07\xb4\xf9\xfd\x01\x9f\x7a\xd7\xda\x65\x7b\x42\xe8\x9c\xb8\x4e\xb7\x34\x46\x33\xd1\x78\x26\x93\xa7\x89\xe6\xee\xd1\xd4\x8c\xa9\xbe\x6d\x6a\xac\xf0\x00\x9a\xb6\x0c\x6d\xd1\xd6\x9a\x35\x4a\x69\x9e\xc9\xf6\x14\x99\xac\x66\x10\x3a\xbf\x89\x91\xbf\xc6\x40\xb3\xe1\xad\xb1\x39\x43\x65\x38\xf0\x51\xa1\xdb\x74\xd2\x20\xa8\x2f\x0f\xfa\x00\x52\x0f\x1d\xe5\x1a\x27\x74\xfd\x2c\xd8\xac\xcc\xdc\x42\x1c\xe1\x80\x21\xbc\x56\xab\x79\x80\xb1\x6a\x81\x3b\x89\xb9\xd8\x81\xde\x1e\x19\x33\xef\xfa\xf1\x58\x46\x11\x28\xb8\x7c\x55\x9f\xf4\xf5\xa0\xf0\x1c\x0a\xb1\x60\x7d\xe4\x72\x39\xe9\x49\x96\x6b\xfb\x5b\x55\xef\xde\xe3\xa6\x46\xc9\x81\x9d\x84\x9e\x6e\x6e\x71\x45\xd5\x3c\x1d\x9e\x3d\xdc\xc5\x2e\xd4\x6e\x24\x9b\xb6\x1f\x18\x32\x3a\x07\xff\xd4\x97\x0a\xd7\xff\x6c\xb6\x1c\x2e\xbf\xee\x31\x5a\x7e\xdc\x17\xc5\x97\xc8\x0a\xbf\x41\xf7\x76\x18\x11\x98\x99\x9d\x39\xbd\xb5\xb1\xc1\x9d\x52\xa0\x98\xf6\x94\x95\x92\x21\x21\xc6\x44\x70\x71\xc2\x18\xd4\x60\x9c\x3a\x41\x5a\xa3\xed\x41\xb8\x3f\x5d\xbc\xab\xa1\xb5\x03\x18\xb6\xd5\xb4\x0d\xa6\xb2\x6d\x7b\xc2\x1f\x44\x83\xd2\x3a\xc1\xfd\x3e\xf9\x0a\x2a\x29\x38\x0e\x63\xc6\xb2\x1d\x2d\xb4\xc3\x49\x10\xe5\x5b\xa7\x2c\x25\x70\x50\xb1\xfe\x8a\x9a\x5e\xa7\x2b\xee\x68\x34\xa0\xe1\x9c\x27\x97\x84\xad\x91\x82\xd8\x38\x2d\x3b\xd0\xc4\xac\xd0\x39\x31\x76\x1c\xd6\x55\x6f\x5a\xc3\x14\xaa\x28\xbe\x87\x46\xed\x62\xd7\xe7\xc4\xe5\x0e\x66\x90\xd3\x59\xc0\xf4\x66\x74\xf5\x16\x32\x07\x21\x1c\x79\x48\x14\x6c\xe5\xb3\x03\x6b\x67\xf7\x98\x4a\x27\xe8\x71\xab\x65\x1a\xda\x41\x4c\x61\x93\xec\xe6\x74\x56\x2d\x08\xb4\x0f\x4c\xcb\x61\x69\xc0\x75\x8e\x1e\xac\x59\x11\x1f\x60\xb2\xef\xb6\xc4\x21\x7d\x5a\x4f\xe1\xe9\x38\x2d\x5c\x29\x62\x83\xbd\x0e\xde\xc1\x38\x1e\x87\xca\x49\xa1\xa5\xb7\xa4\xb9\x9d\x6b\x27\x7f\x30\x65\xb0\x34\x49\x26\x84\xf0\x9c\x69\x2b\x3b\x52\x64\x26\x82\x40\x7f\x1e\x62\x02\x90\xdd\x3e\x69\x43\xf2\xe4\xb0\xd2\x01\x87\x2e\x53\x71\x93\x78\xc9\xbf\x86\xf7\xe4\x5a\xc6\x39\x70\xa1\x2f\x18\x70\xaa\x4f\x1a\xb2\x90\x4e\x3d\x88\x3d\x0e\x31\x7e\x95\xf1\xf3\x55\xbc\x0e\x40\x01\x1c\xa7\x6d\x32\x64\x51\xd8\xc2\x2c\x09\xcf\x20\x53\xed\x21\x36\xcd\x84\x09\x57\x15\x63\xbe\x55\x3c\x10\x3c\x54\xbb\x81\x84\x07\xb7\xe4\xd4\x68\x5e\x90\xa9\x86\x85\xd8\x03\xce\x55\x28\x16\xd3\x70\x07\xb1\x58\x51\x5d\x0b\x75\x54\x6e\xb7\x38\xd9\xb1\x57\xeb\xd2\xad\x6c\x26\xf2\x92\x8c\x6d\xbd\x40\x53\xc9\x5a\xa1\xac\x18\x28\xe6\x6c\x02\xae\x10\xba\x5d\xa8\x69\x02\x73\x6f\x86\x23\x55\x1c\xd4\xd6\x78\xa8\xb2\x30\x1c\x94\x0e\xa3\x04\xab\xba\x2a\x81\xab\x9f\x4d\x3a\x74\x64\x89\xbf\x9d\x4f\xfe\x4a\xc3\xad\xa0\x30\x93\xb7\x16\x86\x27\x9a\x09\xe0\xcf\x51\x1c\xa6\xdd\x10\x71\xba\x29\x19\xf3\xda\x9d\xd8\x44\xa4\xa8\x23\x84\x13\xc2\x0a\x60\x4c\x8a\x04\xae\x76\xbf\xa2\xfa\x33\xa1\xbe\x5a\x11\x19\x3f\x5c\xe7\x24\x13\xff\xcc\x78\x19\x47\x43\x90\x66\xff\xac\x4d\xca\x04\xec\x69\x6d\xc0\x9b\xa1\x71\x99\x48\x38\xe2\xb3\x03\xeb\x1e\x44\xc7\x7d\xbe\xa5\xdf\x9b\xfb\xd7\xf5\x38\x1f\x99\xaa\x9d\xdf\xde\x2a\x70\x65\x43\x87\x44\xa6\xeb\xb1\x80\x78\xad\x15\xf4\x5a\xb3\xe0\xdd\x64\xd9\x25\x9f\x27\xea\xa6\x59\xfb\x21\x73\x78\x7b\xbb\x3f\x39\x90\x36\xdc\x15\x07\xd3\x0d\x4d\xb2\x0e\x7b\x50\xb9\x36\xbd\x76\x4c\x72\x8d\x99\x4f\xac\xc8\x6e\x1b\x72\x74\xc4\x14\x3d\x14\xc7\x3d\x86\xe8\x97\xd2\xd8\xeb\xc7\x2c\x26\x8f\xe1\x42\x38\xc6\xd9\xc6\x2f\x8f\xdd\x5e\xc0\xa3\x7c\xc1\x2d\x3a\x08\xec\x22\x39\xcb\x63\x5a\xb4\x9a\x71\x46\xc1\x8c\xca\x14\xa4\x21\xcc\xde\xe1\x0f\x57\xe3\xca\xf4\x24\x83\x69\x85\x68\x17\x6e\x37\x1a\x11\x8b\x5e\x03\x34\xac\xc6\x11\x94\x95\x28\x8e\xea\xb1\x0c\x91\x33\xb2\x67\x77\x67\x24\xdc\x88\x73\x1d\x11\xd1\x5e\x00\x92\x64\x57\xa3\xa8\xa0\xaa\xc4\x7a\x91\x6e\xf9\x66\xc2\x83\x60\x24\x8a\x8f\x24\x22\x4e\x6f\x73\x10\x32\x69\xb3\x9b\xd3\xf9\x08\x96\x78\x6e\x9c\x29\x80\x57\x5b\xab\xf1\x91\x0a\x3f\xb7\xc2\x5d\x17\x70\xa5\x4e\x22\x4a\xa0\x78\x39\x6a\xe6\x96\xef\x1b\x18\xdb\x1c\x2b\x21\x0e\xdd\x74\x5c\x5c\x5b\x62\x82\x04\x28\x82\x15\x53\x63\xd1\x99\xd2\xa5\xd5\x13\x02\xbe\x4a\x12\x17\xef\x01\xa0\xb9\xdf\xee\x44\xb8\x57\x16\xec\x4a\x0a\x38\x7d\xaa\xa7\xd3\x46\xf1\xe9\xe3\x81\xf7\x02\x6e\xe3\x1e\x0c\x3e\x37\xb4\x97\xc4\x66\x11\xbd\x10\x20\xc2\xae\x8d\x01\xc7\xe0\xfa\x18\x30\x83\x9b\x2d\x6f\x9a\x2e\x1c\x46\xcf\x6f\x8b\x93\xe4\x29\x8f\x3d\x6c\x5f\x48\x03\xb4\x97\x13\x0d\x1d\x32\x7c\x7b\x18\x59\x59\xd8\x7e\xde\x10\x07\xb3\xe0\x1e\x7c\x56\x0a\xf6\xd9\x24\x2e\x0a\xaa\xdc\xbd\xc2\x2a\x6d\x99\xca\x05\x11\x24\xd2\x3c\x69\xa2\xcb\x4a\xb7\x18\x33\x24\x84\xc5\x1a\x2a\x88\xe0\xd1\x7a\xa2\x36\xc6\xcc\x58\xb5\x60\x2f\xe1\x18\x1e\x28\xb0\x1e\x3b\xa7\xa1\xd9\xd4\xc8\x49\xdc\xc4\xce\xd0\x99\x04\x8e\x9d\x7b\x71\xb9\x9e\xbf\x0d\x71\xab\xce\x27\x5e\xc0\x1c\x5e\x82\xce\x9d\x9f\x87\xa3\x59\xed\xfc\xb7\x9d\xe1\x40\xcf\x4c\x93\xdd\x75\x55\xe4\x86\x3d\xc8\x0d\xbe\x2b\x91\x46\xc6\x60\x62\xe1\xa8\x18\x80\xa7\x87\x64\x19\x31\x10\xb3\x33\xa6\xa6\x40\x39\x59\x40\xac\x17\x5d\x96\xc1\xe8\x2d\xbd\xba\xb7\xd9\x54\xe3\x2e\x3a\x9d\x41\x1d\x1a\xb9\xc3\xf0\x88\x63\xe2\x9e\xc8\x27\xe7\x6c\x1d\x5b\x4c\x84\x61\x11\xd3\x0c\x4b\x7e\x8c\xe7\x5c\xa3\x5b\x21\x80\xd4\xfb\xf9\x61\x80\xba\x02\x67\x06\x74\x93\x5d\x22\xd5\x08\xee\xc0\x13\x5d\x81\x9c\x9a\xce\x27\xd0\xb3\x89\x0f\x24\xab\xad\x17\xe2\x52\xf7\x77\x57\xaf\x5b\xe6\x14\x80\x33\x7d\x73\xec\x8c\x47\xa5\xc3\x29\x1d\x3c\xa9\x58\xf2\x74\x6a\x5c\xf5\x98\x88\x12\xd6\xaf\x08\xd7\x2f\x3d\x85\xee\x8e\x57\x1b\xaf\xa4\x74\xea\x0e\x2a\x0c\x9f\xf9\xe7\xf2\xfe\xfe\xfa\xb8\x46\x0a\x2f\x5d\x0e\xbc\x31\xf0\x96\x20\x1a\x8e\x90\xb5\xa7\x66\xeb\x98\xf2\x72\x52\x59\x51\x17\xab\x9c\x36\xc2\x4c\xd4\x9c\xee\x91\xb6\x7b\x41\x77\x67\x2e\x0e\x67\x5f\x03\x99\xf5\x8c\x23\xc4\x15\x07\x90\x95\xe5\x43\x7e\x3d\x80\x67\x98\xe0\x35\x71\x2a\xbc\x35\x61\x41\x21\xd1\x27\x7e\x9a\x06\x60\x66\xa7\xab\x4b\x03\x70\x54\x70\x2c\x7b\x2b\x2a\x6a\xac\xc9\xc5\x3e\xef\xb5\x9d\x7a\xb9\xb0\xe7\x99\xe6\xe1\x9e\x1f\x59\x70\x4f\x97\x14\x14\xf4\x7b\xb4\x51\xd4\xe9\xda\x41\xe0\x9e\x56\x1d\x8a\x5b\x89\xf9\x02\x03\x54\x86\xe6\x86\xdd\x6a\xf1\xbd\x90\xf3\x7c\xef\x03\xa3\x25\x34\x38\x82\x8e\x55\x69\xe0\xca\x15\x05\xce\xfd\x95\x4a\x87\x33\xe9\x81\x6d\x3b\xe3\x31\x6c\xac\x51\xe3\x99\x43\xb2\x4a\xc7\xc5\xa8\xb8\xc3\xb5\xf7\x84\x2b\xa9\x1e\x50\xe4\xd4\xe1\xdc\x6d\x1a\x81\xe8\x21\x05\x43\x4e\x3f\x58\x83\x2a\x04\x12\x9e\xdc\xc5\x42\x3a\x67\x27\xf6\xce\xa0\x1e\xc5\xe8\xe8\x5c\x14\x01\x69\x08\x42\x00\xf6\x04\xbf\x57\xd3\x0d\xad\x23\xae\xa1\xa7\x07\xf2\x7c\x57\xa4\x93\xdd\x64\xf0\x59\x9d\x14\x62\xb8\x2b\xc7\x9a\x17\x01\x21\x53\x36\x1a\x0d\x54\xba\x54\x1f\x66\x64\x1c\x05\x1a\xca\xd3\x22\x1d\xd9\xcd\x31\x4e\x8b\x8b\x61\x5d\xe8\xc1\x6a\x4f\xde\x47\xe7\x08\x9e\xb8\xe9\x6e\x8c\xc2\x0a\x8d\x74\x30\x0f\x71\xf3\x28\xc0\xd8\x53\xce\x58\x44\xc2\x40\x52\x4c\xd7\x15\x86\xbd\x55\xb2\xd1\x5b\x7c\xd3\xa5\x73\x78\xc8\x3c\xd9\xba\x52\xbb\x1d\x37\x52\x56\x93\x71\xb7\x02\x6a\xac\x96\x4d\xf4\x90\xd1\x6a\x4f\x58\x1f\xa0\xb0\xd9\x49\x2c\xa4\xc2\x92\xdd\xb3\xb5\x65\x47\x29\xae\xe0\x88\xc7\x3a\xac\xc1\xd5\xb9\x63\xc4\xb4\xec\xc3\x0d\x74\xb3\x1b\x4e\x00\x25\x7c\xa8\x76\xc5\xc8\x85\x06\x5d\xc6\x21\x80\xfa\xc3\x22\x2c\x21\x12\x46\xfc\x69\x01\xe0\x03\x07\x00\xd6\x5a\x45\x21\x12\x6d\x52\x5b\x87\x00\x8a\xab\x17\x34\x3f\x3f\x2e\xcd\x56\xd6\x9e\xe4\xc8\x00\xca\xcb\x6c\x85\x35\xe5\x94\x7a\x48\x87\xed\xe1\xd6\x6d\x26\x8e\xf5\x81\x33\x44\x1c\x28\x58\x27\x81\xd9\xb0\x69\xc2\x2b\x79\x94\x49\x1f\xa1\x51\x5f\x6e\xf0\xf6\x70\xa7\xa8\x00\x20\x54\xc6\x8f\x51\x45\xc1\xa9\xd1\xef\x0c\x36\xee\x94\xc0\x1e\x0c\x34\x4f\x61\x92\x3c\x94\x55\x9c\x41\x75\x09\xe4\xb1\x3a\x2f\x27\x07\x5e\xc2\x7b\x0d\x4e\x50\x7e\x4c\xf1\x20\x76\x55\x11\x8d\x85\x8e\x63\x25\xea\xe8\x37\x26\xff\x70\x8e\x88\x8c\xf7\x7d\x45\x2a\x92\x35\xa8\xcd\x61\xaf\x3c\xa0\xe3\xc2\xf5\xb5\x7d\xf0\x2e\x70\x02\x9e\x69\xe4\x90\xf4\xc8\x9c\x71\x1d\x9e\x29\xb4\x8c\x2d\x06\x85\x88\xa7\x23\x73\x36\x14\x22\x39\xaa\x70\x26\xa2\x34\xbf\xa4\x9d\x29\x20\x44\x9a\xb1\x8b\x21\xab\x2c\x7d\x5c\xae\xd4\x98\x9e\x00\x54\x42\x15\x37\x05\x4e\xd2\xb1\xbf\x57\x4d\x14\xaa\x67\x1d\x45\xae\xe7\xb6\xf4\x33\xf5\x34\x4e\x67\xc3\x97\xc0\xa2\x70\xe6\x0c\x5a\xc3\x5b\x3f\x37\x04\x62\x23\x10\x35\xf8\xe2\x63\x2c\x54\x9e\x74\x8b\x0e\x3a\x92\x94\x14\x03\x41\x83\x03\xe4\x26\x10\x0f\x5f\xc5\x2b\xb9\x9b\x7b\x60\xf2\x94\xee\xd6\xde\xa3\x70\x1d\x32\xe2\xe1\xf0\x80\xb8\x15\x50\xd3\xc2\x6b\x75\xf6\xaf\x90\xa3\x4b\x48\x1e\xaf\x88\xa9\x00\xe2\x31\x26\xf0\x61\x06\x03\xfe\x91\x53\x25\x92\x1f\xb7\x32\x82\x10\x86\x28\xfb\xe5\x8a\x2f\x0a\xcf\x3c\x24\x9b\xad\xfc\x8b\x75\x2f\x78\x79\x88\x70\x24\xd2\x49\x5c\x38\x1d\xd4\x9b\x12\xf0\x53\xd3\xce\xbb\xaa\x28\xe0\x52\x8b\x02\xe8\xc8\xca\xa3\x3c\xc4\x77\x74\x94\x2d\x19\x58\x57\x4e\x48\xfd\xe3\x99\x5a\x41\xc8\x9a\x87\x4d\x56\x51\x44\xa7\xf3\x84\x95\xee\x70\x9c\x42\x28\x19\x9f\xd7\x16\x30\x69\x37\x9d\xf5\x39\x99\x74\xd0\x61\xb8\x29\x30\xac\x55\x68\x86\xb9\x4c\x8b\x22\xd5\xb6\x6c\xf1\x41\xf6\xc6\xde\xc2\xd0\x6c\x20\xd0\x0c\x03\xbf\x5d\x91\xc7\x01\x94\xcf\x0e\x18\xa8\x07\xff\x01\x47\x31\x5f\x6f\x20\xe7\xf7\x64\xdd\x79\xe1\x69\x46\x24\x06\x38\x29\x72\xc0\x54\xb5\x29\xc7\xed\xe3\x48\xb1\xab\x44\x82\x06\x53\x73\xc1\x8d\xe9\x57\xf0\x78\x17\xe5\x3d\x94\xcf\x92\x7a\x97\x85\x54\x65\x3c\x00\x88\x74\x27\x3e\x3b\x01\xa7\x6c\xbd\x3e\x07\x19\x26\xf2\xc7\x8a\x5f\xd6\x4d\x25\xae\x1c\xcf\x61\x84\x50\x0b\xc0\x28\x80\xb9\xec\x97\x2b\x42\x16\x93\x76\x5f\xd2\x52\x5e\x28\xa9\x9f\x88\x0a\x1e\xee\xc1\x49\x1e\xbb\xfa\xba\x64\x9a\x62\x0f\x56\x5f\x1f\x05\xae\xd9\x3c\x99\xbe\xa5\x4d\x43\x26\x19\x03\x23\xed\x29\x93\x58\xe5\xc4\x51\xe7\x36\x18\x48\x2d\x76\xaa\x58\x74\xaf\xd0\x44\x5f\x21\x73\x0e\xea\x1e\xd9\x8d\xf6\xcc\xc6\xf3\x10\xb0\x81\x7f\x9d\xc5\x50\x3f\x22\xce\x5d\xed\x5c\x88\x3d\x21\x57\x96\x0c\x96\x9d\x3f\xad\xd0\xc4\xbb\xef\xcb\xc9\x4e\xd0\x61\xb0\x99\x51\x49\x03\x26\x2e\x6a\x53\xfb\x8a\x36\x37\x6f\x36\x6b\x3e\xb8\x50\x0f\x45\xaa\x2d\xc9\x5c\xa1\xcc\xaf\xa4\x59\x4b\xda\xe0\xd0\xc2\x63\x36\xad\xfe\x41\xbc\x78\x7e\x72\xf5\x92\xcc\x39\x25\x8f\xc8\xe2\xf2\xf1\x50\xef\xca\xc7\xb8\x93\x10\x0b\x43\xb2\x08\x8c\x31\x53\xbb\xe7\xdf\x15\x93\x0f\x05\x91\x00\x80\xd0\x81\xce\x7b\xf6\x5c\x1e\x64\x5d\x88\x4f\xd7\x84\xb9\x02\x60\x61\x9b\xf7\xb0\xb7\x22\x45\xb3\x77\xbf\xd6\x97\x70\x52\x46\x87\xe4\xd6\xc5\x85\x17\x50\xca\xe5\xd1\x75\x42\xbb\xeb\xa9\xe0\x15\x03\x35\xc6\xb5\x48\x0f\xeb\x72\x38\xc0\x3e\x1d\x5f\x45\x81\x3c\x21\xe2\x8c\x1a\x4a\xec\xb1\xa1\xdd\xf0\x86\x3a\xe2\x91\xcd\x74\x0a\x5b\x9e\xea\x44\x86\xcf\x8c\xca\xe7\x24\x5b\x59\x13\x72\xd5\x31\x0c\xaa\x73\x9c\xf6\xbb\x4a\x00\xb3\x4b\x46\x6f\xcb\x72\xb7\x63\xc7\x58\xef\x53\x28\xc1\xbc\x7a\x6a\xcf\x4b\xd4\xf5\x27\xe3\x9e\x01\xce\x80\x86\xdb\x96\x25\x71\x63\x12\x53\x65\xde\xb4\xf5\xb0\xe7\x94\x34\x62\x1d\xa6\x6b\x1e\x3d\xda\xaa\x63\x25\xf2\xa4\xd6\xe6\x4a\xe6\xe7\xfb\xc4\xa2\x83\xe2\xe2\xe9\x95\x4e\xf7\xdc\x53\x1c\xba\xea\xea\x21\xe7\xb3\xbe\x78\x4e\x83\x6e\x54\xdf\x94\xdb\xb8\x8d\xc3\xc5\x2a\x95\xee\x0e\xe0\xc6\xc5\x37\x95\xf5\x74\x48\x7d\x4c\x22\x63\x4c\x39\xb9\x2b\x9b\x6c\xe9\x62\xd1\x1a\x6e\x9b\x2c\x23\x49\xd1\x62\xe9\x6e\xe1\x23\x0f\x57\x19\x84\x91\xad\x23\x88\x44\xd1\x42\xca\x51\xc9\xf3\x34\x5d\x33\x16\x01\x33\xae\x0f\xf2\x68\x1a\x37\x11\xad\xd7\x46\x0c\xb9\xc7\xe3\xb1\x5e\xfa\x44\x5f\x85\x62\x8e\x4a\xed\x78\x5c\xcd\x23\xee\xd3\x22\xaa\x51\x5b\x09\x94\x0f\xf1\x81\x32\xe6\x40\x45\xc4\x8c\xc5\x26\x74\x96\x43\xe6\x9c\x4e\xed\xfd\x44\x2b\x33\xda\xb2\xfa\x82\x65\x8c\x7d\xcd\xc8\x11\xbf\x85\xb7\xf1\x3a\x82\x7b\x26\x35\x46\x5a\x2f\x03\x8f\x16\x31\xe9\x5d\x9f\xc0\xd8\xa8\x8f\xe4\x0d\xa0\x2c\xdd\x76\x76\x51\xf3\x42\x26\x45\xb0\xdf\x3a\x3e\x96\x1e\xac\x10\x16\xf2\xee\xf0\x30\x3b\x41\xe8\xb7\xea\x7c\x3e\x42\x41\x8c\x22\xbd\xa8\xc7\x34\xd8\xa5\xf2\x11\x23\xd5\x05\x81\x41\x92\x1b\x47\x57\x23\x73\xff\xcc\x8b\xdd\x41\xa0\xeb\x33\x74\x7b\x5c\x1b\x8c\x87\x8b\x6c\xd6\x4e\x42\x22\x94\xf1\xfd\x30\x49\x3b\x02\x23\x9a\x2f\xa3\x6b\x0f\x11\x89\x31\xd8\x05\xd9\x2b\xfc\x28\x92\x47\xd8\x01\x1e\x99\x47\x9d\xee\xcd\x5d\xac\xa3\xdb\x39\x4b\x26\x5e\x39\x74\xa1\x75\xe4\x5d\xc2\xb0\xac\xda\x22\x39\xeb\x0c\x42\x8b\x8f\x50\x33\x5f\xe0\x98\x5b\x15\xe3\x55\xf3\x22\x4f\x56\x9a\xe6\x70\x2a\x2b\x0f\x2b\xd4\x70\x6e\xb7\x8d\x92\xd4\x50\xcf\x00\x1e\x30\x29\x35\x04\xe9\x29\x70\x6b\x6c\x0a\xf5\xb5\x36\xae\x49\x6f\xdf\x00\x8c\x17\x38\x53\x4a\x87\x8e\x98\x4e\xcf\xd7\xbc\x7b\x1d\x72\x20\x8f\xf6\xfa\xcb\x45\xdf\xfa\xac\x74\xe0\x62\x32\x7a\x0d\xec\x6a\x65\xcb\x47\xae\xbe\x62\xae\x61\x65\x9c\xc4\xcf\x17\x25\x3e\xc4\x35\xcb\x43\xa3\x74\x9a\x07\xb6\x54\x3b\x69\xaf\x33\xce\x01\xa0\x00\x95\xdf\xe0\x7d\xec\x08\x4b\xf1\x10\xc3\x32\x20\xab\x31\x2d\x35\x6e\x64\x52\x6b\x4f\xdf\xbb\xa6\xe7\x37\xbd\x84\x06\xa5\xb9\x2e\x77\x82\x68\x8f\x3d\x4e\xe0\x04\xe8\x8f\xae\x64\xec\xa9\x45\x9c\x72\x55\x70\xd1\x2f\x26\x9c\xb4\xf4\x49\x95\x23\x59\x1c\x57\xe0\xae\x02\xb8\xb0\x95\x77\x6b\x06\x22\x05\x2c\x6d\x03\x16\x8a\x6d\xcd\x59\x8f\xa9\x3b\xd2\xd2\x8c\x72\xad\xc4\xcb\x7a\xbe\x4b\xc0\xb4\xf4\x3d\x16\x15\xa1\x0d\xd3\x49\x95\x06\xdb\xe5\xa0\x09\xaa\x4e\xbb\x90\x78\x75\x13\x21\xf0\x8e\xfa\x21\x3c\x92\xbe\x50\x67\xa0\x1c\xca\xf7\x03\xd7\xc6\x81\xef\x73\x19\x75\x0f\x5c\x16\x83\xf7\x62\xb1\xee\x9a\xc6\x27\xe1\x71\x1b\x08\xe2\x74\x32\x53\x57\x6a\x3a\xf8\x96\x0b\x8f\x99\xf1\xcc\xde\x82\xcf\x47\xc6\x3b\x40\x4b\x93\x1e\xd5\xc7\x21\xd0\xec\x35\x98\x17\xc7\xbe\x57\x17\x69\x77\xb0\x07\x81\x04\x58\xd4\x5e\x67\x82\x09\x6d\x56\x5b\x62\x99\x83\x65\x6c\xb0\x62\xb4\x6b\xcb\xc2\x07\xd6\xac\x96\x0c\x79\x1c\xb1\x91\x75\x93\x90\xa4\x0d\xc4\xef\x0d\x0e\x25\x68\x1b\x91\x34\x53\x26\xe2\x7e\x36\xa5\x18\x0c\xd5\x6a\x59\x40\x55\x6b\x60\xa6\xa8\x18\xf5\x0e\x6c\x29\x79\x64\x28\xb5\xea\x53\xc4\x1e\xab\xbb\x67\x5f\xdb\xa8\xf3\x80\x4c\x9f\xe7\x3d\x6b\x10\x4f\x22\x51\xa1\x93\x9a\x8d\x5d\xc6\xcc\xe5\x4c\xec\x29\x98\xa6\x21\xbb\xc7\xa5\x07\x09\x8e\x18\x37\xe6\xcb\x6a\xb7\x68\x7a\x5b\x41\x67\xf6\xd9\xec\x71\x59\x48\x54\x76\x59\x78\x29\xeb\xa0\xef\x48\x34\xe8\xc3\xc5\xe5\x73\x2d\xe8\x9a\xdb\x5d\x53\x22\xc5\xf1\x77\x77\x9c\x68\x1d\x64\x5e\x92\x43\x33\x50\x7a\xeb\xb1\xed\x0d\x4d\xa4\x3c\x57\xa1\x09\x0c\x35\x03\x8c\x09\xba\x58\x1d\xbd\x20\x4c\xfb\xc6\x3f\x10\x38\x5d\x7a\xc0\x5d\x22\xd3\xce\x68\x38\x24\xf2\x73\x99\x34\xa9\x6e\xdd\x2c\x18\xa2\x93\x5b\xa6\x6a\x67\xb6\x4f\x05\x15\x98\x1a\x0d\xb3\xea\xc7\xb9\x52\x2f\xc6\x51\x01\xa7\x3c\x9c\x30\x36\xb0\x79\xa7\x94\xb6\x71\xce\x11\x87\x38\x4d\xba\x52\xd0\x06\x5a\xab\x10\x2c\x44\xc4\x91\xbf\x49\x25\x80\x01\x84\x12\xd0\x66\xde\x76\x97\xa8\x34\xd3\x6b\xee\x2f\x8b\x2e\x84\x75\x91\xa6\xd5\x62\xe5\xf9\x4d\x8b\x59\x84\x15\x89\x04\x2c\x42\xcf\x6a\x90\xe2\xcc\x57\xb2\x57\xe9\xed\x83\xc3\xd6\x44\x86\x74\xf2\xbe\xb1\x9c\xab\x45\x35\x34\xc2\x07\x36\xa5\x34\xcc\x55\xdd\x49\x3e\x9d\xfd\xbe\xd1\x3a\x51\x29\x31\x2a\xe3\xb4\xaa\xd8\x13\x62\x97\x38\x6b\x4b\xba\xe9\x37\x3c\x1f\x72\xce\x38\x5b\x2a\x8b\xdf\xd0\x3d\xb8\x5c\x15\x1f\x42\xc2\x0b\x5f\x10\xa7\x70\xe3\xc9\x74\xe9\x0e\xe9\x91\x57\xd5\xf0\x9a\x01\x32\x84\x51\x34\x46\x36\xda\x23\xb1\xc3\x6c\xc9\x8d\x4c\x5f\x07\x1f\x6d\x74\x79\x50\x64\xfe\x76\x10\x91\x86\xf4\x1c\x49\x3e\xc5\x32\x75\x73\x2f\x6c\xf7\xb0\x97\xb6\x30\xfa\xde\x68\x1d\x22\x86\xae\x9e\x7b\x25\x21\x46\xc6\x44\x61\x84\x76\x9f\x55\xb6\xd5\x46\x56\xd2\x81\xdc\x0e\x16\x0b\xdf\xb6\xb3\x9e\xaf\x79\xe2\x2a\x4e\x3c\x28\xcb\x2a\xcd\x4b\x71\x78\xe4\x27\x52\x49\x71\xa5\xf0\xb9\xbd\x16\x42\xb2\x42\x89\xe1\x54\x2f\x00\x3a\x58\xad\xab\x79\xc7\xea\x07\xb8\x7a\x51\x70\x7b\x40\x68\x76\x5b\x84\xc2\x5f\x8f\xa7\x00\x49\x06\xcb\xd6\x2e\xd1\xd1\x74\x66\x8f\x35\x02\x66\x57\x7d\xb7\xac\xed\x31\x45\x79\x29\x30\x84\xd9\xa9\x47\xb6\x5d\xd6\x6c\x4d\x07\xf3\x1c\xee\x99\x1d\x59\x84\x2e\xd2\xdc\xd1\x8b\x75\xa1\x35\x86\xe4\x71\x99\x26\x97\xa7\x6f\x40\x2e\x8d\xf0\x08\x5c\xeb\x01\x6d\x0f\x3e\xf1\x85\xdd\x73\x91\xb0\x82\xb2\x17\x5a\x85\x54\x47\x00\x6a\x87\x7f\x64\x7b\x25\x52\xd1\xf8\xa3\x1f\xf5\xfa\xa8\x0b\x8f\x7e\x98\x17\x9f\x28\x56\x9b\x26\x5d\x22\xba\x11\x60\x67\x0c\x7d\x31\x9f\x43\x38\x5b\xb6\xdc\xca\xbc\x9c\x56\x27\xab\x1d\xaa\x3c\xcc\x7d\xf9\xe6\x69\xf1\x7c\x78\x1c\xae\x6d\x6e\x6f\x7c\xd2\x6d\xfe\x08\x17\xf7\x6c\x8e\x06\xbe\xea\x0d\x71\xf4\x0a\x59\xe4\xe6\xce\xb7\x56\xf1\x10\x28\x92\x96\x81\xb4\x29\x4f\x40\xd5\x2c\x04\x87\xd4\xf6\x91\x83\x6f\x45\xef\xa2\x76\x20\x8c\x43\xac\xc0\xb8\xca\xe1\x94\xce\xc4\xee\x3c\xdd\x3c\xb9\x14\x5d\x14\xb4\xbd\x3e\x55\x6f\x44\x18\xc2\x45\xed\xc5\x4e\x77\xa3\x4e\x44\xc3\xf8\x62\x6a\xc4\x37\x84\xda\x9d\x36\xa0\xb6\xd1\x62\x48\x91\x6f\x61\xd2\x8d\x46\x3b\x62\x55\x80\x63\xb9\x9c\x80\x73\xe8\xed\xb5\xd7\x1d\x0d\xa6\x62\x8d\xaa\xed\xa8\x7b\x97\x06\x71\xec\xe2\x78\xb8\x9c\x0c\xa8\xb6\x65\x8b\x00\x88\x33\xe9\xbb\x88\x63\xce\x7e\x9d\x0a\x27\x19\x0b\x88\x03\x3e\x85\x9c\x93\x3a\xd2\x30\xa3\x18\x16\x56\x64\x63\x35\x16\x93\xd5\x7a\x6a\x26\x9d\xed\x1f\x8d\x14\xaa\x07\x28\x07\x6d\x1d\xed\xef\x19\x0f\x0f\x52\x63\xb8\x5d\x7c\x03\x98\x23\x80\x2a\x39\x5d\x25\x82\x66\xb2\x68\x69\x5f\xe0\xa5\x41\xc7\x2c\xbd\xbb\xeb\x46\xba\x30\x71\x29\x8d\x8b\x77\x91\x34\x43\xc1\x17\x9d\xec\xfa\xd5\x39\x46\x75\xa3\x2d\x87\xf6\xa1\x44\x8b\x3c\x99\x95\x26\x9d\xca\x75\xea\x26\x77\xd7\x2d\xfb\x3a\x0e\xfe\x40\x76\x0b\xb3\x12\xcf\xc7\x45\xd4\xdd\xb3\x60\x95\x14\xc8\xeb\xc4\x36\x43\xd8\xa4\x45\x53\xdb\xd7\xdd\x57\x8a\x7b\x41\x76\x31\x71\xe4\x24\x35\x7c\x0a\xe4\x15\x0d\xd8\x00\x31\x9f\x73\xf0\x6a\x3b\x51\x6e\x6a\x7b\x19\x3a\x36\x14\x3f\xdf\x6e\xc8\x21\x5f\xe2\x07\xb2\x3d\xa4\xe9\x8a\x00\x77\x8b\x68\x7b\xf5\x04\x46\xd0\xa5\x1b\x77\xd7\xe5\x09\x6e\x5d\x1c\x07\x0a\x8c\xdc\xa3\x6e\x33\x85\xa9\xd5\xf1\xe1\x60\x53\x91\x50\xde\x0a\xc4\xa1\xef\x43\xa4\xd7\x9c\xfe\x88\x3c\x2a\xe7\x60\x18\x8f\xa6\x51\x77\xdb\xe0\xb1\x15\x81\x27\x69\xde\x55\x90\xd4\xe9\x3e\x69\x61\x5d\x1a\x8d\x85\x07\x65\x1a\xe6\xb4\xa4\x1d\xdd\x58\xb3\x0d\xf9\xb1\x25\x4e\x36\x58\xd3\x65\x44\x96\x50\xa6\x14\xd8\xe0\xca\xda\x70\x38\x2e\x0e\x08\x24\xb8\xca\x80\x5c\x5d\xce\xf1\x30\x75\x76\xba\x9e\xf9\xf2\x2e\x0f\x97\xdb\xc4\xeb\x68\x9d\xc0\xf9\x11\x8a\x4c\xb2\x28\x4d\xa9\xd1\x1f\x2b\xf5\xb0\x4f\xb4\x3f\x12\x63\x6a\xd4\x6d\xd3\x7a\x88\x25\xef\x61\x13\x8e\xc0\x49\x46\xe1\x3d\x91\xe5\x16\x2b\x3b\xe9\x4a\x18\x85\xe6\x3a\xb5\x43\x80\x1e\x42\x4c\x0e\x20\x8b\xba\x6b\xd9\xf5\xcc\xa7\x5a\xeb\x65\x9c\xa3\x28\xb1\xd2\xc3\x73\x52\xe2\x60\x7e\xa0\xa7\x34\xd5\x88\xb4\xc9\x6b\x20\xe6\xc7\x76\xdc\x72\xa5\x39\x9d\xcf\x97\x2b\xe9\x68\xd7\x11\x60\xd8\x9e\xdf\x5d\x69\x1b\xd6\x2e\x6a\xe1\x78\x8b\x03\x05\xa3\x76\xc9\x94\xf2\x06\xde\xc1\x27\xbe\x11\xa1\xa3\x52\x4b\xb5\x7c\xc0\x7a\xfc\x9e\xeb\x13\x06\xbb\xb4\x2a\x02\x04\xb2\x21\x41\x89\x21\xb6\x01\xde\xe1\x52\x74\x30\xd5\x26\x10\x78\x59\xc7\xfe\x98\x2f\xbc\x38\xc0\x16\xe2\x1b\x7b\xc5\xb6\xe7\xfb\x9d\x19\xe1\xdc\xe3\x32\x79\xe5\x5a\x9b\xc8\xc0\x19\xfe\xe4\xe2\x28\x4e\xc6\x2e\x19\xb5\x18\xb1\x07\xcb\xca\x29\x35\x1d\x2a\x8f\x76\x31\xe7\xe7\x04\x37\x91\x46\x38\x5d\x78\x40\x5d\xa4\x69\x29\x5a\x12\x6c\x6d\xd9\x6e\x4d\xed\x2c\x29\x89\x2e\x2f\xeb\x9e\x8a\x4a\x7e\x4b\xb0\x13\x79\x4d\xf1\x23\xf1\x80\x69\xf5\xbe\x3d\x4e\x6a\x54\x20\x04\xb3\xd7\x57\xe1\x59\xe7\x65\x80\x4d\xae\xf7\xaa\x56\xdb\x7b\xbf\x33\x34\xf3\xef\x62\x8f\xb2\x87\x3b\x77\x0a\x77\xfb\x80\x0d\xb4\x9f\xa4\xba\x54\x00\x4b\xbb\x3b\x9e\x15\x5c\x0e\x0a\x1b\xad\x7e\x73\x23\x8c\xe2\x9c\x42\x9a\x2c\x6c\xc5\x60\xdf\x84\x5b\x7c\x19\x29\x86\x45\x94\x6c\x9f\xf6\x8a\xc6\x1d\xb2\x0e\x25\xad\xb4\xd3\x70\x9a\x10\xc6\x22\x2d\xdf\x70\xf5\x40\x8c\xda\x83\x2c\x96\x7a\x76\xd1\x97\x6a\xe9\x6b\x2f\x6f\x07\x20\x27\x13\x13\x3c\x2d\xa5\x9a\xdf\x61\x51\xd6\xc4\x30\x0f\x91\x52\xad\xba\x03\x3a\x56\x15\xed\x81\x83\xa6\xe3\x7e\xd6\x21\x48\x60\x68\xc0\x1d\xcb\x4f\x97\xf0\x4c\xed\x21\xec\x8e\x96\x2b\x44\x6b\xb5\x8b\x01\x65\xe7\x3b\xf8\x88\x3f\xae\xc2\x3d\xdd\xdd\x10\x75\x91\x2f\x70\x2c\x84\x9d\xe1\x50\x93\x70\xa2\x56\x81\xb6\x64\x59\xab\x12\x37\xbe\x3f\xd4\x8c\xc0\xf3\x06\xe0\x0e\x12\x4f\xdf\x4f\x27\xdf\x8f\x11\x2f\xb4\x1f\xe1\x0a\x9e\x83\x3d\x29\x79\x24\xb7\xb0\x9e\x8d\xda\xab\x9c\xae\xf5\x7c\xf5\xc2\x3e\x0c\x08\xd1\xab\x0c\xe9\x6b\x46\x6f\xdb\x40\xa8\xbc\x4e\xd2\xef\xe7\x19\xcd\xcb\x39\x3c\x2b\x2d\xd3\x86\xdb\xf9\x78\x2d\x2d\x5c\x02\x18\x6e\x3d\x6f\x6b\x18\xe4\x2b\x7b\x72\x4b\xbc\xec\x6f\x9d\x98\xcf\x6d\x42\x76\x77\xdf\x68\x32\x1e\xa1\x7d\x66\x74\x0a\xe3\x2a\xe0\xdb\x63\xe1\xf4\xb1\xec\xef\xb3\xb8\x42\xb5\x89\x75\x57\x31\x4c\xc0\x25\x75\xed\xa9\xa6\x33\xf4\xea\xd0\x9e\x75\x49\x63\xf5\x8e\xd3\x37\xf5\x70\x46\x7c\x6a\x3e\x67\xde\x62\x4f\x4a\xef\x6e\x97\x87\x85\xba\x52\xd2\xfa\x7b\x4e\x35\x61\xa4\x35\x10\x8f\xbe\x85\x56\x53\x31\x84\x62\x4f\x23\x02\xe1\xca\xf5\xce\x5a\x88\x56\x03\x58\x7d\x2b\x06\xb0\x73\x93\xcf\x1a\x97\x9a\xb7\x4e\x4f\x52\xc4\xc1\xf9\xe8\xb2\x97\xe9\x62\xb9\x2c\x85\x69\x23\xd8\xac\x2b\xa9\x76\x2f\x99\x07\xa2\x15\x59\x96\x24\x0d\x04\x59\xac\x28\xa0\x6c\x2a\x18\x86\x2c\xa3\x0c\x42\x77\x5c\x19\x5d\x86\x79\x0d\x34\x10\x71\xd5\x56\xc2\x1c\x9f\x0f\x58\x9a\x3c\x52\x8f\xa3\x81\x9f\xd6\xb2\x54\x0a\x83\x6b\x76\x36\x90\x8a\x3b\xcd\x87\xce\x2b\x05\x24\x74\xcf\x94\x70\xe0\xab\xd2\x1b\x30\xb6\x9f\x97\x9e\xd3\xfb\x12\xf3\xdc\x6c\xb0\xbb\x96\x83\xd9\xe4\x2e\x40\x99\xc6\x74\x40\xed\x92\xd4\x1c\xb4\xc0\x11\xc9\x40\x38\x50\x35\x70\x8a\x6b\x5f\x40\xcb\x6a\xea\x4a\xc2\x5b\x59\x0d\xc3\x44\xfb\xdc\x67\x41\x9e\x87\xaa\xed\x50\x15\xd0\x1d\xb4\x36\x1f\xf0\xb3\x44\x1c\x31\x91\x42\xae\xd2\x11\x5b\x94\x8b\xdd\x1e\x8c\x46\x53\x4f\xab\x64\x84\x71\xb2\xeb\x7e\xc1\x50\x2c\x8c\x09\xcf\xd7\xb5\x73\x30\xef\x81\xf0\x7e\xa6\xc4\x76\x4a\x5b\xa2\x2d\xb5\xa2\xe0\x7d\x29\xb7\xd1\x04\xd9\x73\x90\x03\x94\xb8\xed\x68\x1f\x44\xa9\xc7\x1e\xf5\xa5\xe4\x4a\xfe\xee\x8f\xa1\x55\x70\x4a\xed\x18\x31\xc7\x13\xb7\x1b\x7a\x71\xc7\xad\xcd\x8c\xcb\x8a\x67\x98\x7a\x9f\xcc\x53\x7a\x33\x28\xec\x38\x7b\x32\x8f\xfa\x63\x3d\xe3\xa5\x36\xba\x54\xd7\x5c\x1f\x79\x1c\x8b\x17\x28\x5a\xaf\x90\x71\x6c\x2e\x78\x47\xe2\xe7\x83\x18\x18\xe8\xdd\x83\x7b\x13\x6f\xf6\xd0\x89\x67\x0a\x89\x48\xd1\x70\x6d\x88\x3d\xa3\x3e\x59\xb9\x54\x38\x5a\x3c\xd9\xc5\xbd\xc8\x91\x49\x0f\xec\x6d\x93\xb5\xb6\x5e\x58\x0e\x03\xa4\x4e\x9c\xd6\x5d\xe1\x29\xe2\xe6\x6f\x49\xe8\xa1\x72\x05\x75\x35\xf8\x80\x8c\x3d\x03\xee\x7a\x30\x56\x9c\x89\x02\xdd\x3d\xb1\x2b\xe2\x59\xbb\x9b\xc7\x5a\xf0\x80\x88\xcc\xe6\xfb\xa3\xec\x94\x25\x16\x2e\x7d\xe5\x92\x8d\xdd\x34\x2a\xb3\xa6\xe5\x30\x63\x30\x6b\x99\x06\xe8\xe9\x85\x72\x31\x76\xb7\x0e\xef\x96\x94\xe8\xdd\x72\xf6\x1b\x2a\xd6\xb4\xbd\x4a\x19\xaf\xab\x13\x17\xad\x55\x6c\xa1\x56\x06\xfc\x71\x8c\x3a\x01\x29\xb1\x4a\xe9\x53\x5f\x3b\x1c\xa5\x9d\xf6\xa9\x05\x07\x90\xb5\xf2\xb0\x5a\xd9\xfb\x9e\x9f\xaf\x63\x46\xdc\xb6\x53\x1c\x3b\x52\x4e\x33\x84\x4b\x3a\x4d\x03\x8b\xe6\xdc\x5d\x55\xb7\x5d\x49\xe5\x72\x8a\xf4\x06\xbd\xc8\xe9\x2c\x4d\x3a\xd0\xa0\x26\x29\xc6\x51\x52\xd1\x81\xce\xe9\xa5\x34\x25\xeb\x9d\x3b\x14\xa6\xa1\xce\x87\x8c\xdb\x12\xb9\x47\xce\xee\x7d\x10\xea\x53\x31\x06\x86\xed\x32\x5d\xf3\x68\xef\xa0\x0d\x0e\x44\xbb\xa8\xde\xb0\xd5\xb6\xd5\xd1\x40\xd8\x85\x95\x11\x5f\x1e\xc6\x78\x28\xc5\x03\x5d\x04\xbb\x7f\x68\xe9\x63\x77\xc2\xc8\x88\x5c\x6f\xfc\xc9\xde\x13\x83\xde\xf7\x82\x5b\x06\x19\x92\x06\xd2\xe3\xed\x7c\xbe\xf2\xa7\xf6\x66\x5b\xb1\xbd\xa9\x72\xd9\xe1\xe9\xe4\x02\x17\x83\x74\xa9\x33\x42\x92\xe7\x91\xe1\xd3\x1c\x47\x34\xed\x0c\x01\xfa\xdc\xb7\x44\x76\x58\x18\x83\x62\xee\x3b\x8c\xf5\xb8\x23\x78\x62\xce\x32\xee\x1d\x08\xbb\x01\x01\xc3\xb8\x81\xfe\xd6\x38\x50\xc9\x40\x91\x43\x9d\xdd\x18\x86\xa1\xb1\x29\x36\x34\xec\xd2\x73\xa9\x4c\x38\xd3\x14\xa7\xf3\x4a\xdd\x2a\xb3\x97\x6e\x05\x8f\x75\x8b\xa0\xa6\x92\x03\x05\x1d\x18\x66\x93\xa5\xd6\xc7\x4c\x3b\x08\x0c\x92\x63\x75\x0f\x9c\x7a\xb6\x3c\x1b\x71\x88\x02\x07\xf6\xd1\x72\x29\x08\xc4\x26\x50\xad\x77\x09\x4c\xb7\x49\xee\x6f\x54\x75\x59\xe1\x49\x96\xcd\x5b\x7f\x11\xea\xb1\x34\xf2\x12\x10\xfb\x30\xe0\xad\xd6\x13\x1b\x16\x7e\x80\xe7\xcb\xda\x29\x54\x5f\xc2\xcb\xcc\x13\xbc\xb2\x96\x06\x92\x80\x82\x57\xe5\x2e\x0d\x8b\x91\x92\xf9\x7b\x68\x3b\x1a\x37\x57\x24\x21\x52\xb4\x01\x31\xea\x4b\x10\x03\x6e\xc6\xa2\x87\x37\xaa\x5b\x46\xa2\xcd\x99\xbd\x4c\xc8\x13\x00\x42\x13\x1a\x21\x97\x3a\x22\x7a\xb6\xb0\xa3\x07\xd0\x0a\x8e\xbd\x64\x84\x7f\x3a\xd6\xf7\x28\xc4\xb1\xb3\x66\x51\xf9\x55\x47\xac\x63\x68\x2c\xac\xdc\xe2\x87\xe5\x91\x5c\x09\x80\x05\x28\x1d\xf6\xa5\x53\xae\x37\x7c\x67\xd5\x1d\xb2\x07\x5c\xe4\x10\x2d\x3e\xac\x9d\x83\x51\xc2\xcc\x07\x38\xd8\x89\xbb\xa7\x88\x94\xa5\x11\x16\xf9\x58\x4c\x89\x2c\x94\xa3\xa9\xf0\xac\x74\x83\xfc\x15\xe1\xd0\xdd\x5a\x1c\xbc\xd6\xa5\xe7\x52\x90\xe2\xb1\x2b\xac\x35\x24\xb4\x26\xbf\x94\x19\x14\xcc\xab\x05\x3e\x92\x04\xa7\x16\x1c\xd4\x05\x72\x4c\xe2\x8b\xe1\x03\x71\xfb\x58\x89\x2e\xc4\x23\xc9\x00\xac\x93\x35\xf8\x78\x6c\x88\x56\xce\x99\x65\x34\x54\x4e\x52\xed\x35\xc2\xf5\xe4\x28\xa9\xbf\xaa\x57\x34\x59\x1c\x58\xe0\x5b\xd5\x92\xcd\xae\x1f\x5a\x12\x28\x1a\xe6\x60\x3c\x6c\x91\x8f\x0e\xa3\x1d\xc6\xf6\x19\x3a\xcb\xdb\xf5\xcc\xcd\x8d\x76\xbf\xda\x45\x6d\x58\xa6\x09\x09\x3a\x31\x77\xe5\x31\x40\xa1\x1b\x91\x12\xe4\x9e\x0d\x92\xf4\xd9\x70\xc8\x2b\xcc\x4e\xf9\xa6\xf8\x70\xaa\x06\xa7\xe4\xec\x15\x2d\x26\x78\x5d\x7c\xa9\xc3\xab\x68\x12\x8f\xc3\x5e\x47\xc9\x7b\x7c\x28\x10\x7d\xa2\xf1\x0a\x05\x53\x3b\x6a\xd2\xd3\x24\x58\x71\xa7\x91\x98\x01\x9f\xcd\x79\x1e\x49\x5d\x59\x1e\x61\x80\x36\x0b\x5a\x8d\x80\x7d\x45\xdc\x53\x46\x14\x47\x07\x18\x57\xbe\xd5\xd0\xa2\x51\x49\xdc\x48\xeb\x0b\x5f\x4d\xed\x5d\xcd\x25\x31\x70\xd7\x3e\xdf\x1c\x46\x53\x6e\xe4\xe9\x0c\xf0\x51\x01\xec\x0e\xd7\xab\x6a\x4b\xb0\x4f\xc5\x43\xd0\xa5\xeb\x6c\xe8\xa8\x46\x23\x5d\x21\xc1\xab\x4b\xf6\xe5\xa1\xc3\x0c\x92\x05\x82\xa1\x46\x36\x06\x21\xe9\xeb\xa1\x66\x4d\xd8\x50\xe2\xe0\xac\x57\x2d\x7e\x77\xa5\xfe\x7c\xa1\xc3\x7c\x08\x88\xad\x3b\x1f\x21\xd5\xf7\x65\xe0\x44\xe1\x8d\x59\x0f\x31\x44\xef\x11\xfe\x74\x2a\x0e\xa6\x24\xf4\x67\x7e\x22\x98\x23\xa2\xc6\x06\x3d\xe4\x26\xc7\xb5\xc7\x6c\x09\x1a\xbd\x92\x10\x22\xa4\x99\x32\x1d\x1d\x65\x19\x2d\xff\xc8\xac\x81\x48\x75\x20\x5c\x72\xb1\x90\x49\xd2\xd1\x09\x07\x73\xbd\xa8\x00\x21\x1c\x60\x9b\xc9\xc3\x25\x7b\x3e\xe7\xe8\x38\x85\xb7\xc5\x7b\x42\xe4\x4d\x65\x4a\x5b\x34\x00\x3d\x18\x21\x93\x1c\xd4\xb6\x11\xb2\x94\x47\xd1\xc3\xb9\x34\xa8\x1a\x45\x22\x2c\x29\xcd\xf1\xd4\x69\xee\xee\xef\x74\xea\x56\xe7\xf4\xc1\x6c\x26\x97\xad\xdc\xa0\xb5\x38\x78\x44\x1f\xab\x6d\x76\xd0\x18\x80\x8d\x8f\x19\xd6\xda\x3d\xe2\x23\x0c\x4f\x49\x76\xe8\x08\xcc\x7a\xa4\x98\x72\x59\x68\x46\xbc\x85\xbe\x43\xef\x9e\x42\x02\xb3\x9b\x0a\x58\x51\xef\x28\x4c\x9c\x77\x82\x16\x52\xf7\x99\x8e\x8a\xcd\x11\x81\x10\x9d\x67\xb1\x31\x40\x54\xa0\x16\x09\xa9\x33\x5c\x02\x0d\x4c\x33\x20\x26\xb6\x54\x28\xe6\x11\x6c\xcf\xcb\xc6\x07\xd5\xcd\x4c\xa9\x0f\x49\x1f\x5c\x0b\xe7\x82\x7a\x8b\xe5\x90\xe1\x81\x21\xa5\x34\xf7\x28\xe4\x76\x55\x33\xf5\x40\xe3\xe3\xdc\xa7\x7c\x3b\x0c\x2e\x22\x80\xf7\x48\x83\xe2\x40\xc7\xbb\x23\x9f\x70\xc4\x5e\xcc\xeb\x80\x65\x12\xe6\xc2\x2f\x93\x76\x1f\xba\x22\xea\xcf\x9c\xa5\xca\xd2\x9e\x3d\x5c\x20\x1e\x87\xda\xe1\xda\x93\x03\x3f\x89\xe7\x03\x29\x0e\xb6\xc6\x79\x8d\x7e\xdf\x6d\x65\xed\xb0\xdd\xd9\x44\x67\xce\xb9\x80\x2e\x65\xf9\xd8\x6c\x17\x83\xc9\x18\xe8\x12\xaf\x17\x5a\xde\x08\x1b\xf2\xf6\x82\x6c\x9b\x2d\xc9\x74\xce\x0a\x57\x68\xc1\x1d\x77\x31\x5d\x35\xa3\xdd\x77\xc4\xfd\xe5\x78\x54\x62\x99\x1e\x29\x0a\x15\xc2\x13\x04\xb4\xc1\x5e\xdd\xc1\x8b\x2c\xb7\xc7\x4b\x50\x9c\x03\xc0\x20\x0a\x2c\xe2\xef\x49\x56\x21\xc4\x45\xf7\x18\x60\x0a\x06\x83\x71\x94\x19\xaa\x59\x48\x8c\xba\x68\x8f\xdb\x7d\x23\x29\x74\xb4\x8d\xa4\xbb\x36\xc7\xb8\x8a\xce\xbd\xed\x41\xa9\x63\x82\x1d\x25\x2d\x22\x4e\x18\x3a\x39\xa2\xfc\x21\x92\x37\x43\x84\x0b\xe1\x72\xbc\x67\xf2\xe2\x8a\x42\x9e\x56\x0d\xb7\x24\xb7\x76\x4c\x47\x3b\x92\x92\xae\xca\x4e\x4d\x51\xb3\xdb\x15\x01\x1f\x7b\x41\x7b\xcd\xbc\x40\x1d\xe3\xca\xcb\x2e\xb2\x6d\xdc\xb1\x16\xca\x3d\xbf\xd1\x98\xec\x0a\x62\xb5\x6c\x89\xd6\xe9\x78\x95\xc5\xf8\x00\x27\x31\xb6\x2e\x04\x75\x6c\xf8\x03\x82\x0c\x3c\x1a\x35\xb9\x42\x8b\x62\x88\x5f\xd2\x1b\x73\x29\x96\x62\x49\x9d\xe8\x66\x54\x80\x7b\x87\x87\x9a\x0f\x08\x09\xf0\x4a\x23\x74\x8d\xe8\x70\xdd\x58\x5e\x23\x42\x31\xb1\x4d\x94\x08\xe3\x80\xab\x1f\x0e\xeb\x39\x58\xaf\x44\x1c\x6c\x3e\x28\x26\xaf\x8f\x11\xa8\xe4\x22\x16\x14\x12\x43\xdc\x41\x79\x09\xc2\xd1\xf2\x04\xfd\x48\xaf\x29\x4f\x54\x27\x0b\x3d\x3b\xb6\x2e\x9e\xd6\x9c\xf5\x57\xaa\xbf\xb3\x07\x5d\x95\x1f\x93\xa7\x5c\x27\x40\x32\xf5\x14\xe7\x3c\x70\xce\x44\x06\xb9\x07\xd5\x5e\xe4\xc1\x8c\x73\xcd\x08\x71\xde\x1a\x49\x38\xc8\xbc\x97\xba\x29\xc8\x05\x99\x50\xdf\xdd\x1c\x07\xa9\x51\x97\x65\x1a\x35\xf4\x59\xde\x93\x30\xb8\xe3\x5a\xdf\xa8\x26\x42\x3e\x01\xb1\x44\xe8\xc0\x23\x4f\x74\x43\xab\x18\x41\x48\x10\xbb\xb1\x2f\xc5\x74\x5d\x7a\xcc\xe5\x3d\x98\x04\xd2\xaa\x5b\x05\xcf\xd3\x15\x0b\xdf\xf6\x62\xd0\x0c\x49\xb4\x2a\xb2\x05\x61\x1f\xfc\xa0\x45\xf1\x2a\x17\x0d\x62\xb7\x12\x2f\xb6\xf1\x9e\x81\x2e\x38\x3c\xe3\xec\xd4\x18\xa8\x02\x13\x86\xe2\x3b\x7b\xfd\x9b\x6d\xe6\xf1\xaa\xf0\x65\x83\x3e\x34\xef\x26\xdd\x14\x22\xd1\xef\x2a\x13\x15\xf7\xab\x9b\x23\xa6\x31\xc0\xea\x84\x3a\x6b\x70\xaf\x9e\xdb\xe2\xa0\xba\x20\x22\x06\x19\xf1\x76\x7d\xab\x92\x25\x99\xa4\xa3\x4d\x38\x23\x56\x56\x84\x96\xaf\x90\x75\x19\x72\xc9\x2d\x96\xd3\xe3\x6e\x77\x38\xcc\x62\x9d\x21\x9c\x50\xd7\x90\x07\x7c\x12\xf7\xda\x42\xf7\x2c\x07\xac\xb8\xc2\xdd\x5c\x87\x42\xa0\xf3\x80\x33\x80\xa8\x8b\xdb\xba\x01\xa0\x3d\x54\xda\x4a\xaf\x59\x83\xc0\xba\xe4\x6d\xf8\x4a\x04\xdc\x40\x87\x8e\xd1\x28\xb9\x79\x0d\x6f\x3d\x7a\x32\xa5\x7c\xd3\xd5\x06\x62\x4b\x63\xc8\x57\x8a\xe6\x74\x1a\x3c\xb0\xa3\x48\x7b\x00\x1d\x61\xe1\x63\x14\x2e\x2d\x90\xc8\x24\xb4\xa7\x82\x25\x93\x5f\x09\x06\x39\x7b\x57\xee\xac\x4c\xfe\x4d\x9e\x05\x0e\xe8\x33\xdf\x80\xcb\x06\xc3\x12\x08\x4f\x9f\x3b\x02\xdb\x12\xca\xee\xb9\xd8\x11\xb8\xd4\x2c\x8b\x8b\x29\x7d\xb3\xdb\x29\x46\xed\x16\xf0\x84\x1a\x77\x0e\x47\x30\x6a\x5b\xe7\x0a\x5f\xe1\x53\x5f\xd6\x1d\x67\x65\x56\x49\x44\x77\x6d\x04\x01\x45\xc3\x5d\xa5\xba\x40\xf9\x92\x5f\x1e\xf7\x94\xbe\x8a\x45\xc1\x5d\xaa\x6a\xd9\x9c\x84\x00\x80\xe1\xee\xcb\x84\x4b\xb7\x53\xd9\x6e\x38\x15\xa7\xd5\xed\x10\x09\xbb\xab\xf1\x56\x52\xe6\x6f\x35\x7f\x27\xa0\x59\xc5\xb2\x5e\xed\x43\xbd\x6a\x6a\x65\x73\xfc\x3d\xb5\x80\x45\xb8\xe6\xdb\xeb\xc9\x9f\x75\xb3\x66\xae\x84\xe4\x2f\xd7\x1a\xb8\xd0\x95\x1c\xd5\xf1\x44\x96\xbd\xdf\x5b\xf7\xd9\x98\x4e\xd9\xe1\x12\x15\x0b\xdf\xfb\xd1\xd4\x5b\xeb\x16\xf2\x78\x75\xf6\x91\xc0\x26\x07\xae\x07\xf7\x98\x51\x25\x95\x71\x2b\xa3\x4a\x4b\xec\xb2\x85\x92\xf6\x40\x51\x81\xc2\x31\x4b\x6c\x1c\xc9\x99\xd0\x67\xb7\xc9\x23\x55\x36\xf8\x83\xd5\x01\xe1\xa6\x9a\xfa\x3c\xa0\xe5\x39\xd2\x6d\xed\xcc\x83\xa7\xe1\x10\x58\x41\x20\x08\x8b\xbb\x86\x64\x13\x16\x21\x97\x89\x89\x32\x8c\x09\xa0\x4c\xd3\x5e\x59\x93\x94\x8a\x6e\x5b\x40\x8d\xa3\x42\x0a\x39\xaf\x9e\x44\x3e\x05\xe5\x6d\xe0\x12\xbe\x9f\x72\x53\x51\xee\x39\x05\xce\x60\xee\xbb\xa6\x00\x8f\x16\xa1\x17\xdb\xc0\x06\xd1\x7d\x9c\x0d\x5b\xf1\x6b\x83\x88\x37\xc4\xf0\xae\x48\xb8\x8c\xc3\x35\x75\x2f\xae\x35\xf9\xa6\x51\x59\xf8\xb1\x76\xa2\xf1\x20\x44\xc7\xa9\x07\x57\x46\x2c\x81\x70\xc0\x11\xa6\xa3\xb4\xad\x40\xee\x69\x04\x51\x87\xa2\x8b\x6c\xaa\x94\xfd\x24\xb4\xa7\x16\xf6\x6f\x70\x9e\x4e\xf7\x60\x04\x93\x10\x4e\x41\x1a\x8f\xe2\x2a\x1b\x80\xa3\x99\xa4\xc7\x3a\xbe\x3c\xb7\x09\xc2\x60\xea\xdc\x8a\xc8\x89\x6d\x0f\xad\x3f\xa6\x7b\xf2\x60\xa2\x72\x2c\x63\xc8\x9e\x3b\x8a\x4c\x05\x9e\xcd\x96\x5b\xe9\x1b\x15\x40\xa5\x43\x02\x03\x1f\x51\x8a\xa1\x1d\x36\x8f\xab\x6a\x7c\xea\xd2\xbd\xc2\x16\xb3\x75\x7a\x9c\xf9\x8d\xf0\x5b\x8c\x79\xc4\x78\xbf\x5e\xdc\x3c\x78\xac\x50\x1b\x69\x6a\xdd\xa4\x40\x84\xb1\xf5\x5e\xdf\x0b\x1a\x37\x06\x23\x1e\xf1\xb2\x35\x4d\xf9\x6c\xd6\xa0\xa1\xdc\x58\x5c\xb3\xc1\xc7\x69\xcf\x0d\x85\x0a\xbe\xf5\xd3\x88\x9b\xb6\x7e\x91\x14\x45\x00\xe3\x51\x60\x73\xb4\xda\x04\xde\x08\xa7\xd0\x4d\xd1\x4d\x0a\x46\x22\x72\x54\x67\x74\xef\xe4\x8d\x4e\xf1\x6b\x5f\x23\xed\x5e\x18\x76\x81\x40\x61\x0a\xbf\x3c\x6a\x28\xd7\x88\x6a\xcb\x7b\x2d\xdd\x8f\xb7\x32\x3c\x17\x53\x3a\x88\xad\x26\x96\x91\xe8\x60\x80\x88\xbb\xde\xda\x9b\xd2\xcc\x41\x9e\x5f\x73\xa1\xe7\x95\x75\xc9\xa2\x11\x9f\x13\x29\x75\x20\x4a\x86\x6b\xc2\x03\x59\xa9\x10\x7c\x44\x2d\x53\x0d\x74\xe9\x0e\x14\xdd\x1a\x1e\x06\xe6\x6e\x85\xe3\x6e\xca\xbb\x3b\xd1\x57\xae\x6f\xb2\x1e\x94\x79\x01\xbc\xa2\x99\xc0\x81\xc9\x11\x23\x9b\xa3\x79\xbf\xf4\x61\x93\x15\x70\x5e\xae\xa6\x84\xda\x01\xd8\xb1\x5e\xfe\xa0\xf0\xec\xce\x5e\xe5\x8a\x33\xd4\xbb\x57\x0e\xf8\x71\x9a\x7a\x64\xa8\x6d\x89\x0b\x8e\xda\x09\x3c\x54\x47\x23\x0a\xf5\x7b\x38\x89\xd0\x59\xe5\x0e\x0d\xd5\x76\xe7\x15\x48\xdb\xb2\xbb\x2c\xbd\x4e\x28\x74\x45\x2b\x88\xa2\x58\xa7\xb1\x18\x57\xf2\x24\xc5\x10\x60\xa9\x84\x6a\x58\x3e\xc8\xd1\x2b\xfa\xdc\xff\x79\xe2\xd6\x8d\xcc\x9c\x1e\x00\x20\x12\xb7\xa2\x38\x63\x76\x15\x50\x21\x6d\x50\xb1\x12\xe7\x8c\xf5\x71\xba\xa4\x57\x8b\x3c\x6c\xa3\x5b\x17\xee\xd5\x3a\x00\xfa\xfd\x01\x08\x08\x7c\xa7\x82\xa2\x0a\xd3\xcd\x4d\x63\xc6\x05\xd6\x3b\x6c\x56\x0d\x00\xa2\x79\x9a\x94\x02\x3a\x2f\xec\xf1\x44\xd5\x91\x3c\xdb\xa7\x8c\x89\x89\x6c\xe5\x3b\xc3\x66\x6e\xb2\x46\x0d\xf7\x7b\x6c\x42\xee\xf5\x4c\xf8\x10\x43\x5e\x88\x9c\x10\xee\xd6\x5d\xf4\xaf\x1e\x74\x39\xb5\x44\xe2\x0b\x9e\x55\xc2\x6c\xe9\xd9\xfa\xa6\xc7\x53\x46\x86\xca\x63\xcc\x49\x00\xbe\x4b\x7e\x09\x08\xf2\x1d\x92\x47\x6c\x04\x95\x6e\xaf\x20\x9c\x63\x1f\xde\xee\x8f\x85\xe0\xb4\x51\x07\xe4\x23\x30\xe4\x37\x98\x60\x6d\x82\xe8\x00\xde\xe8\xd0\x65\xed\xae\x27\x92\x12\x9b\x45\xb0\x52\x90\x1f\x3a\x7c\x32\x26\x84\x86\xf1\xf2\xd4\xf6\xf9\x5e\xb6\x63\x3a\xe4\x23\xa0\x1f\xf6\x48\x8d\x63\xbb\x4b\x74\xe3\xf6\x92\x53\xd7\xea\x82\xaf\x66\xdd\xb9\x5e\x31\x36\x37\xb1\x1b\x48\x47\xd6\x76\x35\xb3\xd6\x92\x3e\x38\x10\x7f\x67\x85\x24\x08\x85\x49\xa1\xd2\x4c\x39\xc0\x7d\xac\xd0\x44\x6b\xa2\x28\xdd\xaf\x91\x93\xa2\xe3\x84\x6f\x52\x2c\x03\x3e\x61\xc6\x50\x42\x61\x08\x4f\x09\xec\x02\x1f\xb1\x93\xbd\x22\xb5\x0e\xed\x19\x37\x56\x3a\x98\xc1\x8e\x78\x0d\xf3\xe0\x3e\xff\x25\x2b\x5c\x9b\x0c\x7c\x98\x0d\x38\x38\xc3\x40\x55\x9b\xf7\xb2\x7a\xbc\x37\x4e\x84\x8b\x62\x29\x45\xb4\x1d\xe8\x75\xe6\x92\x79\x04\xf6\x31\x0d\x63\xf3\x9d\x5e\x9a\xea\xaa\x2b\x76\xed\x08\x4a\xe5\x77\x34\x6b\x0b\x69\x67\x9c\xa1\x64\x0b\xf1\xe5\x22\x28\x14\x8a\x38\xcf\x67\xd0\xf0\xf5\xca\x1c\x46\xe4\x2a\x83\xd2\xcd\x4c\x6b\xc0\xdb\x04\xd2\xd2\x40\x3c\x29\xe6\x25\xcd\x89\xfa\x0c\x91\x92\x71\x63\x51\x13\x52\xa7\xf4\x41\x10\xce\x5e\x6b\x80\x34\x85\x04\x40\xb0\x08\x3c\x7b\xc1\xf6\x94\x8f\xae\xfc\x0d\x83\xd6\x68\x28\x13\x07\x63\x33\x0a\x4e\x1f\x42\x79\xa7\x41\xb9\x2b\x43\x04\xf2\x06\x01\x09\xcd\x09\xa5\xd9\xc1\x68\x04\x06\x84\xee\x38\xc8\xd4\xfa\x6c\x16\xb4\x24\xa0\x20\x6c\x01\xee\x64\x4a\xe5\x0d\x21\xf1\xe9\x68\x41\xda\x03\x9a\x6f\x2a\xef\xb3\x14\x7b\xab\xb7\x43\x80\x65\x4c\xfe\x68\xca\xb6\x72\x3d\xd4\x33\x88\xad\x90\x97\x70\x2b\x61\x2c\x36\x28\x43\x61\x80\x23\x71\x2b\x61\x1f\x5f\x22\x31\x36\xb0\x53\xe5\x85\xe7\x6b\xa1\xc0\x74\xd8\xed\xde\xf0\x8e\xc5\xcf\x5b\x78\xc3\x4d\x35\xec\xb1\x01\x1b\xae\x4a\xc6\x2a\x88\xa8\xe3\x85\x6c\x2f\xc9\x75\xbb\x9c\xb2\xb6\x72\xae\xb6\x7f\xbc\x71\x17\xb7\xc7\x43\x40\x3a\x79\x67\x21\x08\x61\xf3\x66\xb3\x09\x51\x71\xe9\x50\x1a\x22\xd5\x13\x43\xda\x30\x39\xf8\xf0\x8e\x2d\xe1\x44\xba\xe2\x69\x13\x88\x66\x4e\x31\x6d\xcb\x83\x46\x94\x5a\x2d\x59\xd3\x39\x8e\xc5\xe4\x3c\x8c\xab\x08\x26\x65\x3f\x9a\x54\xa6\x18\x87\x5c\x1c\x2b\xc1\x8c\xb0\x2e\x53\xa2\xde\xbd\xbb\xcb\xb6\x5b\x31\xe7\xfa\x89\x13\xad\x03\x44\xf8\xa0\x10\x73\xfb\xa1\x91\x88\x75\x78\xbc\xf8\x93\x0d\x75\xa0\x64\xd7\xd9\x69\x2f\x05\x38\x40\x2c\x6e\x06\x3c\xc6\xfc\x7c\xd2\x6c\x4c\xc1\x0e\xb6\x57\xed\xe5\x6f\x3d\x81\x0a\x9e\x04\x0e\x79\x93\x97\x6c\x1c\x23\xf0\x98\xb4\x1d\xa5\x36\xcf\xe5\x4b\xeb\xcd\x8b\x08\x93\x14\x46\x38\x74\xce\xac\x6b\x4e\x57\x98\x39\x04\xfc\x1e\x87\x17\xf0\x94\x1f\xfa\x53\x23\x35\xf1\x22\xf8\xd7\x72\x44\xa4\xce\xe1\x8b\x44\x4d\x1e\x31\xdd\x53\x97\x78\x12\xc8\xd5\xe2\x31\xe0\xf9\xa0\xa6\xd6\x83\x24\x85\xda\x00\x3c\x71\x45\x16\xf1\xf5\x89\xe4\xb0\x05\x33\xe3\x7e\x1b\x16\x81\x66\x1d\xd3\x53\x93\x5b\x5a\x3f\x32\xfe\x72\xcc\x8b\x35\x13\x4b\x68\xcf\xfb\x31\xd8\xbd\x25\x21\x65\xf1\x70\xbd\xf5\x47\x1f\x45\x81\xfe\x1e\xce\xa6\x82\x91\xdd\x36\x36\x77\x1e\xbd\xa3\x5d\xe6\xec\xf5\x44\x2c\xcf\x8e\x62\x09\xa0\x47\xcf\xd2\x88\x35\xc1\x63\x9b\x72\x8c\xbe\xd1\x5e\xde\x5e\xce\xfe\x7c\x34\xd0\x2a\x1d\x20\xb0\x5a\xf4\xfa\x0a\x6c\x75\xc6\x07\x4d\x40\xc2\xc4\xca\x62\xd4\xb8\x57\x2e\x36\x4e\xb3\xe3\x03\x3b\x57\xb8\x58\xe7\x43\x36\x61\x61\x53\xba\x9e\xa0\xb5\x23\x7e\xa9\xfa\x8a\xba\xa4\xe0\x05\x26\xab\xd1\x8f\x63\x3f\xe9\xec\xf4\x6c\x15\x9e\x1f\x6b\x25\x07\xc7\xe0\xa5\xd6\xd2\x41\xf6\xe2\x3b\xa4\xe3\xcb\x03\xcd\xc3\xc2\x56\xb2\x98\x94\x3b\x7d\x72\xfc\x1a\x12\x65\x5d\x3f\xe6\xd1\x91\xba\xc6\x51\xd8\x8f\x8a\x44\x84\xa4\x80\xa1\x3d\xb2\x61\xec\x06\xca\x2c\xc0\xc7\x11\x5a\x2b\x13\x89\x96\xa0\xce\x3d\x88\x11\x3b\xa0\xa5\x62\xe8\x1d\x0f\x5d\x38\xb9\xaa\xf2\x1e\x89\xec\x07\x6a\xea\xc8\x46\x0f\x30\xa6\x81\xf5\x75\x7b\x24\x81\xa2\xe9\x70\x89\xdd\xbc\x39\xea\x7d\x9e\xee\x37\x37\x63\x5d\xf5\xa4\x87\x8d\x07\x6c\x91\xb1\x3c\x08\x3d\x9a\x6b\x1f\xdf\xe2\xfa\xea\xc8\x3d\x01\x05\x96\x6f\x8e\xe2\xc1\x5f\x67\x9c\x5b\x95\x4e\x24\x68\xf4\x86\x23\x5d\x43\x1c\xc6\x0b\xdf\x9a\x9a\x4c\x4d\x8d\xf6\xb0\xb7\x89\x71\x39\x42\x82\x14\x4f\x95\x6b\x01\xbe\x10\xb8\xc9\xf3\xe8\x85\xf4\x1e\xd5\x14\xb6\x6a\x7c\x8f\x50\xaa\xa6\xf8\x5d\x85\x1e\x07\x85\x3c\x75\x69\x9a\x9e\x29\xe2\x06\x5e\xa2\x7e\x8d\x55\xd5\xda\x0b\xad\xc4\xec\x72\xa9\x1a\x9b\xf5\x24\x1c\x31\x0e\xb6\x83\x3b\xc0\xd1\x3a\xd2\x05\x2a\xea\xc2\xe4\x3d\x4a\xf3\xc7\x83\x2d\x47\xfe\x32\xd4\xaa\x3a\x28\xe9\xb8\x45\x78\x33\x20\xf2\x5a\x9f\xd4\x0a\xbf\x37\x35\x4e\xae\x43\x20\xb8\x75\x97\x24\xf1\xde\x3b\x15\x38\xce\xb0\x28\x0c\x98\x48\x7a\x40\x0d\xe4\x02\x4e\xac\x7a\x3e\x4c\x18\x80\x73\x58\xe8\xc3\x83\xad\x2b\x7b\x7a\x22\xbb\x15\x34\x04\xb3\x43\xa4\xfd\x57\xfe\x21\xfe\xe3\x7f\xde\x6e\x59\xfb\x9f\xec\x4d\x36\xfa\xf3\x9b\x6c\x05\x34\x4c\x10\xc4\x58\x69\x00\x48\x37\x22\x54\xaa\x24\x3a\x9d\x13\xf8\xd4\xd8\x76\x6b\x39\xe5\x89\x85\x7a\x44\x3c\x9d\xe0\x7c\x39\xf8\xe9\x25\xc7\x0e\xc6\x72\x0b\xfb\x40\xbd\x05\xc7\x4a\xab\x44\xee\x01\x25\xfd\x51\x17\xed\x6c\xc5\xf6\x24\x88\xb4\x4f\xdb\x09\x16\x1a\x3c\x8f\x10\x0f\x1b\x52\xe5\x21\xa7\x65\x8b\x4b\x1b\xb6\x8d\xe1\xb6\xb5\xd0\xdc\xea\x26\x4b\xc5\x6a\x57\x40\xa0\xc9\x12\x40\xcc\x0d\xb7\x2c\xb8\x0a\x34\x69\xc2\x97\x00\x99\x34\x2e\xe8\x81\x4e\x40\x23\x8b\x59\xec\xad\xae\xa1\xb8\x86\x39\x5d\xa2\x8e\x40\xa6\x84\x13\x1e\x0c\xa8\x5b\xdd\x79\x62\xb5\x4b\x39\x27\xfa\xa6\xb4\xfc\xbd\x00\x1b\xa0\x07\x7e\xe2\x07\x97\x98\xdc\x07\x4b\xd8\x45\x69\xee\xc9\x38\xa3\x80\x8a\xc2\x09\x86\xa8\xa9\xba\xd6\xf6\x2e\x0b\x14\xc5\x71\x43\x8e\x57\x06\x5a\xfb\x61\xa2\xb0\xe1\xba\xad\x63\xd0\xfb\xce\x61\xcd\x7a\x86\x8f\x01\x8d\x31\x10\x96\xe5\x74\xfd\x2a\x9d\xa5\x6a\x61\x81\x8c\x62\xb1\x3c\xbc\xb2\x15\xb8\x49\xa4\xa2\x5c\x4e\x7b\x15\x1e\x28\x04\x78\x30\xdb\xf3\x1e\x25\xb4\x03\x74\x03\xaa\xf8\xa2\x8d\xf7\x3b\xbf\x27\x55\x49\xa4\xfb\x0e\xe5\x86\x4d\xd5\xf8\x3d\x7d\x95\xa9\x3e\x08\xf6\x34\x44\x90\x57\x8c\x9b\x1f\x94\x58\xe6\xcd\x20\x8a\xda\xb1\xc2\x69\x50\x6f\x8c\x55\x82\xd9\x4b\xe1\x5e\xee\xf2\xa1\x22\x0b\xd1\x4e\x56\x89\x98\x13\xa6\x36\x12\xfd\x42\x4f\x0f\x2d\x16\xd4\x4c\x3a\x71\xc2\x63\x3d\xd9\xfd\x55\xa7\xd9\x0a\x6a\xb0\xdb\xb6\xab\x84\xd1\x19\x39\x1b\x95\x5a\xac\xab\xfe\xae\xe9\xd8\x9e\xc6\x1d\x6f\xf8\x15\x71\x68\xfe\xe8\x1d\xae\xc1\xa2\x25\x27\xa1\xe5\xfd\x42\x12\xb9\x33\x41\x47\xc0\x9c\x9f\x29\xdb\x7d\xe8\xc9\x25\x0b\xa1\x8e\x8c\x07\x2d\x5c\x0a\xbd\xa7\x8d\x07\x83\x14\x09\x53\xc1\x98\xb1\xf3\x29\xa5\xf7\x7a\xfa\xca\xab\x52\x7d\x39\xf7\x20\x7e\x79\x8c\x57\x78\x81\x64\x48\x67\xfb\x6c\xcb\x92\x86\xf3\xae\x8f\x43\x28\x34\xd2\x9e\xcb\x76\xe5\xa1\x17\xec\x05\x9e\xdb\x94\xc3\x0e\x6c\x34\x37\x8f\x85\xbf\x5c\xb8\x99\x49\x06\x65\xa5\xce\x63\x7c\x1b\x0b\x00\x4f\x9a\x92\xe7\x3d\xfe\x3e\x07\x70\x2f\xf2\x0d\xb7\xa1\x8c\xa2\xde\x0d\xfb\xea\x09\x89\x3d\xaf\x61\x73\xe6\x24\x58\xc0\x38\x5f\x53\xb8\x31\x49\x0f\xe8\xad\x55\x38\x0c\x13\xe2\x46\x48\xea\x11\x8b\x48\xa3\x81\x46\x26\x83\xfa\x41\xb8\x9e\x51\x66\x0c\xa9\x03\xed\xb7\xc2\x48\x4c\x6a\x63\x8c\xb4\x39\x70\x25\x31\x58\x63\x73\xca\xc4\x9b\x92\x8c\x10\x00\xe3\x94\x74\xa9\x94\x3d\x67\x18\x56\x0e\xd5\x32\x10\xdd\x4b\x69\x32\xa3\x2e\xd0\x91\xe3\x61\x5e\x50\x70\xee\x88\xba\x18\x37\xc9\x5e\x7a\x2f\xcf\xca\x7c\x2c\x14\xc6\x9c\x42\x35\x40\x40\x30\x5d\xd0\xca\x3d\xef\x39\xd3\xd9\x3b\x51\x17\xca\x37\x86\x10\xba\xf1\x71\xad\x9c\x7d\x89\x8f\x16\x04\x5e\x88\x00\x58\x63\x86\x51\x4a\x51\xe5\xce\x5b\x47\x06\xd7\x7f\xd2\x7f\xe8\x3f\x0f\xa2\x7a\x78\x1f\x66\xd3\xdf\xfe\x08\xe0\xe5\xdb\x10\x5e\x30\xe8\xbf\x7c\x79\xb1\x6d\xfa\x6c\xc8\x9a\xfa\xc7\x97\x60\x87\x17\x75\x3f\x95\x51\xbc\x03\x40\xa9\xff\xf2\xd3\xd0\xb4\x3f\xbe\x20\xc8\x7f\xf9\xe9\xd7\x3e\x9e\xbf\xdb\xd0\x38\x44\x5f\xbb\x94\x46\x59\x92\xee\x43\x61\x84\x6a\x97\x9f\x5a\x2f\x0c\xb3\x3a\x79\xff\x0a\x04\xc5\xa1\xfd\x52\xbc\xe3\xfc\x11\x89\xd2\xeb\x92\xe8\xed\xc2\xfc\x71\x58\xdd\x74\x95\x57\xfe\x34\x44\xcb\xf0\xde\x2b\xb3\xa4\xfe\xf1\x23\x42\xbf\xf8\x4d\xb8\xbe\xfc\xed\x75\x95\x84\x1f\xff\x15\xc5\x50\x12\x8d\xdf\x86\xc6\x5e\x95\x95\xeb\x8f\xef\x84\xa8\x9c\xa2\x21\x0b\xbc\x17\x39\x1a\xa3\x77\x3f\xbc\x7c\xbe\xf0\xc3\x0b\xd3\x65\x5e\xf9\xc3\x4b\xef\xd5\xfd\xfb\x3e\xea\xb2\xf8\x0b\x34\x60\x6c\xc7\xea\xb9\x6c\xc1\xfb\x8f\xb8\xc3\x1f\x30\xf4\xa7\x6a\xc7\x2d\xab\x7f\x84\x7e\x9a\x9b\x2e\x7c\xef\x77\x91\x57\xfc\xf8\xfa\xbd\xa3\x55\xfe\xb4\xe3\xeb\x17\xd9\xf0\xfe\x15\xcf\x27\x94\xf7\x5e\x98\x8f\xfd\x3e\x16\xda\x19\xfb\xbe\xea\xbf\xd5\xf2\x69\x9c\xd7\xbe\x4f\xf7\xc9\xca\xe7\x84\x6f\x4b\x3f\xfc\x38\x3c\x57\x06\x69\xbd\x6e\xa7\xf7\x17\xef\x33\xa5\x30\x4c\x46\x3e\xfa\xc6\x90\x30\x0a\x9a\xce\x7b\x65\x78\xdd\xd4\xd1\x2f\xde\x8f\x69\x33\x45\xdd\xe7\xbe\x10\xe4\x43\x11\xfd\x87\xbe\xbb\x80\xa3\xee\x49\xe0\x3e\xc0\x0b\xf6\x8c\x21\xfa\xc1\xfb\x71\xca\x76\xd1\x45\xe1\xef\xc6\xfe\xf2\x5c\xc5\xe1\xf9\xb2\xc7\x97\x42\x21\x21\xe8\x97\xc1\xf3\xcb\x68\x0f\x47\x3b\x33\xa2\xee\x89\x70\xe9\xb5\xfd\x53\x13\xdf\x0e\x7e\xfa\xd8\xd0\x3f\x17\x8d\xa8\x93\x9d\x6d\x95\xb7\xbc\x2d\x0e\xf1\x46\xf8\xaf\x87\x6f\xa0\x7e\x2c\xbd\x7e\x27\x3c\xcd\xca\xf0\x33\x54\xbf\x19\x86\xa6\x7a\xff\x54\xbd\xf7\xcf\xd5\x2e\xc6\xfe\x47\x74\x17\xcd\x6f\x5b\xbb\x57\x8e\xfd\xda\xfc\xcb\xd0\xfd\x18\x67\xdd\x67\x60\xc3\xaf\xf0\x9e\x4a\x07\x3d\xdb\xeb\x21\x7d\xdf\xc4\xef\x87\xb5\x8d\xbe\x8b\xa6\xa8\xfe\xfe\x37\x71\xf5\x23\x03\x62\x32\xa6\x62\xef\x97\xdf\x8d\x87\xdb\xe5\x65\xd7\xec\x2c\x7c\xf9\xd7\x90\x0e\xfd\x08\xf9\xa4\xd2\x3f\x7e\x20\xf0\xa8\xfa\x69\x67\xff\x53\xc3\xca\x8f\xca\xba\x0f\xf9\xe5\x5f\xdb\x3d\x30\xef\xba\xe4\x85\x4f\xd1\xbc\x5e\x7f\xbf\xb3\xba\xea\x3f\xa9\xf2\xaf\x73\xef\x6c\xf7\x51\x0f\x47\x7f\x4b\xe3\x8f\xd8\xaf\x93\x12\x74\x80\x05\xe1\x4f\x9f\x70\x8c\xe3\x9f\xc2\xac\x6f\x4b\x6f\xfd\x31\x2e\xa3\x2f\x8d\x09\xfe\x00\x23\x3b\x3e\x5f\xca\x0d\x87\xa0\x9f\x9e\xaa\x97\xc5\xeb\xfb\x8f\x9e\xe2\xc7\xa7\x80\xa2\xf7\x7e\x34\xcc\x51\x54\x7f\xa6\x05\x7a\x81\xa3\xea\xb7\x88\xff\xaa\x81\x6f\x28\xfc\xae\xf5\xa3\x22\x7d\xee\x44\x7b\xe1\x9e\xdf\xfc\xbe\xd3\x6f\xd5\x73\xc7\xfe\x37\x1d\xde\x97\x4d\xd2\xbc\xfc\xed\xa3\xd5\x21\x1f\x90\x27\x43\x3f\xda\xdd\x07\x32\xaa\x5e\x7e\xfd\x82\x3e\x2a\x10\xf9\x01\xc6\x7f\x87\xe9\xfb\x2a\xaa\xc7\xaf\xf2\xf9\x37\x9c\xfa\x04\xf7\x29\xb5\x17\xe8\x2b\x10\x76\x1f\xfa\xf2\xb7\x4f\x56\xff\xf2\x81\xf8\xea\x34\x7b\xa7\xdf\x68\xee\xe7\xfe\xcf\xbf\x6f\x8c\x79\xae\x57\xfc\xb7\xe7\x92\x2a\x9f\x58\xf9\xd3\x67\x3f\x43\x7d\x26\xf8\x55\xd9\x76\x11\xe2\xdf\x02\xf1\x89\x97\x6f\x80\x9e\xac\xfc\xf0\x6c\x7a\xa5\xf8\x7d\xed\x75\x5d\x33\xbf\xfc\xed\x13\xc5\xaf\xde\xe1\x5f\x3f\xca\xfc\x33\x96\xaf\x32\xfe\x14\x32\x3e\xe9\xe7\x1f\x0d\x21\x0a\x63\x28\xc6\x7e\xa7\x92\x7f\xb4\x83\x5f\x8d\xe4\x5b\x16\xfb\x6c\xfa\xbd\xb9\xfe\x6a\x3e\x4f\xb1\x3e\x31\xfa\xf0\x34\xcb\xf7\xd9\x8e\xd7\x0f\xbf\x1e\xfe\xf5\xff\x7d\xf9\xdb\x47\x97\xf1\x26\xf1\x0f\x7b\x2c\x7b\xba\xb0\x57\x17\xbc\xb7\xa5\x3b\xdd\xaf\xde\x26\xda\x89\x9d\x3b\xaf\xfd\x98\x34\xa7\x5e\x9f\x7e\x56\xb8\xdd\xa2\x31\x1a\xfe\x4d\x94\xa8\x9a\xba\x79\x1d\xf6\xcb\x7f\xaf\xa2\x30\xf3\x5e\x9a\xba\x5c\x5f\xfa\xa0\xdb\xcd\xe1\xc5\xab\xc3\x97\xef\x7e\x75\x5c\xbb\xfd\xb4\xcb\xee\x2b\xfe\x84\xd1\x59\xfd\xea\x57\xbf\xe8\xb1\x0f\x8d\x7e\x27\x88\x4f\x53\xb5\x5d\xf6\x94\xc6\x6f\xfd\xc3\x57\x45\xf6\xb1\xf5\x87\x5f\x69\xfa\xe1\x37\xe1\x0f\xda\x3d\xf2\x1f\x25\xf9\x2d\x81\x7d\xd9\xfb\x53\xb7\xdf\xb7\x7b\x5f\x0d\x09\xd0\x9f\xc5\x92\xff\x91\x76\x51\xfc\x3f\x7f\xf4\xe2\xe1\xcd\xc6\xdf\x1c\xcc\xbb\x97\xef\xde\xbd\x78\xc3\xd0\x7d\xf7\x6c\xfe\xfe\xe5\xdd\xf7\xef\x76\x17\xfc\xf2\xb7\x57\xa2\xdf\x02\x68\x56\xf7\x3b\x93\x7e\xf4\xa6\x26\x0b\xff\x51\xf7\xfc\x65\x70\xfc\xb6\x8b\x7e\x52\xf2\xcb\x7f\x7f\x86\xdf\xe7\x82\xdf\x6d\xd3\x0d\x9f\xd4\x28\x8c\xa6\x2c\xf8\xb8\x5c\xd1\x2f\x1f\xc2\xf7\x4f\x9f\xf0\x2b\xf3\x9f\x67\xbf\x7c\x78\x7e\xbf\x7f\xea\xd2\x6e\x65\xcf\xc3\xb8\x6c\xe6\x1f\xdf\x74\xeb\xf5\xbc\x4f\x77\x01\x16\xef\xe1\x8f\xcd\x6f\xa7\x3f\xc2\xbb\xf4\x77\xf7\x3f\x0e\xcd\x27\x43\x7b\xb5\x87\x1f\x9f\x57\x76\xe5\x7e\x06\xbb\x3d\x0b\xeb\xdb\x66\xa7\x7a\xfa\x42\x35\xfc\xb2\x09\x8a\x2f\xe2\xe2\x4f\x4f\xfb\x7e\x4e\xf9\x7e\x79\x1d\xfb\x39\x57\xf8\x7c\x7d\xd7\xd2\x3d\xdc\x3e\x8d\x67\x68\xc6\x20\xfd\xe5\x7d\xf4\x2f\x2f\xfb\xe7\x2f\xe0\xeb\x1a\xe6\x7f\x7d\x3b\xa9\xa2\xc1\x7b\xf9\xb4\x64\xfa\xbb\x71\x88\xdf\x53\xef\x5e\xc0\x2f\x1b\x6b\xaf\x8a\x7e\x7e\x17\x46\x3b\xb8\xac\x7d\x0a\xf5\xdd\xcb\x47\xe1\xfd\xfc\x8e\xd9\x3d\x5c\x17\x05\x43\xd3\xad\x2f\x4d\xfc\x5c\x08\x2a\xea\x5f\xd2\xa6\x7f\xaa\x45\x53\xbf\x0c\x69\xb4\x77\xd8\x73\x84\xcc\xdf\x73\xbd\xf0\x87\x97\x5d\x33\xf6\x71\xdd\xee\x7c\xb7\xbd\xc7\x8e\xf0\xcb\xd8\x3f\x5f\x16\x15\xd5\x93\xf1\xee\xcb\x49\xdb\xae\x69\xf7\x50\xb9\xfe\xfc\xae\xd9\xd1\xcf\x86\x32\xfa\x62\xd6\xd3\xeb\x34\x3b\xfc\x3f\x1f\xf6\x75\x94\x3f\xbf\xa0\x1a\x77\x4d\xf5\x8a\x71\xd0\x64\xf5\x9f\x4c\xbe\xab\xd9\x17\xc3\x77\x9c\x9f\x5a\xff\xed\xfe\xaf\x75\xf6\x17\x03\x9e\xcb\xcc\xf7\x3f\x82\x60\xe2\x0d\xd1\xec\xad\xaf\x26\xfa\x21\x6b\xc0\xe7\x2f\xa8\x55\x06\x17\xdd\x98\x88\xae\x12\x8f\x90\x43\xb3\xd9\x98\xfb\xb8\xf2\x57\x54\x83\xdd\xbc\xbf\x0e\x93\x8b\x50\xee\x69\x39\x0b\x54\xa3\xe6\x8f\x77\x7f\x14\xcb\x30\x67\xc3\x6e\x4f\x7f\x60\xd1\x3f\x40\xe5\x6f\x01\xfc\x5f\x96\xef\x6f\x27\x0f\xbc\x2e\xfc\x62\xd6\x7e\xac\x76\xcb\x58\xff\xd7\x6b\xbe\xff\xbf\xde\x18\xfa\xed\xc1\xff\xff\x30\x7c\x8f\x00\xde\xce\x96\x2f\xe6\xfd\xef\xcf\x09\xfe\x64\xc4\xab\xda\xfc\xfd\xee\xff\x77\xc9\xf9\xe4\xf7\xbe\xd4\xf0\xd7\x25\xda\xbe\x74\x80\x3f\xbc\x64\xf5\x5e\xb7\xed\x59\x6b\xbf\xa7\xae\xd1\xcf\xf0\x07\xe8\x13\xa8\xdd\xbd\x14\x2f\x5d\x54\xee\x42\x4b\x77\x30\xc1\x38\xbc\x3c\xe3\xf1\xbb\x97\xa7\x37\xff\xf9\xcb\xed\x19\x96\xd7\x40\xfd\xe9\xe6\xd3\xdb\x6d\xa6\x23\x73\x7c\xbd\xd9\x74\x64\x44\xe6\xd0\x3c\x0f\x4f\xcf\x1b\x50\x6c\xf2\x7a\x03\xea\xf9\x25\x32\x9f\xda\x3f\x7d\x34\xe6\x3f\xf2\x29\x1b\x8a\x06\x71\xe2\xae\x81\x14\xf3\x1f\xfb\xb0\x56\xce\x8d\xf0\x2b\x3c\xa2\x4a\x4d\x15\xd8\x3a\x5b\x05\xeb\xb6\xe4\x40\x1c\xf0\xf4\xcb\xbf\x03\xbe\x20\x67\xa2\x41\x38\xf9\x86\x82\xa3\x7f\xba\x81\x24\x01\x3b\x20\x30\xb5\x30\x07\x8e\x9d\x7e\x07\xa9\x89\xbb\xc7\x20\x3f\x55\xc2\x1b\x7c\xf8\x20\x8b\xd5\x9f\xc0\x3f\x7b\xaa\x1a\x03\x45\x7d\xcc\xc1\xa9\xb3\xfd\x8f\xf0\x80\xc1\x9e\xc0\x8e\x08\x35\x90\x7c\x40\x4e\x0c\x5e\x67\xc4\x05\x57\x4a\x2e\xdf\xe0\x03\x43\x5b\x90\x6f\xf0\xe7\xaf\xc2\x43\x3a\xdb\xfd\x03\xbc\xd7\x73\x02\xa8\x2d\x15\x20\x86\x53\xfd\x05\xbc\x2f\xe1\xf3\x84\x3b\x01\x51\x65\xa9\xe0\x17\xfc\x7b\xa5\xf7\xab\xf0\xbe\x4e\xff\xef\xf0\x7d\x3b\xff\x3d\xbe\xaf\xf2\xf8\x3a\xbc\xbf\x43\xff\x17\xe7\xbf\xd7\x8f\x37\xfa\xbf\x0a\x6f\xf2\x75\x0f\xc4\x5f\xdb\x5f\xe9\xfb\x3a\xfd\xaf\xfc\xfd\xc7\xe9\xc7\x1f\xa6\xa6\x02\xbb\x65\xf9\x60\xd3\xe2\x3c\x48\x5c\x42\x7d\xfa\x3f\xa3\xbf\x7f\x94\xc6\x1b\x3d\xe0\x75\x71\x7c\x10\x92\xca\x16\xa4\xb9\xb3\x17\x83\x97\xe7\xf8\xe6\x3c\x1c\x41\x12\x40\x5d\xf5\xef\xd2\x4f\x0f\xa7\x0e\xac\xce\x47\x00\x24\xf9\xde\x9a\x40\x7d\xad\x3a\x10\x0a\xda\x07\x48\x0b\x17\xef\x0f\xe7\xc0\xd2\x9d\xae\x6f\xf8\xfd\x09\xfd\xaf\xf4\x7d\x75\xfc\xdf\x85\xff\xca\x9f\xaf\xea\xeb\xe5\x12\xe9\x13\x80\xf6\xa5\xff\xef\x81\x07\xf7\x27\xf7\x0d\x9f\xf9\xdb\xa6\xf5\x6c\xb7\x5e\xe1\x83\xf2\x52\xc5\x5f\x87\x47\xa1\xfc\x1d\xfc\x55\x7e\xc6\xbf\xc3\xab\x3c\xfb\xc3\xc4\x2b\xfc\x57\x79\xad\x94\x5d\x81\xaf\xe7\x4f\x78\x9e\xfa\x1f\x74\x5a\xcc\x47\x7d\x8a\xf4\x21\xf8\x87\xfa\x83\xf5\xae\x07\x6a\x12\x33\x0c\x37\x73\x3b\x5f\x66\x46\x7a\x3a\xe5\xe7\x6a\x1a\xaf\xae\x38\x79\xee\x00\xfc\x27\xe7\xaf\xfd\xd5\xfd\x8f\xe1\x30\x61\xfe\x08\x8f\xf9\xf9\xe7\x5f\x73\xcc\xd7\xcc\xe5\xaf\x5f\xcf\x57\xfe\x02\xbe\xb5\xfe\xcb\x5f\xc0\x67\xd5\xb2\xff\x3e\xcb\x9a\xfd\xe7\x59\x7c\x67\xe1\xcf\xef\xbe\x28\x8d\x3e\x45\xa4\xaf\x34\xbd\xde\x38\xd8\x23\xdc\x5e\x89\xf7\x7b\x80\x7d\xd6\x48\xaf\x57\xfe\xfa\x5f\x6b\xbf\x6f\x7f\xfa\xcb\xf3\xdf\x5c\xff\x64\xf4\xb3\x58\xfb\x08\xfd\x73\x9f\x8f\xb0\x7e\x5b\xc7\xbd\xfb\xeb\x5f\xbc\x8f\x81\xef\x53\xd4\xfe\x18\xad\xdf\xbd\x0c\xcf\x5c\x66\x8f\xae\xff\xcb\x2f\xbd\xba\x78\xf7\x16\x33\xeb\x66\x4f\x1c\xeb\xbd\x1c\xaa\x9b\x7d\x54\xd4\x75\x4f\x32\x18\xbf\xd9\xa3\xe8\x33\x63\xfa\x0b\xe8\xfd\xf5\x0b\xe4\xfe\x4f\x27\xff\xd7\xbd\x80\x1a\xbc\xb2\xfc\xf7\x20\x21\xbe\x0d\xf9\xf7\xa1\xf1\x56\xed\xfe\x13\xb9\xf0\x4f\x9b\xf9\x3f\xc0\x82\x6f\xe0\xf0\xeb\xd9\xeb\x95\xdf\xcf\x9b\x64\x43\x3a\xfa\x1f\x82\xa6\x7a\x4b\xd4\xf6\x34\x7a\xaf\x63\xc3\xbd\x0c\x4c\x87\xaa\x04\xb3\xbe\x1f\xa3\x1e\xfc\xf7\xa0\xf3\x9b\xf9\x5e\xe7\x7c\xde\x28\x7a\xdb\xcb\xea\xdd\xb7\xf7\xb2\x7a\xf7\x79\x2f\xab\x77\xcf\x7b\x4e\x30\xf5\x5c\xcc\x1e\xde\x39\x15\x64\x5d\x50\x46\x2f\xc1\xde\x40\x7e\xc0\x77\xeb\xd8\xcb\x17\xec\xc3\x5e\xfc\x75\x3f\xbf\x83\xdf\x81\xbf\xe9\x00\xc3\x1f\xe0\xaf\xf4\xf8\xb8\x2e\xf0\x3b\xe9\x75\x53\x1e\xea\xb9\x07\x04\xf4\xba\x38\x3e\xfe\xba\x96\x37\xf2\xba\xf0\x3f\xfe\xba\x51\xc7\xeb\xae\x17\xd0\x7b\xf4\x05\xfe\xb8\xc7\x03\x2e\x3c\x07\x6d\xbf\x01\x43\xed\x2d\xf4\x07\x52\xc0\x5f\xe1\xbc\x40\x1f\xa8\xfd\x97\x7c\x5d\x6f\x1c\x7a\x5d\x60\x9f\x7c\x5d\xe6\xfb\xb9\xc6\xfa\x73\x97\x1e\xea\x75\xa9\x7d\xfc\x03\x62\xd3\xbf\x87\x04\x3f\x57\x85\x7f\x82\xa2\x3f\xd0\x13\xf9\x81\x0e\x9e\x0b\xfa\x43\xcf\x0d\x1d\x5e\x57\x77\xc7\x5f\x7f\xf7\x91\x2c\x8c\x3d\x57\x0d\xff\xb8\x83\x01\xfd\xdc\xb9\x04\x7f\xf9\x34\x78\xfb\x3d\x17\xf0\x0f\xe4\x1b\x17\x76\xd4\xe9\xaf\x33\x0a\xff\xc4\xa8\xfd\x08\xfb\x56\x17\xf4\x63\x17\xe8\x1b\x5d\x90\xbf\x3b\x0f\xfa\x19\xc6\xb7\xa6\x41\x3f\x23\xf2\x9b\x59\x9e\x2a\xf1\x3b\xd5\xdd\xf5\xfb\x57\xcd\xfe\xc2\x15\xbe\x1d\x7e\xfa\xf9\xe4\x18\x3f\x96\x09\xbf\xf7\xb6\x9f\xfc\xf7\xfb\xdd\x66\x86\xb1\xff\xad\xb3\xfd\xec\xdc\xbf\x78\xfe\xf4\x85\x42\x3b\xd1\xbf\x75\xd1\xae\xf9\x7b\x19\xb9\x17\x1d\x7b\xb7\x21\xcd\xfa\xd7\x18\xf0\x16\x0d\x4e\x1f\xa3\xc1\x87\xbf\xf8\xdd\xaf\xa3\xc4\xe1\x65\xce\x76\x0f\xe5\x47\x2f\xde\xe4\x65\xe5\xeb\x03\x87\xd7\x22\xa4\x5c\x3f\xbc\xa8\x65\xb4\x17\x1a\x2f\x41\x1a\x05\xc5\xcb\xf3\xee\xd0\x4b\xb9\xd7\x4f\xdd\x87\x7f\xf9\x3d\x95\x7f\x20\x60\x2f\xe6\x93\x2e\xea\x7f\x25\xe1\x37\x48\xff\x8e\x2b\x6f\x35\xf3\x1b\xa8\xef\xe2\xb1\x0e\x9e\xf5\xf3\xcb\x77\xdf\xbf\xfc\xed\x33\x9e\x93\xd7\xbd\xbc\x31\xe5\xe5\xe7\x97\xb0\x09\xc6\xdd\x6b\x0d\x1f\x76\xa3\x3f\x96\xd1\xf3\xf0\xb0\x8a\xe1\x77\x7f\xe0\xdf\xf7\x3f\xfd\x06\xc0\x27\xa4\xfe\x21\x10\x9f\x29\xf8\x3d\x90\xa6\x2c\x9f\x10\xa0\x9f\xfe\xe5\xf3\xf5\xcf\x38\x3f\x97\x98\xf7\x76\x48\x43\xd4\x7f\x57\x7f\x89\xff\xa7\xd1\xe3\x73\x5d\xfb\x7d\xf4\xff\x78\x77\x78\xf7\xc3\xcb\xbb\xcb\xeb\xb7\xf4\xfa\xcd\xbf\x7e\x9b\x87\x77\xff\xf3\xa7\x3f\x0c\xcb\xde\x26\xfc\xf2\xf2\x9c\x3e\x45\xfb\x5d\xfd\xf2\xd7\x9f\x9f\x2b\xd8\x63\x2f\xff\xf5\xbf\xee\xdd\xfe\xf2\x36\xc3\x87\x32\xaa\x93\xdd\x8a\xdf\xbf\xc0\xbf\xc7\xe2\xf9\xa9\x5f\xc0\xb7\x41\x3f\xfd\xa1\x29\x03\x80\xdf\x5e\xfc\xe5\x37\x67\xbb\x7e\x8d\x5d\xfd\x52\x7f\x18\x9a\x53\xb6\x44\xe1\x77\xd9\xcb\x7f\x7b\x81\x5f\x7e\x7c\x81\xbe\x7f\x01\x5e\xde\xed\x7f\xc0\x1b\x06\xff\x23\xfb\x82\x8c\x5f\xbe\xc2\xaa\x27\x1f\xbf\xfb\x3d\x72\xaf\xcc\xfd\x3d\x06\xaf\x22\xf9\xee\x1d\xe8\xb5\x19\xf8\x51\xbf\xbd\xb2\x07\xff\xdb\xd3\x4f\xfd\xfc\x9c\x30\xaa\x83\x26\x8c\x2c\x5d\x64\x9b\xaa\x6d\xea\x5d\x96\xdf\x95\x4d\xf0\x7a\xeb\xf4\xc3\xb3\xd3\xb3\x48\xff\xfe\x87\x97\xbf\x05\xde\xae\xc9\x3f\xbe\xec\x91\x61\x57\x90\x3d\x28\xbc\xfb\xe5\xfb\x3f\xd0\xff\x61\x48\xa3\xfa\x0b\x25\x7c\xde\x44\xfc\x1a\x0b\x5f\x79\x15\xbf\x7c\xf7\xff\x3c\x3b\x7c\x68\x8a\x6f\xf5\x79\x7e\x86\xf4\x79\xfb\xba\x8e\xe6\x97\xe3\x1e\x60\xbb\x57\x98\x1f\xde\x54\xd4\x8c\x96\xe1\xfb\x9f\xbe\x3a\xf2\x97\xaf\x5e\xfd\x28\x80\x57\x10\x79\xdf\xd4\xdf\x7d\x65\xf4\x3f\x44\xd6\x27\x3e\x7e\x0b\xf1\xa7\xda\x75\x7b\xea\xb6\xee\xaa\xf7\x6b\xef\x4f\x8a\xf5\xd7\xdf\xab\xe3\x97\xc3\xe2\xdd\x91\x44\xe1\x53\xcb\xff\xe7\x4f\x7f\x02\x7b\xe8\xd6\x3f\xaa\xf5\x97\x5d\x9e\x77\xbc\xfb\x3f\x01\xf3\x05\x5a\xbb\xe1\x1d\x77\xe9\x7e\x49\xe1\x9f\x49\xe4\x29\xb9\xee\x55\x04\xd1\xcb\xff\xf3\xf3\xcf\x2f\xef\x5e\x29\x7d\xf7\x67\x43\xde\x26\x7c\xe3\x47\xbc\x4f\x19\xfd\xf4\xcd\xae\xbf\xfc\x43\xf3\xfe\xfc\x9c\xf7\x8d\x55\x7f\x77\xe2\xb7\x6e\x1f\xda\xb1\x4f\xf7\xf1\xd1\x53\x8b\xbe\xff\xe9\xef\xa0\xfa\xc6\x5e\x69\xd7\xff\x0f\x95\xb7\xbc\x4a\x7c\xfd\xe1\x85\xdb\xa7\xde\x8d\xa2\xeb\xa3\x1d\x50\xbd\x6b\x1f\x33\xec\xa9\x5f\x3b\x7c\xff\xfd\xff\x09\x3d\xaf\x12\x7a\x43\xeb\x4b\xd7\xd7\x7d\xf0\x9f\xbf\x7a\x14\x44\xd9\x14\x85\x6f\xae\xa1\xfb\x78\xf6\x7c\x54\x01\xbc\x7c\xe2\x02\xf0\x7c\x56\xf1\x2d\x0b\xf8\xc6\x75\x10\x7c\x61\x7e\x15\xfe\x6e\x5e\xde\xb0\x07\xba\x3a\xeb\xd3\x1d\x7a\xf9\x7c\x92\xee\x25\xcd\xcb\x1e\xfd\xea\xe6\xf5\x34\x7a\x6a\xdb\xf3\xfe\x5c\x14\xfe\xf0\x2d\x80\x7d\xf3\xf2\xf6\x4c\xc5\xfb\xe8\x59\xbd\xfa\xe5\xc9\x97\x75\xa7\xb1\x1f\x5e\xaa\xc8\xab\xfb\xe7\x9d\xda\x2e\xfa\xb7\x27\xdc\xe1\x35\x12\x0f\xcd\xcb\xec\x65\xc3\xd3\xed\x7f\xf8\xa6\x87\x78\x53\x9a\xff\xfd\xbf\xbf\x34\xba\x4f\x66\xf4\xd4\x01\xe8\xe9\xb9\xdf\xa2\xca\x5f\x5f\xf0\xef\xff\x4c\x15\x3e\x7b\xb5\x3d\xc1\x6d\xbc\xf0\xbb\x3f\x91\xd9\x9b\x9f\xf8\xf7\x78\x96\x27\xae\x1f\xf5\xec\x57\x23\xff\x33\x6c\xde\x3c\xd8\x87\xe7\xc3\x2a\xf6\xe3\x73\xae\x5d\xa3\x9d\x3d\x5f\x68\xc6\x32\xac\xff\x6d\xf8\x24\xa3\xe8\x5b\xb9\xc8\x8f\xaf\xf1\xe2\xe3\xa4\xcf\xfd\x2b\xbe\x7b\xf7\xd3\xcb\xbb\x3f\xa1\xea\x53\x50\xfe\xe3\xa4\xff\xb6\x27\x31\x4f\x8d\xf7\x12\x2f\xab\x5f\x76\x85\x78\x42\x7e\xba\xdc\xa7\xc2\xbf\x29\xff\xf7\x7b\xd0\xba\x36\xcf\x7b\xaf\x66\x56\x45\xc6\x8e\x5a\x9d\x7c\xf7\xaa\x9b\x1f\xde\x7d\x7b\xca\x5d\x37\xf4\x57\x6e\x3f\x05\xde\xd4\x41\xf4\x7a\xbf\xfe\x99\x0c\x35\x71\xfc\x92\x7a\xfd\x4b\xbb\x27\x38\xbb\xe2\x75\xd1\x63\xaf\x46\x86\x57\x2d\x79\xa3\xf5\x15\x97\x0f\xdf\xe6\x5f\x34\x3c\x11\xd9\x2b\xb4\x6f\x24\x3d\xff\x41\x15\xf8\xe5\x87\xdf\x59\xff\x9e\x0e\xbc\x9a\x7f\xdd\xcc\xdf\xed\xf1\xf0\x35\x64\xc3\x7b\x85\xf3\x4f\x55\xa3\x6f\x88\xe8\xcd\x53\xbc\xc9\xf8\x87\x6f\xca\xf8\x0b\x96\x3c\x2d\xe2\x87\x17\xe4\xeb\xe8\x7d\x2d\xc2\xed\x7c\xf9\x4d\x00\xf8\x16\x27\xff\x30\x07\xfe\xad\x39\xbe\x9a\xc0\xfc\x61\xf8\x17\x1c\xfc\xe5\xfb\xa7\x3c\xf6\xf2\xe0\x63\x42\xfb\x17\xf0\xe3\x9d\x16\xf0\x59\xaf\xfe\xf5\x5f\xfe\x3f\x6b\x1d\xff\x4d\x6a\xa1\x01\x00")
 
 func fetchingHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func fetchingHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "fetching.html", size: 104000, mode: os.FileMode(436), modTime: time.Unix(1601413024, 0)}
+	info := bindataFileInfo{name: "fetching.html", size: 106858, mode: os.FileMode(436), modTime: time.Unix(1792196743, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
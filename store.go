@@ -3,23 +3,23 @@ package main
 import (
 	"context"
 	"errors"
-	"fmt"
-	"github.com/ipfs/go-cid"
 	powergate "github.com/textileio/powergate/api/client"
-	"github.com/textileio/powergate/ffs"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"os"
 	"os/signal"
+	"time"
 )
 
 type Store struct {
-	IPFSReverseProxy string `long:"ipfsreverseproxy" description:"An IPFS reverse proxy address if needed." default:"127.0.0.1:6002"`
-	PowergateAPI     string `short:"p" long:"powergateapi" description:"The hostname:port of the Powergate API." default:"127.0.0.1:5002"`
-	PowergateToken   string `long:"powergatetoken" description:"An authentication token for powergate if needed." default:""`
-	DbAPI            string `long:"db" default:"localhost:27017"`
-	Cid              string `short:"c" long:"cid" description:"The CID of a previously staged directly that you want to store in filecoin."`
+	IPFSReverseProxy string        `long:"ipfsreverseproxy" description:"An IPFS reverse proxy address if needed." default:"127.0.0.1:6002"`
+	PowergateAPI     string        `short:"p" long:"powergateapi" description:"The hostname:port of the Powergate API." default:"127.0.0.1:5002"`
+	PowergateToken   string        `long:"powergatetoken" description:"An authentication token for powergate if needed." default:""`
+	DbBackend        string        `long:"dbbackend" description:"The metadata store to use." choice:"mongo" choice:"bolt" default:"mongo"`
+	DbAPI            string        `long:"db" default:"localhost:27017"`
+	DbPath           string        `long:"dbpath" description:"The path to the database file for the bolt metadata store." default:"amzn.db"`
+	Cid              string        `short:"c" long:"cid" description:"The CID of a previously staged directly that you want to store in filecoin. If omitted, only previously pushed jobs are tracked."`
+	RetryBackoff     time.Duration `long:"retrybackoff" description:"How long to wait before retrying a failed storage job. Doubles with each attempt." default:"1m"`
+	MaxRetryBackoff  time.Duration `long:"maxretrybackoff" description:"The maximum time to wait before retrying a failed storage job." default:"1h"`
+	MaxAttempts      int           `long:"maxattempts" description:"The maximum number of times to push the storage config for a bucket." default:"5"`
 }
 
 func (x *Store) Execute(args []string) error {
@@ -29,80 +29,35 @@ func (x *Store) Execute(args []string) error {
 	}
 	defer client.Close()
 
-	dbClient, err := mongo.Connect(context.Background(), options.Client().ApplyURI(fmt.Sprintf("mongodb://%s", x.DbAPI)))
+	db, err := openMetadataStore(x.DbBackend, x.DbAPI, x.DbPath, true)
 	if err != nil {
 		return err
 	}
-	defer dbClient.Disconnect(context.Background())
+	defer db.Close()
 
-	collection := dbClient.Database("filemapdb").Collection("files")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	var (
-		dir    Dir
-		events = make(chan powergate.JobEvent)
-	)
-	filter := bson.D{{"rootcid", x.Cid}}
-
-	if err := collection.FindOne(context.TODO(), filter).Decode(&dir); err != nil {
+	tracker := newJobTracker(ctx, client.FFS, db, x.PowergateToken, x.RetryBackoff, x.MaxRetryBackoff, x.MaxAttempts)
+	if err := tracker.Resume(); err != nil {
 		return err
 	}
 
-	if len(dir.Buckets) == 0 {
-		return errors.New("no buckets found for CID")
-	}
-
-	for _, b := range dir.Buckets {
-		id, err := cid.Decode(b)
-		if err != nil {
-			return err
-		}
-
-		ctx := context.WithValue(context.Background(), powergate.AuthKey, x.PowergateToken)
-		jobID, err := client.FFS.PushStorageConfig(ctx, id, powergate.WithOverride(true))
+	if x.Cid != "" {
+		dir, err := db.GetDir(x.Cid)
 		if err != nil {
 			return err
 		}
-
-		dir.Jobs[jobID.String()] = ffs.Job{
-			ID:  jobID,
-			Cid: id,
-		}
-
-		update := bson.M{
-			"$set": bson.M{
-				"Jobs": dir.Jobs,
-			},
-		}
-		fmt.Println(dir.Jobs)
-
-		if _, err := collection.UpdateOne(context.TODO(), filter, update); err != nil {
-			return err
+		if len(dir.Buckets) == 0 {
+			return errors.New("no buckets found for CID")
 		}
-		if err := client.FFS.WatchJobs(context.TODO(), events, jobID); err != nil {
+		if err := tracker.Store(dir.RootCID); err != nil {
 			return err
 		}
 	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, os.Kill)
-	for {
-		select {
-		case e := <-events:
-			dir.Jobs[e.Job.ID.String()] = e.Job
-			update := bson.D{
-				{"$inc", bson.D{
-					{"Jobs", dir.Jobs},
-				}},
-			}
-			if _, err := collection.UpdateOne(context.TODO(), filter, update); err != nil {
-				return err
-			}
-			log.Info("Job %s: Cid %s: Status Update: %s", e.Job.ID.String(), e.Job.Cid.String(), e.Job.Status)
-
-			// TODO: handle failure and retry.
-		case <-c:
-			close(events)
-			os.Exit(1)
-		}
-	}
+	<-c
+	return nil
 }
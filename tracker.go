@@ -0,0 +1,377 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-cid"
+	powergate "github.com/textileio/powergate/api/client"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/rpc"
+	"strings"
+	"sync"
+	"time"
+)
+
+// jobTracker follows the Filecoin storage jobs of every staged directory. Job
+// state lives in the metadata store so that tracking can be resumed after a
+// restart, and buckets whose jobs fail or are canceled have their storage
+// config pushed again with an exponential backoff until maxAttempts is reached.
+//
+// Buckets can be shared by several staged directories, so jobs are tracked by
+// bucket: each bucket has a single job, watcher and retry schedule, and every
+// update is written to each directory that references the bucket. Pushing a
+// bucket twice would make Powergate cancel the first job.
+type jobTracker struct {
+	client      storageClient
+	db          MetadataStore
+	token       string
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+
+	ctx      context.Context
+	dirs     map[string]*Dir
+	jobs     map[string]BucketJob
+	retrying map[string]bool
+	mtx      sync.Mutex
+}
+
+func newJobTracker(ctx context.Context, client storageClient, db MetadataStore, token string, backoff, maxBackoff time.Duration, maxAttempts int) *jobTracker {
+	return &jobTracker{
+		client:      client,
+		db:          db,
+		token:       token,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+		maxAttempts: maxAttempts,
+		ctx:         ctx,
+		dirs:        make(map[string]*Dir),
+		jobs:        make(map[string]BucketJob),
+		retrying:    make(map[string]bool),
+	}
+}
+
+// storageClient is the part of the Powergate FFS API used to store buckets.
+type storageClient interface {
+	Show(ctx context.Context, c cid.Cid) (*rpc.ShowResponse, error)
+	PushStorageConfig(ctx context.Context, c cid.Cid, opts ...powergate.PushStorageConfigOption) (ffs.JobID, error)
+	WatchJobs(ctx context.Context, ch chan<- powergate.JobEvent, jids ...ffs.JobID) error
+}
+
+// Resume loads every staged directory from the metadata store, watches the
+// jobs that are still pending and schedules retries for failed buckets.
+func (t *jobTracker) Resume() error {
+	dirs, err := t.db.ListDirs()
+	if err != nil {
+		return err
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	var buckets []string
+	for i := range dirs {
+		dir := &dirs[i]
+		if dir.Jobs == nil {
+			dir.Jobs = make(map[string]BucketJob)
+		}
+		t.dirs[dir.RootCID] = dir
+		buckets = appendMissing(buckets, dir.Buckets...)
+	}
+
+	for _, bucket := range buckets {
+		job, ok := t.latestJob(bucket)
+		if !ok {
+			if job, ok = t.recover(bucket); !ok {
+				continue
+			}
+		} else if err := t.setJob(bucket, job); err != nil {
+			log.Errorf("Error saving job %s: %s", job.JobID, err)
+		}
+		switch {
+		case job.Succeeded():
+		case job.Failed():
+			t.scheduleRetry(bucket, job.Attempts)
+		default:
+			log.Infof("Resuming job %s for bucket %s", job.JobID, bucket)
+			t.watch(bucket, ffs.JobID(job.JobID))
+		}
+	}
+	return nil
+}
+
+// latestJob picks the job for a bucket from those saved in the directories
+// referencing it, which can differ for records written before jobs were
+// tracked by bucket. A successful job wins, then the most recently updated.
+// It must be called with the lock held.
+func (t *jobTracker) latestJob(bucket string) (BucketJob, bool) {
+	var (
+		latest BucketJob
+		found  bool
+	)
+	for _, dir := range t.dirs {
+		job, ok := dir.Jobs[bucket]
+		if !ok {
+			continue
+		}
+		if !found || (job.Succeeded() && !latest.Succeeded()) ||
+			(job.Succeeded() == latest.Succeeded() && job.Updated.After(latest.Updated)) {
+			latest, found = job, true
+		}
+	}
+	return latest, found
+}
+
+// recover looks up the job Powergate has for a bucket that has no job in the
+// metadata store, such as one dropped while migrating an older record. Buckets
+// that were never pushed have no job. It must be called with the lock held.
+func (t *jobTracker) recover(bucket string) (BucketJob, bool) {
+	id, err := cid.Decode(bucket)
+	if err != nil {
+		return BucketJob{}, false
+	}
+	resp, err := t.client.Show(t.authCtx(t.ctx), id)
+	if err != nil || resp.CidInfo == nil || resp.CidInfo.JobId == "" {
+		return BucketJob{}, false
+	}
+
+	now := time.Now()
+	job := BucketJob{
+		JobID:    resp.CidInfo.JobId,
+		Status:   ffs.JobStatusStr[ffs.Unspecified],
+		Attempts: 1,
+		Created:  now,
+		Updated:  now,
+	}
+	if err := t.setJob(bucket, job); err != nil {
+		log.Errorf("Error saving job %s: %s", job.JobID, err)
+	}
+	log.Infof("Recovered job %s for bucket %s", job.JobID, bucket)
+	return job, true
+}
+
+// Store pushes the storage config for every bucket of the staged directory
+// with the given root CID and starts tracking the resulting jobs. Buckets that
+// are already stored, have a job in progress or are waiting for a retry, such
+// as those shared with an earlier staging, are left alone.
+func (t *jobTracker) Store(rootCID string) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	dir, ok := t.dirs[rootCID]
+	if !ok {
+		return ErrNotFound
+	}
+	for _, bucket := range dir.Buckets {
+		if job, ok := t.jobs[bucket]; (ok && !job.Failed()) || t.retrying[bucket] {
+			continue
+		}
+		if err := t.push(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// push must be called with the lock held.
+func (t *jobTracker) push(bucket string) error {
+	id, err := cid.Decode(bucket)
+	if err != nil {
+		return err
+	}
+
+	jobID, err := t.client.PushStorageConfig(t.authCtx(t.ctx), id, powergate.WithOverride(true))
+	if err != nil {
+		return err
+	}
+	log.Infof("Pushed storage config for bucket %s: Job %s", bucket, jobID)
+
+	now := time.Now()
+	job := BucketJob{
+		JobID:    jobID.String(),
+		Status:   ffs.JobStatusStr[ffs.Queued],
+		Attempts: t.jobs[bucket].Attempts + 1,
+		Created:  now,
+		Updated:  now,
+	}
+	if err := t.setJob(bucket, job); err != nil {
+		return err
+	}
+	t.watch(bucket, jobID)
+	return nil
+}
+
+// setJob records the job of a bucket in every directory that references the
+// bucket. It must be called with the lock held.
+func (t *jobTracker) setJob(bucket string, job BucketJob) error {
+	t.jobs[bucket] = job
+	var firstErr error
+	for _, dir := range t.dirs {
+		if !contains(dir.Buckets, bucket) {
+			continue
+		}
+		if cur, ok := dir.Jobs[bucket]; ok && cur.JobID == job.JobID && cur.Updated.Equal(job.Updated) {
+			continue
+		}
+		dir.Jobs[bucket] = job
+		if err := t.db.UpdateJob(dir.RootCID, bucket, job); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// watch subscribes to updates for the job until it reaches a final state. If
+// the subscription breaks or ends early it is re-established after the backoff.
+func (t *jobTracker) watch(bucket string, jobID ffs.JobID) {
+	ctx, cancel := context.WithCancel(t.ctx)
+	events := make(chan powergate.JobEvent)
+	if err := t.client.WatchJobs(t.authCtx(ctx), events, jobID); err != nil {
+		cancel()
+		log.Errorf("Error watching job %s: %s", jobID, err)
+		t.after(t.backoff, func() { t.watch(bucket, jobID) })
+		return
+	}
+
+	go func() {
+		defer func() {
+			cancel()
+			for range events {
+			}
+		}()
+		for e := range events {
+			if e.Err != nil {
+				log.Errorf("Error watching job %s: %s", jobID, e.Err)
+				t.after(t.backoff, func() { t.watch(bucket, jobID) })
+				return
+			}
+			if t.update(bucket, e.Job) {
+				return
+			}
+		}
+		if t.ctx.Err() == nil {
+			log.Errorf("Error watching job %s: updates stopped", jobID)
+			t.after(t.backoff, func() { t.watch(bucket, jobID) })
+		}
+	}()
+}
+
+// update records the new state of a job and reports whether tracking of the
+// job is finished, either because it reached a final state or was superseded.
+func (t *jobTracker) update(bucket string, e ffs.Job) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	job, ok := t.jobs[bucket]
+	if !ok || job.JobID != e.ID.String() {
+		return true
+	}
+
+	job.Status = ffs.JobStatusStr[e.Status]
+	job.Error = jobError(e)
+	job.Updated = time.Now()
+	if e.Status == ffs.Success {
+		job.Deals = t.deals(e.Cid)
+	}
+	if err := t.setJob(bucket, job); err != nil {
+		log.Errorf("Error saving job %s: %s", job.JobID, err)
+	}
+	log.Infof("Job %s: Cid %s: Status Update: %s", job.JobID, bucket, job.Status)
+
+	switch {
+	case job.Succeeded():
+		return true
+	case job.Failed():
+		log.Errorf("Job %s failed: %s", job.JobID, job.Error)
+		t.scheduleRetry(bucket, job.Attempts)
+		return true
+	}
+	return false
+}
+
+// deals returns the proposal CIDs of the deals Powergate made for the bucket.
+func (t *jobTracker) deals(bucket cid.Cid) []string {
+	resp, err := t.client.Show(t.authCtx(t.ctx), bucket)
+	if err != nil {
+		log.Errorf("Error loading deals for bucket %s: %s", bucket, err)
+		return nil
+	}
+	if resp.CidInfo == nil || resp.CidInfo.Cold == nil || resp.CidInfo.Cold.Filecoin == nil {
+		return nil
+	}
+	var deals []string
+	for _, p := range resp.CidInfo.Cold.Filecoin.Proposals {
+		deals = append(deals, p.ProposalCid)
+	}
+	return deals
+}
+
+// scheduleRetry pushes the storage config for the bucket again once the backoff
+// for the given number of attempts has passed. Only one retry is scheduled per
+// bucket at a time. It must be called with the lock held.
+func (t *jobTracker) scheduleRetry(bucket string, attempts int) {
+	if attempts >= t.maxAttempts {
+		log.Errorf("Giving up on bucket %s after %d attempts", bucket, attempts)
+		return
+	}
+	if t.retrying[bucket] {
+		return
+	}
+	delay := t.retryDelay(attempts)
+	log.Infof("Retrying bucket %s in %s", bucket, delay)
+	t.retrying[bucket] = true
+	t.after(delay, func() {
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+
+		delete(t.retrying, bucket)
+		if !t.jobs[bucket].Failed() {
+			return
+		}
+		if err := t.push(bucket); err != nil {
+			log.Errorf("Error pushing storage config for bucket %s: %s", bucket, err)
+			t.scheduleRetry(bucket, attempts+1)
+		}
+	})
+}
+
+// retryDelay returns how long to wait before pushing a bucket again after the
+// given number of attempts. The delay doubles with every attempt up to the
+// maximum backoff.
+func (t *jobTracker) retryDelay(attempts int) time.Duration {
+	delay := t.backoff
+	for i := 1; i < attempts && delay < t.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > t.maxBackoff {
+		delay = t.maxBackoff
+	}
+	return delay
+}
+
+// after runs fn once the delay has passed unless the tracker is stopped first.
+func (t *jobTracker) after(delay time.Duration, fn func()) {
+	go func() {
+		select {
+		case <-time.After(delay):
+			fn()
+		case <-t.ctx.Done():
+		}
+	}()
+}
+
+func (t *jobTracker) authCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, powergate.AuthKey, t.token)
+}
+
+// jobError describes why a job failed, including the errors of its deals.
+func jobError(job ffs.Job) string {
+	var msgs []string
+	if job.ErrCause != "" {
+		msgs = append(msgs, job.ErrCause)
+	}
+	for _, de := range job.DealErrors {
+		msgs = append(msgs, fmt.Sprintf("deal %s with %s: %s", de.ProposalCid, de.Miner, de.Message))
+	}
+	return strings.Join(msgs, "; ")
+}
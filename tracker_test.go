@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-cid"
+	powergate "github.com/textileio/powergate/api/client"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/rpc"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeStorage is a storageClient that records pushes and lets tests drive the
+// jobs being watched.
+type fakeStorage struct {
+	mtx      sync.Mutex
+	pushes   []string
+	jobs     map[ffs.JobID]cid.Cid
+	watches  map[ffs.JobID]int
+	watchers map[ffs.JobID]chan<- powergate.JobEvent
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{
+		jobs:     make(map[ffs.JobID]cid.Cid),
+		watches:  make(map[ffs.JobID]int),
+		watchers: make(map[ffs.JobID]chan<- powergate.JobEvent),
+	}
+}
+
+func (f *fakeStorage) Show(ctx context.Context, c cid.Cid) (*rpc.ShowResponse, error) {
+	return &rpc.ShowResponse{CidInfo: &rpc.CidInfo{
+		Cold: &rpc.ColdInfo{Filecoin: &rpc.FilInfo{
+			Proposals: []*rpc.FilStorage{{ProposalCid: "deal-" + c.String()}},
+		}},
+	}}, nil
+}
+
+func (f *fakeStorage) PushStorageConfig(ctx context.Context, c cid.Cid, opts ...powergate.PushStorageConfigOption) (ffs.JobID, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.pushes = append(f.pushes, c.String())
+	id := ffs.JobID(fmt.Sprintf("job%d", len(f.pushes)))
+	f.jobs[id] = c
+	return id, nil
+}
+
+// WatchJobs closes the channel once ctx is canceled, like the real client.
+func (f *fakeStorage) WatchJobs(ctx context.Context, ch chan<- powergate.JobEvent, jids ...ffs.JobID) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	id := jids[0]
+	f.watches[id]++
+	f.watchers[id] = ch
+	go func() {
+		<-ctx.Done()
+		f.closeWatch(id, ch)
+	}()
+	return nil
+}
+
+// send reports a new status of the job to its watcher.
+func (f *fakeStorage) send(id ffs.JobID, status ffs.JobStatus) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if ch, ok := f.watchers[id]; ok {
+		ch <- powergate.JobEvent{Job: ffs.Job{ID: id, Cid: f.jobs[id], Status: status}}
+	}
+}
+
+// closeWatch ends the stream of updates for the job.
+func (f *fakeStorage) closeWatch(id ffs.JobID, ch chan<- powergate.JobEvent) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if cur, ok := f.watchers[id]; ok && cur == ch {
+		delete(f.watchers, id)
+		close(ch)
+	}
+}
+
+func (f *fakeStorage) watching(id ffs.JobID) bool {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	_, ok := f.watchers[id]
+	return ok
+}
+
+func (f *fakeStorage) pushCount() int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return len(f.pushes)
+}
+
+func (f *fakeStorage) watchCount(id ffs.JobID) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.watches[id]
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func testBuckets(t *testing.T) (string, string) {
+	b1, err := cid.Decode("QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := cid.Decode("QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b1.String(), b2.String()
+}
+
+func newTestTracker(t *testing.T, db MetadataStore, client storageClient, backoff time.Duration, maxAttempts int) *jobTracker {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	tracker := newJobTracker(ctx, client, db, "", backoff, time.Hour, maxAttempts)
+	if err := tracker.Resume(); err != nil {
+		t.Fatal(err)
+	}
+	return tracker
+}
+
+func TestJobTrackerSharedBuckets(t *testing.T) {
+	b1, b2 := testBuckets(t)
+	db := newTestBoltStore(t)
+	for _, dir := range []Dir{
+		{RootCID: "R", Buckets: []string{b1, b2}, Jobs: map[string]BucketJob{}},
+		{RootCID: "S", Buckets: []string{b2}, Jobs: map[string]BucketJob{}},
+	} {
+		if err := db.PutDir(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := newFakeStorage()
+	tracker := newTestTracker(t, db, client, time.Hour, 5)
+
+	if err := tracker.Store("R"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracker.Store("S"); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.pushCount(); n != 2 {
+		t.Fatalf("got %d pushes, want 2: %v", n, client.pushes)
+	}
+
+	// The job of the shared bucket is recorded in both directories.
+	client.send("job2", ffs.Success)
+	waitFor(t, "job of shared bucket to succeed", func() bool {
+		for _, root := range []string{"R", "S"} {
+			if dir, err := db.GetDir(root); err != nil || !dir.Jobs[b2].Succeeded() {
+				return false
+			}
+		}
+		return true
+	})
+	dir, err := db.GetDir("R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	job := dir.Jobs[b2]
+	if job.JobID != "job2" || !job.Succeeded() || len(job.Deals) != 1 || job.Deals[0] != "deal-"+b2 {
+		t.Errorf("job of shared bucket in R: got %+v", job)
+	}
+	if job := dir.Jobs[b1]; job.JobID != "job1" || job.Succeeded() {
+		t.Errorf("job of other bucket in R: got %+v", job)
+	}
+	waitFor(t, "watch of finished job to end", func() bool { return !client.watching("job2") })
+}
+
+func TestJobTrackerRetry(t *testing.T) {
+	b1, _ := testBuckets(t)
+	db := newTestBoltStore(t)
+	if err := db.PutDir(Dir{RootCID: "R", Buckets: []string{b1}, Jobs: map[string]BucketJob{}}); err != nil {
+		t.Fatal(err)
+	}
+	client := newFakeStorage()
+	tracker := newTestTracker(t, db, client, 10*time.Millisecond, 2)
+
+	if err := tracker.Store("R"); err != nil {
+		t.Fatal(err)
+	}
+	client.send("job1", ffs.Failed)
+	waitFor(t, "failed bucket to be pushed again", func() bool {
+		dir, err := db.GetDir("R")
+		return err == nil && dir.Jobs[b1].JobID == "job2" && client.watching("job2")
+	})
+	dir, err := db.GetDir("R")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job := dir.Jobs[b1]; job.Attempts != 2 || client.pushCount() != 2 {
+		t.Errorf("retried job: got %+v after %d pushes", job, client.pushCount())
+	}
+
+	// Storing again while the retry is in progress doesn't push it again.
+	if err := tracker.Store("R"); err != nil {
+		t.Fatal(err)
+	}
+	// The last attempt failing gives up on the bucket.
+	client.send("job2", ffs.Canceled)
+	waitFor(t, "failed job to be saved", func() bool {
+		dir, err := db.GetDir("R")
+		return err == nil && dir.Jobs[b1].Failed()
+	})
+	time.Sleep(50 * time.Millisecond)
+	if n := client.pushCount(); n != 2 {
+		t.Errorf("got %d pushes after giving up, want 2", n)
+	}
+}
+
+func TestJobTrackerRewatch(t *testing.T) {
+	b1, _ := testBuckets(t)
+	db := newTestBoltStore(t)
+	if err := db.PutDir(Dir{RootCID: "R", Buckets: []string{b1}, Jobs: map[string]BucketJob{}}); err != nil {
+		t.Fatal(err)
+	}
+	client := newFakeStorage()
+	tracker := newTestTracker(t, db, client, 10*time.Millisecond, 5)
+
+	if err := tracker.Store("R"); err != nil {
+		t.Fatal(err)
+	}
+	client.send("job1", ffs.Executing)
+	client.mtx.Lock()
+	ch := client.watchers["job1"]
+	client.mtx.Unlock()
+	client.closeWatch("job1", ch)
+	waitFor(t, "job to be watched again", func() bool { return client.watchCount("job1") == 2 })
+
+	client.send("job1", ffs.Success)
+	waitFor(t, "job to succeed", func() bool {
+		dir, err := db.GetDir("R")
+		return err == nil && dir.Jobs[b1].Succeeded()
+	})
+	if n := client.pushCount(); n != 1 {
+		t.Errorf("got %d pushes, want 1", n)
+	}
+}
+
+func TestJobTrackerResume(t *testing.T) {
+	b1, b2 := testBuckets(t)
+	db := newTestBoltStore(t)
+	now := time.Now()
+	for _, dir := range []Dir{
+		{RootCID: "R", Buckets: []string{b1, b2}, Jobs: map[string]BucketJob{
+			b1: {JobID: "old", Status: "Executing", Attempts: 1, Updated: now},
+			b2: {JobID: "done", Status: "Success", Attempts: 1, Updated: now.Add(-time.Hour)},
+		}},
+		{RootCID: "S", Buckets: []string{b2}, Jobs: map[string]BucketJob{
+			b2: {JobID: "later", Status: "Failed", Attempts: 1, Updated: now},
+		}},
+	} {
+		if err := db.PutDir(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := newFakeStorage()
+	newTestTracker(t, db, client, 10*time.Millisecond, 5)
+
+	// The pending job is watched again and the successful job of the shared
+	// bucket wins over the failed one, which isn't retried.
+	waitFor(t, "pending job to be watched", func() bool { return client.watchCount("old") == 1 })
+	dir, err := db.GetDir("S")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job := dir.Jobs[b2]; job.JobID != "done" {
+		t.Errorf("job of shared bucket in S: got %+v", job)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if n := client.pushCount(); n != 0 {
+		t.Errorf("got %d pushes, want 0", n)
+	}
+}
+
+func TestJobTrackerRetryDelay(t *testing.T) {
+	tracker := &jobTracker{backoff: time.Minute, maxBackoff: 10 * time.Minute}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{200, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := tracker.retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}